@@ -0,0 +1,83 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// AccessControlPolicyKind is the kind of the AccessControlPolicy resource.
+const AccessControlPolicyKind = "AccessControlPolicy"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway,shortName=acp
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AccessControlPolicy allows or denies the access of the clients to a Gateway, a listener of a Gateway or
+// an HTTPRoute based on their IP addresses. NGINX rejects the requests of the denied clients with the 403 status code.
+// A policy for a listener overrides a policy for the Gateway, and a policy for an HTTPRoute overrides both.
+type AccessControlPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the AccessControlPolicy.
+	Spec AccessControlPolicySpec `json:"spec"`
+
+	// Status defines the state of the AccessControlPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// GetTargetRef returns the reference to the target resource of the AccessControlPolicy.
+func (p *AccessControlPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// SetPolicyStatus sets the status of the AccessControlPolicy.
+func (p *AccessControlPolicy) SetPolicyStatus(status PolicyStatus) {
+	p.Status = status
+}
+
+// +kubebuilder:object:root=true
+
+// AccessControlPolicyList contains a list of AccessControlPolicies.
+type AccessControlPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessControlPolicy `json:"items"`
+}
+
+// AccessControlPolicySpec defines the desired state of the AccessControlPolicy.
+//
+// +kubebuilder:validation:XValidation:message="sectionName can only be set for a Gateway",rule="!has(self.sectionName) || self.targetRef.kind == 'Gateway'"
+// +kubebuilder:validation:XValidation:message="at least one of allow and deny must be set",rule="(has(self.allow) && size(self.allow) > 0) || (has(self.deny) && size(self.deny) > 0)"
+type AccessControlPolicySpec struct {
+	// TargetRef identifies the API object to apply the policy to.
+	// The object must be a Gateway or an HTTPRoute in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be Gateway or HTTPRoute",rule="(self.kind == 'Gateway' || self.kind == 'HTTPRoute') && self.group == 'gateway.networking.k8s.io'"
+	TargetRef v1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// SectionName is the name of the listener of the target Gateway to apply the policy to.
+	// If not set, the policy applies to all listeners of the Gateway.
+	//
+	// +optional
+	SectionName *v1beta1.SectionName `json:"sectionName,omitempty"`
+
+	// Allow are the IP addresses or the CIDR blocks of the clients that can access the target.
+	// If set, NGINX denies the access to all other clients.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=set
+	Allow []string `json:"allow,omitempty"`
+
+	// Deny are the IP addresses or the CIDR blocks of the clients that cannot access the target,
+	// even if Allow includes them.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=set
+	Deny []string `json:"deny,omitempty"`
+}
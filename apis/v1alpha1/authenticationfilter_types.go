@@ -0,0 +1,187 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AuthenticationFilterKind is the kind of the AuthenticationFilter resource.
+const AuthenticationFilterKind = "AuthenticationFilter"
+
+const (
+	// HtpasswdSecretKey is the key of the htpasswd file in the Secret of a basic authentication.
+	HtpasswdSecretKey = "auth"
+	// JWKSKey is the key of the JSON Web Key Set in the Secret or the ConfigMap of a JWT validation.
+	JWKSKey = "jwks"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:categories=nginx-gateway,shortName=authfilter
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AuthenticationFilter configures the authentication of the requests that match the rules of an HTTPRoute.
+// A rule references the filter in an ExtensionRef filter. The filter must be in the namespace of the HTTPRoute.
+// NGINX rejects the requests that fail the authentication with the 401 status code or, for external
+// authorization, with the 401 or 403 status code of the authorization service.
+type AuthenticationFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the AuthenticationFilter.
+	Spec AuthenticationFilterSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// AuthenticationFilterList contains a list of AuthenticationFilters.
+type AuthenticationFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AuthenticationFilter `json:"items"`
+}
+
+// AuthenticationFilterSpec defines the desired state of the AuthenticationFilter.
+//
+// +kubebuilder:validation:XValidation:message="basic is required for the Basic type and forbidden otherwise",rule="self.type == 'Basic' ? has(self.basic) : !has(self.basic)"
+// +kubebuilder:validation:XValidation:message="jwt is required for the JWT type and forbidden otherwise",rule="self.type == 'JWT' ? has(self.jwt) : !has(self.jwt)"
+// +kubebuilder:validation:XValidation:message="external is required for the External type and forbidden otherwise",rule="self.type == 'External' ? has(self.external) : !has(self.external)"
+type AuthenticationFilterSpec struct {
+	// Basic configures HTTP basic authentication.
+	//
+	// +optional
+	Basic *BasicAuthentication `json:"basic,omitempty"`
+
+	// JWT configures the validation of JSON Web Tokens in the Authorization header of the requests.
+	//
+	// +optional
+	JWT *JWTAuthentication `json:"jwt,omitempty"`
+
+	// External configures the authorization of the requests by an external authorization service.
+	//
+	// +optional
+	External *ExternalAuthentication `json:"external,omitempty"`
+
+	// Type is the type of the authentication.
+	Type AuthenticationType `json:"type"`
+}
+
+// AuthenticationType is the type of the authentication.
+//
+// +kubebuilder:validation:Enum=Basic;JWT;External
+type AuthenticationType string
+
+const (
+	// AuthenticationTypeBasic authenticates the requests with HTTP basic authentication.
+	AuthenticationTypeBasic AuthenticationType = "Basic"
+	// AuthenticationTypeJWT authenticates the requests with JSON Web Tokens.
+	AuthenticationTypeJWT AuthenticationType = "JWT"
+	// AuthenticationTypeExternal authorizes the requests with an external authorization service.
+	AuthenticationTypeExternal AuthenticationType = "External"
+)
+
+// BasicAuthentication configures HTTP basic authentication.
+type BasicAuthentication struct {
+	// Realm is the realm of the authentication, which the clients show to the users.
+	// If not set, Restricted is used.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	Realm *string `json:"realm,omitempty"`
+
+	// SecretRef references the Secret with the users and their passwords in the htpasswd format
+	// under the auth key. The Secret must be in the namespace of the filter.
+	SecretRef LocalObjectReference `json:"secretRef"`
+}
+
+// JWTAuthentication configures the validation of JSON Web Tokens.
+// A token is valid if it is signed with one of the keys of the JSON Web Key Set, it is not expired and
+// it includes the required claims.
+type JWTAuthentication struct {
+	// Realm is the realm of the authentication, which NGINX returns in the WWW-Authenticate header.
+	// If not set, Restricted is used.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	Realm *string `json:"realm,omitempty"`
+
+	// RequiredClaims are the claims that a token must include with the exact values.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxProperties=16
+	RequiredClaims map[string]string `json:"requiredClaims,omitempty"`
+
+	// JWKS configures the source of the JSON Web Key Set that NGINX uses to validate the signatures of the tokens.
+	JWKS JWKSSource `json:"jwks"`
+}
+
+// JWKSSource references the Secret or the ConfigMap with a JSON Web Key Set under the jwks key.
+// The resource must be in the namespace of the filter.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of secretRef and configMapRef must be set",rule="has(self.secretRef) != has(self.configMapRef)"
+type JWKSSource struct {
+	// SecretRef references a Secret.
+	//
+	// +optional
+	SecretRef *LocalObjectReference `json:"secretRef,omitempty"`
+
+	// ConfigMapRef references a ConfigMap.
+	//
+	// +optional
+	ConfigMapRef *LocalObjectReference `json:"configMapRef,omitempty"`
+}
+
+// ExternalAuthentication configures the authorization of the requests by an external authorization service.
+// For every request, NGINX sends a subrequest without the body to the service. If the service responds with
+// a 2xx status code, NGINX proxies the request to the backends. If the service responds with 401 or 403,
+// NGINX responds to the client with the same status code. Any other status code results in 500.
+type ExternalAuthentication struct {
+	// Path is the path of the subrequests. NGINX sends the original URI and method of a request
+	// in the X-Original-URI and X-Original-Method headers of its subrequest.
+	// If not set, / is used.
+	//
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// BackendRef references the Service of the authorization service. The Service must be in the namespace
+	// of the filter.
+	BackendRef ServiceReference `json:"backendRef"`
+
+	// RequestHeaders are the headers of the requests that NGINX forwards to the authorization service.
+	// NGINX doesn't forward any other headers of the requests.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	RequestHeaders []string `json:"requestHeaders,omitempty"`
+
+	// ResponseHeaders are the headers of the successful responses of the authorization service that NGINX
+	// adds to the requests to the backends. NGINX removes these headers from the requests of the clients.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	ResponseHeaders []string `json:"responseHeaders,omitempty"`
+}
+
+// ServiceReference references a port of a Service in the same namespace.
+type ServiceReference struct {
+	// Name is the name of the Service.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Port is the port of the Service.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
+
+// LocalObjectReference references a resource in the same namespace.
+type LocalObjectReference struct {
+	// Name is the name of the resource.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+}
@@ -0,0 +1,199 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// CachePolicyKind is the kind of the CachePolicy resource.
+const CachePolicyKind = "CachePolicy"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway,shortName=cachepolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// CachePolicy configures the caching of the responses of the backends.
+// A policy for a Gateway declares the cache zones, where NGINX stores the cached responses.
+// A policy for an HTTPRoute enables the caching of the responses to the requests that match the rules of
+// the HTTPRoute in one of the zones of the Gateway.
+type CachePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the CachePolicy.
+	Spec CachePolicySpec `json:"spec"`
+
+	// Status defines the state of the CachePolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// GetTargetRef returns the reference to the target resource of the CachePolicy.
+func (p *CachePolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// SetPolicyStatus sets the status of the CachePolicy.
+func (p *CachePolicy) SetPolicyStatus(status PolicyStatus) {
+	p.Status = status
+}
+
+// +kubebuilder:object:root=true
+
+// CachePolicyList contains a list of CachePolicies.
+type CachePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CachePolicy `json:"items"`
+}
+
+// CachePolicySpec defines the desired state of the CachePolicy.
+//
+// +kubebuilder:validation:XValidation:message="zones must be set for a Gateway",rule="self.targetRef.kind != 'Gateway' || (has(self.zones) && !has(self.cache) && !has(self.ruleIndex))"
+// +kubebuilder:validation:XValidation:message="cache must be set for an HTTPRoute",rule="self.targetRef.kind != 'HTTPRoute' || (has(self.cache) && !has(self.zones))"
+type CachePolicySpec struct {
+	// TargetRef identifies the API object to apply the policy to.
+	// The object must be a Gateway or an HTTPRoute in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be Gateway or HTTPRoute",rule="(self.kind == 'Gateway' || self.kind == 'HTTPRoute') && self.group == 'gateway.networking.k8s.io'"
+	TargetRef v1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// RuleIndex is the index of the rule of the target HTTPRoute to apply the policy to.
+	// If not set, the policy applies to all rules of the HTTPRoute.
+	// A policy for a rule overrides a policy for the whole HTTPRoute.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RuleIndex *int32 `json:"ruleIndex,omitempty"`
+
+	// Zones are the cache zones of the target Gateway. Must be set if the target is a Gateway.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=name
+	Zones []CacheZone `json:"zones,omitempty"`
+
+	// Cache configures the caching for the target HTTPRoute. Must be set if the target is an HTTPRoute.
+	//
+	// +optional
+	Cache *CacheSettings `json:"cache,omitempty"`
+}
+
+// CacheZone is a cache zone: a directory with the cached responses and a shared memory zone with their keys.
+type CacheZone struct {
+	// Name is the name of the zone, which the CachePolicies of the HTTPRoutes reference.
+	//
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9_-]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Name string `json:"name"`
+
+	// KeysSize is the size of the shared memory zone with the keys of the cached responses.
+	// One megabyte zone can keep about 8 thousand keys.
+	KeysSize Size `json:"keysSize"`
+
+	// MaxSize limits the size of the cached responses on the disk. When the limit is reached, NGINX removes
+	// the least recently used responses. If not set, the size is not limited.
+	//
+	// +optional
+	MaxSize *Size `json:"maxSize,omitempty"`
+
+	// Inactive is the time after which NGINX removes a cached response that is not accessed, regardless of
+	// its freshness. If not set, NGINX uses 10m.
+	//
+	// +optional
+	Inactive *Duration `json:"inactive,omitempty"`
+}
+
+// CacheSettings configures the caching of the responses to the requests that match the rules of an HTTPRoute.
+type CacheSettings struct {
+	// Zone is the name of the cache zone of the Gateway to store the responses in.
+	//
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9_-]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=63
+	Zone string `json:"zone"`
+
+	// Key is the key of a cached response: a combination of NGINX variables and text.
+	// The supported variables are $remote_addr, $request_uri, $uri, $host, $scheme, and the variables with
+	// the prefixes $http_, $cookie_ and $arg_. If not set, the key is $scheme$host$request_uri.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=256
+	Key *string `json:"key,omitempty"`
+
+	// Valid are the times during which the responses with particular status codes stay fresh.
+	// The Cache-Control, Expires and X-Accel-Expires headers of the responses take precedence over Valid.
+	// If not set, NGINX only caches the responses with those headers.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Valid []CacheValid `json:"valid,omitempty"`
+
+	// Bypass are the conditions under which NGINX doesn't take a response from the cache. NGINX bypasses the cache
+	// if the value of at least one of the conditions is not empty and not 0.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Bypass []CacheBypassCondition `json:"bypass,omitempty"`
+
+	// StaleWhileRevalidate allows NGINX to respond with a stale cached response while it updates the response
+	// in the background.
+	//
+	// +optional
+	StaleWhileRevalidate *bool `json:"staleWhileRevalidate,omitempty"`
+
+	// StaleIfError allows NGINX to respond with a stale cached response if the backend is unavailable or responds
+	// with the 500, 502, 503 or 504 status code.
+	//
+	// +optional
+	StaleIfError *bool `json:"staleIfError,omitempty"`
+}
+
+// CacheValid is the time during which the responses with particular status codes stay fresh.
+type CacheValid struct {
+	// Codes are the status codes of the responses. If not set, the codes are 200, 301 and 302.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=set
+	Codes []CacheStatusCode `json:"codes,omitempty"`
+
+	// Duration is the time during which the responses stay fresh.
+	Duration Duration `json:"duration"`
+}
+
+// CacheStatusCode is the status code of a cached response.
+//
+// +kubebuilder:validation:Minimum=200
+// +kubebuilder:validation:Maximum=599
+type CacheStatusCode int32
+
+// CacheBypassCondition is a part of a request, whose value makes NGINX bypass the cache.
+//
+// +kubebuilder:validation:XValidation:message="name of a Cookie or a QueryParam cannot contain '-'",rule="self.type == 'Header' || !self.name.contains('-')"
+type CacheBypassCondition struct {
+	// Type is the type of the part of the request.
+	Type CacheBypassConditionType `json:"type"`
+
+	// Name is the name of the header, the cookie or the query parameter.
+	//
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_-]+$`
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+}
+
+// CacheBypassConditionType is the type of the part of a request in a CacheBypassCondition.
+//
+// +kubebuilder:validation:Enum=Header;Cookie;QueryParam
+type CacheBypassConditionType string
+
+const (
+	// CacheBypassConditionTypeHeader is a request header.
+	CacheBypassConditionTypeHeader CacheBypassConditionType = "Header"
+	// CacheBypassConditionTypeCookie is a cookie of the request.
+	CacheBypassConditionTypeCookie CacheBypassConditionType = "Cookie"
+	// CacheBypassConditionTypeQueryParam is a query parameter of the request.
+	CacheBypassConditionTypeQueryParam CacheBypassConditionType = "QueryParam"
+)
@@ -0,0 +1,136 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// ClientSettingsPolicyKind is the kind of the ClientSettingsPolicy resource.
+const ClientSettingsPolicyKind = "ClientSettingsPolicy"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway,shortName=csp
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClientSettingsPolicy configures how NGINX handles the connections and the requests of the clients of a Gateway
+// or an HTTPRoute. A policy for an HTTPRoute overrides the settings of a policy for the Gateway.
+type ClientSettingsPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ClientSettingsPolicy.
+	Spec ClientSettingsPolicySpec `json:"spec"`
+
+	// Status defines the state of the ClientSettingsPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// GetTargetRef returns the reference to the target resource of the ClientSettingsPolicy.
+func (p *ClientSettingsPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// SetPolicyStatus sets the status of the ClientSettingsPolicy.
+func (p *ClientSettingsPolicy) SetPolicyStatus(status PolicyStatus) {
+	p.Status = status
+}
+
+// +kubebuilder:object:root=true
+
+// ClientSettingsPolicyList contains a list of ClientSettingsPolicies.
+type ClientSettingsPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClientSettingsPolicy `json:"items"`
+}
+
+// ClientSettingsPolicySpec defines the desired state of the ClientSettingsPolicy.
+//
+// +kubebuilder:validation:XValidation:message="header can only be set for a Gateway",rule="!has(self.header) || self.targetRef.kind == 'Gateway'"
+type ClientSettingsPolicySpec struct {
+	// TargetRef identifies the API object to apply the policy to.
+	// The object must be a Gateway or an HTTPRoute in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be Gateway or HTTPRoute",rule="(self.kind == 'Gateway' || self.kind == 'HTTPRoute') && self.group == 'gateway.networking.k8s.io'"
+	TargetRef v1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// Body configures the handling of the request bodies.
+	//
+	// +optional
+	Body *ClientBody `json:"body,omitempty"`
+
+	// Header configures the handling of the request headers.
+	// It can only be set for a Gateway, because NGINX reads the headers before it picks an HTTPRoute.
+	//
+	// +optional
+	Header *ClientHeader `json:"header,omitempty"`
+
+	// KeepAlive configures the keep-alive connections of the clients.
+	//
+	// +optional
+	KeepAlive *ClientKeepAlive `json:"keepAlive,omitempty"`
+}
+
+// ClientBody configures the handling of the request bodies.
+type ClientBody struct {
+	// MaxSize is the maximum size of a request body. NGINX rejects the requests with larger bodies
+	// with the 413 status code. 0 disables the check. If not set, NGINX uses its default of 1m.
+	//
+	// +optional
+	MaxSize *Size `json:"maxSize,omitempty"`
+
+	// Timeout is the time during which a client must send the next part of a request body.
+	// NGINX rejects the request with the 408 status code when the time expires.
+	// If not set, NGINX uses its default of 60s.
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+}
+
+// ClientHeader configures the handling of the request headers.
+type ClientHeader struct {
+	// Timeout is the time during which a client must send the whole request header.
+	// NGINX rejects the request with the 408 status code when the time expires.
+	// If not set, NGINX uses its default of 60s.
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+}
+
+// ClientKeepAlive configures the keep-alive connections of the clients.
+type ClientKeepAlive struct {
+	// Requests is the maximum number of requests that can be served through one keep-alive connection.
+	// After the maximum number of requests is made, the connection is closed.
+	// If not set, NGINX uses its default of 1000.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Requests *int32 `json:"requests,omitempty"`
+
+	// Time limits the maximum time during which requests can be processed through one keep-alive connection.
+	// If not set, NGINX uses its default of 1h.
+	//
+	// +optional
+	Time *Duration `json:"time,omitempty"`
+
+	// Timeout configures the time during which an idle keep-alive connection stays open.
+	// If not set, NGINX uses its default of 75s.
+	//
+	// +optional
+	Timeout *ClientKeepAliveTimeout `json:"timeout,omitempty"`
+}
+
+// ClientKeepAliveTimeout configures the time during which an idle keep-alive connection of a client stays open.
+type ClientKeepAliveTimeout struct {
+	// Server is the time during which an idle keep-alive connection stays open on the server side.
+	// 0 disables the keep-alive connections.
+	Server Duration `json:"server"`
+
+	// Header is the value of the "Keep-Alive: timeout=<time>" response header.
+	// If not set, NGINX doesn't send the header.
+	//
+	// +optional
+	Header *Duration `json:"header,omitempty"`
+}
@@ -0,0 +1,102 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// CompressionPolicyKind is the kind of the CompressionPolicy resource.
+const CompressionPolicyKind = "CompressionPolicy"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway,shortName=cmpp
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// CompressionPolicy configures the compression of the responses to the clients of a Gateway or an HTTPRoute.
+// A policy for an HTTPRoute overrides the settings of a policy for the Gateway.
+type CompressionPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the CompressionPolicy.
+	Spec CompressionPolicySpec `json:"spec"`
+
+	// Status defines the state of the CompressionPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// GetTargetRef returns the reference to the target resource of the CompressionPolicy.
+func (p *CompressionPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// SetPolicyStatus sets the status of the CompressionPolicy.
+func (p *CompressionPolicy) SetPolicyStatus(status PolicyStatus) {
+	p.Status = status
+}
+
+// +kubebuilder:object:root=true
+
+// CompressionPolicyList contains a list of CompressionPolicies.
+type CompressionPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CompressionPolicy `json:"items"`
+}
+
+// CompressionPolicySpec defines the desired state of the CompressionPolicy.
+type CompressionPolicySpec struct {
+	// TargetRef identifies the API object to apply the policy to.
+	// The object must be a Gateway or an HTTPRoute in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be Gateway or HTTPRoute",rule="(self.kind == 'Gateway' || self.kind == 'HTTPRoute') && self.group == 'gateway.networking.k8s.io'"
+	TargetRef v1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// Gzip configures the gzip compression of the responses.
+	Gzip Gzip `json:"gzip"`
+}
+
+// Gzip configures the gzip compression of the responses.
+// The settings that a policy for an HTTPRoute doesn't set are inherited from the policy for the Gateway.
+type Gzip struct {
+	// Enable enables the compression. A policy for an HTTPRoute can disable the compression that a policy for
+	// the Gateway enables.
+	Enable bool `json:"enable"`
+
+	// Types are the MIME types of the responses to compress in addition to text/html, which NGINX always
+	// compresses. * matches any type. If not set, NGINX only compresses text/html.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=set
+	Types []MIMEType `json:"types,omitempty"`
+
+	// MinLength is the minimum length of a response to compress, which NGINX takes from the Content-Length
+	// header of the response. If not set, NGINX uses its default of 20.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MinLength *int32 `json:"minLength,omitempty"`
+
+	// Level is the compression level from 1 (the fastest) to 9 (the smallest responses).
+	// If not set, NGINX uses its default of 1.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9
+	Level *int32 `json:"level,omitempty"`
+
+	// Vary enables the "Vary: Accept-Encoding" response header, so that caches store the compressed and
+	// the uncompressed responses separately. If not set, NGINX doesn't add the header.
+	//
+	// +optional
+	Vary *bool `json:"vary,omitempty"`
+}
+
+// MIMEType is a MIME type without parameters. For example, application/json.
+//
+// +kubebuilder:validation:Pattern=`^(\*|[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]*/[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]*)$`
+// +kubebuilder:validation:MaxLength=127
+type MIMEType string
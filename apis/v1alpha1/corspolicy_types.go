@@ -0,0 +1,122 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// CORSPolicyKind is the kind of the CORSPolicy resource.
+const CORSPolicyKind = "CORSPolicy"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway,shortName=corspolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// CORSPolicy configures Cross-Origin Resource Sharing (CORS) for the requests that match the rules of an HTTPRoute.
+// NGINX responds to the preflight requests of the allowed origins itself, without proxying them to the backends,
+// and adds the CORS headers to the responses to the other requests of the allowed origins.
+type CORSPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the CORSPolicy.
+	Spec CORSPolicySpec `json:"spec"`
+
+	// Status defines the state of the CORSPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// GetTargetRef returns the reference to the target resource of the CORSPolicy.
+func (p *CORSPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// SetPolicyStatus sets the status of the CORSPolicy.
+func (p *CORSPolicy) SetPolicyStatus(status PolicyStatus) {
+	p.Status = status
+}
+
+// +kubebuilder:object:root=true
+
+// CORSPolicyList contains a list of CORSPolicies.
+type CORSPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CORSPolicy `json:"items"`
+}
+
+// CORSPolicySpec defines the desired state of the CORSPolicy.
+//
+// +kubebuilder:validation:XValidation:message="allowCredentials cannot be combined with the * origin",rule="!(has(self.allowCredentials) && self.allowCredentials) || !('*' in self.allowOrigins)"
+type CORSPolicySpec struct {
+	// TargetRef identifies the API object to apply the policy to.
+	// The object must be an HTTPRoute in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be HTTPRoute",rule="self.kind == 'HTTPRoute' && self.group == 'gateway.networking.k8s.io'"
+	TargetRef v1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// RuleIndex is the index of the rule of the HTTPRoute to apply the policy to.
+	// If not set, the policy applies to all rules of the HTTPRoute.
+	// A policy for a rule overrides a policy for the whole HTTPRoute.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RuleIndex *int32 `json:"ruleIndex,omitempty"`
+
+	// AllowOrigins are the origins that can access the resources, for example, https://example.com.
+	// The host of an origin can start with a wildcard label, for example, https://*.example.com, which matches
+	// the subdomains of example.com, but not example.com itself. * allows any origin.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=set
+	AllowOrigins []string `json:"allowOrigins"`
+
+	// AllowMethods are the methods that the origins can use in the requests.
+	// * allows any method. If not set, GET, HEAD and POST are allowed.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=set
+	AllowMethods []CORSMethod `json:"allowMethods,omitempty"`
+
+	// AllowHeaders are the headers that the origins can include in the requests.
+	// * allows any header. If not set, only the CORS-safelisted request headers are allowed.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=set
+	AllowHeaders []string `json:"allowHeaders,omitempty"`
+
+	// ExposeHeaders are the headers of the responses, in addition to the CORS-safelisted response headers,
+	// that the origins can access.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	// +listType=set
+	ExposeHeaders []string `json:"exposeHeaders,omitempty"`
+
+	// AllowCredentials allows the origins to include credentials, such as cookies, in the requests.
+	// It cannot be combined with the * origin.
+	//
+	// +optional
+	AllowCredentials *bool `json:"allowCredentials,omitempty"`
+
+	// MaxAge is the number of seconds during which the clients can cache the response to a preflight request.
+	// If not set, the clients use their defaults.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=86400
+	MaxAge *int32 `json:"maxAge,omitempty"`
+}
+
+// CORSMethod is a method that the origins can use in the requests.
+//
+// +kubebuilder:validation:Enum=GET;HEAD;POST;PUT;DELETE;CONNECT;OPTIONS;TRACE;PATCH;*
+type CORSMethod string
+
+// CORSMethodAll allows any method.
+const CORSMethodAll CORSMethod = "*"
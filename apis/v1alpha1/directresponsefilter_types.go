@@ -0,0 +1,91 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DirectResponseFilterKind is the kind of the DirectResponseFilter resource.
+const DirectResponseFilterKind = "DirectResponseFilter"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:resource:categories=nginx-gateway,shortName=drfilter
+// +kubebuilder:printcolumn:name="Status Code",type=integer,JSONPath=`.spec.statusCode`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// DirectResponseFilter configures NGINX to respond to the requests that match the rules of an HTTPRoute with
+// a fixed response instead of proxying them to the backends. For example, a maintenance page or a health endpoint.
+// A rule references the filter in an ExtensionRef filter. The filter must be in the namespace of the HTTPRoute.
+type DirectResponseFilter struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the DirectResponseFilter.
+	Spec DirectResponseFilterSpec `json:"spec"`
+}
+
+// +kubebuilder:object:root=true
+
+// DirectResponseFilterList contains a list of DirectResponseFilters.
+type DirectResponseFilterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DirectResponseFilter `json:"items"`
+}
+
+// DirectResponseFilterSpec defines the desired state of the DirectResponseFilter.
+type DirectResponseFilterSpec struct {
+	// Body is the body of the response. If not set, the response has no body, unless the status code is
+	// an error, for which NGINX responds with its error page or the error page of an ErrorPagePolicy.
+	//
+	// +optional
+	Body *DirectResponseBody `json:"body,omitempty"`
+
+	// ContentType is the value of the Content-Type header of the response.
+	// If not set, the content type is text/plain.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=127
+	ContentType *string `json:"contentType,omitempty"`
+
+	// Headers are the headers of the response. The Content-Type header must be set with ContentType.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	Headers []ResponseHeader `json:"headers,omitempty"`
+
+	// StatusCode is the status code of the response. The redirect status codes 301, 302, 303, 307 and 308
+	// are not supported. Use the RequestRedirect filter for redirects.
+	//
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	StatusCode int32 `json:"statusCode"`
+}
+
+// DirectResponseBody references the ConfigMap with the body of a response.
+type DirectResponseBody struct {
+	// ConfigMapRef references the ConfigMap with the body. The ConfigMap must be in the namespace of the filter.
+	ConfigMapRef LocalObjectReference `json:"configMapRef"`
+
+	// Key is the key of the body in the ConfigMap.
+	//
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	// +kubebuilder:validation:MaxLength=253
+	Key string `json:"key"`
+}
+
+// ResponseHeader is a header of a response.
+type ResponseHeader struct {
+	// Name is the name of the header.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// Value is the value of the header.
+	//
+	// +kubebuilder:validation:MaxLength=4096
+	Value string `json:"value"`
+}
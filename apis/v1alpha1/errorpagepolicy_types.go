@@ -0,0 +1,136 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// ErrorPagePolicyKind is the kind of the ErrorPagePolicy resource.
+const ErrorPagePolicyKind = "ErrorPagePolicy"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway,shortName=epp
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ErrorPagePolicy replaces the error responses to the clients of a Gateway or an HTTPRoute with custom responses
+// or redirects. A policy for the Gateway also applies to the responses of the default server, which handles
+// the requests that don't match any HTTPRoute. A policy for an HTTPRoute replaces the error pages of a policy for
+// the Gateway.
+type ErrorPagePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ErrorPagePolicy.
+	Spec ErrorPagePolicySpec `json:"spec"`
+
+	// Status defines the state of the ErrorPagePolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// GetTargetRef returns the reference to the target resource of the ErrorPagePolicy.
+func (p *ErrorPagePolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// SetPolicyStatus sets the status of the ErrorPagePolicy.
+func (p *ErrorPagePolicy) SetPolicyStatus(status PolicyStatus) {
+	p.Status = status
+}
+
+// +kubebuilder:object:root=true
+
+// ErrorPagePolicyList contains a list of ErrorPagePolicies.
+type ErrorPagePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ErrorPagePolicy `json:"items"`
+}
+
+// ErrorPagePolicySpec defines the desired state of the ErrorPagePolicy.
+type ErrorPagePolicySpec struct {
+	// TargetRef identifies the API object to apply the policy to.
+	// The object must be a Gateway or an HTTPRoute in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be Gateway or HTTPRoute",rule="(self.kind == 'Gateway' || self.kind == 'HTTPRoute') && self.group == 'gateway.networking.k8s.io'"
+	TargetRef v1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// ErrorPages are the error pages. A status code can only belong to one error page.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	ErrorPages []ErrorPage `json:"errorPages"`
+}
+
+// ErrorPage replaces the error responses with particular status codes with a response or a redirect.
+// NGINX replaces both its own error responses and the error responses of the backends.
+//
+// +kubebuilder:validation:XValidation:message="exactly one of response and redirect must be set",rule="has(self.response) != has(self.redirect)"
+type ErrorPage struct {
+	// Codes are the status codes of the error responses to replace.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=32
+	// +listType=set
+	Codes []ErrorStatusCode `json:"codes"`
+
+	// Response is the response that replaces the error responses.
+	//
+	// +optional
+	Response *ErrorPageResponse `json:"response,omitempty"`
+
+	// Redirect is the redirect that replaces the error responses.
+	//
+	// +optional
+	Redirect *ErrorPageRedirect `json:"redirect,omitempty"`
+}
+
+// ErrorStatusCode is the status code of an error response.
+//
+// +kubebuilder:validation:Minimum=300
+// +kubebuilder:validation:Maximum=599
+type ErrorStatusCode int32
+
+// ErrorPageResponse is a response with the body from a ConfigMap.
+type ErrorPageResponse struct {
+	// ConfigMapRef references the ConfigMap with the body of the response.
+	// The ConfigMap must be in the namespace of the policy.
+	ConfigMapRef LocalObjectReference `json:"configMapRef"`
+
+	// Key is the key of the body in the ConfigMap.
+	//
+	// +kubebuilder:validation:Pattern=`^[-._a-zA-Z0-9]+$`
+	// +kubebuilder:validation:MaxLength=253
+	Key string `json:"key"`
+
+	// ContentType is the value of the Content-Type header of the response.
+	// If not set, the content type is text/html.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=127
+	ContentType *string `json:"contentType,omitempty"`
+
+	// StatusCode is the status code of the response. If not set, the response keeps the status code of
+	// the error response.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=200
+	// +kubebuilder:validation:Maximum=599
+	StatusCode *int32 `json:"statusCode,omitempty"`
+}
+
+// ErrorPageRedirect is a redirect to a URL.
+type ErrorPageRedirect struct {
+	// URL is the absolute http or https URL to redirect to.
+	//
+	// +kubebuilder:validation:Pattern=`^https?://[^\s"'$;{}\\]+$`
+	// +kubebuilder:validation:MaxLength=2048
+	URL string `json:"url"`
+
+	// StatusCode is the status code of the redirect. If not set, the status code is 302.
+	//
+	// +optional
+	// +kubebuilder:validation:Enum=301;302;303;307;308
+	StatusCode *int32 `json:"statusCode,omitempty"`
+}
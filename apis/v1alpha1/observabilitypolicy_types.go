@@ -0,0 +1,224 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// ObservabilityPolicyKind is the kind of the ObservabilityPolicy resource.
+const ObservabilityPolicyKind = "ObservabilityPolicy"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway,shortName=obsp
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ObservabilityPolicy configures the OpenTelemetry tracing and the access logs of the requests that a Gateway or
+// an HTTPRoute processes. A policy for the Gateway enables tracing and configures the export of the spans and
+// the access logs. A policy for an HTTPRoute overrides the sampling ratio and enables or disables the access logs
+// for the requests of the HTTPRoute.
+type ObservabilityPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the ObservabilityPolicy.
+	Spec ObservabilityPolicySpec `json:"spec"`
+
+	// Status defines the state of the ObservabilityPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// GetTargetRef returns the reference to the target resource of the ObservabilityPolicy.
+func (p *ObservabilityPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// SetPolicyStatus sets the status of the ObservabilityPolicy.
+func (p *ObservabilityPolicy) SetPolicyStatus(status PolicyStatus) {
+	p.Status = status
+}
+
+// +kubebuilder:object:root=true
+
+// ObservabilityPolicyList contains a list of ObservabilityPolicies.
+type ObservabilityPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ObservabilityPolicy `json:"items"`
+}
+
+// ObservabilityPolicySpec defines the desired state of the ObservabilityPolicy.
+//
+// +kubebuilder:validation:XValidation:message="tracing or accessLog is required",rule="has(self.tracing) || has(self.accessLog)"
+// +kubebuilder:validation:XValidation:message="exporter is required for a Gateway",rule="self.targetRef.kind != 'Gateway' || !has(self.tracing) || has(self.tracing.exporter)"
+// +kubebuilder:validation:XValidation:message="only ratio can be set for an HTTPRoute",rule="self.targetRef.kind != 'HTTPRoute' || !has(self.tracing) || (!has(self.tracing.exporter) && !has(self.tracing.serviceName) && !has(self.tracing.context))"
+// +kubebuilder:validation:XValidation:message="only enabled of accessLog can be set for an HTTPRoute",rule="self.targetRef.kind != 'HTTPRoute' || !has(self.accessLog) || (!has(self.accessLog.format) && !has(self.accessLog.destination))"
+type ObservabilityPolicySpec struct {
+	// TargetRef identifies the API object to apply the policy to.
+	// The object must be a Gateway or an HTTPRoute in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be Gateway or HTTPRoute",rule="(self.kind == 'Gateway' || self.kind == 'HTTPRoute') && self.group == 'gateway.networking.k8s.io'"
+	TargetRef v1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// Tracing configures the tracing of the requests.
+	//
+	// +optional
+	Tracing *Tracing `json:"tracing,omitempty"`
+
+	// AccessLog configures the access logs of the requests.
+	//
+	// +optional
+	AccessLog *AccessLog `json:"accessLog,omitempty"`
+}
+
+// Tracing configures the OpenTelemetry tracing of the requests.
+type Tracing struct {
+	// Exporter configures the export of the spans to an OpenTelemetry collector.
+	// It is required for a Gateway and can't be set for an HTTPRoute.
+	//
+	// +optional
+	Exporter *TracingExporter `json:"exporter,omitempty"`
+
+	// ServiceName is the value of the service.name attribute of the spans.
+	// It can only be set for a Gateway. If not set, NGINX uses unknown_service:nginx.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_.:/-]+$`
+	ServiceName *string `json:"serviceName,omitempty"`
+
+	// Ratio is the percentage of the requests to trace. 0 disables tracing.
+	// A policy for an HTTPRoute overrides the ratio of the policy for the Gateway.
+	// If not set, NGINX traces all requests.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	Ratio *int32 `json:"ratio,omitempty"`
+
+	// Context configures the propagation of the trace context in the traceparent and tracestate headers.
+	// It can only be set for a Gateway. If not set, the context is propagated.
+	//
+	// +optional
+	Context *TraceContext `json:"context,omitempty"`
+}
+
+// TracingExporter configures the export of the spans to an OpenTelemetry collector.
+type TracingExporter struct {
+	// Endpoint is the address of the OpenTelemetry collector that accepts the spans over OTLP/gRPC in the format
+	// host:port. For example, otel-collector.monitoring.svc:4317.
+	//
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9.-]+:[0-9]{1,5}$`
+	Endpoint string `json:"endpoint"`
+
+	// Interval is the maximum interval between two exports. If not set, NGINX uses its default of 5s.
+	//
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+
+	// BatchSize is the maximum number of spans to send in one batch per worker.
+	// If not set, NGINX uses its default of 512.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	BatchSize *int32 `json:"batchSize,omitempty"`
+
+	// BatchCount is the number of pending batches per worker. NGINX drops the spans that don't fit into them.
+	// If not set, NGINX uses its default of 4.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	BatchCount *int32 `json:"batchCount,omitempty"`
+}
+
+// TraceContext is the propagation mode of the trace context in the traceparent and tracestate headers.
+//
+// +kubebuilder:validation:Enum=Extract;Inject;Propagate;Ignore
+type TraceContext string
+
+const (
+	// TraceContextExtract uses the context of the request headers as the parent of the spans.
+	TraceContextExtract TraceContext = "Extract"
+	// TraceContextInject adds the context of the spans to the requests to the backends,
+	// replacing the context of the request headers.
+	TraceContextInject TraceContext = "Inject"
+	// TraceContextPropagate combines Extract and Inject: the spans continue the trace of the request headers,
+	// and the requests to the backends carry the context of the spans.
+	TraceContextPropagate TraceContext = "Propagate"
+	// TraceContextIgnore ignores the context of the request headers and doesn't add any context to the requests
+	// to the backends.
+	TraceContextIgnore TraceContext = "Ignore"
+)
+
+// AccessLog configures the access logs of the requests. Every log entry includes the name of the Gateway listener,
+// the namespace and the name of the HTTPRoute and the index of the rule of the HTTPRoute that handled the request.
+type AccessLog struct {
+	// Enabled enables or disables the access logs. A policy for an HTTPRoute overrides the policy for the Gateway.
+	// If not set, the access logs are enabled.
+	//
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Format is the format of the log entries. It can only be set for a Gateway. If not set, the format is JSON.
+	//
+	// +optional
+	Format *AccessLogFormat `json:"format,omitempty"`
+
+	// Destination is where NGINX writes the log entries. It can only be set for a Gateway.
+	// If not set, NGINX writes the log entries to stdout.
+	//
+	// +optional
+	Destination *AccessLogDestination `json:"destination,omitempty"`
+}
+
+// AccessLogFormat is the format of the access log entries.
+//
+// +kubebuilder:validation:Enum=JSON;Text
+type AccessLogFormat string
+
+const (
+	// AccessLogFormatJSON formats a log entry as a JSON object.
+	AccessLogFormatJSON AccessLogFormat = "JSON"
+	// AccessLogFormatText formats a log entry as a line of text similar to the combined format of NGINX.
+	AccessLogFormatText AccessLogFormat = "Text"
+)
+
+// AccessLogDestination configures where NGINX writes the access log entries.
+//
+// +kubebuilder:validation:XValidation:message="syslog is required for the Syslog type",rule="self.type != 'Syslog' || has(self.syslog)"
+// +kubebuilder:validation:XValidation:message="syslog can only be set for the Syslog type",rule="self.type == 'Syslog' || !has(self.syslog)"
+type AccessLogDestination struct {
+	// Type is the type of the destination.
+	Type AccessLogDestinationType `json:"type"`
+
+	// Syslog configures the syslog server. It is required for the Syslog type.
+	//
+	// +optional
+	Syslog *SyslogDestination `json:"syslog,omitempty"`
+}
+
+// AccessLogDestinationType is the type of the destination of the access logs.
+//
+// +kubebuilder:validation:Enum=Stdout;Syslog
+type AccessLogDestinationType string
+
+const (
+	// AccessLogDestinationStdout writes the log entries to the standard output of the NGINX container.
+	AccessLogDestinationStdout AccessLogDestinationType = "Stdout"
+	// AccessLogDestinationSyslog sends the log entries to a syslog server over UDP.
+	AccessLogDestinationSyslog AccessLogDestinationType = "Syslog"
+)
+
+// SyslogDestination configures the syslog server of the access logs.
+type SyslogDestination struct {
+	// Server is the address of the syslog server in the format host:port. For example, syslog.logging.svc:514.
+	//
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9.-]+:[0-9]{1,5}$`
+	Server string `json:"server"`
+
+	// Tag is the tag of the syslog messages. If not set, NGINX uses nginx.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_]{1,32}$`
+	Tag *string `json:"tag,omitempty"`
+}
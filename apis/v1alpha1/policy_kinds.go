@@ -0,0 +1,112 @@
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PolicyList is a list of NKG policies of one kind.
+//
+// +kubebuilder:object:generate=false
+type PolicyList interface {
+	metav1.ListInterface
+	runtime.Object
+}
+
+// PolicyKind describes a kind of NKG policies.
+//
+// +kubebuilder:object:generate=false
+type PolicyKind struct {
+	// New returns an empty policy of the kind.
+	New func() Policy
+	// NewList returns an empty list of the policies of the kind.
+	NewList func() PolicyList
+	// Name is the kind of the policies. For example, RetryPolicy.
+	Name string
+}
+
+// PolicyKinds includes all NKG policy kinds. The Gateway watches the policies of these kinds, renders them and
+// reports their statuses. To add a new kind, register it here.
+var PolicyKinds = []PolicyKind{
+	{
+		Name:    UpstreamSettingsPolicyKind,
+		New:     func() Policy { return &UpstreamSettingsPolicy{} },
+		NewList: func() PolicyList { return &UpstreamSettingsPolicyList{} },
+	},
+	{
+		Name:    UpstreamHealthPolicyKind,
+		New:     func() Policy { return &UpstreamHealthPolicy{} },
+		NewList: func() PolicyList { return &UpstreamHealthPolicyList{} },
+	},
+	{
+		Name:    RetryPolicyKind,
+		New:     func() Policy { return &RetryPolicy{} },
+		NewList: func() PolicyList { return &RetryPolicyList{} },
+	},
+	{
+		Name:    RateLimitPolicyKind,
+		New:     func() Policy { return &RateLimitPolicy{} },
+		NewList: func() PolicyList { return &RateLimitPolicyList{} },
+	},
+	{
+		Name:    ClientSettingsPolicyKind,
+		New:     func() Policy { return &ClientSettingsPolicy{} },
+		NewList: func() PolicyList { return &ClientSettingsPolicyList{} },
+	},
+	{
+		Name:    ObservabilityPolicyKind,
+		New:     func() Policy { return &ObservabilityPolicy{} },
+		NewList: func() PolicyList { return &ObservabilityPolicyList{} },
+	},
+	{
+		Name:    CORSPolicyKind,
+		New:     func() Policy { return &CORSPolicy{} },
+		NewList: func() PolicyList { return &CORSPolicyList{} },
+	},
+	{
+		Name:    AccessControlPolicyKind,
+		New:     func() Policy { return &AccessControlPolicy{} },
+		NewList: func() PolicyList { return &AccessControlPolicyList{} },
+	},
+	{
+		Name:    CachePolicyKind,
+		New:     func() Policy { return &CachePolicy{} },
+		NewList: func() PolicyList { return &CachePolicyList{} },
+	},
+	{
+		Name:    CompressionPolicyKind,
+		New:     func() Policy { return &CompressionPolicy{} },
+		NewList: func() PolicyList { return &CompressionPolicyList{} },
+	},
+	{
+		Name:    ErrorPagePolicyKind,
+		New:     func() Policy { return &ErrorPagePolicy{} },
+		NewList: func() PolicyList { return &ErrorPagePolicyList{} },
+	},
+	{
+		Name:    SnippetsPolicyKind,
+		New:     func() Policy { return &SnippetsPolicy{} },
+		NewList: func() PolicyList { return &SnippetsPolicyList{} },
+	},
+	{
+		Name:    TimeoutPolicyKind,
+		New:     func() Policy { return &TimeoutPolicy{} },
+		NewList: func() PolicyList { return &TimeoutPolicyList{} },
+	},
+}
+
+// FindPolicyKind returns the kind of the object.
+// The second return value is false if the object is not a policy of any of the PolicyKinds.
+func FindPolicyKind(obj runtime.Object) (PolicyKind, bool) {
+	objType := reflect.TypeOf(obj)
+
+	for _, kind := range PolicyKinds {
+		if reflect.TypeOf(kind.New()) == objType {
+			return kind, true
+		}
+	}
+
+	return PolicyKind{}, false
+}
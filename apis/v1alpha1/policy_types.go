@@ -0,0 +1,43 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// Policy is an NKG policy, which attaches to the resource that its targetRef references.
+//
+// +kubebuilder:object:generate=false
+type Policy interface {
+	metav1.Object
+	runtime.Object
+	// GetTargetRef returns the reference to the target resource of the policy.
+	GetTargetRef() v1alpha2.PolicyTargetReference
+	// SetPolicyStatus sets the status of the policy.
+	SetPolicyStatus(status PolicyStatus)
+}
+
+// PolicyStatus defines the observed state of a policy.
+type PolicyStatus struct {
+	// Conditions describe the current conditions of the policy.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// Duration is a time duration in the NGINX format: a number followed by an optional unit
+// - ms (milliseconds), s (seconds), m (minutes) or h (hours). If the unit is omitted, seconds are used.
+// For example, 500ms, 30s or 1h.
+//
+// +kubebuilder:validation:Pattern=`^[0-9]{1,4}(ms|s|m|h)?$`
+type Duration string
+
+// Size is a size in the NGINX format: a number followed by an optional unit - k (kilobytes), m (megabytes)
+// or g (gigabytes). If the unit is omitted, bytes are used. For example, 1024, 64k or 10m.
+//
+// +kubebuilder:validation:Pattern=`^[0-9]{1,4}(k|m|g)?$`
+type Size string
@@ -0,0 +1,121 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// RateLimitPolicyKind is the kind of the RateLimitPolicy resource.
+const RateLimitPolicyKind = "RateLimitPolicy"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway,shortName=rlp
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// RateLimitPolicy limits the rate of the requests that a Gateway, a listener of a Gateway or an HTTPRoute processes.
+// NGINX rejects the requests that exceed the rate with the 429 status code.
+type RateLimitPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the RateLimitPolicy.
+	Spec RateLimitPolicySpec `json:"spec"`
+
+	// Status defines the state of the RateLimitPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// GetTargetRef returns the reference to the target resource of the RateLimitPolicy.
+func (p *RateLimitPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// SetPolicyStatus sets the status of the RateLimitPolicy.
+func (p *RateLimitPolicy) SetPolicyStatus(status PolicyStatus) {
+	p.Status = status
+}
+
+// +kubebuilder:object:root=true
+
+// RateLimitPolicyList contains a list of RateLimitPolicies.
+type RateLimitPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RateLimitPolicy `json:"items"`
+}
+
+// RateLimitPolicySpec defines the desired state of the RateLimitPolicy.
+//
+// +kubebuilder:validation:XValidation:message="sectionName can only be set for a Gateway",rule="!has(self.sectionName) || self.targetRef.kind == 'Gateway'"
+type RateLimitPolicySpec struct {
+	// TargetRef identifies the API object to apply the policy to.
+	// The object must be a Gateway or an HTTPRoute in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be Gateway or HTTPRoute",rule="(self.kind == 'Gateway' || self.kind == 'HTTPRoute') && self.group == 'gateway.networking.k8s.io'"
+	TargetRef v1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// SectionName is the name of the listener of the target Gateway to apply the policy to.
+	// If not set, the policy applies to all listeners of the Gateway.
+	//
+	// +optional
+	SectionName *v1beta1.SectionName `json:"sectionName,omitempty"`
+
+	// Key identifies the client whose requests are limited.
+	Key RateLimitKey `json:"key"`
+
+	// Rate is the maximum rate of the requests of a client, in requests per second (r/s)
+	// or requests per minute (r/m). For example, 10r/s.
+	//
+	// +kubebuilder:validation:Pattern=`^[0-9]{1,7}r/[sm]$`
+	Rate string `json:"rate"`
+
+	// Burst is the number of requests above the rate that NGINX delays rather than rejects.
+	// If not set, NGINX rejects all requests above the rate.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Burst *int32 `json:"burst,omitempty"`
+
+	// DryRun enables the dry-run mode, in which NGINX accounts the requests but doesn't limit them.
+	// The requests that would be limited are logged.
+	//
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+}
+
+// RateLimitKey identifies the client whose requests are limited.
+// The requests for which the key is empty are not limited.
+//
+// +kubebuilder:validation:XValidation:message="name is required for the Header key",rule="self.type == 'ClientIP' || has(self.name)"
+// +kubebuilder:validation:XValidation:message="name cannot be set for the ClientIP key",rule="self.type != 'ClientIP' || !has(self.name)"
+// +kubebuilder:validation:XValidation:message="JWTClaim is not supported, because NGINX limits the rate of the requests before it validates their JSON Web Tokens, so the claim would come from a token that is not validated",rule="self.type != 'JWTClaim'"
+type RateLimitKey struct {
+	// Type is the type of the key.
+	Type RateLimitKeyType `json:"type"`
+
+	// Name is the name of the request header for the Header key.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_-]+$`
+	Name *string `json:"name,omitempty"`
+}
+
+// RateLimitKeyType is the type of the key of a rate limit.
+//
+// +kubebuilder:validation:Enum=ClientIP;Header;JWTClaim
+type RateLimitKeyType string
+
+const (
+	// RateLimitKeyTypeClientIP identifies a client by its IP address.
+	RateLimitKeyTypeClientIP RateLimitKeyType = "ClientIP"
+	// RateLimitKeyTypeHeader identifies a client by the value of a request header.
+	RateLimitKeyTypeHeader RateLimitKeyType = "Header"
+	// RateLimitKeyTypeJWTClaim identifies a client by a claim of its JSON Web Token. It is not supported:
+	// NGINX limits the rate in the preaccess phase, before the auth_request of an AuthenticationFilter validates
+	// the token, so the claim would come from a token that is not validated, and any client could pick its key.
+	// The policies with this key are rejected.
+	RateLimitKeyTypeJWTClaim RateLimitKeyType = "JWTClaim"
+)
@@ -0,0 +1,88 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// RetryPolicyKind is the kind of the RetryPolicy resource.
+const RetryPolicyKind = "RetryPolicy"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway,shortName=rp
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// RetryPolicy configures the retries of the requests that match the rules of an HTTPRoute.
+// NGINX retries a request by passing it to the next endpoint of the upstream it picked for the request.
+type RetryPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the RetryPolicy.
+	Spec RetryPolicySpec `json:"spec"`
+
+	// Status defines the state of the RetryPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// GetTargetRef returns the reference to the target resource of the RetryPolicy.
+func (p *RetryPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// SetPolicyStatus sets the status of the RetryPolicy.
+func (p *RetryPolicy) SetPolicyStatus(status PolicyStatus) {
+	p.Status = status
+}
+
+// +kubebuilder:object:root=true
+
+// RetryPolicyList contains a list of RetryPolicies.
+type RetryPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RetryPolicy `json:"items"`
+}
+
+// RetryPolicySpec defines the desired state of the RetryPolicy.
+//
+// +kubebuilder:validation:XValidation:message="Off condition cannot be combined with other conditions",rule="!('Off' in self.conditions) || size(self.conditions) == 1"
+type RetryPolicySpec struct {
+	// TargetRef identifies the API object to apply the policy to.
+	// The object must be an HTTPRoute in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be HTTPRoute",rule="self.kind == 'HTTPRoute' && self.group == 'gateway.networking.k8s.io'"
+	TargetRef v1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// RuleIndex is the index of the rule of the HTTPRoute to apply the policy to.
+	// If not set, the policy applies to all rules of the HTTPRoute.
+	// A policy for a rule overrides a policy for the whole HTTPRoute.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RuleIndex *int32 `json:"ruleIndex,omitempty"`
+
+	// Conditions are the cases in which NGINX retries a request.
+	// Retrying a request with a non-idempotent method (POST, LOCK, PATCH) is only possible with
+	// the NonIdempotent condition. Off disables the retries.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=11
+	// +listType=set
+	Conditions []NextUpstreamCondition `json:"conditions"`
+
+	// Attempts limits the number of attempts to process a request, including the first one.
+	// 0 means no limit. If not set, the number of attempts is not limited.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Attempts *int32 `json:"attempts,omitempty"`
+
+	// Timeout limits the time, counted from the first attempt, during which a request can be retried.
+	// If not set, the time is not limited.
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+}
@@ -0,0 +1,97 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// SnippetsPolicyKind is the kind of the SnippetsPolicy resource.
+const SnippetsPolicyKind = "SnippetsPolicy"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway,shortName=snp
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// SnippetsPolicy injects NGINX configuration snippets with the directives that NKG doesn't support into
+// the configuration of a Gateway or an HTTPRoute. NKG only processes SnippetsPolicies if the snippets are enabled
+// with the --snippets flag, and it validates every snippet with nginx -t before applying it.
+type SnippetsPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the SnippetsPolicy.
+	Spec SnippetsPolicySpec `json:"spec"`
+
+	// Status defines the state of the SnippetsPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// GetTargetRef returns the reference to the target resource of the SnippetsPolicy.
+func (p *SnippetsPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// SetPolicyStatus sets the status of the SnippetsPolicy.
+func (p *SnippetsPolicy) SetPolicyStatus(status PolicyStatus) {
+	p.Status = status
+}
+
+// +kubebuilder:object:root=true
+
+// SnippetsPolicyList contains a list of SnippetsPolicies.
+type SnippetsPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SnippetsPolicy `json:"items"`
+}
+
+// SnippetsPolicySpec defines the desired state of the SnippetsPolicy.
+type SnippetsPolicySpec struct {
+	// TargetRef identifies the API object to apply the policy to.
+	// The object must be a Gateway or an HTTPRoute in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be Gateway or HTTPRoute",rule="(self.kind == 'Gateway' || self.kind == 'HTTPRoute') && self.group == 'gateway.networking.k8s.io'"
+	TargetRef v1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// Snippets are the snippets to inject, at most one per context.
+	// A policy for a Gateway supports the HTTP and Server contexts.
+	// A policy for an HTTPRoute supports the Location context.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=3
+	// +listType=map
+	// +listMapKey=context
+	Snippets []Snippet `json:"snippets"`
+}
+
+// Snippet is an NGINX configuration snippet.
+type Snippet struct {
+	// Context is the NGINX context to inject the snippet into.
+	Context SnippetContext `json:"context"`
+
+	// Value is the snippet: one or more NGINX directives. For example, "proxy_buffer_size 16k;".
+	// The braces of the blocks in the snippet must be balanced.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=4096
+	Value string `json:"value"`
+}
+
+// SnippetContext is the NGINX context of a snippet.
+//
+// +kubebuilder:validation:Enum=HTTP;Server;Location
+type SnippetContext string
+
+const (
+	// SnippetContextHTTP injects the snippet into the http context, which applies to the whole NGINX
+	// configuration. Only a policy for a Gateway supports it.
+	SnippetContextHTTP SnippetContext = "HTTP"
+	// SnippetContextServer injects the snippet into the server contexts of the listeners of a Gateway.
+	// Only a policy for a Gateway supports it.
+	SnippetContextServer SnippetContext = "Server"
+	// SnippetContextLocation injects the snippet into the location contexts of the rules of an HTTPRoute.
+	// Only a policy for an HTTPRoute supports it.
+	SnippetContextLocation SnippetContext = "Location"
+)
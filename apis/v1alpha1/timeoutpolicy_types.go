@@ -0,0 +1,70 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// TimeoutPolicyKind is the kind of the TimeoutPolicy resource.
+const TimeoutPolicyKind = "TimeoutPolicy"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway,shortName=tp
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// TimeoutPolicy configures the timeouts of the requests to the backends for the rules of an HTTPRoute.
+// The backendRequest timeout follows the timeouts of the HTTPRoute rules from the Gateway API (GEP-1742).
+// The request timeout of GEP-1742 is not supported, because NGINX can't limit the time of a whole request.
+type TimeoutPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the TimeoutPolicy.
+	Spec TimeoutPolicySpec `json:"spec"`
+
+	// Status defines the state of the TimeoutPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// GetTargetRef returns the reference to the target resource of the TimeoutPolicy.
+func (p *TimeoutPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// SetPolicyStatus sets the status of the TimeoutPolicy.
+func (p *TimeoutPolicy) SetPolicyStatus(status PolicyStatus) {
+	p.Status = status
+}
+
+// +kubebuilder:object:root=true
+
+// TimeoutPolicyList contains a list of TimeoutPolicies.
+type TimeoutPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TimeoutPolicy `json:"items"`
+}
+
+// TimeoutPolicySpec defines the desired state of the TimeoutPolicy.
+type TimeoutPolicySpec struct {
+	// TargetRef identifies the API object to apply the policy to.
+	// The object must be an HTTPRoute in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be HTTPRoute",rule="self.kind == 'HTTPRoute' && self.group == 'gateway.networking.k8s.io'"
+	TargetRef v1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// RuleIndex is the index of the rule of the HTTPRoute to apply the policy to.
+	// If not set, the policy applies to all rules of the HTTPRoute.
+	// A policy for a rule overrides a policy for the whole HTTPRoute.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	RuleIndex *int32 `json:"ruleIndex,omitempty"`
+
+	// BackendRequest limits every request to a backend: establishing a connection, sending the request and
+	// waiting for each part of the response. It sets the proxy_connect_timeout, proxy_send_timeout and
+	// proxy_read_timeout directives.
+	BackendRequest Duration `json:"backendRequest"`
+}
@@ -0,0 +1,143 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// UpstreamHealthPolicyKind is the kind of the UpstreamHealthPolicy resource.
+const UpstreamHealthPolicyKind = "UpstreamHealthPolicy"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway,shortName=uhp
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// UpstreamHealthPolicy configures the passive health checks of the endpoints of a Service:
+// when NGINX considers an endpoint unavailable and when it passes a request to the next endpoint.
+type UpstreamHealthPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the UpstreamHealthPolicy.
+	Spec UpstreamHealthPolicySpec `json:"spec"`
+
+	// Status defines the state of the UpstreamHealthPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// GetTargetRef returns the reference to the target resource of the UpstreamHealthPolicy.
+func (p *UpstreamHealthPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// SetPolicyStatus sets the status of the UpstreamHealthPolicy.
+func (p *UpstreamHealthPolicy) SetPolicyStatus(status PolicyStatus) {
+	p.Status = status
+}
+
+// +kubebuilder:object:root=true
+
+// UpstreamHealthPolicyList contains a list of UpstreamHealthPolicies.
+type UpstreamHealthPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UpstreamHealthPolicy `json:"items"`
+}
+
+// UpstreamHealthPolicySpec defines the desired state of the UpstreamHealthPolicy.
+type UpstreamHealthPolicySpec struct {
+	// TargetRef identifies the API object to apply the policy to.
+	// The object must be a Service in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be Service",rule="self.kind == 'Service' && self.group == ''"
+	TargetRef v1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// MaxFails is the number of unsuccessful attempts to communicate with an endpoint that should happen
+	// within FailTimeout for NGINX to consider the endpoint unavailable for the duration of FailTimeout.
+	// What is considered an unsuccessful attempt is defined by the conditions of NextUpstream.
+	// 0 disables the accounting of attempts. If not set, NGINX uses its default of 1.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxFails *int32 `json:"maxFails,omitempty"`
+
+	// FailTimeout is the time during which the unsuccessful attempts to communicate with an endpoint
+	// should happen to consider the endpoint unavailable, and the period of time the endpoint will be considered
+	// unavailable. If not set, NGINX uses its default of 10s.
+	//
+	// +optional
+	FailTimeout *Duration `json:"failTimeout,omitempty"`
+
+	// NextUpstream configures in which cases NGINX passes a request to the next endpoint.
+	// If not set, NGINX passes a request to the next endpoint if an error or a timeout occurs
+	// while communicating with an endpoint.
+	//
+	// +optional
+	NextUpstream *NextUpstream `json:"nextUpstream,omitempty"`
+}
+
+// NextUpstream configures in which cases NGINX passes a request to the next endpoint of an upstream.
+//
+// +kubebuilder:validation:XValidation:message="Off condition cannot be combined with other conditions",rule="!('Off' in self.conditions) || size(self.conditions) == 1"
+type NextUpstream struct {
+	// Conditions are the cases in which NGINX passes a request to the next endpoint.
+	// Passing a request with a non-idempotent method (POST, LOCK, PATCH) is only possible with
+	// the NonIdempotent condition.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=11
+	// +listType=set
+	Conditions []NextUpstreamCondition `json:"conditions"`
+
+	// Tries limits the number of possible tries for passing a request to the next endpoint.
+	// 0 means no limit. If not set, the number of tries is not limited.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Tries *int32 `json:"tries,omitempty"`
+
+	// Timeout limits the time during which a request can be passed to the next endpoint.
+	// If not set, the time is not limited.
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+}
+
+// NextUpstreamCondition is a case in which NGINX passes a request to the next endpoint of an upstream.
+//
+// +kubebuilder:validation:Enum=Error;Timeout;InvalidHeader;HTTP500;HTTP502;HTTP503;HTTP504;HTTP403;HTTP404;HTTP429;NonIdempotent;Off
+type NextUpstreamCondition string
+
+const (
+	// NextUpstreamConditionError is an error that occurred while establishing a connection with an endpoint,
+	// passing a request to it, or reading the response header.
+	NextUpstreamConditionError NextUpstreamCondition = "Error"
+	// NextUpstreamConditionTimeout is a timeout that occurred while establishing a connection with an endpoint,
+	// passing a request to it, or reading the response header.
+	NextUpstreamConditionTimeout NextUpstreamCondition = "Timeout"
+	// NextUpstreamConditionInvalidHeader is an empty or invalid response of an endpoint.
+	NextUpstreamConditionInvalidHeader NextUpstreamCondition = "InvalidHeader"
+	// NextUpstreamConditionHTTP500 is a response with the 500 status code.
+	NextUpstreamConditionHTTP500 NextUpstreamCondition = "HTTP500"
+	// NextUpstreamConditionHTTP502 is a response with the 502 status code.
+	NextUpstreamConditionHTTP502 NextUpstreamCondition = "HTTP502"
+	// NextUpstreamConditionHTTP503 is a response with the 503 status code.
+	NextUpstreamConditionHTTP503 NextUpstreamCondition = "HTTP503"
+	// NextUpstreamConditionHTTP504 is a response with the 504 status code.
+	NextUpstreamConditionHTTP504 NextUpstreamCondition = "HTTP504"
+	// NextUpstreamConditionHTTP403 is a response with the 403 status code.
+	// It is not considered an unsuccessful attempt.
+	NextUpstreamConditionHTTP403 NextUpstreamCondition = "HTTP403"
+	// NextUpstreamConditionHTTP404 is a response with the 404 status code.
+	// It is not considered an unsuccessful attempt.
+	NextUpstreamConditionHTTP404 NextUpstreamCondition = "HTTP404"
+	// NextUpstreamConditionHTTP429 is a response with the 429 status code.
+	NextUpstreamConditionHTTP429 NextUpstreamCondition = "HTTP429"
+	// NextUpstreamConditionNonIdempotent enables passing requests with non-idempotent methods
+	// to the next endpoint in the other cases.
+	NextUpstreamConditionNonIdempotent NextUpstreamCondition = "NonIdempotent"
+	// NextUpstreamConditionOff disables passing a request to the next endpoint.
+	NextUpstreamConditionOff NextUpstreamCondition = "Off"
+)
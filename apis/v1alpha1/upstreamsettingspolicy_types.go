@@ -0,0 +1,140 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+)
+
+// UpstreamSettingsPolicyKind is the kind of the UpstreamSettingsPolicy resource.
+const UpstreamSettingsPolicyKind = "UpstreamSettingsPolicy"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway,shortName=usp
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// UpstreamSettingsPolicy configures the NGINX upstreams of Services.
+// It can target a Service, in which case it applies to all upstreams of that Service, or an HTTPRoute,
+// in which case it applies to the upstreams of the backends of that HTTPRoute.
+type UpstreamSettingsPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the UpstreamSettingsPolicy.
+	Spec UpstreamSettingsPolicySpec `json:"spec"`
+
+	// Status defines the state of the UpstreamSettingsPolicy.
+	Status PolicyStatus `json:"status,omitempty"`
+}
+
+// GetTargetRef returns the reference to the target resource of the UpstreamSettingsPolicy.
+func (p *UpstreamSettingsPolicy) GetTargetRef() v1alpha2.PolicyTargetReference {
+	return p.Spec.TargetRef
+}
+
+// SetPolicyStatus sets the status of the UpstreamSettingsPolicy.
+func (p *UpstreamSettingsPolicy) SetPolicyStatus(status PolicyStatus) {
+	p.Status = status
+}
+
+// +kubebuilder:object:root=true
+
+// UpstreamSettingsPolicyList contains a list of UpstreamSettingsPolicies.
+type UpstreamSettingsPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []UpstreamSettingsPolicy `json:"items"`
+}
+
+// UpstreamSettingsPolicySpec defines the desired state of the UpstreamSettingsPolicy.
+//
+// +kubebuilder:validation:XValidation:message="hashMethodKey is required for Hash and HashConsistent load-balancing methods",rule="!has(self.loadBalancingMethod) || !(self.loadBalancingMethod in ['Hash', 'HashConsistent']) || has(self.hashMethodKey)"
+// +kubebuilder:validation:XValidation:message="hashMethodKey can only be set for Hash and HashConsistent load-balancing methods",rule="!has(self.hashMethodKey) || (has(self.loadBalancingMethod) && self.loadBalancingMethod in ['Hash', 'HashConsistent'])"
+type UpstreamSettingsPolicySpec struct {
+	// TargetRef identifies the API object to apply the policy to.
+	// The object must be a Service or an HTTPRoute in the same namespace as the policy.
+	//
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be Service or HTTPRoute",rule="(self.kind == 'Service' && self.group == '') || (self.kind == 'HTTPRoute' && self.group == 'gateway.networking.k8s.io')"
+	TargetRef v1alpha2.PolicyTargetReference `json:"targetRef"`
+
+	// LoadBalancingMethod specifies the load-balancing method of the upstream.
+	// If not set, NGINX uses the RandomTwoLeastConn method.
+	//
+	// +optional
+	LoadBalancingMethod *LoadBalancingMethod `json:"loadBalancingMethod,omitempty"`
+
+	// HashMethodKey is the key that the Hash and HashConsistent load-balancing methods use to pick an endpoint.
+	// It is NGINX text that can contain variables. For example, `$http_x_user_id` to pick the endpoint by
+	// the value of the X-User-ID request header, or `$cookie_session` to pick the endpoint by the value
+	// of the session cookie. The supported variables are $remote_addr, $request_uri, $uri, $host, $scheme,
+	// and the variables with the prefixes $http_, $cookie_ and $arg_.
+	//
+	// +optional
+	HashMethodKey *string `json:"hashMethodKey,omitempty"`
+
+	// KeepAlive configures the keep-alive connections to the endpoints of the upstream.
+	// If not set, NGINX opens a new connection to an endpoint for every request.
+	//
+	// +optional
+	KeepAlive *UpstreamKeepAlive `json:"keepAlive,omitempty"`
+
+	// MaxConnections limits the number of simultaneous active connections to each endpoint of the upstream.
+	// If not set, the number of connections is not limited.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxConnections *int32 `json:"maxConnections,omitempty"`
+}
+
+// UpstreamKeepAlive configures the keep-alive connections to the endpoints of an upstream.
+type UpstreamKeepAlive struct {
+	// Connections is the maximum number of idle keep-alive connections to the endpoints of the upstream
+	// that each NGINX worker process keeps open. When the number is exceeded, the least recently used connections
+	// are closed.
+	//
+	// +kubebuilder:validation:Minimum=1
+	Connections int32 `json:"connections"`
+
+	// Requests is the maximum number of requests that can be served through one keep-alive connection.
+	// After the maximum number of requests is made, the connection is closed.
+	// If not set, NGINX uses its default of 1000.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Requests *int32 `json:"requests,omitempty"`
+
+	// Time limits the maximum time during which requests can be processed through one keep-alive connection.
+	// If not set, NGINX uses its default of 1h.
+	//
+	// +optional
+	Time *Duration `json:"time,omitempty"`
+
+	// Timeout is the time during which an idle keep-alive connection to an endpoint stays open.
+	// If not set, NGINX uses its default of 60s.
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+}
+
+// LoadBalancingMethod is the load-balancing method of an upstream.
+//
+// +kubebuilder:validation:Enum=RandomTwoLeastConn;RoundRobin;LeastConn;IPHash;Hash;HashConsistent
+type LoadBalancingMethod string
+
+const (
+	// LoadBalancingMethodRandomTwoLeastConn picks two random endpoints and then chooses the one
+	// with the least number of active connections.
+	LoadBalancingMethodRandomTwoLeastConn LoadBalancingMethod = "RandomTwoLeastConn"
+	// LoadBalancingMethodRoundRobin distributes requests among the endpoints in turn.
+	LoadBalancingMethodRoundRobin LoadBalancingMethod = "RoundRobin"
+	// LoadBalancingMethodLeastConn picks the endpoint with the least number of active connections.
+	LoadBalancingMethodLeastConn LoadBalancingMethod = "LeastConn"
+	// LoadBalancingMethodIPHash picks the endpoint based on the client IP address.
+	LoadBalancingMethodIPHash LoadBalancingMethod = "IPHash"
+	// LoadBalancingMethodHash picks the endpoint based on the hash of the HashMethodKey.
+	LoadBalancingMethodHash LoadBalancingMethod = "Hash"
+	// LoadBalancingMethodHashConsistent picks the endpoint based on the hash of the HashMethodKey using
+	// ketama consistent hashing, so that adding or removing an endpoint only remaps a few keys.
+	LoadBalancingMethodHashConsistent LoadBalancingMethod = "HashConsistent"
+)
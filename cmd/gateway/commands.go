@@ -3,15 +3,19 @@ package main
 import (
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/status"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/provisioner"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/config"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/routetest"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/webhook"
 )
 
 const (
@@ -59,6 +63,35 @@ func (v *stringValidatingValue) Type() string {
 	return "string"
 }
 
+// intValidatingValue is an int flag value with custom validation logic.
+// it implements the pflag.Value interface.
+type intValidatingValue struct {
+	validator func(v int) error
+	value     int
+}
+
+func (v *intValidatingValue) String() string {
+	return strconv.Itoa(v.value)
+}
+
+func (v *intValidatingValue) Set(param string) error {
+	intVal, err := strconv.Atoi(param)
+	if err != nil {
+		return fmt.Errorf("failed to parse int value: %w", err)
+	}
+
+	if err := v.validator(intVal); err != nil {
+		return err
+	}
+
+	v.value = intVal
+	return nil
+}
+
+func (v *intValidatingValue) Type() string {
+	return "int"
+}
+
 // namespacedNameValue is a string flag value that represents a namespaced name.
 // it implements the pflag.Value interface.
 type namespacedNameValue struct {
@@ -113,11 +146,46 @@ func createRootCommand() *cobra.Command {
 }
 
 func createStaticModeCommand() *cobra.Command {
-	const gatewayFlag = "gateway"
+	const (
+		gatewayFlag         = "gateway"
+		debugServerFlag     = "debug-server"
+		debugServerPortFlag = "debug-server-port"
+		webhookFlag         = "webhook"
+		webhookPortFlag     = "webhook-port"
+		webhookCertFlag     = "webhook-cert-secret"
+		webhookModeFlag     = "webhook-mode"
+		snippetsFlag        = "snippets"
+		snippetsNginxFlag   = "snippets-nginx-path"
+	)
 
 	// flag values
 	gateway := namespacedNameValue{}
 	var updateGCStatus bool
+	var enableDebugServer bool
+	debugServerPort := intValidatingValue{
+		validator: validatePort,
+		value:     8090,
+	}
+	var enableWebhook bool
+	webhookPort := intValidatingValue{
+		validator: validatePort,
+		value:     8443,
+	}
+	webhookCertSecret := namespacedNameValue{
+		value: types.NamespacedName{
+			Namespace: "nginx-gateway",
+			Name:      "nginx-gateway-webhook-cert",
+		},
+	}
+	webhookMode := stringValidatingValue{
+		validator: validateWebhookMode,
+		value:     string(webhook.ModeReject),
+	}
+	var enableSnippets bool
+	snippetsNginxPath := stringValidatingValue{
+		validator: validateAbsolutePath,
+		value:     "/usr/sbin/nginx",
+	}
 
 	cmd := &cobra.Command{
 		Use:   "static-mode",
@@ -140,6 +208,27 @@ func createStaticModeCommand() *cobra.Command {
 				gwNsName = &gateway.value
 			}
 
+			var debugServerAddress string
+			if enableDebugServer {
+				// The debug server exposes the internal state of the control plane, so it only listens on the
+				// loopback interface. Use kubectl port-forward to access it.
+				debugServerAddress = fmt.Sprintf("127.0.0.1:%d", debugServerPort.value)
+			}
+
+			var webhookCfg *config.WebhookConfig
+			if enableWebhook {
+				webhookCfg = &config.WebhookConfig{
+					CertSecretNsName: webhookCertSecret.value,
+					Mode:             webhookMode.value,
+					Port:             webhookPort.value,
+				}
+			}
+
+			var nginxPath string
+			if enableSnippets {
+				nginxPath = snippetsNginxPath.value
+			}
+
 			conf := config.Config{
 				GatewayCtlrName:          gatewayCtlrName.value,
 				Logger:                   logger,
@@ -147,6 +236,9 @@ func createStaticModeCommand() *cobra.Command {
 				PodIP:                    podIP,
 				GatewayNsName:            gwNsName,
 				UpdateGatewayClassStatus: updateGCStatus,
+				DebugServerAddress:       debugServerAddress,
+				Webhook:                  webhookCfg,
+				SnippetsNginxPath:        nginxPath,
 			}
 
 			if err := static.StartManager(conf); err != nil {
@@ -174,6 +266,70 @@ func createStaticModeCommand() *cobra.Command {
 		"Update the status of the GatewayClass resource.",
 	)
 
+	cmd.Flags().BoolVar(
+		&enableDebugServer,
+		debugServerFlag,
+		false,
+		"Enable the debug server, which serves the internal state of the control plane as JSON: "+
+			"the Graph, the dataplane configuration, the generated NGINX configuration files and the result of "+
+			"the last NGINX reload. The server listens on the loopback interface only.",
+	)
+
+	cmd.Flags().Var(
+		&debugServerPort,
+		debugServerPortFlag,
+		"Set the port of the debug server. The port must not be used by any listener of the Gateway.",
+	)
+
+	cmd.Flags().BoolVar(
+		&enableWebhook,
+		webhookFlag,
+		false,
+		"Enable the validating webhook server, which validates Gateway and HTTPRoute resources with the "+
+			"NGINX-specific validation rules at admission time. A ValidatingWebhookConfiguration that points "+
+			"to the server must be created separately.",
+	)
+
+	cmd.Flags().Var(
+		&webhookPort,
+		webhookPortFlag,
+		"Set the port of the validating webhook server. The port must not be used by any listener of the Gateway.",
+	)
+
+	cmd.Flags().Var(
+		&webhookCertSecret,
+		webhookCertFlag,
+		"The namespaced name of the Secret of the type kubernetes.io/tls with the certificate of the validating "+
+			"webhook server. Must be of the form: NAMESPACE/NAME. A rotated certificate is used without a restart.",
+	)
+
+	cmd.Flags().Var(
+		&webhookMode,
+		webhookModeFlag,
+		fmt.Sprintf(
+			"Set the validation mode of the validating webhook server: %q rejects resources with values that "+
+				"NKG doesn't support, %q allows them with warnings.",
+			webhook.ModeReject,
+			webhook.ModeWarn,
+		),
+	)
+
+	cmd.Flags().BoolVar(
+		&enableSnippets,
+		snippetsFlag,
+		false,
+		"Enable SnippetsPolicies, which inject NGINX configuration snippets into the configuration of Gateways and "+
+			"HTTPRoutes. The NGINX configuration with the snippets is tested with nginx -t before every reload, so "+
+			"the control plane must run the -snippets variant of the image, which has the NGINX binary, and mount "+
+			"the NGINX main configuration file and the NJS modules of the NGINX container.",
+	)
+
+	cmd.Flags().Var(
+		&snippetsNginxPath,
+		snippetsNginxFlag,
+		"The absolute path to the NGINX binary that tests the NGINX configuration with the snippets with nginx -t.",
+	)
+
 	return cmd
 }
 
@@ -198,3 +354,186 @@ func createProvisionerModeCommand() *cobra.Command {
 		},
 	}
 }
+
+func createRenderCommand() *cobra.Command {
+	const fileFlag = "file"
+
+	// flag values
+	var files []string
+
+	cmd := &cobra.Command{
+		Use:   "render",
+		Short: "Generate the NGINX configuration from resources in files without connecting to Kubernetes",
+		Long: "Generate the NGINX configuration and the resource statuses from Gateway API and core " +
+			"resources in files, the same way the Gateway does in static mode. Services are resolved using " +
+			"the EndpointSlices in the files. The configuration files and the statuses are printed to stdout; " +
+			"the ignored resources and the events for the rejected resources are printed to stderr.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			objects, ignored, err := readObjects(files, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+
+			for _, desc := range ignored {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Ignored unsupported resource %s\n", desc)
+			}
+
+			res, err := static.Render(
+				cmd.Context(),
+				static.RenderConfig{
+					Logger:           zap.New(zap.WriteTo(cmd.ErrOrStderr())),
+					Clock:            status.NewRealClock(),
+					GatewayCtlrName:  gatewayCtlrName.value,
+					GatewayClassName: gatewayClassName.value,
+				},
+				objects,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to render NGINX configuration: %w", err)
+			}
+
+			for _, event := range res.Events {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Event %s\n", event)
+			}
+
+			return writeRenderResult(cmd.OutOrStdout(), res)
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(
+		&files,
+		fileFlag,
+		"f",
+		nil,
+		"The files or directories with the resources in YAML or JSON. "+
+			"For directories, all .yaml, .yml and .json files in the directory are read. "+
+			"Use '-' to read the resources from stdin.",
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(fileFlag))
+
+	return cmd
+}
+
+func createRouteTestCommand() *cobra.Command {
+	const (
+		fileFlag   = "file"
+		hostFlag   = "host"
+		portFlag   = "port"
+		methodFlag = "method"
+		pathFlag   = "path"
+		headerFlag = "header"
+		queryFlag  = "query"
+	)
+
+	// flag values
+	var (
+		files   []string
+		host    string
+		method  string
+		path    string
+		headers []string
+		query   []string
+	)
+	port := intValidatingValue{
+		validator: validateListenerPort,
+		value:     80,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "route-test",
+		Short: "Explain which routing rule NGINX would select for a request",
+		Long: "Build the NGINX configuration from Gateway API and core resources in files, the same way the " +
+			"render command does, and evaluate a synthetic request against it. The command prints the selected " +
+			"Gateway listener, HTTPRoute, rule, match, the applied filters and the backends with their weights.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := buildRouteTestRequest(host, int32(port.value), method, path, headers, query)
+			if err != nil {
+				return err
+			}
+
+			objects, ignored, err := readObjects(files, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+
+			for _, desc := range ignored {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Ignored unsupported resource %s\n", desc)
+			}
+
+			res, err := static.Render(
+				cmd.Context(),
+				static.RenderConfig{
+					Logger:           zap.New(zap.WriteTo(cmd.ErrOrStderr())),
+					Clock:            status.NewRealClock(),
+					GatewayCtlrName:  gatewayCtlrName.value,
+					GatewayClassName: gatewayClassName.value,
+				},
+				objects,
+			)
+			if err != nil {
+				return fmt.Errorf("failed to build NGINX configuration: %w", err)
+			}
+
+			for _, event := range res.Events {
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "Event %s\n", event)
+			}
+
+			return writeRouteTestResult(cmd.OutOrStdout(), routetest.Evaluate(res.Graph, res.Configuration, req))
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(
+		&files,
+		fileFlag,
+		"f",
+		nil,
+		"The files or directories with the resources in YAML or JSON. "+
+			"For directories, all .yaml, .yml and .json files in the directory are read. "+
+			"Use '-' to read the resources from stdin.",
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(fileFlag))
+
+	cmd.Flags().StringVar(
+		&host,
+		hostFlag,
+		"",
+		"The Host header of the request.",
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(hostFlag))
+
+	cmd.Flags().Var(
+		&port,
+		portFlag,
+		"The port of the Gateway listener that receives the request.",
+	)
+
+	cmd.Flags().StringVar(
+		&method,
+		methodFlag,
+		"GET",
+		"The method of the request.",
+	)
+
+	cmd.Flags().StringVar(
+		&path,
+		pathFlag,
+		"/",
+		"The path of the request. It may include a query, for example, '/coffee?flavor=latte'.",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&headers,
+		headerFlag,
+		nil,
+		"A header of the request in the form 'NAME: VALUE'. Can be repeated.",
+	)
+
+	cmd.Flags().StringArrayVar(
+		&query,
+		queryFlag,
+		nil,
+		"A query parameter of the request in the form 'NAME=VALUE'. Can be repeated.",
+	)
+
+	return cmd
+}
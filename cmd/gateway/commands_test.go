@@ -117,6 +117,14 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 			args: []string{
 				"--gateway=nginx-gateway/nginx",
 				"--update-gatewayclass-status=true",
+				"--debug-server",
+				"--debug-server-port=9090",
+				"--webhook",
+				"--webhook-port=9443",
+				"--webhook-cert-secret=nginx-gateway/webhook-cert",
+				"--webhook-mode=warn",
+				"--snippets",
+				"--snippets-nginx-path=/usr/local/sbin/nginx",
 			},
 			wantErr: false,
 		},
@@ -158,6 +166,55 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 			wantErr:           true,
 			expectedErrPrefix: `invalid argument "invalid" for "--update-gatewayclass-status" flag: strconv.ParseBool`,
 		},
+		{
+			name: "debug-server-port is invalid",
+			args: []string{
+				"--debug-server-port=invalid", // not an int
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "invalid" for "--debug-server-port" flag: failed to parse int value`,
+		},
+		{
+			name: "debug-server-port is outside of the allowed range",
+			args: []string{
+				"--debug-server-port=80",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "80" for "--debug-server-port" flag: port outside of allowed port range`,
+		},
+		{
+			name: "webhook-port is outside of the allowed range",
+			args: []string{
+				"--webhook-port=443",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "443" for "--webhook-port" flag: port outside of allowed port range`,
+		},
+		{
+			name: "webhook-cert-secret is invalid",
+			args: []string{
+				"--webhook-cert-secret=webhook-cert", // no namespace
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "webhook-cert" for "--webhook-cert-secret" flag: invalid format; ` +
+				"must be NAMESPACE/NAME",
+		},
+		{
+			name: "webhook-mode is invalid",
+			args: []string{
+				"--webhook-mode=ignore",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "ignore" for "--webhook-mode" flag: "ignore" must be one of`,
+		},
+		{
+			name: "snippets-nginx-path is not absolute",
+			args: []string{
+				"--snippets-nginx-path=nginx",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "nginx" for "--snippets-nginx-path" flag: "nginx" must be an absolute path`,
+		},
 	}
 
 	for _, test := range tests {
@@ -167,3 +224,72 @@ func TestStaticModeCmdFlagValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderCmdFlagValidation(t *testing.T) {
+	tests := []flagTestCase{
+		{
+			name: "valid flags",
+			args: []string{
+				"--file=manifests.yaml",
+				"-f=manifests",
+			},
+			wantErr: false,
+		},
+		{
+			name:              "file is not set",
+			args:              nil,
+			wantErr:           true,
+			expectedErrPrefix: `required flag(s) "file" not set`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cmd := createRenderCommand()
+			testFlag(t, cmd, test)
+		})
+	}
+}
+
+func TestRouteTestCmdFlagValidation(t *testing.T) {
+	tests := []flagTestCase{
+		{
+			name: "valid flags",
+			args: []string{
+				"--file=manifests.yaml",
+				"--host=cafe.example.com",
+				"--port=8080",
+				"--method=POST",
+				"--path=/coffee?flavor=latte",
+				"--header=version: v2",
+				"--query=size=large",
+			},
+			wantErr: false,
+		},
+		{
+			name: "host is not set",
+			args: []string{
+				"--file=manifests.yaml",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `required flag(s) "host" not set`,
+		},
+		{
+			name: "port is outside of the allowed range",
+			args: []string{
+				"--file=manifests.yaml",
+				"--host=cafe.example.com",
+				"--port=0",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "0" for "--port" flag: port outside of allowed port range`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cmd := createRouteTestCommand()
+			testFlag(t, cmd, test)
+		})
+	}
+}
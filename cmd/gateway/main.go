@@ -18,6 +18,8 @@ func main() {
 	rootCmd.AddCommand(
 		createStaticModeCommand(),
 		createProvisionerModeCommand(),
+		createRenderCommand(),
+		createRouteTestCommand(),
 	)
 
 	if err := rootCmd.Execute(); err != nil {
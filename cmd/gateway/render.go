@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/file"
+)
+
+const stdinPath = "-"
+
+var manifestExtensions = map[string]struct{}{
+	".yaml": {},
+	".yml":  {},
+	".json": {},
+}
+
+// readObjects reads the resources from the files. Directories are not read recursively.
+func readObjects(paths []string, stdin io.Reader) (objects []client.Object, ignored []string, err error) {
+	for _, path := range paths {
+		var filePaths []string
+
+		if path == stdinPath {
+			filePaths = []string{stdinPath}
+		} else {
+			filePaths, err = expandPath(path)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+
+		for _, filePath := range filePaths {
+			objs, ign, err := readObjectsFromFile(filePath, stdin)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			objects = append(objects, objs...)
+			ignored = append(ignored, ign...)
+		}
+	}
+
+	return objects, ignored, nil
+}
+
+func expandPath(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read directory %s: %w", path, err)
+	}
+
+	var filePaths []string
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		if _, ok := manifestExtensions[filepath.Ext(entry.Name())]; ok {
+			filePaths = append(filePaths, filepath.Join(path, entry.Name()))
+		}
+	}
+
+	// os.ReadDir returns the entries sorted by filename, but we sort them to be explicit about the order.
+	sort.Strings(filePaths)
+
+	return filePaths, nil
+}
+
+func readObjectsFromFile(path string, stdin io.Reader) ([]client.Object, []string, error) {
+	r := stdin
+
+	if path != stdinPath {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to open %s: %w", path, err)
+		}
+		defer f.Close()
+
+		r = f
+	}
+
+	objects, ignored, err := static.DecodeObjects(r)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to decode resources from %s: %w", path, err)
+	}
+
+	return objects, ignored, nil
+}
+
+// statusOnlyObject is a resource without spec. It is used to print the status of a resource.
+type statusOnlyObject struct {
+	Status     any                      `json:"status"`
+	APIVersion string                   `json:"apiVersion"`
+	Kind       string                   `json:"kind"`
+	Metadata   statusOnlyObjectMetadata `json:"metadata"`
+}
+
+type statusOnlyObjectMetadata struct {
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+}
+
+// writeRenderResult writes the NGINX configuration files followed by the resource statuses as YAML documents.
+// The content of the secret files is omitted, because they include private keys.
+func writeRenderResult(w io.Writer, res static.RenderResult) error {
+	for _, f := range res.Files {
+		if _, err := fmt.Fprintf(w, "# File: %s\n", f.Path); err != nil {
+			return err
+		}
+
+		content := f.Content
+		if f.Type == file.TypeSecret {
+			content = []byte("# The content is omitted because the file includes a private key.\n")
+		}
+
+		if _, err := fmt.Fprintf(w, "%s\n", content); err != nil {
+			return err
+		}
+	}
+
+	for _, obj := range res.StatusObjects {
+		gvk := obj.GetObjectKind().GroupVersionKind()
+		if gvk.Empty() {
+			return fmt.Errorf("the kind of %s is not set", client.ObjectKeyFromObject(obj))
+		}
+
+		desc := fmt.Sprintf("%s %s", gvk.Kind, client.ObjectKeyFromObject(obj))
+
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return fmt.Errorf("failed to convert %s: %w", desc, err)
+		}
+
+		st, exists := content["status"]
+		if !exists {
+			return fmt.Errorf("%s doesn't have a status", desc)
+		}
+
+		out, err := yaml.Marshal(statusOnlyObject{
+			APIVersion: gvk.GroupVersion().String(),
+			Kind:       gvk.Kind,
+			Metadata: statusOnlyObjectMetadata{
+				Namespace: obj.GetNamespace(),
+				Name:      obj.GetName(),
+			},
+			Status: st,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to marshal status of %s: %w", desc, err)
+		}
+
+		if _, err := fmt.Fprintf(w, "---\n%s", out); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static"
+)
+
+const renderManifests = `
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: GatewayClass
+metadata:
+  name: nginx
+spec:
+  controllerName: k8s-gateway.nginx.org/nginx-gateway-controller
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: Gateway
+metadata:
+  name: gateway
+spec:
+  gatewayClassName: nginx
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: HTTPRoute
+metadata:
+  name: coffee
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - backendRefs:
+    - name: coffee
+      port: 80
+---
+apiVersion: gateway.nginx.org/v1alpha1
+kind: RetryPolicy
+metadata:
+  name: coffee
+spec:
+  targetRef:
+    group: gateway.networking.k8s.io
+    kind: HTTPRoute
+    name: coffee
+  conditions:
+  - error
+  - timeout
+`
+
+func TestRenderCmd(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	// the flags of the root command are set for the render command
+	gatewayCtlrName.value = "k8s-gateway.nginx.org/nginx-gateway-controller"
+	gatewayClassName.value = "nginx"
+	t.Cleanup(func() {
+		gatewayCtlrName.value = ""
+		gatewayClassName.value = ""
+	})
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := createRenderCommand()
+	cmd.SetIn(strings.NewReader(renderManifests))
+	cmd.SetOut(&stdout)
+	cmd.SetErr(&stderr)
+	cmd.SetArgs([]string{"-f", "-"})
+
+	g.Expect(cmd.Execute()).To(Succeed())
+
+	out := stdout.String()
+	g.Expect(out).To(ContainSubstring("# File: /etc/nginx/conf.d/http.conf"))
+	g.Expect(out).To(ContainSubstring("---\napiVersion: gateway.networking.k8s.io/v1beta1\nkind: GatewayClass\n"))
+	g.Expect(out).To(ContainSubstring("---\napiVersion: gateway.networking.k8s.io/v1beta1\nkind: Gateway\n"))
+	g.Expect(out).To(ContainSubstring("---\napiVersion: gateway.networking.k8s.io/v1beta1\nkind: HTTPRoute\n"))
+	g.Expect(out).To(ContainSubstring("---\napiVersion: gateway.nginx.org/v1alpha1\nkind: RetryPolicy\n"))
+	g.Expect(out).To(ContainSubstring("reason: Accepted"))
+}
+
+func TestWriteRenderResultUnknownKind(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	res := static.RenderResult{
+		StatusObjects: []client.Object{
+			&v1beta1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "hr"},
+			},
+		},
+	}
+
+	err := writeRenderResult(&bytes.Buffer{}, res)
+	g.Expect(err).To(MatchError("the kind of test/hr is not set"))
+}
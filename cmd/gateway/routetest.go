@@ -0,0 +1,202 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/routetest"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+// buildRouteTestRequest builds a routetest.Request from the values of the route-test command flags.
+func buildRouteTestRequest(
+	host string,
+	port int32,
+	method string,
+	path string,
+	headers []string,
+	query []string,
+) (routetest.Request, error) {
+	if host == "" {
+		return routetest.Request{}, errors.New("host must be set")
+	}
+
+	if !strings.HasPrefix(path, "/") {
+		return routetest.Request{}, fmt.Errorf("path %q must start with '/'", path)
+	}
+
+	req := routetest.Request{
+		Host:    host,
+		Port:    port,
+		Method:  method,
+		Headers: make(http.Header),
+		Query:   make(url.Values),
+	}
+
+	path, rawQuery, _ := strings.Cut(path, "?")
+	req.Path = path
+
+	if rawQuery != "" {
+		values, err := url.ParseQuery(rawQuery)
+		if err != nil {
+			return routetest.Request{}, fmt.Errorf("invalid query in path %q: %w", path, err)
+		}
+		req.Query = values
+	}
+
+	for _, q := range query {
+		name, value, found := strings.Cut(q, "=")
+		if !found || name == "" {
+			return routetest.Request{}, fmt.Errorf("query parameter %q must be in the form NAME=VALUE", q)
+		}
+		req.Query.Add(name, value)
+	}
+
+	for _, h := range headers {
+		name, value, found := strings.Cut(h, ":")
+		if !found || strings.TrimSpace(name) == "" {
+			return routetest.Request{}, fmt.Errorf("header %q must be in the form 'NAME: VALUE'", h)
+		}
+		req.Headers.Add(strings.TrimSpace(name), strings.TrimSpace(value))
+	}
+
+	return req, nil
+}
+
+// writeRouteTestResult writes the result of the route-test command in a human-readable form.
+func writeRouteTestResult(w io.Writer, res routetest.Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	row := func(name, format string, args ...any) {
+		_, _ = fmt.Fprintf(tw, "%s:\t%s\n", name, fmt.Sprintf(format, args...))
+	}
+
+	if res.Server != nil {
+		serverName := res.Server.Hostname
+		if res.Server.IsDefault {
+			serverName = "default server"
+		}
+		row("Server", "%s (port %d)", serverName, res.Server.Port)
+	}
+
+	if res.Listener != nil {
+		row("Listener", "%s (Gateway %s)", res.Listener.Name, res.Listener.Gateway)
+	}
+
+	if res.Location != "" {
+		row("Location", "%s", res.Location)
+	}
+
+	if res.MatchRule != nil {
+		row(
+			"HTTPRoute",
+			"%s/%s, rule %d, match %d",
+			res.MatchRule.Source.Namespace,
+			res.MatchRule.Source.Name,
+			res.MatchRule.RuleIdx,
+			res.MatchRule.MatchIdx,
+		)
+
+		for _, f := range describeFilters(res.MatchRule.Filters) {
+			row("Filter", "%s", f)
+		}
+	}
+
+	for _, b := range res.Backends {
+		validity := ""
+		if !b.Valid {
+			validity = ", invalid: NGINX responds with 500"
+		}
+		row("Backend", "%s (weight %d, %.2f%%%s)", b.UpstreamName, b.Weight, b.Percent, validity)
+	}
+
+	if res.StatusCode != 0 {
+		row("Response", "%d", res.StatusCode)
+	}
+
+	row("Result", "%s", res.Message)
+
+	return tw.Flush()
+}
+
+func describeFilters(filters dataplane.Filters) []string {
+	var result []string
+
+	if filters.InvalidFilter != nil {
+		result = append(result, "invalid filters: NGINX responds with 500")
+	}
+
+	if rr := filters.RequestRedirect; rr != nil {
+		var parts []string
+		if rr.Scheme != nil {
+			parts = append(parts, "scheme="+*rr.Scheme)
+		}
+		if rr.Hostname != nil {
+			parts = append(parts, "hostname="+string(*rr.Hostname))
+		}
+		if rr.Port != nil {
+			parts = append(parts, fmt.Sprintf("port=%d", *rr.Port))
+		}
+		if rr.StatusCode != nil {
+			parts = append(parts, fmt.Sprintf("statusCode=%d", *rr.StatusCode))
+		}
+		result = append(result, fmt.Sprintf("RequestRedirect %s", strings.Join(parts, " ")))
+	}
+
+	if auth := filters.Authentication; auth != nil {
+		result = append(result, describeAuthentication(*auth))
+	}
+
+	if dr := filters.DirectResponse; dr != nil {
+		parts := []string{fmt.Sprintf("statusCode=%d", dr.StatusCode), "contentType=" + dr.ContentType}
+		for _, h := range dr.Headers {
+			parts = append(parts, fmt.Sprintf("header %s=%s", h.Name, h.Value))
+		}
+		result = append(result, fmt.Sprintf("DirectResponse %s", strings.Join(parts, " ")))
+	}
+
+	if hm := filters.RequestHeaderModifiers; hm != nil {
+		var parts []string
+		for _, h := range hm.Set {
+			parts = append(parts, fmt.Sprintf("set %s=%s", h.Name, h.Value))
+		}
+		for _, h := range hm.Add {
+			parts = append(parts, fmt.Sprintf("add %s=%s", h.Name, h.Value))
+		}
+		for _, name := range hm.Remove {
+			parts = append(parts, "remove "+name)
+		}
+		result = append(result, fmt.Sprintf("RequestHeaderModifier %s", strings.Join(parts, ", ")))
+	}
+
+	return result
+}
+
+func describeAuthentication(auth dataplane.Authentication) string {
+	if ext := auth.External; ext != nil {
+		return fmt.Sprintf("Authentication external upstream=%s path=%s", ext.UpstreamName, ext.Path)
+	}
+
+	if auth.JWT == nil {
+		return fmt.Sprintf("Authentication basic realm=%s", auth.Realm)
+	}
+
+	claims := make([]string, 0, len(auth.JWT.RequiredClaims))
+	for name, value := range auth.JWT.RequiredClaims {
+		claims = append(claims, fmt.Sprintf("%s=%s", name, value))
+	}
+	sort.Strings(claims)
+
+	description := fmt.Sprintf("Authentication JWT realm=%s", auth.Realm)
+	if len(claims) > 0 {
+		description += " requiredClaims=" + strings.Join(claims, ",")
+	}
+
+	return description
+}
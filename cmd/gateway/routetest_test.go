@@ -0,0 +1,200 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/routetest"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+func TestBuildRouteTestRequest(t *testing.T) {
+	tests := []struct {
+		expected routetest.Request
+		name     string
+		host     string
+		path     string
+		headers  []string
+		query    []string
+		expErr   bool
+	}{
+		{
+			name:    "valid request",
+			host:    "cafe.example.com",
+			path:    "/coffee?flavor=latte",
+			headers: []string{"version: v2", "Accept:text/html"},
+			query:   []string{"size=large", "flavor=mocha"},
+			expected: routetest.Request{
+				Host:   "cafe.example.com",
+				Port:   80,
+				Method: "GET",
+				Path:   "/coffee",
+				Headers: http.Header{
+					"Version": []string{"v2"},
+					"Accept":  []string{"text/html"},
+				},
+				Query: url.Values{
+					"flavor": []string{"latte", "mocha"},
+					"size":   []string{"large"},
+				},
+			},
+		},
+		{
+			name:   "empty host",
+			path:   "/",
+			expErr: true,
+		},
+		{
+			name:   "relative path",
+			host:   "cafe.example.com",
+			path:   "coffee",
+			expErr: true,
+		},
+		{
+			name:    "invalid header",
+			host:    "cafe.example.com",
+			path:    "/",
+			headers: []string{"version"},
+			expErr:  true,
+		},
+		{
+			name:   "invalid query",
+			host:   "cafe.example.com",
+			path:   "/",
+			query:  []string{"=latte"},
+			expErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			req, err := buildRouteTestRequest(test.host, 80, "GET", test.path, test.headers, test.query)
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(req).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestWriteRouteTestResult(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	res := routetest.Result{
+		Server: &dataplane.VirtualServer{Hostname: "cafe.example.com", Port: 80},
+		Listener: &routetest.Listener{
+			Gateway: types.NamespacedName{Namespace: "test", Name: "gateway"},
+			Name:    "http",
+		},
+		Location: "/coffee/",
+		MatchRule: &dataplane.MatchRule{
+			Source: &v1beta1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "coffee"},
+			},
+			Filters: dataplane.Filters{
+				RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+					Set:    []dataplane.HTTPHeader{{Name: "My-Header", Value: "value"}},
+					Remove: []string{"Other-Header"},
+				},
+			},
+			RuleIdx:  1,
+			MatchIdx: 2,
+		},
+		Backends: []routetest.Backend{
+			{UpstreamName: "test_coffee_80", Weight: 1, Percent: 50, Valid: true},
+			{UpstreamName: "invalid-backend-ref", Weight: 1, Percent: 50},
+		},
+		Message: "the request is proxied to the backends",
+	}
+
+	var buf bytes.Buffer
+	g.Expect(writeRouteTestResult(&buf, res)).To(Succeed())
+
+	expected := `Server:     cafe.example.com (port 80)
+Listener:   http (Gateway test/gateway)
+Location:   /coffee/
+HTTPRoute:  test/coffee, rule 1, match 2
+Filter:     RequestHeaderModifier set My-Header=value, remove Other-Header
+Backend:    test_coffee_80 (weight 1, 50.00%)
+Backend:    invalid-backend-ref (weight 1, 50.00%, invalid: NGINX responds with 500)
+Result:     the request is proxied to the backends
+`
+	g.Expect(buf.String()).To(Equal(expected))
+}
+
+func TestDescribeFilters(t *testing.T) {
+	tests := []struct {
+		name     string
+		expected []string
+		filters  dataplane.Filters
+	}{
+		{
+			filters: dataplane.Filters{
+				DirectResponse: &dataplane.DirectResponse{
+					Headers:     []dataplane.HTTPHeader{{Name: "Cache-Control", Value: "no-store"}},
+					StatusCode:  200,
+					ContentType: "application/json",
+				},
+			},
+			expected: []string{
+				"DirectResponse statusCode=200 contentType=application/json header Cache-Control=no-store",
+			},
+			name: "direct response",
+		},
+		{
+			filters: dataplane.Filters{
+				Authentication: &dataplane.Authentication{Realm: "cafe"},
+			},
+			expected: []string{"Authentication basic realm=cafe"},
+			name:     "basic authentication",
+		},
+		{
+			filters: dataplane.Filters{
+				Authentication: &dataplane.Authentication{
+					JWT: &dataplane.JWTAuthentication{
+						RequiredClaims: map[string]string{"iss": "issuer", "aud": "cafe"},
+					},
+					Realm: "cafe",
+				},
+			},
+			expected: []string{"Authentication JWT realm=cafe requiredClaims=aud=cafe,iss=issuer"},
+			name:     "JWT authentication",
+		},
+		{
+			filters: dataplane.Filters{
+				Authentication: &dataplane.Authentication{
+					External: &dataplane.ExternalAuthentication{
+						UpstreamName: "ext_auth_test_auth",
+						Path:         "/verify",
+					},
+				},
+			},
+			expected: []string{"Authentication external upstream=ext_auth_test_auth path=/verify"},
+			name:     "external authorization",
+		},
+		{
+			filters: dataplane.Filters{},
+			name:    "no filters",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(describeFilters(test.filters)).To(Equal(test.expected))
+		})
+	}
+}
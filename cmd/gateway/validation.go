@@ -4,11 +4,14 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"path/filepath"
 	"regexp"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/webhook"
 )
 
 const (
@@ -99,3 +102,36 @@ func validateIP(ip string) error {
 
 	return nil
 }
+
+func validatePort(port int) error {
+	if port < 1024 || port > 65535 {
+		return fmt.Errorf("port outside of allowed port range [1024 - 65535]: %v", port)
+	}
+
+	return nil
+}
+
+func validateListenerPort(port int) error {
+	if port < 1 || port > 65535 {
+		return fmt.Errorf("port outside of allowed port range [1 - 65535]: %v", port)
+	}
+
+	return nil
+}
+
+func validateWebhookMode(mode string) error {
+	switch webhook.Mode(mode) {
+	case webhook.ModeReject, webhook.ModeWarn:
+		return nil
+	default:
+		return fmt.Errorf("%q must be one of: %s, %s", mode, webhook.ModeReject, webhook.ModeWarn)
+	}
+}
+
+func validateAbsolutePath(path string) error {
+	if !filepath.IsAbs(path) {
+		return fmt.Errorf("%q must be an absolute path", path)
+	}
+
+	return nil
+}
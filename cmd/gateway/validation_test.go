@@ -294,3 +294,156 @@ func TestValidateIP(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePort(t *testing.T) {
+	tests := []struct {
+		name   string
+		port   int
+		expErr bool
+	}{
+		{
+			name:   "port under minimum allowed value",
+			port:   1023,
+			expErr: true,
+		},
+		{
+			name:   "port over maximum allowed value",
+			port:   65536,
+			expErr: true,
+		},
+		{
+			name:   "valid port",
+			port:   9113,
+			expErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			err := validatePort(tc.port)
+			if !tc.expErr {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestValidateListenerPort(t *testing.T) {
+	tests := []struct {
+		name   string
+		port   int
+		expErr bool
+	}{
+		{
+			name:   "port under minimum allowed value",
+			port:   0,
+			expErr: true,
+		},
+		{
+			name:   "port over maximum allowed value",
+			port:   65536,
+			expErr: true,
+		},
+		{
+			name:   "valid port",
+			port:   80,
+			expErr: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			err := validateListenerPort(tc.port)
+			if !tc.expErr {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestValidateWebhookMode(t *testing.T) {
+	tests := []struct {
+		name   string
+		mode   string
+		expErr bool
+	}{
+		{
+			name:   "reject",
+			mode:   "reject",
+			expErr: false,
+		},
+		{
+			name:   "warn",
+			mode:   "warn",
+			expErr: false,
+		},
+		{
+			name:   "unknown mode",
+			mode:   "ignore",
+			expErr: true,
+		},
+		{
+			name:   "empty",
+			mode:   "",
+			expErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			err := validateWebhookMode(tc.mode)
+			if !tc.expErr {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestValidateAbsolutePath(t *testing.T) {
+	tests := []struct {
+		name   string
+		path   string
+		expErr bool
+	}{
+		{
+			name:   "absolute",
+			path:   "/usr/sbin/nginx",
+			expErr: false,
+		},
+		{
+			name:   "relative",
+			path:   "sbin/nginx",
+			expErr: true,
+		},
+		{
+			name:   "empty",
+			path:   "",
+			expErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			err := validateAbsolutePath(tc.path)
+			if !tc.expErr {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+			}
+		})
+	}
+}
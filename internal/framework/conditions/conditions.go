@@ -14,6 +14,11 @@ const (
 
 	// GatewayClassMessageGatewayClassConflict is a message that describes GatewayClassReasonGatewayClassConflict.
 	GatewayClassMessageGatewayClassConflict = "The resource is ignored due to a conflicting GatewayClass resource"
+
+	// GatewayReasonProvisioningFailed indicates that the provisioner failed to provision the resources of the
+	// data plane for the Gateway.
+	// This reason is used with GatewayConditionProgrammed (false).
+	GatewayReasonProvisioningFailed v1beta1.GatewayConditionReason = "ProvisioningFailed"
 )
 
 // Condition defines a condition to be reported in the status of resources.
@@ -46,3 +51,13 @@ func NewGatewayClassConflict() Condition {
 		Message: GatewayClassMessageGatewayClassConflict,
 	}
 }
+
+// NewGatewayClassInvalidParameters returns a Condition that indicates that the GatewayClass has invalid parameters.
+func NewGatewayClassInvalidParameters(msg string) Condition {
+	return Condition{
+		Type:    string(v1beta1.GatewayClassConditionStatusAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1beta1.GatewayClassReasonInvalidParameters),
+		Message: msg,
+	}
+}
@@ -0,0 +1,40 @@
+package status
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+)
+
+// preparePolicyStatus prepares the status for an NKG policy.
+func preparePolicyStatus(status PolicyStatus, transitionTime metav1.Time) nkgAPI.PolicyStatus {
+	return nkgAPI.PolicyStatus{
+		Conditions: convertConditions(status.Conditions, status.ObservedGeneration, transitionTime),
+	}
+}
+
+// newPolicy returns an empty policy of the kind.
+// It panics if the kind is not an NKG policy kind.
+func newPolicy(kind string) nkgAPI.Policy {
+	for _, k := range nkgAPI.PolicyKinds {
+		if k.Name == kind {
+			return k.New()
+		}
+	}
+
+	panic(fmt.Errorf("unknown policy kind %q", kind))
+}
+
+// setPolicyStatus sets the status of the policy.
+// It panics if the object is not an NKG policy.
+func setPolicyStatus(obj client.Object, status nkgAPI.PolicyStatus) {
+	policy, ok := obj.(nkgAPI.Policy)
+	if !ok {
+		panic(fmt.Errorf("unknown policy type %T", obj))
+	}
+
+	policy.SetPolicyStatus(status)
+}
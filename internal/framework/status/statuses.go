@@ -19,11 +19,15 @@ type GatewayStatuses map[types.NamespacedName]GatewayStatus
 // GatewayClassStatuses holds the statuses of GatewayClasses where the key is the namespaced name of a GatewayClass.
 type GatewayClassStatuses map[types.NamespacedName]GatewayClassStatus
 
-// Statuses holds the status-related information about Gateway API resources.
+// PolicyStatuses holds the statuses of NKG policies.
+type PolicyStatuses map[PolicyKey]PolicyStatus
+
+// Statuses holds the status-related information about Gateway API resources and NKG policies.
 type Statuses struct {
 	GatewayClassStatuses GatewayClassStatuses
 	GatewayStatuses      GatewayStatuses
 	HTTPRouteStatuses    HTTPRouteStatuses
+	PolicyStatuses       PolicyStatuses
 }
 
 // GatewayStatus holds the status of the winning Gateway resource.
@@ -69,3 +73,17 @@ type GatewayClassStatus struct {
 	Conditions         []conditions.Condition
 	ObservedGeneration int64
 }
+
+// PolicyKey identifies an NKG policy.
+type PolicyKey struct {
+	// NsName is the namespaced name of the policy.
+	NsName types.NamespacedName
+	// Kind is the kind of the policy. For example, UpstreamSettingsPolicy.
+	Kind string
+}
+
+// PolicyStatus holds status-related information about an NKG policy.
+type PolicyStatus struct {
+	Conditions         []conditions.Condition
+	ObservedGeneration int64
+}
@@ -8,11 +8,13 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Updater
 
-// Updater updates statuses of the Gateway API resources.
+// Updater updates statuses of the Gateway API resources and NKG policies.
 type Updater interface {
 	// Update updates the statuses of the resources.
 	Update(context.Context, Statuses)
@@ -117,6 +119,51 @@ func (upd *updaterImpl) Update(ctx context.Context, statuses Statuses) {
 			)
 		})
 	}
+
+	for key, ps := range statuses.PolicyStatuses {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		upd.update(ctx, key.NsName, newPolicy(key.Kind), func(object client.Object) {
+			setPolicyStatus(object, preparePolicyStatus(ps, upd.cfg.Clock.Now()))
+		})
+	}
+}
+
+// SetStatuses sets the statuses of the objects to the statuses that an Updater with the same configuration would
+// write for them. Unlike the Updater, it doesn't make any API calls, so the Client and Logger of the configuration
+// are not used. Objects without a corresponding status in statuses are not changed.
+func SetStatuses(cfg UpdaterConfig, objects []client.Object, statuses Statuses) {
+	for _, obj := range objects {
+		nsname := client.ObjectKeyFromObject(obj)
+
+		switch o := obj.(type) {
+		case *v1beta1.GatewayClass:
+			if gcs, exist := statuses.GatewayClassStatuses[nsname]; exist && cfg.UpdateGatewayClassStatus {
+				o.Status = prepareGatewayClassStatus(gcs, cfg.Clock.Now())
+			}
+		case *v1beta1.Gateway:
+			if gs, exist := statuses.GatewayStatuses[nsname]; exist {
+				o.Status = prepareGatewayStatus(gs, cfg.PodIP, cfg.Clock.Now())
+			}
+		case *v1beta1.HTTPRoute:
+			if rs, exist := statuses.HTTPRouteStatuses[nsname]; exist {
+				o.Status = prepareHTTPRouteStatus(rs, cfg.GatewayCtlrName, cfg.Clock.Now())
+			}
+		case nkgAPI.Policy:
+			kind, supported := nkgAPI.FindPolicyKind(o)
+			if !supported {
+				continue
+			}
+
+			if ps, exist := statuses.PolicyStatuses[PolicyKey{NsName: nsname, Kind: kind.Name}]; exist {
+				o.SetPolicyStatus(preparePolicyStatus(ps, cfg.Clock.Now()))
+			}
+		}
+	}
 }
 
 func (upd *updaterImpl) update(
@@ -14,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/status"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/status/statusfakes"
@@ -34,6 +35,7 @@ var _ = Describe("Updater", func() {
 		scheme := runtime.NewScheme()
 
 		Expect(v1beta1.AddToScheme(scheme)).Should(Succeed())
+		Expect(nkgAPI.AddToScheme(scheme)).Should(Succeed())
 
 		client = fake.NewClientBuilder().
 			WithScheme(scheme).
@@ -41,6 +43,7 @@ var _ = Describe("Updater", func() {
 				&v1beta1.GatewayClass{},
 				&v1beta1.Gateway{},
 				&v1beta1.HTTPRoute{},
+				&nkgAPI.UpstreamSettingsPolicy{},
 			).
 			Build()
 
@@ -62,6 +65,7 @@ var _ = Describe("Updater", func() {
 			gc            *v1beta1.GatewayClass
 			gw, ignoredGw *v1beta1.Gateway
 			hr            *v1beta1.HTTPRoute
+			usp           *nkgAPI.UpstreamSettingsPolicy
 			ipAddrType    = v1beta1.IPAddressType
 			addr          = v1beta1.GatewayAddress{
 				Type:  &ipAddrType,
@@ -105,6 +109,15 @@ var _ = Describe("Updater", func() {
 							},
 						},
 					},
+					PolicyStatuses: status.PolicyStatuses{
+						{
+							NsName: types.NamespacedName{Namespace: "test", Name: "usp"},
+							Kind:   nkgAPI.UpstreamSettingsPolicyKind,
+						}: {
+							ObservedGeneration: 6,
+							Conditions:         status.CreateTestConditions("Test"),
+						},
+					},
 				}
 			}
 
@@ -209,6 +222,22 @@ var _ = Describe("Updater", func() {
 					},
 				}
 			}
+
+			createExpectedUSP = func() *nkgAPI.UpstreamSettingsPolicy {
+				return &nkgAPI.UpstreamSettingsPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test",
+						Name:      "usp",
+					},
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "UpstreamSettingsPolicy",
+						APIVersion: "gateway.nginx.org/v1alpha1",
+					},
+					Status: nkgAPI.PolicyStatus{
+						Conditions: status.CreateExpectedAPIConditions("Test", 6, fakeClockTime),
+					},
+				}
+			}
 		)
 
 		BeforeAll(func() {
@@ -261,6 +290,16 @@ var _ = Describe("Updater", func() {
 					APIVersion: "gateway.networking.k8s.io/v1beta1",
 				},
 			}
+			usp = &nkgAPI.UpstreamSettingsPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "usp",
+				},
+				TypeMeta: metav1.TypeMeta{
+					Kind:       "UpstreamSettingsPolicy",
+					APIVersion: "gateway.nginx.org/v1alpha1",
+				},
+			}
 		})
 
 		It("should create resources in the API server", func() {
@@ -268,6 +307,7 @@ var _ = Describe("Updater", func() {
 			Expect(client.Create(context.Background(), gw)).Should(Succeed())
 			Expect(client.Create(context.Background(), ignoredGw)).Should(Succeed())
 			Expect(client.Create(context.Background(), hr)).Should(Succeed())
+			Expect(client.Create(context.Background(), usp)).Should(Succeed())
 		})
 
 		It("should update statuses", func() {
@@ -329,6 +369,18 @@ var _ = Describe("Updater", func() {
 			Expect(helpers.Diff(expectedHR, latestHR)).To(BeEmpty())
 		})
 
+		It("should have the updated status of UpstreamSettingsPolicy in the API server", func() {
+			latestUSP := &nkgAPI.UpstreamSettingsPolicy{}
+			expectedUSP := createExpectedUSP()
+
+			err := client.Get(context.Background(), types.NamespacedName{Namespace: "test", Name: "usp"}, latestUSP)
+			Expect(err).Should(Not(HaveOccurred()))
+
+			expectedUSP.ResourceVersion = latestUSP.ResourceVersion
+
+			Expect(helpers.Diff(expectedUSP, latestUSP)).To(BeEmpty())
+		})
+
 		It("should update statuses with canceled context - function normally returns", func() {
 			ctx, cancel := context.WithCancel(context.Background())
 			cancel()
@@ -457,3 +509,178 @@ var _ = Describe("Updater", func() {
 		})
 	})
 })
+
+var _ = Describe("SetStatuses", func() {
+	It("sets the statuses of the objects without making API calls", func() {
+		fakeClockTime := helpers.PrepareTimeForFakeClient(metav1.NewTime(time.Now()))
+		fakeClock := &statusfakes.FakeClock{}
+		fakeClock.NowReturns(fakeClockTime)
+
+		gc := &v1beta1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "my-class"}}
+		gw := &v1beta1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"}}
+		hr := &v1beta1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route1"}}
+		otherHR := &v1beta1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route2"}}
+		usp := &nkgAPI.UpstreamSettingsPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "usp"}}
+		uhp := &nkgAPI.UpstreamHealthPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "uhp"}}
+		rp := &nkgAPI.RetryPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "rp"}}
+		rlp := &nkgAPI.RateLimitPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "rlp"}}
+		csp := &nkgAPI.ClientSettingsPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "csp"}}
+		obsp := &nkgAPI.ObservabilityPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "obsp"}}
+		corsp := &nkgAPI.CORSPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "corsp"}}
+		acp := &nkgAPI.AccessControlPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "acp"}}
+		cp := &nkgAPI.CachePolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "cp"}}
+		cmpp := &nkgAPI.CompressionPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "cmpp"}}
+		epp := &nkgAPI.ErrorPagePolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "epp"}}
+		snp := &nkgAPI.SnippetsPolicy{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "snp"}}
+
+		statuses := status.Statuses{
+			GatewayClassStatuses: status.GatewayClassStatuses{
+				{Name: "my-class"}: {
+					ObservedGeneration: 1,
+					Conditions:         status.CreateTestConditions("Test"),
+				},
+			},
+			GatewayStatuses: status.GatewayStatuses{
+				{Namespace: "test", Name: "gateway"}: {
+					Conditions:         status.CreateTestConditions("Test"),
+					ObservedGeneration: 2,
+				},
+			},
+			HTTPRouteStatuses: status.HTTPRouteStatuses{
+				{Namespace: "test", Name: "route1"}: {
+					ObservedGeneration: 3,
+					ParentStatuses: []status.ParentStatus{
+						{
+							GatewayNsName: types.NamespacedName{Namespace: "test", Name: "gateway"},
+							Conditions:    status.CreateTestConditions("Test"),
+						},
+					},
+				},
+			},
+			PolicyStatuses: status.PolicyStatuses{
+				{
+					NsName: types.NamespacedName{Namespace: "test", Name: "usp"},
+					Kind:   nkgAPI.UpstreamSettingsPolicyKind,
+				}: {
+					ObservedGeneration: 4,
+					Conditions:         status.CreateTestConditions("Test"),
+				},
+				{
+					NsName: types.NamespacedName{Namespace: "test", Name: "uhp"},
+					Kind:   nkgAPI.UpstreamHealthPolicyKind,
+				}: {
+					ObservedGeneration: 5,
+					Conditions:         status.CreateTestConditions("Test"),
+				},
+				{
+					NsName: types.NamespacedName{Namespace: "test", Name: "rp"},
+					Kind:   nkgAPI.RetryPolicyKind,
+				}: {
+					ObservedGeneration: 6,
+					Conditions:         status.CreateTestConditions("Test"),
+				},
+				{
+					NsName: types.NamespacedName{Namespace: "test", Name: "rlp"},
+					Kind:   nkgAPI.RateLimitPolicyKind,
+				}: {
+					ObservedGeneration: 7,
+					Conditions:         status.CreateTestConditions("Test"),
+				},
+				{
+					NsName: types.NamespacedName{Namespace: "test", Name: "csp"},
+					Kind:   nkgAPI.ClientSettingsPolicyKind,
+				}: {
+					ObservedGeneration: 8,
+					Conditions:         status.CreateTestConditions("Test"),
+				},
+				{
+					NsName: types.NamespacedName{Namespace: "test", Name: "obsp"},
+					Kind:   nkgAPI.ObservabilityPolicyKind,
+				}: {
+					ObservedGeneration: 9,
+					Conditions:         status.CreateTestConditions("Test"),
+				},
+				{
+					NsName: types.NamespacedName{Namespace: "test", Name: "corsp"},
+					Kind:   nkgAPI.CORSPolicyKind,
+				}: {
+					ObservedGeneration: 10,
+					Conditions:         status.CreateTestConditions("Test"),
+				},
+				{
+					NsName: types.NamespacedName{Namespace: "test", Name: "acp"},
+					Kind:   nkgAPI.AccessControlPolicyKind,
+				}: {
+					ObservedGeneration: 11,
+					Conditions:         status.CreateTestConditions("Test"),
+				},
+				{
+					NsName: types.NamespacedName{Namespace: "test", Name: "cp"},
+					Kind:   nkgAPI.CachePolicyKind,
+				}: {
+					ObservedGeneration: 12,
+					Conditions:         status.CreateTestConditions("Test"),
+				},
+				{
+					NsName: types.NamespacedName{Namespace: "test", Name: "cmpp"},
+					Kind:   nkgAPI.CompressionPolicyKind,
+				}: {
+					ObservedGeneration: 13,
+					Conditions:         status.CreateTestConditions("Test"),
+				},
+				{
+					NsName: types.NamespacedName{Namespace: "test", Name: "epp"},
+					Kind:   nkgAPI.ErrorPagePolicyKind,
+				}: {
+					ObservedGeneration: 14,
+					Conditions:         status.CreateTestConditions("Test"),
+				},
+				{
+					NsName: types.NamespacedName{Namespace: "test", Name: "snp"},
+					Kind:   nkgAPI.SnippetsPolicyKind,
+				}: {
+					ObservedGeneration: 15,
+					Conditions:         status.CreateTestConditions("Test"),
+				},
+			},
+		}
+
+		status.SetStatuses(
+			status.UpdaterConfig{
+				Clock:                    fakeClock,
+				GatewayCtlrName:          "test.example.com",
+				PodIP:                    "1.2.3.4",
+				UpdateGatewayClassStatus: true,
+			},
+			[]client.Object{gc, gw, hr, otherHR, usp, uhp, rp, rlp, csp, obsp, corsp, acp, cp, cmpp, epp, snp},
+			statuses,
+		)
+
+		Expect(gc.Status.Conditions).To(Equal(status.CreateExpectedAPIConditions("Test", 1, fakeClockTime)))
+
+		Expect(gw.Status.Conditions).To(Equal(status.CreateExpectedAPIConditions("Test", 2, fakeClockTime)))
+		Expect(gw.Status.Addresses).To(HaveLen(1))
+		Expect(gw.Status.Addresses[0].Value).To(Equal("1.2.3.4"))
+
+		Expect(hr.Status.Parents).To(HaveLen(1))
+		Expect(hr.Status.Parents[0].ControllerName).To(Equal(v1beta1.GatewayController("test.example.com")))
+		Expect(hr.Status.Parents[0].Conditions).To(
+			Equal(status.CreateExpectedAPIConditions("Test", 3, fakeClockTime)),
+		)
+
+		Expect(otherHR.Status).To(Equal(v1beta1.HTTPRouteStatus{}))
+
+		Expect(usp.Status.Conditions).To(Equal(status.CreateExpectedAPIConditions("Test", 4, fakeClockTime)))
+		Expect(uhp.Status.Conditions).To(Equal(status.CreateExpectedAPIConditions("Test", 5, fakeClockTime)))
+		Expect(rp.Status.Conditions).To(Equal(status.CreateExpectedAPIConditions("Test", 6, fakeClockTime)))
+		Expect(rlp.Status.Conditions).To(Equal(status.CreateExpectedAPIConditions("Test", 7, fakeClockTime)))
+		Expect(csp.Status.Conditions).To(Equal(status.CreateExpectedAPIConditions("Test", 8, fakeClockTime)))
+		Expect(obsp.Status.Conditions).To(Equal(status.CreateExpectedAPIConditions("Test", 9, fakeClockTime)))
+		Expect(corsp.Status.Conditions).To(Equal(status.CreateExpectedAPIConditions("Test", 10, fakeClockTime)))
+		Expect(acp.Status.Conditions).To(Equal(status.CreateExpectedAPIConditions("Test", 11, fakeClockTime)))
+		Expect(cp.Status.Conditions).To(Equal(status.CreateExpectedAPIConditions("Test", 12, fakeClockTime)))
+		Expect(cmpp.Status.Conditions).To(Equal(status.CreateExpectedAPIConditions("Test", 13, fakeClockTime)))
+		Expect(epp.Status.Conditions).To(Equal(status.CreateExpectedAPIConditions("Test", 14, fakeClockTime)))
+		Expect(snp.Status.Conditions).To(Equal(status.CreateExpectedAPIConditions("Test", 15, fakeClockTime)))
+	})
+})
@@ -1,33 +1,213 @@
 package provisioner
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"sort"
 
 	v1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
+// staticModeContainerName is the name of the static mode container in the Deployment manifest.
+const staticModeContainerName = "nginx-gateway"
+
 // prepareDeployment prepares a new the static mode Deployment based on the YAML manifest.
-// It will use the specified id to set unique parts of the deployment, so it must be unique among all Deployments for
-// Gateways.
+// It applies the patches in order before configuring the parts of the Deployment that NKG relies on.
+// It will use the specified name to set unique parts of the deployment, so it must be unique among all Deployments
+// for Gateways.
 // It will configure the Deployment to use the Gateway with the given NamespacedName.
-func prepareDeployment(depYAML []byte, id string, gwNsName types.NamespacedName) (*v1.Deployment, error) {
+func prepareDeployment(
+	depYAML []byte,
+	name string,
+	gwNsName types.NamespacedName,
+	patches ...deploymentPatch,
+) (*v1.Deployment, error) {
+	depJSON, err := yaml.ToJSON(depYAML)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal deployment: %w", err)
+	}
+
+	for _, p := range patches {
+		depJSON, err = applyDeploymentPatch(depJSON, p)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	dep := &v1.Deployment{}
-	err := yaml.Unmarshal(depYAML, dep)
+	err = yaml.Unmarshal(depJSON, dep)
 	if err != nil {
 		return nil, fmt.Errorf("failed to unmarshal deployment: %w", err)
 	}
 
-	dep.ObjectMeta.Name = id
-	dep.Spec.Selector.MatchLabels["app"] = id
-	dep.Spec.Template.ObjectMeta.Labels["app"] = id
+	container := findContainer(dep, staticModeContainerName)
+	if container == nil {
+		return nil, fmt.Errorf("deployment must include the container %q", staticModeContainerName)
+	}
+
+	dep.ObjectMeta.Name = name
+	if dep.Spec.Selector == nil {
+		dep.Spec.Selector = &metav1.LabelSelector{}
+	}
+	dep.Spec.Selector.MatchLabels = map[string]string{"app": name}
+	if dep.Spec.Template.ObjectMeta.Labels == nil {
+		dep.Spec.Template.ObjectMeta.Labels = make(map[string]string)
+	}
+	dep.Spec.Template.ObjectMeta.Labels["app"] = name
 
 	extraArgs := []string{
 		"--gateway=" + gwNsName.String(),
 		"--update-gatewayclass-status=false",
 	}
-	dep.Spec.Template.Spec.Containers[0].Args = append(dep.Spec.Template.Spec.Containers[0].Args, extraArgs...)
+	container.Args = append(container.Args, extraArgs...)
 
 	return dep, nil
 }
+
+// applyDeploymentPatch applies the strategic merge patch to the Deployment in JSON.
+// The patch must not change the fields that the provisioner manages.
+func applyDeploymentPatch(depJSON []byte, p deploymentPatch) ([]byte, error) {
+	if len(bytes.TrimSpace(p.data)) == 0 {
+		return depJSON, nil
+	}
+
+	patchJSON, err := yaml.ToJSON(p.data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the deployment patch from %s: %w", p.source, err)
+	}
+
+	var patchDep v1.Deployment
+	if err := json.Unmarshal(patchJSON, &patchDep); err != nil {
+		return nil, fmt.Errorf("invalid deployment patch from %s: %w", p.source, err)
+	}
+
+	if p.allowedFields != nil {
+		var patch interface{}
+		if err := json.Unmarshal(patchJSON, &patch); err != nil {
+			return nil, fmt.Errorf("invalid deployment patch from %s: %w", p.source, err)
+		}
+
+		if err := validatePatchFields(patch, p.allowedFields, nil); err != nil {
+			return nil, fmt.Errorf("invalid deployment patch from %s: %w", p.source, err)
+		}
+	}
+
+	if patchDep.Name != "" || patchDep.Namespace != "" || patchDep.Spec.Selector != nil {
+		return nil, fmt.Errorf(
+			"invalid deployment patch from %s: metadata.name, metadata.namespace and spec.selector cannot be set",
+			p.source,
+		)
+	}
+
+	result, err := strategicpatch.StrategicMergePatch(depJSON, patchJSON, v1.Deployment{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply the deployment patch from %s: %w", p.source, err)
+	}
+
+	return result, nil
+}
+
+// validatePatchFields validates that the patch only sets the allowed fields. The directives of strategic merge
+// patches, like $patch, are not allowed fields either, so the patch cannot remove the fields that it cannot set.
+func validatePatchFields(value interface{}, allowed *patchFields, path *field.Path) error {
+	if allowed == nil {
+		return nil
+	}
+
+	if allowed.items != nil {
+		items, ok := value.([]interface{})
+		if !ok {
+			return field.Invalid(path, value, "must be a list")
+		}
+
+		for i, item := range items {
+			if err := validatePatchFields(item, allowed.items, path.Index(i)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	obj, ok := value.(map[string]interface{})
+	if !ok {
+		return field.Invalid(path, value, "must be an object")
+	}
+
+	// sort the fields for a deterministic error
+	names := make([]string, 0, len(obj))
+	for name := range obj {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fieldAllowed, exists := allowed.fields[name]
+		if !exists {
+			return field.Forbidden(path.Child(name), "cannot be set by the patch")
+		}
+
+		if err := validatePatchFields(obj[name], fieldAllowed, path.Child(name)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func findContainer(dep *v1.Deployment, name string) *apiv1.Container {
+	for i := range dep.Spec.Template.Spec.Containers {
+		if dep.Spec.Template.Spec.Containers[i].Name == name {
+			return &dep.Spec.Template.Spec.Containers[i]
+		}
+	}
+
+	return nil
+}
+
+// prepareService prepares a new LoadBalancer Service that exposes the listener ports of the Gateway
+// for the static mode Deployment with the given name.
+func prepareService(name, namespace string, gw *v1beta1.Gateway) *apiv1.Service {
+	uniquePorts := make(map[v1beta1.PortNumber]struct{})
+	for _, l := range gw.Spec.Listeners {
+		uniquePorts[l.Port] = struct{}{}
+	}
+
+	ports := make([]apiv1.ServicePort, 0, len(uniquePorts))
+	for port := range uniquePorts {
+		ports = append(ports, apiv1.ServicePort{
+			Name:       fmt.Sprintf("port-%d", port),
+			Protocol:   apiv1.ProtocolTCP,
+			Port:       int32(port),
+			TargetPort: intstr.FromInt(int(port)),
+		})
+	}
+
+	sort.Slice(ports, func(i, j int) bool {
+		return ports[i].Port < ports[j].Port
+	})
+
+	return &apiv1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+		},
+		Spec: apiv1.ServiceSpec{
+			Type:                  apiv1.ServiceTypeLoadBalancer,
+			ExternalTrafficPolicy: apiv1.ServiceExternalTrafficPolicyTypeLocal,
+			Selector: map[string]string{
+				"app": name,
+			},
+			Ports: ports,
+		},
+	}
+}
@@ -0,0 +1,295 @@
+package provisioner
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	embeddedfiles "github.com/nginxinc/nginx-kubernetes-gateway"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+)
+
+func TestPrepareDeployment(t *testing.T) {
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	gcPatch := deploymentPatch{
+		source: "gatewayclass",
+		data: []byte(`
+spec:
+  replicas: 2
+  template:
+    spec:
+      nodeSelector:
+        pool: gateways
+      tolerations:
+      - key: dedicated
+        operator: Equal
+        value: gateways
+        effect: NoSchedule
+`),
+	}
+
+	gwPatch := deploymentPatch{
+		source: "gateway",
+		data: []byte(`{
+  "spec": {
+    "replicas": 3,
+    "template": {
+      "spec": {
+        "containers": [
+          {
+            "name": "nginx",
+            "resources": {"limits": {"cpu": "500m"}}
+          }
+        ]
+      }
+    }
+  }
+}`),
+	}
+
+	g := NewWithT(t)
+
+	dep, err := prepareDeployment(embeddedfiles.StaticModeDeploymentYAML, "nginx-gateway-1", gwNsName, gcPatch, gwPatch)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(dep.Name).To(Equal("nginx-gateway-1"))
+	g.Expect(dep.Namespace).To(Equal("nginx-gateway"))
+	g.Expect(dep.Spec.Replicas).To(Equal(helpers.GetPointer[int32](3)))
+	g.Expect(dep.Spec.Selector.MatchLabels).To(Equal(map[string]string{"app": "nginx-gateway-1"}))
+	g.Expect(dep.Spec.Template.Labels).To(HaveKeyWithValue("app", "nginx-gateway-1"))
+	g.Expect(dep.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"pool": "gateways"}))
+	g.Expect(dep.Spec.Template.Spec.Tolerations).To(HaveLen(1))
+
+	// the patch is merged into the existing container
+	g.Expect(dep.Spec.Template.Spec.Containers).To(HaveLen(2))
+
+	nginx := findContainer(dep, "nginx")
+	g.Expect(nginx).ToNot(BeNil())
+	g.Expect(nginx.Image).ToNot(BeEmpty())
+	g.Expect(nginx.Resources.Limits).To(HaveKeyWithValue(apiv1.ResourceCPU, resource.MustParse("500m")))
+
+	staticMode := findContainer(dep, staticModeContainerName)
+	g.Expect(staticMode).ToNot(BeNil())
+	g.Expect(staticMode.Args).To(ContainElements("static-mode", "--gateway=test/gateway"))
+}
+
+func TestPrepareDeploymentInvalidPatch(t *testing.T) {
+	tests := []struct {
+		name           string
+		patch          string
+		expectedErrMsg string
+	}{
+		{
+			name:           "invalid YAML",
+			patch:          "spec: [",
+			expectedErrMsg: "failed to parse the deployment patch from test",
+		},
+		{
+			name:           "invalid type of field",
+			patch:          "spec: {replicas: many}",
+			expectedErrMsg: "invalid deployment patch from test",
+		},
+		{
+			name:           "name is set",
+			patch:          "metadata: {name: other}",
+			expectedErrMsg: "metadata.name, metadata.namespace and spec.selector cannot be set",
+		},
+		{
+			name:           "namespace is set",
+			patch:          "metadata: {namespace: other}",
+			expectedErrMsg: "metadata.name, metadata.namespace and spec.selector cannot be set",
+		},
+		{
+			name:           "selector is set",
+			patch:          "spec: {selector: {matchLabels: {app: other}}}",
+			expectedErrMsg: "metadata.name, metadata.namespace and spec.selector cannot be set",
+		},
+		{
+			name:           "static mode container is removed",
+			patch:          "spec: {template: {spec: {containers: [{name: nginx-gateway, $patch: delete}]}}}",
+			expectedErrMsg: `deployment must include the container "nginx-gateway"`,
+		},
+	}
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			dep, err := prepareDeployment(
+				embeddedfiles.StaticModeDeploymentYAML,
+				"nginx-gateway-1",
+				gwNsName,
+				deploymentPatch{source: "test", data: []byte(test.patch)},
+			)
+			g.Expect(err).To(MatchError(ContainSubstring(test.expectedErrMsg)))
+			g.Expect(dep).To(BeNil())
+		})
+	}
+}
+
+func TestPrepareDeploymentEmptyPatch(t *testing.T) {
+	g := NewWithT(t)
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	expected, err := prepareDeployment(embeddedfiles.StaticModeDeploymentYAML, "nginx-gateway-1", gwNsName)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	dep, err := prepareDeployment(
+		embeddedfiles.StaticModeDeploymentYAML,
+		"nginx-gateway-1",
+		gwNsName,
+		deploymentPatch{source: "test", data: []byte("  \n")},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dep).To(Equal(expected))
+}
+
+func TestPrepareDeploymentGatewayPatch(t *testing.T) {
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	createGateway := func(patch string) *v1beta1.Gateway {
+		return &v1beta1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:   gwNsName.Namespace,
+				Name:        gwNsName.Name,
+				Annotations: map[string]string{deploymentPatchAnnotation: patch},
+			},
+		}
+	}
+
+	g := NewWithT(t)
+
+	allowed := `
+metadata:
+  labels:
+    team: cafe
+  annotations:
+    owner: cafe
+spec:
+  replicas: 3
+  template:
+    metadata:
+      labels:
+        team: cafe
+      annotations:
+        owner: cafe
+    spec:
+      nodeSelector:
+        pool: gateways
+      tolerations:
+      - key: dedicated
+        operator: Exists
+      affinity:
+        podAntiAffinity:
+          preferredDuringSchedulingIgnoredDuringExecution:
+          - weight: 100
+            podAffinityTerm:
+              topologyKey: kubernetes.io/hostname
+      containers:
+      - name: nginx
+        resources:
+          limits:
+            cpu: 500m
+`
+
+	dep, err := prepareDeployment(
+		embeddedfiles.StaticModeDeploymentYAML,
+		"nginx-gateway-1",
+		gwNsName,
+		*getGatewayDeploymentPatch(createGateway(allowed)),
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(dep.Spec.Replicas).To(Equal(helpers.GetPointer[int32](3)))
+	g.Expect(dep.Labels).To(HaveKeyWithValue("team", "cafe"))
+	g.Expect(dep.Spec.Template.Annotations).To(HaveKeyWithValue("owner", "cafe"))
+	g.Expect(dep.Spec.Template.Spec.Affinity).ToNot(BeNil())
+	g.Expect(findContainer(dep, "nginx").Resources.Limits).To(HaveKey(apiv1.ResourceCPU))
+
+	tests := []struct {
+		name           string
+		patch          string
+		expectedErrMsg string
+	}{
+		{
+			name:           "service account",
+			patch:          "spec: {template: {spec: {serviceAccountName: admin}}}",
+			expectedErrMsg: "spec.template.spec.serviceAccountName: Forbidden",
+		},
+		{
+			name:           "image",
+			patch:          "spec: {template: {spec: {containers: [{name: nginx, image: evil}]}}}",
+			expectedErrMsg: "spec.template.spec.containers[0].image: Forbidden",
+		},
+		{
+			name: "privileged container",
+			patch: "spec: {template: {spec: {containers: " +
+				"[{name: nginx, securityContext: {privileged: true}}]}}}",
+			expectedErrMsg: "spec.template.spec.containers[0].securityContext: Forbidden",
+		},
+		{
+			name:           "env",
+			patch:          "spec: {template: {spec: {containers: [{name: nginx, env: [{name: A, value: b}]}]}}}",
+			expectedErrMsg: "spec.template.spec.containers[0].env: Forbidden",
+		},
+		{
+			name: "host path volume",
+			patch: "spec: {template: {spec: {volumes: " +
+				"[{name: host, hostPath: {path: /}}]}}}",
+			expectedErrMsg: "spec.template.spec.volumes: Forbidden",
+		},
+		{
+			name:           "pod security context",
+			patch:          "spec: {template: {spec: {hostNetwork: true}}}",
+			expectedErrMsg: "spec.template.spec.hostNetwork: Forbidden",
+		},
+		{
+			name:           "init container",
+			patch:          "spec: {template: {spec: {initContainers: [{name: init, image: evil}]}}}",
+			expectedErrMsg: "spec.template.spec.initContainers: Forbidden",
+		},
+		{
+			name:           "strategy",
+			patch:          "spec: {strategy: {type: Recreate}}",
+			expectedErrMsg: "spec.strategy: Forbidden",
+		},
+		{
+			name:           "patch directive",
+			patch:          "spec: {template: {spec: {containers: [{name: nginx, $patch: replace}]}}}",
+			expectedErrMsg: "spec.template.spec.containers[0].$patch: Forbidden",
+		},
+		{
+			name:           "element order directive",
+			patch:          `spec: {template: {spec: {"$setElementOrder/containers": [{name: nginx}]}}}`,
+			expectedErrMsg: "spec.template.spec.$setElementOrder/containers: Forbidden",
+		},
+		{
+			name:           "containers removed",
+			patch:          "spec: {template: {spec: {containers: null}}}",
+			expectedErrMsg: "spec.template.spec.containers: Invalid value: \"null\": must be a list",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			dep, err := prepareDeployment(
+				embeddedfiles.StaticModeDeploymentYAML,
+				"nginx-gateway-1",
+				gwNsName,
+				*getGatewayDeploymentPatch(createGateway(test.patch)),
+			)
+			g.Expect(err).To(MatchError(ContainSubstring(test.expectedErrMsg)))
+			g.Expect(dep).To(BeNil())
+		})
+	}
+}
@@ -3,11 +3,20 @@ package provisioner
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/events"
@@ -15,23 +24,18 @@ import (
 )
 
 // eventHandler ensures each Gateway for the specific GatewayClass has a corresponding Deployment
-// of NKG configured to use that specific Gateway.
+// of NKG configured to use that specific Gateway and a Service that exposes the listener ports of the Gateway.
 //
 // eventHandler implements events.Handler interface.
 type eventHandler struct {
 	gcName string
 	store  *store
 
-	// provisions maps NamespacedName of Gateway to its corresponding Deployment
-	provisions map[types.NamespacedName]*v1.Deployment
-
 	statusUpdater status.Updater
 	k8sClient     client.Client
 	logger        logr.Logger
 
 	staticModeDeploymentYAML []byte
-
-	gatewayNextID int64
 }
 
 func newEventHandler(
@@ -43,13 +47,11 @@ func newEventHandler(
 ) *eventHandler {
 	return &eventHandler{
 		store:                    newStore(),
-		provisions:               make(map[types.NamespacedName]*v1.Deployment),
 		statusUpdater:            statusUpdater,
 		gcName:                   gcName,
 		k8sClient:                k8sClient,
 		logger:                   logger,
 		staticModeDeploymentYAML: staticModeDeploymentYAML,
-		gatewayNextID:            1,
 	}
 }
 
@@ -64,6 +66,10 @@ func (h *eventHandler) setGatewayClassStatuses(ctx context.Context) {
 		if gc.Name == h.gcName {
 			gcExists = true
 			conds = conditions.NewDefaultGatewayClassConditions()
+
+			if _, err := getGatewayClassDeploymentPatch(gc, h.store.configMaps); err != nil {
+				conds = []conditions.Condition{conditions.NewGatewayClassInvalidParameters(err.Error())}
+			}
 		} else {
 			conds = []conditions.Condition{conditions.NewGatewayClassConflict()}
 		}
@@ -80,79 +86,271 @@ func (h *eventHandler) setGatewayClassStatuses(ctx context.Context) {
 	h.statusUpdater.Update(ctx, statuses)
 }
 
+// ensureDeploymentsMatchGateways reconciles the Deployments and the Services toward the desired state:
+// a Deployment and a Service for each Gateway of the GatewayClass. It creates missing resources, repairs
+// the resources that were changed, and deletes the resources of the removed Gateways.
+// The provisioning failures are reported in the status of the corresponding Gateways.
 func (h *eventHandler) ensureDeploymentsMatchGateways(ctx context.Context) {
-	var gwsWithoutDeps, removedGwsWithDeps []types.NamespacedName
+	gc := h.store.gatewayClasses[types.NamespacedName{Name: h.gcName}]
 
+	gwNsNames := make([]types.NamespacedName, 0, len(h.store.gateways))
 	for nsname, gw := range h.store.gateways {
 		if string(gw.Spec.GatewayClassName) != h.gcName {
 			continue
 		}
-		if _, exist := h.provisions[nsname]; exist {
-			continue
+		gwNsNames = append(gwNsNames, nsname)
+	}
+
+	sort.Slice(gwNsNames, func(i, j int) bool {
+		return gwNsNames[i].String() < gwNsNames[j].String()
+	})
+
+	gcPatch, gcPatchErr := getGatewayClassDeploymentPatch(gc, h.store.configMaps)
+
+	provisioned := make(map[types.NamespacedName]struct{}, len(gwNsNames))
+
+	for _, nsname := range gwNsNames {
+		gw := h.store.gateways[nsname]
+
+		var err error
+		if gcPatchErr != nil {
+			// We don't update the existing resources until the parameters are fixed.
+			err = fmt.Errorf("GatewayClass %s has invalid parameters: %w", gc.Name, gcPatchErr)
+		} else {
+			var patches []deploymentPatch
+			if gcPatch != nil {
+				patches = append(patches, *gcPatch)
+			}
+			if gwPatch := getGatewayDeploymentPatch(gw); gwPatch != nil {
+				patches = append(patches, *gwPatch)
+			}
+
+			err = h.provision(ctx, gc, gw, patches)
 		}
+		if err != nil {
+			h.logger.Error(err, "Failed to provision resources for Gateway", "gateway", nsname)
+		}
+
+		h.setProvisioningStatus(ctx, gw, err)
 
-		gwsWithoutDeps = append(gwsWithoutDeps, nsname)
+		provisioned[nsname] = struct{}{}
 	}
 
-	for nsname := range h.provisions {
-		if _, exist := h.store.gateways[nsname]; exist {
-			continue
+	h.removeUnnecessaryResources(ctx, provisioned)
+}
+
+func (h *eventHandler) provision(
+	ctx context.Context,
+	gc *v1beta1.GatewayClass,
+	gw *v1beta1.Gateway,
+	patches []deploymentPatch,
+) error {
+	gwNsName := client.ObjectKeyFromObject(gw)
+	name := provisionedResourceName(gwNsName)
+
+	desiredDep, err := prepareDeployment(h.staticModeDeploymentYAML, name, gwNsName, patches...)
+	if err != nil {
+		return fmt.Errorf("failed to prepare deployment: %w", err)
+	}
+	setProvisionedMetadata(desiredDep, gc, gwNsName)
+
+	specHash, err := hashSpec(desiredDep.Spec)
+	if err != nil {
+		return fmt.Errorf("failed to prepare deployment: %w", err)
+	}
+
+	dep := &v1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: desiredDep.Namespace, Name: desiredDep.Name}}
+
+	res, err := controllerutil.CreateOrUpdate(ctx, h.k8sClient, dep, func() error {
+		if err := h.checkProvisionedFor(dep, gwNsName); err != nil {
+			return err
 		}
 
-		removedGwsWithDeps = append(removedGwsWithDeps, nsname)
+		setProvisionedMetadata(dep, gc, gwNsName)
+
+		// Kubernetes sets the default values of the fields we don't specify, so we only update the spec if
+		// the fields that we specify are different.
+		// DeepDerivative doesn't detect the fields removed from the desired spec (for example, by a patch),
+		// so we also compare the hash of the desired spec, which we keep in an annotation.
+		if dep.Annotations[specHashAnnotation] != specHash ||
+			!equality.Semantic.DeepDerivative(desiredDep.Spec, dep.Spec) {
+			dep.Spec = desiredDep.Spec
+		}
+
+		dep.Annotations[specHashAnnotation] = specHash
+
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update deployment %s: %w", client.ObjectKeyFromObject(dep), err)
 	}
 
-	// Create new deployments
+	h.logOperationResult(res, dep, gwNsName)
 
-	for _, nsname := range gwsWithoutDeps {
-		deployment, err := prepareDeployment(h.staticModeDeploymentYAML, h.generateDeploymentID(), nsname)
-		if err != nil {
-			panic(fmt.Errorf("failed to prepare deployment: %w", err))
+	desiredSvc := prepareService(name, desiredDep.Namespace, gw)
+
+	svc := &apiv1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: desiredSvc.Namespace, Name: desiredSvc.Name}}
+
+	res, err = controllerutil.CreateOrUpdate(ctx, h.k8sClient, svc, func() error {
+		if err := h.checkProvisionedFor(svc, gwNsName); err != nil {
+			return err
 		}
 
-		err = h.k8sClient.Create(ctx, deployment)
-		if err != nil {
-			panic(fmt.Errorf("failed to create deployment: %w", err))
+		setProvisionedMetadata(svc, gc, gwNsName)
+
+		// Kubernetes allocates some of the fields of the spec, like the cluster IP and the node ports,
+		// so we only update the fields that we specify.
+		if !equality.Semantic.DeepDerivative(desiredSvc.Spec, svc.Spec) {
+			svc.Spec.Type = desiredSvc.Spec.Type
+			svc.Spec.ExternalTrafficPolicy = desiredSvc.Spec.ExternalTrafficPolicy
+			svc.Spec.Selector = desiredSvc.Spec.Selector
+			svc.Spec.Ports = desiredSvc.Spec.Ports
 		}
 
-		h.provisions[nsname] = deployment
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create or update service %s: %w", client.ObjectKeyFromObject(svc), err)
+	}
 
-		h.logger.Info("Created deployment",
-			"deployment", client.ObjectKeyFromObject(deployment),
-			"gateway", nsname,
-		)
+	h.logOperationResult(res, svc, gwNsName)
+
+	return nil
+}
+
+// checkProvisionedFor ensures that an existing resource was provisioned for the Gateway, so that the provisioner
+// doesn't take over the resources created by someone else.
+func (h *eventHandler) checkProvisionedFor(obj client.Object, gwNsName types.NamespacedName) error {
+	if obj.GetResourceVersion() == "" {
+		// the resource doesn't exist yet
+		return nil
 	}
 
-	// Remove unnecessary deployments
+	if provisionedFor, ok := getProvisionedFor(obj, h.gcName); !ok || provisionedFor != gwNsName {
+		return fmt.Errorf("the resource already exists and is not provisioned for the Gateway %s", gwNsName)
+	}
 
-	for _, nsname := range removedGwsWithDeps {
-		deployment := h.provisions[nsname]
+	return nil
+}
 
-		err := h.k8sClient.Delete(ctx, deployment)
-		if err != nil {
-			panic(fmt.Errorf("failed to delete deployment: %w", err))
+func (h *eventHandler) logOperationResult(
+	res controllerutil.OperationResult,
+	obj client.Object,
+	gwNsName types.NamespacedName,
+) {
+	if res == controllerutil.OperationResultNone {
+		return
+	}
+
+	h.logger.Info(fmt.Sprintf("%T %s", obj, res),
+		"name", client.ObjectKeyFromObject(obj),
+		"gateway", gwNsName,
+	)
+}
+
+// removeUnnecessaryResources deletes the Deployments and the Services that were provisioned for the Gateways
+// that no longer exist or no longer belong to the GatewayClass.
+func (h *eventHandler) removeUnnecessaryResources(
+	ctx context.Context,
+	provisioned map[types.NamespacedName]struct{},
+) {
+	var deps v1.DeploymentList
+	if err := h.k8sClient.List(ctx, &deps, client.MatchingLabels{gatewayClassLabel: h.gcName}); err != nil {
+		h.logger.Error(err, "Failed to list deployments")
+	}
+
+	var svcs apiv1.ServiceList
+	if err := h.k8sClient.List(ctx, &svcs, client.MatchingLabels{gatewayClassLabel: h.gcName}); err != nil {
+		h.logger.Error(err, "Failed to list services")
+	}
+
+	objs := make([]client.Object, 0, len(deps.Items)+len(svcs.Items))
+	for i := range deps.Items {
+		objs = append(objs, &deps.Items[i])
+	}
+	for i := range svcs.Items {
+		objs = append(objs, &svcs.Items[i])
+	}
+
+	for _, obj := range objs {
+		gwNsName, ok := getProvisionedFor(obj, h.gcName)
+		if ok {
+			if _, exists := provisioned[gwNsName]; exists {
+				continue
+			}
 		}
 
-		delete(h.provisions, nsname)
+		if err := h.k8sClient.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+			h.logger.Error(err, fmt.Sprintf("Failed to delete %T", obj),
+				"name", client.ObjectKeyFromObject(obj),
+				"gateway", gwNsName,
+			)
+			continue
+		}
 
-		h.logger.Info("Deleted deployment",
-			"deployment", client.ObjectKeyFromObject(deployment),
-			"gateway", nsname,
+		h.logger.Info(fmt.Sprintf("%T deleted", obj),
+			"name", client.ObjectKeyFromObject(obj),
+			"gateway", gwNsName,
 		)
 	}
 }
 
+// setProvisioningStatus reports the provisioning failure in the Programmed condition of the Gateway.
+// If the provisioning succeeds, it removes the condition reported for the previous failure.
+//
+// The provisioner doesn't use the status updater, because the static mode Deployment owns the rest of
+// the Gateway status.
+func (h *eventHandler) setProvisioningStatus(ctx context.Context, gw *v1beta1.Gateway, provisionErr error) {
+	failedCond := meta.FindStatusCondition(gw.Status.Conditions, string(v1beta1.GatewayConditionProgrammed))
+	if failedCond != nil && failedCond.Reason != string(conditions.GatewayReasonProvisioningFailed) {
+		failedCond = nil
+	}
+
+	var update func(conds *[]metav1.Condition)
+
+	switch {
+	case provisionErr != nil:
+		msg := provisionErr.Error()
+		if failedCond != nil && failedCond.Message == msg && failedCond.ObservedGeneration == gw.Generation {
+			return
+		}
+
+		update = func(conds *[]metav1.Condition) {
+			meta.SetStatusCondition(conds, metav1.Condition{
+				Type:               string(v1beta1.GatewayConditionProgrammed),
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: gw.Generation,
+				Reason:             string(conditions.GatewayReasonProvisioningFailed),
+				Message:            msg,
+			})
+		}
+	case failedCond != nil:
+		update = func(conds *[]metav1.Condition) {
+			meta.RemoveStatusCondition(conds, string(v1beta1.GatewayConditionProgrammed))
+		}
+	default:
+		return
+	}
+
+	nsname := client.ObjectKeyFromObject(gw)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		var latest v1beta1.Gateway
+		if err := h.k8sClient.Get(ctx, nsname, &latest); err != nil {
+			return err
+		}
+
+		update(&latest.Status.Conditions)
+
+		return h.k8sClient.Status().Update(ctx, &latest)
+	})
+	if err != nil && !apierrors.IsNotFound(err) {
+		h.logger.Error(err, "Failed to update the status of Gateway", "gateway", nsname)
+	}
+}
+
 func (h *eventHandler) HandleEventBatch(ctx context.Context, batch events.EventBatch) {
 	h.store.update(batch)
 	h.setGatewayClassStatuses(ctx)
 	h.ensureDeploymentsMatchGateways(ctx)
 }
-
-func (h *eventHandler) generateDeploymentID() string {
-	// This approach will break if the provisioner is restarted, because the existing Gateways might get
-	// IDs different from the previous replica of the provisioner.
-	id := h.gatewayNextID
-	h.gatewayNextID++
-
-	return fmt.Sprintf("nginx-gateway-%d", id)
-}
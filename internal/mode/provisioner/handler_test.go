@@ -6,6 +6,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	v1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -41,6 +42,7 @@ var _ = Describe("handler", func() {
 
 		Expect(v1beta1.AddToScheme(scheme)).Should(Succeed())
 		Expect(v1.AddToScheme(scheme)).Should(Succeed())
+		Expect(apiv1.AddToScheme(scheme)).Should(Succeed())
 
 		k8sclient = fake.NewClientBuilder().
 			WithScheme(scheme).
@@ -73,10 +75,24 @@ var _ = Describe("handler", func() {
 			},
 			Spec: v1beta1.GatewaySpec{
 				GatewayClassName: gcName,
+				Listeners: []v1beta1.Listener{
+					{
+						Name:     "http",
+						Port:     80,
+						Protocol: v1beta1.HTTPProtocolType,
+					},
+				},
 			},
 		}
 	}
 
+	provisionedNsName := func(gwNsName types.NamespacedName) types.NamespacedName {
+		return types.NamespacedName{
+			Namespace: "nginx-gateway",
+			Name:      provisionedResourceName(gwNsName),
+		}
+	}
+
 	itShouldUpsertGatewayClass := func() {
 		// Add GatewayClass to the cluster
 
@@ -119,7 +135,7 @@ var _ = Describe("handler", func() {
 		Expect(clusterGc.Status.Conditions).To(Equal(expectedConditions))
 	}
 
-	itShouldUpsertGateway := func(gwNsName types.NamespacedName, seqNumber int64) {
+	itShouldUpsertGateway := func(gwNsName types.NamespacedName) {
 		batch := []interface{}{
 			&events.UpsertEvent{
 				Resource: createGateway(gwNsName),
@@ -128,10 +144,7 @@ var _ = Describe("handler", func() {
 
 		handler.HandleEventBatch(context.Background(), batch)
 
-		depNsName := types.NamespacedName{
-			Namespace: "nginx-gateway",
-			Name:      fmt.Sprintf("nginx-gateway-%d", seqNumber),
-		}
+		depNsName := provisionedNsName(gwNsName)
 
 		dep := &v1.Deployment{}
 		err := k8sclient.Get(context.Background(), depNsName, dep)
@@ -140,10 +153,38 @@ var _ = Describe("handler", func() {
 
 		Expect(dep.ObjectMeta.Namespace).To(Equal("nginx-gateway"))
 		Expect(dep.ObjectMeta.Name).To(Equal(depNsName.Name))
+		Expect(dep.ObjectMeta.Labels).To(HaveKeyWithValue(gatewayClassLabel, gcName))
+		Expect(dep.ObjectMeta.Annotations).To(HaveKeyWithValue(gatewayAnnotation, gwNsName.String()))
+		Expect(dep.ObjectMeta.OwnerReferences).To(HaveLen(1))
+		Expect(dep.ObjectMeta.OwnerReferences[0].Kind).To(Equal("GatewayClass"))
+		Expect(dep.ObjectMeta.OwnerReferences[0].Name).To(Equal(gcName))
 		Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement("static-mode"))
 		expectedGwFlag := fmt.Sprintf("--gateway=%s", gwNsName.String())
 		Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement(expectedGwFlag))
 		Expect(dep.Spec.Template.Spec.Containers[0].Args).To(ContainElement("--update-gatewayclass-status=false"))
+
+		svc := &apiv1.Service{}
+		err = k8sclient.Get(context.Background(), depNsName, svc)
+
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(svc.ObjectMeta.Labels).To(HaveKeyWithValue(gatewayClassLabel, gcName))
+		Expect(svc.ObjectMeta.Annotations).To(HaveKeyWithValue(gatewayAnnotation, gwNsName.String()))
+		Expect(svc.Spec.Selector).To(Equal(map[string]string{"app": depNsName.Name}))
+		Expect(svc.Spec.Ports).To(HaveLen(1))
+		Expect(svc.Spec.Ports[0].Port).To(Equal(int32(80)))
+	}
+
+	itShouldReportProvisioningFailure := func(gwNsName types.NamespacedName, msg string) {
+		gw := &v1beta1.Gateway{}
+		err := k8sclient.Get(context.Background(), gwNsName, gw)
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(gw.Status.Conditions).To(HaveLen(1))
+		Expect(gw.Status.Conditions[0].Type).To(Equal(string(v1beta1.GatewayConditionProgrammed)))
+		Expect(gw.Status.Conditions[0].Status).To(Equal(metav1.ConditionFalse))
+		Expect(gw.Status.Conditions[0].Reason).To(Equal(string(conditions.GatewayReasonProvisioningFailed)))
+		Expect(gw.Status.Conditions[0].Message).To(ContainSubstring(msg))
 	}
 
 	itShouldPanicWhenUpsertingGateway := func(gwNsName types.NamespacedName) {
@@ -190,19 +231,102 @@ var _ = Describe("handler", func() {
 
 		When("upserting first Gateway", func() {
 			It("should create first Deployment", func() {
-				itShouldUpsertGateway(gwNsName1, 1)
+				itShouldUpsertGateway(gwNsName1)
 			})
 		})
 
 		When("upserting first Gateway again", func() {
 			It("must retain Deployment", func() {
-				itShouldUpsertGateway(gwNsName1, 1)
+				itShouldUpsertGateway(gwNsName1)
 			})
 		})
 
 		When("upserting second Gateway", func() {
 			It("should create second Deployment", func() {
-				itShouldUpsertGateway(gwNsName2, 2)
+				itShouldUpsertGateway(gwNsName2)
+			})
+		})
+
+		When("deleting first Deployment", func() {
+			It("should recreate it", func() {
+				dep := &v1.Deployment{}
+				err := k8sclient.Get(context.Background(), provisionedNsName(gwNsName1), dep)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				err = k8sclient.Delete(context.Background(), dep)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				batch := []interface{}{
+					&events.DeleteEvent{
+						Type:           &v1.Deployment{},
+						NamespacedName: client.ObjectKeyFromObject(dep),
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), batch)
+
+				err = k8sclient.Get(context.Background(), provisionedNsName(gwNsName1), &v1.Deployment{})
+				Expect(err).ShouldNot(HaveOccurred())
+			})
+		})
+
+		When("changing first Deployment and Service", func() {
+			It("should revert the changes", func() {
+				dep := &v1.Deployment{}
+				err := k8sclient.Get(context.Background(), provisionedNsName(gwNsName1), dep)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				dep.Spec.Template.Spec.Containers[0].Args = []string{"changed"}
+				err = k8sclient.Update(context.Background(), dep)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				svc := &apiv1.Service{}
+				err = k8sclient.Get(context.Background(), provisionedNsName(gwNsName1), svc)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				svc.Spec.Ports[0].Port = 8080
+				err = k8sclient.Update(context.Background(), svc)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				batch := []interface{}{
+					&events.UpsertEvent{
+						Resource: dep,
+					},
+					&events.UpsertEvent{
+						Resource: svc,
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), batch)
+
+				itShouldUpsertGateway(gwNsName1)
+			})
+		})
+
+		When("changing listener ports of first Gateway", func() {
+			It("should update the Service", func() {
+				gw := createGateway(gwNsName1)
+				gw.Spec.Listeners = append(gw.Spec.Listeners, v1beta1.Listener{
+					Name:     "https",
+					Port:     443,
+					Protocol: v1beta1.HTTPSProtocolType,
+				})
+
+				batch := []interface{}{
+					&events.UpsertEvent{
+						Resource: gw,
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), batch)
+
+				svc := &apiv1.Service{}
+				err := k8sclient.Get(context.Background(), provisionedNsName(gwNsName1), svc)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				Expect(svc.Spec.Ports).To(HaveLen(2))
+				Expect(svc.Spec.Ports[0].Port).To(Equal(int32(80)))
+				Expect(svc.Spec.Ports[1].Port).To(Equal(int32(443)))
 			})
 		})
 
@@ -222,7 +346,15 @@ var _ = Describe("handler", func() {
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(deps.Items).To(HaveLen(1))
-				Expect(deps.Items[0].ObjectMeta.Name).To(Equal("nginx-gateway-2"))
+				Expect(deps.Items[0].ObjectMeta.Name).To(Equal(provisionedResourceName(gwNsName2)))
+
+				svcs := &apiv1.ServiceList{}
+
+				err = k8sclient.List(context.Background(), svcs)
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(svcs.Items).To(HaveLen(1))
+				Expect(svcs.Items[0].ObjectMeta.Name).To(Equal(provisionedResourceName(gwNsName2)))
 			})
 		})
 
@@ -243,6 +375,13 @@ var _ = Describe("handler", func() {
 
 				Expect(err).ShouldNot(HaveOccurred())
 				Expect(deps.Items).To(HaveLen(0))
+
+				svcs := &apiv1.ServiceList{}
+
+				err = k8sclient.List(context.Background(), svcs)
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(svcs.Items).To(HaveLen(0))
 			})
 		})
 
@@ -315,6 +454,174 @@ var _ = Describe("handler", func() {
 		})
 	})
 
+	Describe("Deployment customization", Ordered, func() {
+		var (
+			gwNsName types.NamespacedName
+			gc       *v1beta1.GatewayClass
+			cm       *apiv1.ConfigMap
+		)
+
+		getDeployment := func() *v1.Deployment {
+			dep := &v1.Deployment{}
+			err := k8sclient.Get(context.Background(), provisionedNsName(gwNsName), dep)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			return dep
+		}
+
+		getGatewayClassConditions := func() []metav1.Condition {
+			clusterGc := &v1beta1.GatewayClass{}
+			err := k8sclient.Get(context.Background(), client.ObjectKeyFromObject(gc), clusterGc)
+			Expect(err).ShouldNot(HaveOccurred())
+
+			return clusterGc.Status.Conditions
+		}
+
+		BeforeAll(func() {
+			gwNsName = types.NamespacedName{
+				Namespace: "test-ns",
+				Name:      "test-gw",
+			}
+
+			handler = newEventHandler(
+				gcName,
+				statusUpdater,
+				k8sclient,
+				zap.New(),
+				embeddedfiles.StaticModeDeploymentYAML,
+			)
+
+			cm = &apiv1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "nginx-gateway",
+					Name:      "params",
+				},
+				Data: map[string]string{
+					deploymentPatchKey: "spec: {replicas: 2, template: {spec: {nodeSelector: {pool: gateways}}}}",
+				},
+			}
+
+			gc = &v1beta1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: gcName,
+				},
+				Spec: v1beta1.GatewayClassSpec{
+					ParametersRef: &v1beta1.ParametersReference{
+						Kind:      "ConfigMap",
+						Name:      cm.Name,
+						Namespace: helpers.GetPointer[v1beta1.Namespace](v1beta1.Namespace(cm.Namespace)),
+					},
+				},
+			}
+
+			Expect(k8sclient.Create(context.Background(), gc)).To(Succeed())
+			Expect(k8sclient.Create(context.Background(), createGateway(gwNsName))).To(Succeed())
+		})
+
+		When("the referenced ConfigMap doesn't exist", func() {
+			It("should report invalid parameters", func() {
+				batch := []interface{}{
+					&events.UpsertEvent{
+						Resource: gc,
+					},
+					&events.UpsertEvent{
+						Resource: createGateway(gwNsName),
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), batch)
+
+				conds := getGatewayClassConditions()
+				Expect(conds).To(HaveLen(1))
+				Expect(conds[0].Reason).To(Equal(string(v1beta1.GatewayClassReasonInvalidParameters)))
+
+				itShouldReportProvisioningFailure(gwNsName, "has invalid parameters")
+			})
+		})
+
+		When("the referenced ConfigMap is created", func() {
+			It("should apply the patch from the ConfigMap", func() {
+				batch := []interface{}{
+					&events.UpsertEvent{
+						Resource: cm,
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), batch)
+
+				conds := getGatewayClassConditions()
+				Expect(conds).To(HaveLen(1))
+				Expect(conds[0].Reason).To(Equal(string(v1beta1.GatewayClassReasonAccepted)))
+
+				dep := getDeployment()
+				Expect(dep.Spec.Replicas).To(Equal(helpers.GetPointer[int32](2)))
+				Expect(dep.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"pool": "gateways"}))
+			})
+		})
+
+		When("the Gateway is annotated with a patch", func() {
+			It("should apply the patch from the annotation after the patch from the ConfigMap", func() {
+				gw := createGateway(gwNsName)
+				gw.Annotations = map[string]string{
+					deploymentPatchAnnotation: "spec: {replicas: 3}",
+				}
+
+				batch := []interface{}{
+					&events.UpsertEvent{
+						Resource: gw,
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), batch)
+
+				dep := getDeployment()
+				Expect(dep.Spec.Replicas).To(Equal(helpers.GetPointer[int32](3)))
+				Expect(dep.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"pool": "gateways"}))
+			})
+		})
+
+		When("the patch in the ConfigMap is changed", func() {
+			It("should update the Deployment", func() {
+				updatedCm := cm.DeepCopy()
+				updatedCm.Data[deploymentPatchKey] = "spec: {replicas: 2}"
+
+				batch := []interface{}{
+					&events.UpsertEvent{
+						Resource: updatedCm,
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), batch)
+
+				dep := getDeployment()
+				Expect(dep.Spec.Replicas).To(Equal(helpers.GetPointer[int32](3)))
+				Expect(dep.Spec.Template.Spec.NodeSelector).To(BeEmpty())
+			})
+		})
+
+		When("the Gateway is annotated with an invalid patch", func() {
+			It("should report the failure and keep the Deployment", func() {
+				gw := createGateway(gwNsName)
+				gw.Annotations = map[string]string{
+					deploymentPatchAnnotation: "metadata: {name: other}",
+				}
+
+				batch := []interface{}{
+					&events.UpsertEvent{
+						Resource: gw,
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), batch)
+
+				itShouldReportProvisioningFailure(gwNsName, "invalid deployment patch")
+
+				dep := getDeployment()
+				Expect(dep.Spec.Replicas).To(Equal(helpers.GetPointer[int32](3)))
+			})
+		})
+	})
+
 	Describe("Edge cases", func() {
 		var gwNsName types.NamespacedName
 
@@ -362,36 +669,68 @@ var _ = Describe("handler", func() {
 		})
 
 		When("upserting Gateway when Deployment can't be created", func() {
-			It("should panic", func() {
+			It("should report the failure in the Gateway status", func() {
 				itShouldUpsertGatewayClass()
 
-				// Create a deployment so that the Handler will fail to create it because it already exists.
+				gw := createGateway(gwNsName)
+				err := k8sclient.Create(context.Background(), gw)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				// Create a deployment so that the Handler will fail to take it over.
 
 				dep := &v1.Deployment{
 					ObjectMeta: metav1.ObjectMeta{
 						Namespace: "nginx-gateway",
-						Name:      "nginx-gateway-1",
+						Name:      provisionedResourceName(gwNsName),
 					},
 				}
 
-				err := k8sclient.Create(context.Background(), dep)
+				err = k8sclient.Create(context.Background(), dep)
 				Expect(err).ShouldNot(HaveOccurred())
 
-				itShouldPanicWhenUpsertingGateway(gwNsName)
+				batch := []interface{}{
+					&events.UpsertEvent{
+						Resource: gw,
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), batch)
+
+				itShouldReportProvisioningFailure(gwNsName, "is not provisioned for the Gateway")
+
+				// Remove the deployment so that the Handler can provision the resources.
+
+				err = k8sclient.Delete(context.Background(), dep)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				err = k8sclient.Get(context.Background(), gwNsName, gw)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				batch = []interface{}{
+					&events.UpsertEvent{
+						Resource: gw,
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), batch)
+
+				err = k8sclient.Get(context.Background(), gwNsName, gw)
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(gw.Status.Conditions).To(BeEmpty())
+
+				itShouldUpsertGateway(gwNsName)
 			})
 		})
 
-		When("deleting Gateway when Deployment can't be deleted", func() {
-			It("should panic", func() {
+		When("deleting Gateway when Deployment was already deleted", func() {
+			It("should delete the Service", func() {
 				itShouldUpsertGatewayClass()
-				itShouldUpsertGateway(gwNsName, 1)
-
-				// Delete the deployment so that the Handler will fail to delete it because it doesn't exist.
+				itShouldUpsertGateway(gwNsName)
 
 				dep := &v1.Deployment{
 					ObjectMeta: metav1.ObjectMeta{
 						Namespace: "nginx-gateway",
-						Name:      "nginx-gateway-1",
+						Name:      provisionedResourceName(gwNsName),
 					},
 				}
 
@@ -405,11 +744,54 @@ var _ = Describe("handler", func() {
 					},
 				}
 
-				handle := func() {
-					handler.HandleEventBatch(context.Background(), batch)
+				handler.HandleEventBatch(context.Background(), batch)
+
+				svcs := &apiv1.ServiceList{}
+				err = k8sclient.List(context.Background(), svcs)
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(svcs.Items).To(BeEmpty())
+			})
+		})
+
+		When("restarting with resources of a deleted Gateway", func() {
+			It("should delete the resources", func() {
+				itShouldUpsertGatewayClass()
+				itShouldUpsertGateway(gwNsName)
+
+				// The new handler doesn't know about the Gateway, like after a restart of the provisioner.
+
+				handler = newEventHandler(
+					gcName,
+					statusUpdater,
+					k8sclient,
+					zap.New(),
+					embeddedfiles.StaticModeDeploymentYAML,
+				)
+
+				gc := &v1beta1.GatewayClass{}
+				err := k8sclient.Get(context.Background(), types.NamespacedName{Name: gcName}, gc)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				batch := []interface{}{
+					&events.UpsertEvent{
+						Resource: gc,
+					},
 				}
 
-				Expect(handle).Should(Panic())
+				handler.HandleEventBatch(context.Background(), batch)
+
+				deps := &v1.DeploymentList{}
+				err = k8sclient.List(context.Background(), deps)
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(deps.Items).To(BeEmpty())
+
+				svcs := &apiv1.ServiceList{}
+				err = k8sclient.List(context.Background(), svcs)
+
+				Expect(err).ShouldNot(HaveOccurred())
+				Expect(svcs.Items).To(BeEmpty())
 			})
 		})
 
@@ -435,7 +817,7 @@ var _ = Describe("handler", func() {
 		})
 
 		When("upserting Gateway with broken static Deployment YAML", func() {
-			It("it should panic", func() {
+			It("should report the failure in the Gateway status", func() {
 				handler = newEventHandler(
 					gcName,
 					statusUpdater,
@@ -445,7 +827,20 @@ var _ = Describe("handler", func() {
 				)
 
 				itShouldUpsertGatewayClass()
-				itShouldPanicWhenUpsertingGateway(types.NamespacedName{Namespace: "test-ns", Name: "test-gw"})
+
+				gw := createGateway(gwNsName)
+				err := k8sclient.Create(context.Background(), gw)
+				Expect(err).ShouldNot(HaveOccurred())
+
+				batch := []interface{}{
+					&events.UpsertEvent{
+						Resource: gw,
+					},
+				}
+
+				handler.HandleEventBatch(context.Background(), batch)
+
+				itShouldReportProvisioningFailure(gwNsName, "failed to prepare deployment")
 			})
 		})
 	})
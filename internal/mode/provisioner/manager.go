@@ -5,12 +5,14 @@ import (
 
 	"github.com/go-logr/logr"
 	v1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	ctlr "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	k8spredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	embeddedfiles "github.com/nginxinc/nginx-kubernetes-gateway"
@@ -28,7 +30,7 @@ type Config struct {
 }
 
 // StartManager starts a Manager for the provisioner mode, which provisions
-// a Deployment of NKG (static mode) for each Gateway of the provisioner GatewayClass.
+// a Deployment of NKG (static mode) and a Service for each Gateway of the provisioner GatewayClass.
 //
 // The provisioner mode is introduced to allow running Gateway API conformance tests for NKG, which expects
 // an independent data plane instance being provisioned for each Gateway.
@@ -39,6 +41,7 @@ func StartManager(cfg Config) error {
 	scheme := runtime.NewScheme()
 	utilruntime.Must(gatewayv1beta1.AddToScheme(scheme))
 	utilruntime.Must(v1.AddToScheme(scheme))
+	utilruntime.Must(apiv1.AddToScheme(scheme))
 
 	options := manager.Options{
 		Scheme: scheme,
@@ -51,6 +54,13 @@ func StartManager(cfg Config) error {
 		return fmt.Errorf("cannot build runtime manager: %w", err)
 	}
 
+	// The provisioner only reconciles the Deployments and the Services that it provisioned: it repairs them
+	// if they are changed or deleted.
+	provisionedPredicate := k8spredicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, ok := getProvisionedFor(obj, cfg.GatewayClassName)
+		return ok
+	})
+
 	// Note: for any new object type or a change to the existing one,
 	// make sure to also update firstBatchPreparer creation below
 	controllerRegCfgs := []struct {
@@ -66,6 +76,21 @@ func StartManager(cfg Config) error {
 		{
 			objectType: &gatewayv1beta1.Gateway{},
 		},
+		{
+			objectType: &apiv1.ConfigMap{},
+		},
+		{
+			objectType: &v1.Deployment{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(provisionedPredicate),
+			},
+		},
+		{
+			objectType: &apiv1.Service{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(provisionedPredicate),
+			},
+		},
 	}
 
 	ctx := ctlr.SetupSignalHandler()
@@ -85,6 +110,9 @@ func StartManager(cfg Config) error {
 		},
 		[]client.ObjectList{
 			&gatewayv1beta1.GatewayList{},
+			&apiv1.ConfigMapList{},
+			&v1.DeploymentList{},
+			&apiv1.ServiceList{},
 		},
 	)
 
@@ -0,0 +1,138 @@
+package provisioner
+
+import (
+	"fmt"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// deploymentPatchKey is the key in the ConfigMap referenced by the parametersRef of the GatewayClass.
+	// Its value is a strategic merge patch for the Deployments of all Gateways of the GatewayClass.
+	deploymentPatchKey = "deployment-patch"
+	// deploymentPatchAnnotation is the annotation of a Gateway. Its value is a strategic merge patch for
+	// the Deployment of the Gateway. It is applied after the patch from the GatewayClass.
+	deploymentPatchAnnotation = "k8s-gateway.nginx.org/deployment-patch"
+)
+
+// deploymentPatch is a strategic merge patch for the static mode Deployment in YAML or JSON.
+type deploymentPatch struct {
+	// allowedFields are the fields that the patch can set. If nil, the patch can set any field.
+	allowedFields *patchFields
+	// source describes where the patch comes from. It is used in error messages.
+	source string
+	data   []byte
+}
+
+// patchFields describes the fields of a patch. A nil *patchFields allows any value of the field.
+type patchFields struct {
+	// fields are the allowed fields of an object.
+	fields map[string]*patchFields
+	// items are the allowed fields of the objects of a list.
+	items *patchFields
+}
+
+// allowedGatewayPatchFields are the fields of the Deployment that the patch from the annotation of a Gateway can set.
+// Anyone who can annotate a Gateway can set the annotation, so, unlike the patch from the GatewayClass, the patch
+// can only change the scale, the scheduling and the resources of the Deployment, but not, for example, the images,
+// the service account, the security context, the volumes or the environment of the containers.
+var allowedGatewayPatchFields = &patchFields{
+	fields: map[string]*patchFields{
+		"metadata": {
+			fields: map[string]*patchFields{
+				"labels":      nil,
+				"annotations": nil,
+			},
+		},
+		"spec": {
+			fields: map[string]*patchFields{
+				"replicas": nil,
+				"template": {
+					fields: map[string]*patchFields{
+						"metadata": {
+							fields: map[string]*patchFields{
+								"labels":      nil,
+								"annotations": nil,
+							},
+						},
+						"spec": {
+							fields: map[string]*patchFields{
+								"nodeSelector": nil,
+								"tolerations":  nil,
+								"affinity":     nil,
+								"containers": {
+									items: &patchFields{
+										fields: map[string]*patchFields{
+											"name":      nil,
+											"resources": nil,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	},
+}
+
+// getGatewayClassDeploymentPatch returns the patch from the ConfigMap referenced by the parametersRef of
+// the GatewayClass. It returns nil if the GatewayClass doesn't reference any parameters.
+func getGatewayClassDeploymentPatch(
+	gc *v1beta1.GatewayClass,
+	configMaps map[types.NamespacedName]*apiv1.ConfigMap,
+) (*deploymentPatch, error) {
+	ref := gc.Spec.ParametersRef
+	if ref == nil {
+		return nil, nil
+	}
+
+	path := field.NewPath("spec").Child("parametersRef")
+
+	if ref.Group != "" {
+		return nil, field.NotSupported(path.Child("group"), ref.Group, []string{""})
+	}
+	if ref.Kind != "ConfigMap" {
+		return nil, field.NotSupported(path.Child("kind"), ref.Kind, []string{"ConfigMap"})
+	}
+	if ref.Namespace == nil {
+		return nil, field.Required(path.Child("namespace"), "namespace of the ConfigMap must be set")
+	}
+
+	nsname := types.NamespacedName{Namespace: string(*ref.Namespace), Name: ref.Name}
+
+	cm, exists := configMaps[nsname]
+	if !exists {
+		return nil, field.NotFound(path, nsname.String())
+	}
+
+	data, exists := cm.Data[deploymentPatchKey]
+	if !exists {
+		// the ConfigMap doesn't customize the Deployment
+		return nil, nil
+	}
+
+	return &deploymentPatch{
+		source: fmt.Sprintf("ConfigMap %s", nsname),
+		data:   []byte(data),
+	}, nil
+}
+
+// getGatewayDeploymentPatch returns the patch from the annotation of the Gateway. The patch can only set
+// the allowedGatewayPatchFields. It returns nil if the Gateway doesn't have the annotation.
+func getGatewayDeploymentPatch(gw *v1beta1.Gateway) *deploymentPatch {
+	data, exists := gw.Annotations[deploymentPatchAnnotation]
+	if !exists {
+		return nil
+	}
+
+	return &deploymentPatch{
+		allowedFields: allowedGatewayPatchFields,
+		source:        fmt.Sprintf("annotation %s", deploymentPatchAnnotation),
+		data:          []byte(data),
+	}
+}
@@ -0,0 +1,93 @@
+package provisioner
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	// gatewayClassLabel marks the resources that the provisioner creates for the Gateways of a GatewayClass.
+	// Its value is the name of the GatewayClass.
+	gatewayClassLabel = "k8s-gateway.nginx.org/gatewayclass"
+	// gatewayAnnotation holds the namespaced name of the Gateway for which the provisioner created the resource.
+	gatewayAnnotation = "k8s-gateway.nginx.org/gateway"
+	// specHashAnnotation holds the hash of the desired spec of the provisioned Deployment.
+	specHashAnnotation = "k8s-gateway.nginx.org/spec-hash"
+)
+
+// provisionedResourceName returns the name of the Deployment and the Service for the Gateway.
+// The name is derived from the namespaced name of the Gateway, so that the provisioner finds the resources it
+// created before a restart.
+func provisionedResourceName(gwNsName types.NamespacedName) string {
+	h := fnv.New64a()
+	// Write never returns an error
+	_, _ = h.Write([]byte(gwNsName.String()))
+
+	return fmt.Sprintf("nginx-gateway-%x", h.Sum64())
+}
+
+// setProvisionedMetadata marks the resource as provisioned for the Gateway.
+//
+// The resource is owned by the GatewayClass rather than by the Gateway, because Kubernetes doesn't support
+// owners in a different namespace. As a result, Kubernetes garbage-collects the resource when the GatewayClass is
+// deleted, while the provisioner deletes the resource when the Gateway is deleted.
+func setProvisionedMetadata(obj client.Object, gc *v1beta1.GatewayClass, gwNsName types.NamespacedName) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[gatewayClassLabel] = gc.Name
+	obj.SetLabels(labels)
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[gatewayAnnotation] = gwNsName.String()
+	obj.SetAnnotations(annotations)
+
+	obj.SetOwnerReferences([]metav1.OwnerReference{
+		{
+			APIVersion: v1beta1.GroupVersion.String(),
+			Kind:       "GatewayClass",
+			Name:       gc.Name,
+			UID:        gc.UID,
+		},
+	})
+}
+
+// getProvisionedFor returns the namespaced name of the Gateway for which the resource was provisioned.
+// If the resource is not provisioned for a Gateway of the GatewayClass, it returns false.
+func getProvisionedFor(obj client.Object, gcName string) (types.NamespacedName, bool) {
+	if obj.GetLabels()[gatewayClassLabel] != gcName {
+		return types.NamespacedName{}, false
+	}
+
+	ns, name, ok := strings.Cut(obj.GetAnnotations()[gatewayAnnotation], "/")
+	if !ok {
+		return types.NamespacedName{}, false
+	}
+
+	return types.NamespacedName{Namespace: ns, Name: name}, true
+}
+
+// hashSpec returns the hash of the spec of a provisioned resource.
+func hashSpec(spec any) (string, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal spec: %w", err)
+	}
+
+	h := fnv.New64a()
+	// Write never returns an error
+	_, _ = h.Write(data)
+
+	return fmt.Sprintf("%x", h.Sum64()), nil
+}
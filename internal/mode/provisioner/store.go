@@ -3,6 +3,8 @@ package provisioner
 import (
 	"fmt"
 
+	v1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
@@ -14,12 +16,14 @@ import (
 type store struct {
 	gatewayClasses map[types.NamespacedName]*v1beta1.GatewayClass
 	gateways       map[types.NamespacedName]*v1beta1.Gateway
+	configMaps     map[types.NamespacedName]*apiv1.ConfigMap
 }
 
 func newStore() *store {
 	return &store{
 		gatewayClasses: make(map[types.NamespacedName]*v1beta1.GatewayClass),
 		gateways:       make(map[types.NamespacedName]*v1beta1.Gateway),
+		configMaps:     make(map[types.NamespacedName]*apiv1.ConfigMap),
 	}
 }
 
@@ -32,6 +36,11 @@ func (s *store) update(batch events.EventBatch) {
 				s.gatewayClasses[client.ObjectKeyFromObject(obj)] = obj
 			case *v1beta1.Gateway:
 				s.gateways[client.ObjectKeyFromObject(obj)] = obj
+			case *apiv1.ConfigMap:
+				s.configMaps[client.ObjectKeyFromObject(obj)] = obj
+			case *v1.Deployment, *apiv1.Service:
+				// The provisioned resources are not stored. Their events only trigger the reconciliation,
+				// which gets the resources from the API.
 			default:
 				panic(fmt.Errorf("unknown resource type %T", e.Resource))
 			}
@@ -41,6 +50,10 @@ func (s *store) update(batch events.EventBatch) {
 				delete(s.gatewayClasses, e.NamespacedName)
 			case *v1beta1.Gateway:
 				delete(s.gateways, e.NamespacedName)
+			case *apiv1.ConfigMap:
+				delete(s.configMaps, e.NamespacedName)
+			case *v1.Deployment, *apiv1.Service:
+				// See the comment for the upsert event.
 			default:
 				panic(fmt.Errorf("unknown resource type %T", e.Type))
 			}
@@ -5,6 +5,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/status"
 	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
@@ -15,8 +16,13 @@ type nginxReloadResult struct {
 	error error
 }
 
-// buildStatuses builds status.Statuses from a Graph.
-func buildStatuses(graph *graph.Graph, nginxReloadRes nginxReloadResult) status.Statuses {
+// buildStatuses builds status.Statuses from a Graph. The SnippetsPolicies in rejectedSnippets get the conditions from
+// rejectedSnippets, because their snippets failed the test of the NGINX configuration.
+func buildStatuses(
+	graph *graph.Graph,
+	nginxReloadRes nginxReloadResult,
+	rejectedSnippets map[types.NamespacedName]conditions.Condition,
+) status.Statuses {
 	statuses := status.Statuses{
 		HTTPRouteStatuses: make(status.HTTPRouteStatuses),
 	}
@@ -25,6 +31,8 @@ func buildStatuses(graph *graph.Graph, nginxReloadRes nginxReloadResult) status.
 
 	statuses.GatewayStatuses = buildGatewayStatuses(graph.Gateway, graph.IgnoredGateways, nginxReloadRes)
 
+	statuses.PolicyStatuses = buildPolicyStatuses(graph, rejectedSnippets)
+
 	for nsname, r := range graph.Routes {
 		parentStatuses := make([]status.ParentStatus, 0, len(r.ParentRefs))
 
@@ -102,6 +110,46 @@ func buildGatewayClassStatuses(
 	return statuses
 }
 
+func buildPolicyStatuses(
+	g *graph.Graph,
+	rejectedSnippets map[types.NamespacedName]conditions.Condition,
+) status.PolicyStatuses {
+	statuses := make(status.PolicyStatuses)
+
+	for key, p := range g.GetAllPolicies() {
+		conds := p.Conditions
+		if cond, rejected := rejectedSnippets[key.NsName]; rejected && key.Kind == nkgAPI.SnippetsPolicyKind {
+			// We copy the conditions, so that the Graph is not modified.
+			conds = make([]conditions.Condition, 0, len(p.Conditions)+1)
+			conds = append(conds, p.Conditions...)
+			conds = append(conds, cond)
+		}
+
+		statuses[status.PolicyKey{NsName: key.NsName, Kind: key.Kind}] = buildPolicyStatus(
+			conds,
+			p.Source.GetGeneration(),
+		)
+	}
+
+	return statuses
+}
+
+func buildPolicyStatus(policyConds []conditions.Condition, generation int64) status.PolicyStatus {
+	defaultConds := staticConds.NewDefaultPolicyConditions()
+
+	conds := make([]conditions.Condition, 0, len(policyConds)+len(defaultConds))
+
+	// We add default conds first, so that any additional conditions will override them, which is
+	// ensured by DeduplicateConditions.
+	conds = append(conds, defaultConds...)
+	conds = append(conds, policyConds...)
+
+	return status.PolicyStatus{
+		Conditions:         staticConds.DeduplicateConditions(conds),
+		ObservedGeneration: generation,
+	}
+}
+
 func buildGatewayStatuses(
 	gateway *graph.Gateway,
 	ignoredGateways map[types.NamespacedName]*v1beta1.Gateway,
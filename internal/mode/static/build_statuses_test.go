@@ -10,6 +10,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/status"
@@ -133,6 +134,152 @@ func TestBuildStatuses(t *testing.T) {
 			client.ObjectKeyFromObject(ignoredGw): ignoredGw,
 		},
 		Routes: routes,
+		UpstreamSettingsPolicies: map[types.NamespacedName]*graph.UpstreamSettingsPolicy{
+			{Namespace: "test", Name: "usp-valid"}: {
+				Source: &nkgAPI.UpstreamSettingsPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: 4,
+					},
+				},
+				Valid: true,
+			},
+			{Namespace: "test", Name: "usp-invalid"}: {
+				Source: &nkgAPI.UpstreamSettingsPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: 5,
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewPolicyInvalid("invalid"),
+				},
+			},
+		},
+		UpstreamHealthPolicies: map[types.NamespacedName]*graph.UpstreamHealthPolicy{
+			{Namespace: "test", Name: "uhp"}: {
+				Source: &nkgAPI.UpstreamHealthPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: 6,
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewPolicyTargetNotFound("not found"),
+				},
+			},
+		},
+		RetryPolicies: map[types.NamespacedName]*graph.RetryPolicy{
+			{Namespace: "test", Name: "rp"}: {
+				Source: &nkgAPI.RetryPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: 7,
+					},
+				},
+				Valid: true,
+			},
+		},
+		RateLimitPolicies: map[types.NamespacedName]*graph.RateLimitPolicy{
+			{Namespace: "test", Name: "rlp"}: {
+				Source: &nkgAPI.RateLimitPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: 8,
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewPolicyConflicted("conflicted"),
+				},
+			},
+		},
+		ClientSettingsPolicies: map[types.NamespacedName]*graph.ClientSettingsPolicy{
+			{Namespace: "test", Name: "csp"}: {
+				Source: &nkgAPI.ClientSettingsPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: 9,
+					},
+				},
+				Valid: true,
+			},
+		},
+		ObservabilityPolicies: map[types.NamespacedName]*graph.ObservabilityPolicy{
+			{Namespace: "test", Name: "obsp"}: {
+				Source: &nkgAPI.ObservabilityPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: 10,
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewPolicyInvalid("invalid"),
+				},
+			},
+		},
+		CORSPolicies: map[types.NamespacedName]*graph.CORSPolicy{
+			{Namespace: "test", Name: "corsp"}: {
+				Source: &nkgAPI.CORSPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: 11,
+					},
+				},
+				Valid: true,
+			},
+		},
+		AccessControlPolicies: map[types.NamespacedName]*graph.AccessControlPolicy{
+			{Namespace: "test", Name: "acp"}: {
+				Source: &nkgAPI.AccessControlPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: 12,
+					},
+				},
+				Valid: true,
+			},
+		},
+		CachePolicies: map[types.NamespacedName]*graph.CachePolicy{
+			{Namespace: "test", Name: "cp"}: {
+				Source: &nkgAPI.CachePolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: 13,
+					},
+				},
+				Valid: true,
+			},
+		},
+		CompressionPolicies: map[types.NamespacedName]*graph.CompressionPolicy{
+			{Namespace: "test", Name: "cmpp"}: {
+				Source: &nkgAPI.CompressionPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: 14,
+					},
+				},
+				Valid: true,
+			},
+		},
+		ErrorPagePolicies: map[types.NamespacedName]*graph.ErrorPagePolicy{
+			{Namespace: "test", Name: "epp"}: {
+				Source: &nkgAPI.ErrorPagePolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: 15,
+					},
+				},
+				Valid: true,
+			},
+		},
+		SnippetsPolicies: map[types.NamespacedName]*graph.SnippetsPolicy{
+			{Namespace: "test", Name: "snp"}: {
+				Source: &nkgAPI.SnippetsPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: 16,
+					},
+				},
+				Valid: true,
+			},
+		},
+		TimeoutPolicies: map[types.NamespacedName]*graph.TimeoutPolicy{
+			{Namespace: "test", Name: "tp"}: {
+				Source: &nkgAPI.TimeoutPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Generation: 17,
+					},
+				},
+				Valid: true,
+			},
+		},
 	}
 
 	expected := status.Statuses{
@@ -191,12 +338,120 @@ func TestBuildStatuses(t *testing.T) {
 				},
 			},
 		},
+		PolicyStatuses: status.PolicyStatuses{
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "usp-valid"},
+				Kind:   nkgAPI.UpstreamSettingsPolicyKind,
+			}: {
+				ObservedGeneration: 4,
+				Conditions:         staticConds.NewDefaultPolicyConditions(),
+			},
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "usp-invalid"},
+				Kind:   nkgAPI.UpstreamSettingsPolicyKind,
+			}: {
+				ObservedGeneration: 5,
+				Conditions: []conditions.Condition{
+					staticConds.NewPolicyInvalid("invalid"),
+				},
+			},
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "uhp"},
+				Kind:   nkgAPI.UpstreamHealthPolicyKind,
+			}: {
+				ObservedGeneration: 6,
+				Conditions: []conditions.Condition{
+					staticConds.NewPolicyTargetNotFound("not found"),
+				},
+			},
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "rp"},
+				Kind:   nkgAPI.RetryPolicyKind,
+			}: {
+				ObservedGeneration: 7,
+				Conditions:         staticConds.NewDefaultPolicyConditions(),
+			},
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "rlp"},
+				Kind:   nkgAPI.RateLimitPolicyKind,
+			}: {
+				ObservedGeneration: 8,
+				Conditions: []conditions.Condition{
+					staticConds.NewPolicyConflicted("conflicted"),
+				},
+			},
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "csp"},
+				Kind:   nkgAPI.ClientSettingsPolicyKind,
+			}: {
+				ObservedGeneration: 9,
+				Conditions:         staticConds.NewDefaultPolicyConditions(),
+			},
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "obsp"},
+				Kind:   nkgAPI.ObservabilityPolicyKind,
+			}: {
+				ObservedGeneration: 10,
+				Conditions: []conditions.Condition{
+					staticConds.NewPolicyInvalid("invalid"),
+				},
+			},
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "corsp"},
+				Kind:   nkgAPI.CORSPolicyKind,
+			}: {
+				ObservedGeneration: 11,
+				Conditions:         staticConds.NewDefaultPolicyConditions(),
+			},
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "acp"},
+				Kind:   nkgAPI.AccessControlPolicyKind,
+			}: {
+				ObservedGeneration: 12,
+				Conditions:         staticConds.NewDefaultPolicyConditions(),
+			},
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "cp"},
+				Kind:   nkgAPI.CachePolicyKind,
+			}: {
+				ObservedGeneration: 13,
+				Conditions:         staticConds.NewDefaultPolicyConditions(),
+			},
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "cmpp"},
+				Kind:   nkgAPI.CompressionPolicyKind,
+			}: {
+				ObservedGeneration: 14,
+				Conditions:         staticConds.NewDefaultPolicyConditions(),
+			},
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "epp"},
+				Kind:   nkgAPI.ErrorPagePolicyKind,
+			}: {
+				ObservedGeneration: 15,
+				Conditions:         staticConds.NewDefaultPolicyConditions(),
+			},
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "snp"},
+				Kind:   nkgAPI.SnippetsPolicyKind,
+			}: {
+				ObservedGeneration: 16,
+				Conditions:         staticConds.NewDefaultPolicyConditions(),
+			},
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "tp"},
+				Kind:   nkgAPI.TimeoutPolicyKind,
+			}: {
+				ObservedGeneration: 17,
+				Conditions:         staticConds.NewDefaultPolicyConditions(),
+			},
+		},
 	}
 
 	g := NewGomegaWithT(t)
 
 	var nginxReloadRes nginxReloadResult
-	result := buildStatuses(graph, nginxReloadRes)
+	result := buildStatuses(graph, nginxReloadRes, nil)
 	g.Expect(helpers.Diff(expected, result)).To(BeEmpty())
 }
 
@@ -283,12 +538,13 @@ func TestBuildStatusesNginxErr(t *testing.T) {
 				},
 			},
 		},
+		PolicyStatuses: status.PolicyStatuses{},
 	}
 
 	g := NewGomegaWithT(t)
 
 	nginxReloadRes := nginxReloadResult{error: errors.New("test error")}
-	result := buildStatuses(graph, nginxReloadRes)
+	result := buildStatuses(graph, nginxReloadRes, nil)
 	g.Expect(helpers.Diff(expected, result)).To(BeEmpty())
 }
 
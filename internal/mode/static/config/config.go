@@ -15,6 +15,25 @@ type Config struct {
 	GatewayClassName string
 	// PodIP is the IP address of this Pod.
 	PodIP string
+	// DebugServerAddress is the address of the debug server, which serves the internal state of the control plane.
+	// If empty, the debug server is disabled.
+	DebugServerAddress string
+	// Webhook holds the configuration of the validating webhook server.
+	// If nil, the webhook server is disabled.
+	Webhook *WebhookConfig
+	// SnippetsNginxPath is the path to the NGINX binary that tests the NGINX configuration with the snippets of
+	// SnippetsPolicies with nginx -t. If empty, the snippets are disabled.
+	SnippetsNginxPath string
 	// UpdateGatewayClassStatus enables updating the status of the GatewayClass resource.
 	UpdateGatewayClassStatus bool
 }
+
+// WebhookConfig holds the configuration of the validating webhook server.
+type WebhookConfig struct {
+	// CertSecretNsName is the namespaced name of the Secret with the TLS certificate of the server.
+	CertSecretNsName types.NamespacedName
+	// Mode is the validation mode: "reject" or "warn".
+	Mode string
+	// Port is the port the server listens on.
+	Port int
+}
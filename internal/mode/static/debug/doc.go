@@ -0,0 +1,9 @@
+/*
+Package debug exposes the internal state of the static mode for introspection.
+
+The package includes:
+- SnapshotStore, which keeps the latest Graph, dataplane Configuration, generated NGINX files and the result of
+the last NGINX reload.
+- Server, an opt-in HTTP server that serves the latest snapshot as JSON.
+*/
+package debug
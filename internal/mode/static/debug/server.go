@@ -0,0 +1,124 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-logr/logr"
+)
+
+const (
+	// GraphPath is the path of the endpoint that serves the latest Graph.
+	GraphPath = "/debug/graph"
+	// ConfigurationPath is the path of the endpoint that serves the latest dataplane Configuration.
+	ConfigurationPath = "/debug/configuration"
+	// NginxPath is the path of the endpoint that serves the latest NGINX files and the result of the last reload.
+	NginxPath = "/debug/nginx"
+
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 5 * time.Second
+)
+
+// Server is an HTTP server that serves the latest Snapshot from the SnapshotStore as JSON.
+//
+// Server implements the manager.Runnable interface from sigs.k8s.io/controller-runtime.
+type Server struct {
+	store  *SnapshotStore
+	logger logr.Logger
+	addr   string
+}
+
+// NewServer creates a new Server that will listen on the specified address.
+func NewServer(addr string, store *SnapshotStore, logger logr.Logger) *Server {
+	return &Server{
+		addr:   addr,
+		store:  store,
+		logger: logger,
+	}
+}
+
+// Start starts the Server.
+// This method will block until the Server stops, which will happen after the ctx is closed.
+func (s *Server) Start(ctx context.Context) error {
+	srv := &http.Server{
+		Addr:              s.addr,
+		Handler:           s.Handler(),
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		s.logger.Info("Starting debug server", "address", s.addr)
+		errCh <- srv.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("debug server failed: %w", err)
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down debug server: %w", err)
+	}
+
+	if err := <-errCh; !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("debug server failed: %w", err)
+	}
+
+	return nil
+}
+
+// Handler returns the http.Handler of the Server.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc(GraphPath, s.serve(func(snapshot Snapshot) any {
+		return newGraphView(snapshot.Graph)
+	}))
+	mux.HandleFunc(ConfigurationPath, s.serve(func(snapshot Snapshot) any {
+		return newConfigurationView(snapshot.Configuration)
+	}))
+	mux.HandleFunc(NginxPath, s.serve(func(snapshot Snapshot) any {
+		return newNginxView(snapshot)
+	}))
+
+	return mux
+}
+
+func (s *Server) serve(buildView func(snapshot Snapshot) any) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		snapshot, exists := s.store.Latest()
+		if !exists {
+			http.Error(w, "the configuration has not been processed yet", http.StatusServiceUnavailable)
+			return
+		}
+
+		body, err := json.MarshalIndent(buildView(snapshot), "", "  ")
+		if err != nil {
+			s.logger.Error(err, "Failed to marshal debug view", "path", r.URL.Path)
+			http.Error(w, "failed to marshal the response", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if _, err := w.Write(body); err != nil {
+			s.logger.Error(err, "Failed to write debug response", "path", r.URL.Path)
+		}
+	}
+}
@@ -0,0 +1,301 @@
+package debug
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/file"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/graph"
+)
+
+func createSnapshot() Snapshot {
+	gw := &v1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gateway",
+		},
+	}
+
+	hr := &v1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "hr",
+		},
+		Spec: v1beta1.HTTPRouteSpec{
+			CommonRouteSpec: v1beta1.CommonRouteSpec{
+				ParentRefs: []v1beta1.ParentReference{
+					{
+						Name:        "gateway",
+						SectionName: helpers.GetPointer[v1beta1.SectionName]("listener-80"),
+					},
+				},
+			},
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+								Value: helpers.GetStringPointer("/"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	route := &graph.Route{
+		Source: hr,
+		ParentRefs: []graph.ParentRef{
+			{
+				Gateway: types.NamespacedName{Namespace: "test", Name: "gateway"},
+				Attachment: &graph.ParentRefAttachmentStatus{
+					AcceptedHostnames: map[string][]string{"listener-80": {"foo.example.com"}},
+					Attached:          true,
+				},
+			},
+		},
+		Rules: []graph.Rule{{ValidMatches: true, ValidFilters: true}},
+		Valid: true,
+	}
+
+	routeNsName := types.NamespacedName{Namespace: "test", Name: "hr"}
+
+	g := &graph.Graph{
+		GatewayClass: &graph.GatewayClass{
+			Source: &v1beta1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}},
+			Valid:  true,
+		},
+		Gateway: &graph.Gateway{
+			Source: gw,
+			Listeners: map[string]*graph.Listener{
+				"listener-80": {
+					Source: v1beta1.Listener{
+						Name:     "listener-80",
+						Protocol: v1beta1.HTTPProtocolType,
+						Port:     80,
+					},
+					Routes: map[types.NamespacedName]*graph.Route{routeNsName: route},
+					Valid:  true,
+				},
+			},
+			Valid: true,
+		},
+		IgnoredGateways: map[types.NamespacedName]*v1beta1.Gateway{
+			{Namespace: "test", Name: "ignored"}: {},
+		},
+		Routes: map[types.NamespacedName]*graph.Route{routeNsName: route},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "foo.example.com",
+				Port:     80,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Source: hr,
+								BackendGroup: dataplane.BackendGroup{
+									Source: routeNsName,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		SSLKeyPairs: map[dataplane.SSLKeyPairID]dataplane.SSLKeyPair{
+			"ssl_keypair_test_secret": {
+				Cert: []byte("cert"),
+				Key:  []byte("key"),
+			},
+		},
+	}
+
+	return Snapshot{
+		Time:          time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+		Graph:         g,
+		Configuration: conf,
+		Files: []file.File{
+			{
+				Path:    "/etc/nginx/conf.d/http.conf",
+				Content: []byte("http config"),
+				Type:    file.TypeRegular,
+			},
+			{
+				Path:    "/etc/nginx/secrets/ssl_keypair_test_secret.pem",
+				Content: []byte("cert and key"),
+				Type:    file.TypeSecret,
+			},
+		},
+		ReloadError: errors.New("reload failed"),
+	}
+}
+
+func TestServerNoSnapshot(t *testing.T) {
+	g := NewWithT(t)
+
+	server := NewServer("127.0.0.1:0", NewSnapshotStore(), zap.New())
+
+	for _, path := range []string{GraphPath, ConfigurationPath, NginxPath} {
+		rec := httptest.NewRecorder()
+		server.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+
+		g.Expect(rec.Code).To(Equal(http.StatusServiceUnavailable))
+	}
+}
+
+func TestServerMethodNotAllowed(t *testing.T) {
+	g := NewWithT(t)
+
+	store := NewSnapshotStore()
+	store.Update(createSnapshot())
+
+	server := NewServer("127.0.0.1:0", store, zap.New())
+
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, GraphPath, nil))
+
+	g.Expect(rec.Code).To(Equal(http.StatusMethodNotAllowed))
+}
+
+func TestServerGraph(t *testing.T) {
+	g := NewWithT(t)
+
+	store := NewSnapshotStore()
+	store.Update(createSnapshot())
+
+	server := NewServer("127.0.0.1:0", store, zap.New())
+
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, GraphPath, nil))
+
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+	g.Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+	var view graphView
+	g.Expect(json.Unmarshal(rec.Body.Bytes(), &view)).To(Succeed())
+
+	expected := graphView{
+		GatewayClass: &gatewayClassView{
+			Name:  "nginx",
+			Valid: true,
+		},
+		Gateway: &gatewayView{
+			Name: "test/gateway",
+			Listeners: []listenerView{
+				{
+					Name:           "listener-80",
+					Protocol:       "HTTP",
+					AttachedRoutes: []string{"test/hr"},
+					Port:           80,
+					Valid:          true,
+				},
+			},
+			Valid: true,
+		},
+		IgnoredGateways: []string{"test/ignored"},
+		Routes: []routeView{
+			{
+				Name: "test/hr",
+				ParentRefs: []parentRefView{
+					{
+						AcceptedHostnames: map[string][]string{"listener-80": {"foo.example.com"}},
+						Gateway:           "test/gateway",
+						SectionName:       "listener-80",
+						Attached:          true,
+					},
+				},
+				Rules: []ruleView{{ValidMatches: true, ValidFilters: true}},
+				Valid: true,
+			},
+		},
+	}
+
+	g.Expect(helpers.Diff(expected, view)).To(BeEmpty())
+}
+
+func TestServerConfiguration(t *testing.T) {
+	g := NewWithT(t)
+
+	store := NewSnapshotStore()
+	store.Update(createSnapshot())
+
+	server := NewServer("127.0.0.1:0", store, zap.New())
+
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, ConfigurationPath, nil))
+
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+
+	var view configurationView
+	g.Expect(json.Unmarshal(rec.Body.Bytes(), &view)).To(Succeed())
+
+	g.Expect(view.SSLKeyPairIDs).To(ConsistOf("ssl_keypair_test_secret"))
+	g.Expect(view.HTTPServers).To(HaveLen(1))
+	g.Expect(view.HTTPServers[0].PathRules).To(HaveLen(1))
+	g.Expect(view.HTTPServers[0].PathRules[0].MatchRules).To(HaveLen(1))
+
+	mr := view.HTTPServers[0].PathRules[0].MatchRules[0]
+	g.Expect(mr.Route).To(Equal("test/hr"))
+	g.Expect(mr.BackendGroup).To(Equal("test__hr_rule0"))
+
+	// The private keys must never be exposed ("a2V5" is "key" encoded in base64).
+	g.Expect(rec.Body.String()).ToNot(ContainSubstring("a2V5"))
+}
+
+func TestServerNginx(t *testing.T) {
+	g := NewWithT(t)
+
+	store := NewSnapshotStore()
+	store.Update(createSnapshot())
+
+	server := NewServer("127.0.0.1:0", store, zap.New())
+
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, NginxPath, nil))
+
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+
+	var view nginxView
+	g.Expect(json.Unmarshal(rec.Body.Bytes(), &view)).To(Succeed())
+
+	expected := nginxView{
+		Reload: reloadView{
+			Time:      time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC),
+			Error:     "reload failed",
+			Succeeded: false,
+		},
+		Files: []fileView{
+			{
+				Path:    "/etc/nginx/conf.d/http.conf",
+				Type:    "Regular",
+				Content: "http config",
+			},
+			{
+				Path:    "/etc/nginx/secrets/ssl_keypair_test_secret.pem",
+				Type:    "Secret",
+				Content: redactedContent,
+			},
+		},
+	}
+
+	g.Expect(helpers.Diff(expected, view)).To(BeEmpty())
+}
@@ -0,0 +1,58 @@
+package debug
+
+import (
+	"sync"
+	"time"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/file"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/graph"
+)
+
+// Snapshot is the state of the static mode after handling a batch of events that changed the configuration.
+type Snapshot struct {
+	// Time is the time when the snapshot was taken.
+	Time time.Time
+	// Graph is the Graph built from the cluster state.
+	Graph *graph.Graph
+	// ReloadError is the error that occurred while updating NGINX. It is nil if the update succeeded.
+	ReloadError error
+	// Configuration is the dataplane Configuration built from the Graph.
+	Configuration dataplane.Configuration
+	// Files are the NGINX configuration files generated from the Configuration.
+	Files []file.File
+}
+
+// SnapshotStore stores the latest Snapshot.
+// It is safe to use from multiple goroutines.
+type SnapshotStore struct {
+	latest *Snapshot
+	lock   sync.RWMutex
+}
+
+// NewSnapshotStore creates a new SnapshotStore.
+func NewSnapshotStore() *SnapshotStore {
+	return &SnapshotStore{}
+}
+
+// Update replaces the latest Snapshot.
+// The caller must not modify the Snapshot and its contents after calling Update.
+func (s *SnapshotStore) Update(snapshot Snapshot) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.latest = &snapshot
+}
+
+// Latest returns the latest Snapshot. If there is no Snapshot yet, it returns false.
+// The caller must not modify the returned Snapshot.
+func (s *SnapshotStore) Latest() (Snapshot, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.latest == nil {
+		return Snapshot{}, false
+	}
+
+	return *s.latest, true
+}
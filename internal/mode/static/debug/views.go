@@ -0,0 +1,400 @@
+package debug
+
+import (
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/file"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/graph"
+)
+
+// The view types below are JSON-friendly representations of the Graph, the dataplane Configuration and the NGINX
+// files. We don't marshal the original types directly, because:
+// - Some of them use maps with types.NamespacedName keys, which encoding/json doesn't support.
+// - Some of them include sensitive data, like TLS private keys, which must not be exposed.
+// - Some of them include the full Kubernetes resources, which makes the output hard to read.
+
+// redactedContent replaces the content of secret files.
+const redactedContent = "<redacted>"
+
+type graphView struct {
+	GatewayClass          *gatewayClassView `json:"gatewayClass,omitempty"`
+	Gateway               *gatewayView      `json:"gateway,omitempty"`
+	IgnoredGatewayClasses []string          `json:"ignoredGatewayClasses,omitempty"`
+	IgnoredGateways       []string          `json:"ignoredGateways,omitempty"`
+	Routes                []routeView       `json:"routes,omitempty"`
+	ReferencedSecrets     []secretView      `json:"referencedSecrets,omitempty"`
+}
+
+type gatewayClassView struct {
+	Name       string                 `json:"name"`
+	Conditions []conditions.Condition `json:"conditions,omitempty"`
+	Valid      bool                   `json:"valid"`
+}
+
+type gatewayView struct {
+	Name       string                 `json:"name"`
+	Listeners  []listenerView         `json:"listeners,omitempty"`
+	Conditions []conditions.Condition `json:"conditions,omitempty"`
+	Valid      bool                   `json:"valid"`
+}
+
+type listenerView struct {
+	Name           string                 `json:"name"`
+	Hostname       string                 `json:"hostname,omitempty"`
+	Protocol       string                 `json:"protocol"`
+	ResolvedSecret string                 `json:"resolvedSecret,omitempty"`
+	AttachedRoutes []string               `json:"attachedRoutes,omitempty"`
+	Conditions     []conditions.Condition `json:"conditions,omitempty"`
+	Port           int32                  `json:"port"`
+	Valid          bool                   `json:"valid"`
+}
+
+type routeView struct {
+	Name       string                 `json:"name"`
+	ParentRefs []parentRefView        `json:"parentRefs,omitempty"`
+	Rules      []ruleView             `json:"rules,omitempty"`
+	Conditions []conditions.Condition `json:"conditions,omitempty"`
+	Valid      bool                   `json:"valid"`
+}
+
+type parentRefView struct {
+	AcceptedHostnames map[string][]string   `json:"acceptedHostnames,omitempty"`
+	FailedCondition   *conditions.Condition `json:"failedCondition,omitempty"`
+	Gateway           string                `json:"gateway"`
+	SectionName       string                `json:"sectionName,omitempty"`
+	Attached          bool                  `json:"attached"`
+}
+
+type ruleView struct {
+	BackendRefs  []backendRefView `json:"backendRefs,omitempty"`
+	ValidMatches bool             `json:"validMatches"`
+	ValidFilters bool             `json:"validFilters"`
+}
+
+type backendRefView struct {
+	Service string `json:"service,omitempty"`
+	Port    int32  `json:"port,omitempty"`
+	Weight  int32  `json:"weight"`
+	Valid   bool   `json:"valid"`
+}
+
+type secretView struct {
+	Name   string `json:"name"`
+	Exists bool   `json:"exists"`
+}
+
+type configurationView struct {
+	HTTPServers   []virtualServerView  `json:"httpServers,omitempty"`
+	SSLServers    []virtualServerView  `json:"sslServers,omitempty"`
+	Upstreams     []dataplane.Upstream `json:"upstreams,omitempty"`
+	BackendGroups []backendGroupView   `json:"backendGroups,omitempty"`
+	SSLKeyPairIDs []string             `json:"sslKeyPairIDs,omitempty"`
+}
+
+type virtualServerView struct {
+	Hostname     string         `json:"hostname,omitempty"`
+	SSLKeyPairID string         `json:"sslKeyPairID,omitempty"`
+	PathRules    []pathRuleView `json:"pathRules,omitempty"`
+	Port         int32          `json:"port"`
+	IsDefault    bool           `json:"isDefault"`
+}
+
+type pathRuleView struct {
+	Path       string          `json:"path"`
+	PathType   string          `json:"pathType"`
+	MatchRules []matchRuleView `json:"matchRules,omitempty"`
+}
+
+type matchRuleView struct {
+	Route        string                 `json:"route"`
+	BackendGroup string                 `json:"backendGroup"`
+	Match        v1beta1.HTTPRouteMatch `json:"match"`
+	Filters      dataplane.Filters      `json:"filters"`
+	RuleIdx      int                    `json:"ruleIdx"`
+	MatchIdx     int                    `json:"matchIdx"`
+}
+
+type backendGroupView struct {
+	Name     string              `json:"name"`
+	Backends []dataplane.Backend `json:"backends,omitempty"`
+}
+
+type nginxView struct {
+	Reload reloadView `json:"reload"`
+	Files  []fileView `json:"files,omitempty"`
+}
+
+type reloadView struct {
+	Time      time.Time `json:"time"`
+	Error     string    `json:"error,omitempty"`
+	Succeeded bool      `json:"succeeded"`
+}
+
+type fileView struct {
+	Path    string `json:"path"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+}
+
+func newGraphView(g *graph.Graph) graphView {
+	if g == nil {
+		return graphView{}
+	}
+
+	var view graphView
+
+	if g.GatewayClass != nil {
+		view.GatewayClass = &gatewayClassView{
+			Name:       g.GatewayClass.Source.Name,
+			Conditions: g.GatewayClass.Conditions,
+			Valid:      g.GatewayClass.Valid,
+		}
+	}
+
+	if g.Gateway != nil {
+		view.Gateway = newGatewayView(g.Gateway)
+	}
+
+	for nsname := range g.IgnoredGatewayClasses {
+		view.IgnoredGatewayClasses = append(view.IgnoredGatewayClasses, nsname.Name)
+	}
+	sort.Strings(view.IgnoredGatewayClasses)
+
+	for nsname := range g.IgnoredGateways {
+		view.IgnoredGateways = append(view.IgnoredGateways, nsname.String())
+	}
+	sort.Strings(view.IgnoredGateways)
+
+	for nsname, r := range g.Routes {
+		view.Routes = append(view.Routes, newRouteView(nsname, r))
+	}
+	sort.Slice(view.Routes, func(i, j int) bool {
+		return view.Routes[i].Name < view.Routes[j].Name
+	})
+
+	for nsname, s := range g.ReferencedSecrets {
+		view.ReferencedSecrets = append(view.ReferencedSecrets, secretView{
+			Name:   nsname.String(),
+			Exists: s.Source != nil,
+		})
+	}
+	sort.Slice(view.ReferencedSecrets, func(i, j int) bool {
+		return view.ReferencedSecrets[i].Name < view.ReferencedSecrets[j].Name
+	})
+
+	return view
+}
+
+func newGatewayView(gw *graph.Gateway) *gatewayView {
+	view := &gatewayView{
+		Name:       client.ObjectKeyFromObject(gw.Source).String(),
+		Conditions: gw.Conditions,
+		Valid:      gw.Valid,
+	}
+
+	for name, l := range gw.Listeners {
+		lv := listenerView{
+			Name:       name,
+			Protocol:   string(l.Source.Protocol),
+			Port:       int32(l.Source.Port),
+			Conditions: l.Conditions,
+			Valid:      l.Valid,
+		}
+
+		if l.Source.Hostname != nil {
+			lv.Hostname = string(*l.Source.Hostname)
+		}
+
+		if l.ResolvedSecret != nil {
+			lv.ResolvedSecret = l.ResolvedSecret.String()
+		}
+
+		lv.AttachedRoutes = sortedNsNames(l.Routes)
+
+		view.Listeners = append(view.Listeners, lv)
+	}
+
+	sort.Slice(view.Listeners, func(i, j int) bool {
+		return view.Listeners[i].Name < view.Listeners[j].Name
+	})
+
+	return view
+}
+
+func newRouteView(nsname types.NamespacedName, r *graph.Route) routeView {
+	view := routeView{
+		Name:       nsname.String(),
+		Conditions: r.Conditions,
+		Valid:      r.Valid,
+	}
+
+	for _, ref := range r.ParentRefs {
+		refView := parentRefView{
+			Gateway: ref.Gateway.String(),
+		}
+
+		if sectionName := r.Source.Spec.ParentRefs[ref.Idx].SectionName; sectionName != nil {
+			refView.SectionName = string(*sectionName)
+		}
+
+		if ref.Attachment != nil {
+			refView.Attached = ref.Attachment.Attached
+			refView.AcceptedHostnames = ref.Attachment.AcceptedHostnames
+
+			if !ref.Attachment.Attached {
+				cond := ref.Attachment.FailedCondition
+				refView.FailedCondition = &cond
+			}
+		}
+
+		view.ParentRefs = append(view.ParentRefs, refView)
+	}
+
+	for _, rule := range r.Rules {
+		rv := ruleView{
+			ValidMatches: rule.ValidMatches,
+			ValidFilters: rule.ValidFilters,
+		}
+
+		for _, ref := range rule.BackendRefs {
+			brv := backendRefView{
+				Weight: ref.Weight,
+				Valid:  ref.Valid,
+			}
+
+			if ref.Svc != nil {
+				brv.Service = client.ObjectKeyFromObject(ref.Svc).String()
+				brv.Port = ref.Port
+			}
+
+			rv.BackendRefs = append(rv.BackendRefs, brv)
+		}
+
+		view.Rules = append(view.Rules, rv)
+	}
+
+	return view
+}
+
+func newConfigurationView(conf dataplane.Configuration) configurationView {
+	view := configurationView{
+		HTTPServers: newVirtualServerViews(conf.HTTPServers),
+		SSLServers:  newVirtualServerViews(conf.SSLServers),
+		Upstreams:   conf.Upstreams,
+	}
+
+	for _, group := range conf.BackendGroups {
+		view.BackendGroups = append(view.BackendGroups, backendGroupView{
+			Name:     group.Name(),
+			Backends: group.Backends,
+		})
+	}
+	sort.Slice(view.BackendGroups, func(i, j int) bool {
+		return view.BackendGroups[i].Name < view.BackendGroups[j].Name
+	})
+
+	for id := range conf.SSLKeyPairs {
+		view.SSLKeyPairIDs = append(view.SSLKeyPairIDs, string(id))
+	}
+	sort.Strings(view.SSLKeyPairIDs)
+
+	return view
+}
+
+func newVirtualServerViews(servers []dataplane.VirtualServer) []virtualServerView {
+	if len(servers) == 0 {
+		return nil
+	}
+
+	views := make([]virtualServerView, 0, len(servers))
+
+	for _, s := range servers {
+		sv := virtualServerView{
+			Hostname:  s.Hostname,
+			Port:      s.Port,
+			IsDefault: s.IsDefault,
+		}
+
+		if s.SSL != nil {
+			sv.SSLKeyPairID = string(s.SSL.KeyPairID)
+		}
+
+		for _, pr := range s.PathRules {
+			prv := pathRuleView{
+				Path:     pr.Path,
+				PathType: string(pr.PathType),
+			}
+
+			for _, mr := range pr.MatchRules {
+				prv.MatchRules = append(prv.MatchRules, matchRuleView{
+					Route:        client.ObjectKeyFromObject(mr.Source).String(),
+					BackendGroup: mr.BackendGroup.Name(),
+					Match:        mr.GetMatch(),
+					Filters:      mr.Filters,
+					RuleIdx:      mr.RuleIdx,
+					MatchIdx:     mr.MatchIdx,
+				})
+			}
+
+			sv.PathRules = append(sv.PathRules, prv)
+		}
+
+		views = append(views, sv)
+	}
+
+	return views
+}
+
+func newNginxView(snapshot Snapshot) nginxView {
+	view := nginxView{
+		Reload: reloadView{
+			Time:      snapshot.Time,
+			Succeeded: snapshot.ReloadError == nil,
+		},
+	}
+
+	if snapshot.ReloadError != nil {
+		view.Reload.Error = snapshot.ReloadError.Error()
+	}
+
+	for _, f := range snapshot.Files {
+		content := redactedContent
+		if f.Type != file.TypeSecret {
+			content = string(f.Content)
+		}
+
+		view.Files = append(view.Files, fileView{
+			Path:    f.Path,
+			Type:    f.Type.String(),
+			Content: content,
+		})
+	}
+
+	sort.Slice(view.Files, func(i, j int) bool {
+		return view.Files[i].Path < view.Files[j].Path
+	})
+
+	return view
+}
+
+func sortedNsNames(routes map[types.NamespacedName]*graph.Route) []string {
+	if len(routes) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(routes))
+	for nsname := range routes {
+		names = append(names, nsname.String())
+	}
+
+	sort.Strings(names)
+
+	return names
+}
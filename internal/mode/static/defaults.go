@@ -0,0 +1,157 @@
+package static
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+)
+
+// setDefaults sets the default values of the fields of the Gateway API resources that are not set, the same way
+// the API server does it using the defaults from the Gateway API CRDs. The Gateway relies on the API server to set
+// them, so the resources that don't come from the API server, like in Render, must get them here.
+// The defaults must match the `+kubebuilder:default` markers of the Gateway API types.
+func setDefaults(obj client.Object) {
+	switch o := obj.(type) {
+	case *v1beta1.Gateway:
+		setGatewayDefaults(o)
+	case *v1beta1.HTTPRoute:
+		setHTTPRouteDefaults(o)
+	}
+}
+
+func setGatewayDefaults(gw *v1beta1.Gateway) {
+	for i := range gw.Spec.Listeners {
+		l := &gw.Spec.Listeners[i]
+
+		if l.AllowedRoutes == nil {
+			l.AllowedRoutes = &v1beta1.AllowedRoutes{}
+		}
+		if l.AllowedRoutes.Namespaces == nil {
+			l.AllowedRoutes.Namespaces = &v1beta1.RouteNamespaces{}
+		}
+		if l.AllowedRoutes.Namespaces.From == nil {
+			l.AllowedRoutes.Namespaces.From = helpers.GetPointer(v1beta1.NamespacesFromSame)
+		}
+		for j := range l.AllowedRoutes.Kinds {
+			if l.AllowedRoutes.Kinds[j].Group == nil {
+				l.AllowedRoutes.Kinds[j].Group = helpers.GetPointer[v1beta1.Group](v1beta1.GroupName)
+			}
+		}
+
+		if l.TLS != nil {
+			if l.TLS.Mode == nil {
+				l.TLS.Mode = helpers.GetPointer(v1beta1.TLSModeTerminate)
+			}
+			for j := range l.TLS.CertificateRefs {
+				setSecretObjectReferenceDefaults(&l.TLS.CertificateRefs[j])
+			}
+		}
+	}
+
+	for i := range gw.Spec.Addresses {
+		if gw.Spec.Addresses[i].Type == nil {
+			gw.Spec.Addresses[i].Type = helpers.GetPointer(v1beta1.IPAddressType)
+		}
+	}
+}
+
+func setSecretObjectReferenceDefaults(ref *v1beta1.SecretObjectReference) {
+	if ref.Group == nil {
+		ref.Group = helpers.GetPointer[v1beta1.Group]("")
+	}
+	if ref.Kind == nil {
+		ref.Kind = helpers.GetPointer[v1beta1.Kind]("Secret")
+	}
+}
+
+func setHTTPRouteDefaults(hr *v1beta1.HTTPRoute) {
+	for i := range hr.Spec.ParentRefs {
+		ref := &hr.Spec.ParentRefs[i]
+
+		if ref.Group == nil {
+			ref.Group = helpers.GetPointer[v1beta1.Group](v1beta1.GroupName)
+		}
+		if ref.Kind == nil {
+			ref.Kind = helpers.GetPointer[v1beta1.Kind]("Gateway")
+		}
+	}
+
+	if hr.Spec.Rules == nil {
+		hr.Spec.Rules = []v1beta1.HTTPRouteRule{{}}
+	}
+
+	for i := range hr.Spec.Rules {
+		rule := &hr.Spec.Rules[i]
+
+		if rule.Matches == nil {
+			rule.Matches = []v1beta1.HTTPRouteMatch{{}}
+		}
+		for j := range rule.Matches {
+			setHTTPRouteMatchDefaults(&rule.Matches[j])
+		}
+
+		setHTTPRouteFiltersDefaults(rule.Filters)
+
+		for j := range rule.BackendRefs {
+			ref := &rule.BackendRefs[j]
+
+			setBackendRefDefaults(&ref.BackendRef)
+			setHTTPRouteFiltersDefaults(ref.Filters)
+		}
+	}
+}
+
+func setHTTPRouteMatchDefaults(match *v1beta1.HTTPRouteMatch) {
+	if match.Path == nil {
+		match.Path = &v1beta1.HTTPPathMatch{}
+	}
+	if match.Path.Type == nil {
+		match.Path.Type = helpers.GetPointer(v1beta1.PathMatchPathPrefix)
+	}
+	if match.Path.Value == nil {
+		match.Path.Value = helpers.GetPointer("/")
+	}
+
+	for i := range match.Headers {
+		if match.Headers[i].Type == nil {
+			match.Headers[i].Type = helpers.GetPointer(v1beta1.HeaderMatchExact)
+		}
+	}
+
+	for i := range match.QueryParams {
+		if match.QueryParams[i].Type == nil {
+			match.QueryParams[i].Type = helpers.GetPointer(v1beta1.QueryParamMatchExact)
+		}
+	}
+}
+
+func setHTTPRouteFiltersDefaults(filters []v1beta1.HTTPRouteFilter) {
+	for i := range filters {
+		f := &filters[i]
+
+		if f.RequestRedirect != nil && f.RequestRedirect.StatusCode == nil {
+			f.RequestRedirect.StatusCode = helpers.GetPointer(302)
+		}
+		if f.RequestMirror != nil {
+			setBackendObjectReferenceDefaults(&f.RequestMirror.BackendRef)
+		}
+	}
+}
+
+func setBackendRefDefaults(ref *v1beta1.BackendRef) {
+	setBackendObjectReferenceDefaults(&ref.BackendObjectReference)
+
+	if ref.Weight == nil {
+		ref.Weight = helpers.GetPointer[int32](1)
+	}
+}
+
+func setBackendObjectReferenceDefaults(ref *v1beta1.BackendObjectReference) {
+	if ref.Group == nil {
+		ref.Group = helpers.GetPointer[v1beta1.Group]("")
+	}
+	if ref.Kind == nil {
+		ref.Kind = helpers.GetPointer[v1beta1.Kind]("Service")
+	}
+}
@@ -3,16 +3,22 @@ package static
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
 
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/events"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/status"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/debug"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/file"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/runtime"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/graph"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/resolver"
 )
 
@@ -28,8 +34,13 @@ type eventHandlerConfig struct {
 	nginxFileMgr file.Manager
 	// nginxRuntimeMgr manages nginx runtime.
 	nginxRuntimeMgr runtime.Manager
+	// nginxConfigTester tests the nginx configuration with the snippets of SnippetsPolicies.
+	// It is nil if the snippets are disabled.
+	nginxConfigTester runtime.ConfigTester
 	// statusUpdater updates statuses on Kubernetes resources.
 	statusUpdater status.Updater
+	// snapshotStore stores the latest state of the handler for introspection.
+	snapshotStore *debug.SnapshotStore
 	// logger is the logger to be used by the EventHandler.
 	logger logr.Logger
 }
@@ -68,20 +79,28 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, batch events.Ev
 	}
 
 	var nginxReloadRes nginxReloadResult
-	err := h.updateNginx(ctx, dataplane.BuildConfiguration(ctx, graph, h.cfg.serviceResolver))
-	if err != nil {
+
+	conf, files, rejectedSnippets := h.generateConfig(ctx, graph)
+
+	if err := h.updateNginx(ctx, files); err != nil {
 		h.cfg.logger.Error(err, "Failed to update NGINX configuration")
 		nginxReloadRes.error = err
 	} else {
 		h.cfg.logger.Info("NGINX configuration was successfully updated")
 	}
 
-	h.cfg.statusUpdater.Update(ctx, buildStatuses(graph, nginxReloadRes))
-}
+	h.cfg.snapshotStore.Update(debug.Snapshot{
+		Time:          time.Now(),
+		Graph:         graph,
+		Configuration: conf,
+		Files:         files,
+		ReloadError:   nginxReloadRes.error,
+	})
 
-func (h *eventHandlerImpl) updateNginx(ctx context.Context, conf dataplane.Configuration) error {
-	files := h.cfg.generator.Generate(conf)
+	h.cfg.statusUpdater.Update(ctx, buildStatuses(graph, nginxReloadRes, rejectedSnippets))
+}
 
+func (h *eventHandlerImpl) updateNginx(ctx context.Context, files []file.File) error {
 	if err := h.cfg.nginxFileMgr.ReplaceFiles(files); err != nil {
 		return fmt.Errorf("failed to replace NGINX configuration files: %w", err)
 	}
@@ -92,3 +111,72 @@ func (h *eventHandlerImpl) updateNginx(ctx context.Context, conf dataplane.Confi
 
 	return nil
 }
+
+// generateConfig generates the nginx configuration files for the Graph. If the snippets are enabled, it tests
+// the configuration with the snippets of the valid SnippetsPolicies with nginx -t, so that the snippets that conflict
+// with the generated configuration don't break the reload of nginx. The test doesn't change the configuration files
+// of nginx, and the Graph is not modified.
+//
+// If the configuration fails the test, it tests the configuration without the snippets and then adds the snippets of
+// the policies one by one, from the oldest policy to the newest. A policy with the snippets that make the configuration
+// fail the test is rejected, and the configuration doesn't include its snippets. generateConfig returns
+// the conditions of the rejected policies.
+func (h *eventHandlerImpl) generateConfig(
+	ctx context.Context,
+	g *graph.Graph,
+) (dataplane.Configuration, []file.File, map[types.NamespacedName]conditions.Condition) {
+	generate := func(excludedSnippets map[types.NamespacedName]struct{}) (dataplane.Configuration, []file.File) {
+		conf := dataplane.BuildConfiguration(ctx, g, h.cfg.serviceResolver, excludedSnippets)
+		return conf, h.cfg.generator.Generate(conf)
+	}
+
+	conf, files := generate(nil)
+
+	policies := g.GetValidSnippetsPolicies()
+	if h.cfg.nginxConfigTester == nil || len(policies) == 0 {
+		return conf, files, nil
+	}
+
+	testErr := h.cfg.nginxConfigTester.Test(ctx, files)
+	if testErr == nil {
+		return conf, files, nil
+	}
+
+	h.cfg.logger.Error(testErr, "NGINX configuration with the snippets failed the test")
+
+	excluded := make(map[types.NamespacedName]struct{}, len(policies))
+	for _, nsname := range policies {
+		excluded[nsname] = struct{}{}
+	}
+
+	rejected := make(map[types.NamespacedName]conditions.Condition)
+
+	conf, files = generate(excluded)
+
+	if testErr = h.cfg.nginxConfigTester.Test(ctx, files); testErr != nil {
+		h.cfg.logger.Error(testErr, "NGINX configuration without the snippets failed the test")
+
+		for _, nsname := range policies {
+			rejected[nsname] = staticConds.NewPolicySnippetsNotTested()
+		}
+
+		return conf, files, rejected
+	}
+
+	for _, nsname := range policies {
+		delete(excluded, nsname)
+
+		_, files = generate(excluded)
+
+		if testErr = h.cfg.nginxConfigTester.Test(ctx, files); testErr != nil {
+			h.cfg.logger.Error(testErr, "NGINX configuration with the snippets of the SnippetsPolicy failed the test",
+				"policy", nsname)
+
+			excluded[nsname] = struct{}{}
+			rejected[nsname] = staticConds.NewPolicySnippetsTestFailed()
+		}
+	}
+
+	conf, files = generate(excluded)
+	return conf, files, rejected
+}
@@ -2,19 +2,27 @@ package static
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/events"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/status"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/status/statusfakes"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/debug"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/configfakes"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/file"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/file/filefakes"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/runtime/runtimefakes"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/graph"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/statefakes"
@@ -28,6 +36,7 @@ var _ = Describe("eventHandler", func() {
 		fakeNginxFileMgr    *filefakes.FakeManager
 		fakeNginxRuntimeMgr *runtimefakes.FakeManager
 		fakeStatusUpdater   *statusfakes.FakeUpdater
+		snapshotStore       *debug.SnapshotStore
 	)
 
 	expectReconfig := func(expectedConf dataplane.Configuration, expectedFiles []file.File) {
@@ -43,6 +52,12 @@ var _ = Describe("eventHandler", func() {
 		Expect(fakeNginxRuntimeMgr.ReloadCallCount()).Should(Equal(1))
 
 		Expect(fakeStatusUpdater.UpdateCallCount()).Should(Equal(1))
+
+		snapshot, exists := snapshotStore.Latest()
+		Expect(exists).Should(BeTrue())
+		Expect(snapshot.Configuration).Should(Equal(expectedConf))
+		Expect(snapshot.Files).Should(Equal(expectedFiles))
+		Expect(snapshot.ReloadError).ShouldNot(HaveOccurred())
 	}
 
 	BeforeEach(func() {
@@ -51,6 +66,7 @@ var _ = Describe("eventHandler", func() {
 		fakeNginxFileMgr = &filefakes.FakeManager{}
 		fakeNginxRuntimeMgr = &runtimefakes.FakeManager{}
 		fakeStatusUpdater = &statusfakes.FakeUpdater{}
+		snapshotStore = debug.NewSnapshotStore()
 
 		handler = newEventHandlerImpl(eventHandlerConfig{
 			processor:       fakeProcessor,
@@ -59,6 +75,7 @@ var _ = Describe("eventHandler", func() {
 			nginxFileMgr:    fakeNginxFileMgr,
 			nginxRuntimeMgr: fakeNginxRuntimeMgr,
 			statusUpdater:   fakeStatusUpdater,
+			snapshotStore:   snapshotStore,
 		})
 	})
 
@@ -130,6 +147,133 @@ var _ = Describe("eventHandler", func() {
 				handler.HandleEventBatch(context.Background(), batch)
 			})
 		})
+
+		When("NGINX fails to reload", func() {
+			It("should record the error in the snapshot", func() {
+				fakeNginxRuntimeMgr.ReloadReturns(errors.New("reload error"))
+
+				e := &events.UpsertEvent{Resource: &v1beta1.HTTPRoute{}}
+				batch := []interface{}{e}
+
+				handler.HandleEventBatch(context.Background(), batch)
+
+				snapshot, exists := snapshotStore.Latest()
+				Expect(exists).Should(BeTrue())
+				Expect(snapshot.Files).Should(Equal(fakeCfgFiles))
+				Expect(snapshot.ReloadError).Should(MatchError(ContainSubstring("reload error")))
+			})
+		})
+	})
+
+	Describe("Test the NGINX configuration with the snippets", func() {
+		var (
+			fakeNginxConfigTester *runtimefakes.FakeConfigTester
+			g                     *graph.Graph
+		)
+
+		olderPolicy := types.NamespacedName{Namespace: "test", Name: "older"}
+		newerPolicy := types.NamespacedName{Namespace: "test", Name: "newer"}
+
+		createSnippetsPolicy := func(nsname types.NamespacedName, created time.Time) *graph.SnippetsPolicy {
+			return &graph.SnippetsPolicy{
+				Source: &nkgAPI.SnippetsPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         nsname.Namespace,
+						Name:              nsname.Name,
+						CreationTimestamp: metav1.NewTime(created),
+					},
+				},
+				Valid: true,
+			}
+		}
+
+		cfgFiles := []file.File{
+			{
+				Content: []byte("server { proxy_buffer_size 16k; }"),
+				Path:    "/etc/nginx/conf.d/http.conf",
+			},
+		}
+
+		handle := func() {
+			batch := []interface{}{&events.UpsertEvent{Resource: &nkgAPI.SnippetsPolicy{}}}
+			handler.HandleEventBatch(context.Background(), batch)
+		}
+
+		BeforeEach(func() {
+			now := time.Now()
+
+			g = &graph.Graph{
+				SnippetsPolicies: map[types.NamespacedName]*graph.SnippetsPolicy{
+					olderPolicy: createSnippetsPolicy(olderPolicy, now.Add(-time.Minute)),
+					newerPolicy: createSnippetsPolicy(newerPolicy, now),
+				},
+			}
+			fakeProcessor.ProcessReturns(true /* changed */, g)
+			fakeGenerator.GenerateReturns(cfgFiles)
+
+			fakeNginxConfigTester = &runtimefakes.FakeConfigTester{}
+			handler.cfg.nginxConfigTester = fakeNginxConfigTester
+		})
+
+		expectPolicyConditions := func(nsname types.NamespacedName, expected ...conditions.Condition) {
+			Expect(fakeStatusUpdater.UpdateCallCount()).Should(Equal(1))
+			_, statuses := fakeStatusUpdater.UpdateArgsForCall(0)
+
+			key := status.PolicyKey{NsName: nsname, Kind: nkgAPI.SnippetsPolicyKind}
+			Expect(statuses.PolicyStatuses).Should(HaveKey(key))
+			Expect(statuses.PolicyStatuses[key].Conditions).Should(Equal(expected))
+		}
+
+		acceptedConds := staticConds.NewDefaultPolicyConditions()
+
+		It("should test the configuration once if it passes the test", func() {
+			handle()
+
+			Expect(fakeNginxConfigTester.TestCallCount()).Should(Equal(1))
+			_, testedFiles := fakeNginxConfigTester.TestArgsForCall(0)
+			Expect(testedFiles).Should(Equal(cfgFiles))
+
+			Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).Should(Equal(1))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).Should(Equal(1))
+
+			expectPolicyConditions(olderPolicy, acceptedConds...)
+			expectPolicyConditions(newerPolicy, acceptedConds...)
+		})
+
+		It("should reject the policy with the snippets that fail the test", func() {
+			testErr := errors.New("nginx -t failed")
+
+			// with all snippets, without snippets, with the older policy, with both policies
+			fakeNginxConfigTester.TestReturnsOnCall(0, testErr)
+			fakeNginxConfigTester.TestReturnsOnCall(1, nil)
+			fakeNginxConfigTester.TestReturnsOnCall(2, nil)
+			fakeNginxConfigTester.TestReturnsOnCall(3, testErr)
+
+			handle()
+
+			Expect(fakeNginxConfigTester.TestCallCount()).Should(Equal(4))
+			Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).Should(Equal(1))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).Should(Equal(1))
+
+			expectPolicyConditions(olderPolicy, acceptedConds...)
+			expectPolicyConditions(newerPolicy, staticConds.NewPolicySnippetsTestFailed())
+
+			Expect(g.SnippetsPolicies[newerPolicy].Valid).Should(BeTrue())
+			Expect(g.SnippetsPolicies[newerPolicy].Conditions).Should(BeEmpty())
+		})
+
+		It("should reject all policies if the configuration without the snippets fails the test", func() {
+			fakeNginxConfigTester.TestReturns(errors.New("nginx -t failed"))
+
+			handle()
+
+			Expect(fakeNginxConfigTester.TestCallCount()).Should(Equal(2))
+			Expect(fakeNginxFileMgr.ReplaceFilesCallCount()).Should(Equal(1))
+			Expect(fakeNginxRuntimeMgr.ReloadCallCount()).Should(Equal(1))
+
+			expectPolicyConditions(olderPolicy, staticConds.NewPolicySnippetsNotTested())
+			expectPolicyConditions(newerPolicy, staticConds.NewPolicySnippetsNotTested())
+		})
 	})
 
 	It("should panic for an unknown event type", func() {
@@ -14,8 +14,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	k8spredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/controller"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/controller/filter"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/controller/index"
@@ -23,6 +25,7 @@ import (
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/events"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/status"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/config"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/debug"
 	ngxcfg "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config"
 	ngxvalidation "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/validation"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/file"
@@ -31,6 +34,7 @@ import (
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/relationship"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/resolver"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/webhook"
 )
 
 const (
@@ -44,6 +48,7 @@ func init() {
 	utilruntime.Must(gatewayv1beta1.AddToScheme(scheme))
 	utilruntime.Must(apiv1.AddToScheme(scheme))
 	utilruntime.Must(discoveryV1.AddToScheme(scheme))
+	utilruntime.Must(nkgAPI.AddToScheme(scheme))
 }
 
 func StartManager(cfg config.Config) error {
@@ -67,12 +72,15 @@ func StartManager(cfg config.Config) error {
 		return fmt.Errorf("cannot build runtime manager: %w", err)
 	}
 
-	// Note: for any new object type or a change to the existing one,
-	// make sure to also update prepareFirstEventBatchPreparerArgs()
-	controllerRegCfgs := []struct {
+	type ctlrCfg struct {
 		objectType client.Object
 		options    []controller.Option
-	}{
+	}
+
+	// Note: for any new object type or a change to the existing one,
+	// make sure to also update prepareFirstEventBatchPreparerArgs().
+	// The policies are registered in nkgAPI.PolicyKinds.
+	controllerRegCfgs := []ctlrCfg{
 		{
 			objectType: &gatewayv1beta1.GatewayClass{},
 			options: []controller.Option{
@@ -102,6 +110,9 @@ func StartManager(cfg config.Config) error {
 		{
 			objectType: &apiv1.Secret{},
 		},
+		{
+			objectType: &apiv1.ConfigMap{},
+		},
 		{
 			objectType: &discoveryV1.EndpointSlice{},
 			options: []controller.Option{
@@ -118,6 +129,26 @@ func StartManager(cfg config.Config) error {
 		{
 			objectType: &gatewayv1beta1.ReferenceGrant{},
 		},
+		{
+			objectType: &nkgAPI.AuthenticationFilter{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &nkgAPI.DirectResponseFilter{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+	}
+	for _, kind := range nkgAPI.PolicyKinds {
+		controllerRegCfgs = append(controllerRegCfgs, ctlrCfg{
+			objectType: kind.New(),
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		})
 	}
 
 	ctx := ctlr.SetupSignalHandler()
@@ -132,16 +163,25 @@ func StartManager(cfg config.Config) error {
 	recorderName := fmt.Sprintf("nginx-kubernetes-gateway-%s", cfg.GatewayClassName)
 	recorder := mgr.GetEventRecorderFor(recorderName)
 
+	validators := validation.Validators{
+		HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
+		PolicyValidator:     ngxvalidation.PolicyValidator{},
+	}
+
+	var nginxConfigTester ngxruntime.ConfigTester
+	if cfg.SnippetsNginxPath != "" {
+		validators.SnippetsValidator = ngxvalidation.SnippetsValidator{}
+		nginxConfigTester = ngxruntime.NewConfigTesterImpl(cfg.SnippetsNginxPath)
+	}
+
 	processor := state.NewChangeProcessorImpl(state.ChangeProcessorConfig{
 		GatewayCtlrName:      cfg.GatewayCtlrName,
 		GatewayClassName:     cfg.GatewayClassName,
 		RelationshipCapturer: relationship.NewCapturerImpl(),
 		Logger:               cfg.Logger.WithName("changeProcessor"),
-		Validators: validation.Validators{
-			HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
-		},
-		EventRecorder: recorder,
-		Scheme:        scheme,
+		Validators:           validators,
+		EventRecorder:        recorder,
+		Scheme:               scheme,
 	})
 
 	configGenerator := ngxcfg.NewGeneratorImpl()
@@ -168,14 +208,18 @@ func StartManager(cfg config.Config) error {
 		UpdateGatewayClassStatus: cfg.UpdateGatewayClassStatus,
 	})
 
+	snapshotStore := debug.NewSnapshotStore()
+
 	eventHandler := newEventHandlerImpl(eventHandlerConfig{
-		processor:       processor,
-		serviceResolver: resolver.NewServiceResolverImpl(mgr.GetClient()),
-		generator:       configGenerator,
-		logger:          cfg.Logger.WithName("eventHandler"),
-		nginxFileMgr:    nginxFileMgr,
-		nginxRuntimeMgr: nginxRuntimeMgr,
-		statusUpdater:   statusUpdater,
+		processor:         processor,
+		serviceResolver:   resolver.NewServiceResolverImpl(mgr.GetClient()),
+		generator:         configGenerator,
+		logger:            cfg.Logger.WithName("eventHandler"),
+		nginxFileMgr:      nginxFileMgr,
+		nginxRuntimeMgr:   nginxRuntimeMgr,
+		nginxConfigTester: nginxConfigTester,
+		statusUpdater:     statusUpdater,
+		snapshotStore:     snapshotStore,
 	})
 
 	objects, objectLists := prepareFirstEventBatchPreparerArgs(cfg.GatewayClassName, cfg.GatewayNsName)
@@ -192,6 +236,30 @@ func StartManager(cfg config.Config) error {
 		return fmt.Errorf("cannot register event loop: %w", err)
 	}
 
+	if cfg.DebugServerAddress != "" {
+		debugServer := debug.NewServer(cfg.DebugServerAddress, snapshotStore, cfg.Logger.WithName("debugServer"))
+		if err := mgr.Add(debugServer); err != nil {
+			return fmt.Errorf("cannot register debug server: %w", err)
+		}
+	}
+
+	if cfg.Webhook != nil {
+		webhookServer := webhook.NewServer(webhook.ServerConfig{
+			CertSecretNsName: cfg.Webhook.CertSecretNsName,
+			Port:             cfg.Webhook.Port,
+			Validator: webhook.ValidatorConfig{
+				Reader:              mgr.GetClient(),
+				Decoder:             admission.NewDecoder(scheme),
+				HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
+				GatewayClassName:    cfg.GatewayClassName,
+				Mode:                webhook.Mode(cfg.Webhook.Mode),
+			},
+		})
+		if err := mgr.Add(webhookServer); err != nil {
+			return fmt.Errorf("cannot register webhook server: %w", err)
+		}
+	}
+
 	logger.Info("Starting manager")
 	return mgr.Start(ctx)
 }
@@ -206,10 +274,16 @@ func prepareFirstEventBatchPreparerArgs(
 	objectLists := []client.ObjectList{
 		&apiv1.ServiceList{},
 		&apiv1.SecretList{},
+		&apiv1.ConfigMapList{},
 		&apiv1.NamespaceList{},
 		&discoveryV1.EndpointSliceList{},
 		&gatewayv1beta1.HTTPRouteList{},
 		&gatewayv1beta1.ReferenceGrantList{},
+		&nkgAPI.AuthenticationFilterList{},
+		&nkgAPI.DirectResponseFilterList{},
+	}
+	for _, kind := range nkgAPI.PolicyKinds {
+		objectLists = append(objectLists, kind.NewList())
 	}
 
 	if gwNsName == nil {
@@ -10,6 +10,8 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 )
 
 func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
@@ -30,11 +32,27 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 			expectedObjectLists: []client.ObjectList{
 				&apiv1.ServiceList{},
 				&apiv1.SecretList{},
+				&apiv1.ConfigMapList{},
 				&apiv1.NamespaceList{},
 				&discoveryV1.EndpointSliceList{},
 				&gatewayv1beta1.HTTPRouteList{},
 				&gatewayv1beta1.GatewayList{},
 				&gatewayv1beta1.ReferenceGrantList{},
+				&nkgAPI.AuthenticationFilterList{},
+				&nkgAPI.DirectResponseFilterList{},
+				&nkgAPI.UpstreamSettingsPolicyList{},
+				&nkgAPI.UpstreamHealthPolicyList{},
+				&nkgAPI.RetryPolicyList{},
+				&nkgAPI.RateLimitPolicyList{},
+				&nkgAPI.ClientSettingsPolicyList{},
+				&nkgAPI.ObservabilityPolicyList{},
+				&nkgAPI.CORSPolicyList{},
+				&nkgAPI.AccessControlPolicyList{},
+				&nkgAPI.CachePolicyList{},
+				&nkgAPI.CompressionPolicyList{},
+				&nkgAPI.ErrorPagePolicyList{},
+				&nkgAPI.SnippetsPolicyList{},
+				&nkgAPI.TimeoutPolicyList{},
 			},
 		},
 		{
@@ -50,10 +68,26 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 			expectedObjectLists: []client.ObjectList{
 				&apiv1.ServiceList{},
 				&apiv1.SecretList{},
+				&apiv1.ConfigMapList{},
 				&apiv1.NamespaceList{},
 				&discoveryV1.EndpointSliceList{},
 				&gatewayv1beta1.HTTPRouteList{},
 				&gatewayv1beta1.ReferenceGrantList{},
+				&nkgAPI.AuthenticationFilterList{},
+				&nkgAPI.DirectResponseFilterList{},
+				&nkgAPI.UpstreamSettingsPolicyList{},
+				&nkgAPI.UpstreamHealthPolicyList{},
+				&nkgAPI.RetryPolicyList{},
+				&nkgAPI.RateLimitPolicyList{},
+				&nkgAPI.ClientSettingsPolicyList{},
+				&nkgAPI.ObservabilityPolicyList{},
+				&nkgAPI.CORSPolicyList{},
+				&nkgAPI.AccessControlPolicyList{},
+				&nkgAPI.CachePolicyList{},
+				&nkgAPI.CompressionPolicyList{},
+				&nkgAPI.ErrorPagePolicyList{},
+				&nkgAPI.SnippetsPolicyList{},
+				&nkgAPI.TimeoutPolicyList{},
 			},
 		},
 	}
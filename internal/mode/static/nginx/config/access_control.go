@@ -0,0 +1,31 @@
+package config
+
+import (
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+// createAccessRules creates the allow and deny directives of a location. NGINX checks the directives in order
+// until the first match, so the deny directives come first to take precedence over the allow directives.
+// If any address is allowed, the last directive denies the access of all other clients.
+func createAccessRules(accessControl *dataplane.AccessControl) []http.AccessRule {
+	if accessControl == nil {
+		return nil
+	}
+
+	rules := make([]http.AccessRule, 0, len(accessControl.Deny)+len(accessControl.Allow)+1)
+
+	for _, address := range accessControl.Deny {
+		rules = append(rules, http.AccessRule{Directive: http.AccessDeny, Address: address})
+	}
+
+	for _, address := range accessControl.Allow {
+		rules = append(rules, http.AccessRule{Directive: http.AccessAllow, Address: address})
+	}
+
+	if len(accessControl.Allow) > 0 {
+		rules = append(rules, http.AccessRule{Directive: http.AccessDeny, Address: "all"})
+	}
+
+	return rules
+}
@@ -0,0 +1,55 @@
+package config
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+func TestCreateAccessRules(t *testing.T) {
+	tests := []struct {
+		accessControl *dataplane.AccessControl
+		name          string
+		expected      []http.AccessRule
+	}{
+		{
+			accessControl: nil,
+			expected:      nil,
+			name:          "no access control",
+		},
+		{
+			accessControl: &dataplane.AccessControl{
+				Allow: []string{"10.0.0.0/8", "192.168.1.1"},
+				Deny:  []string{"10.0.1.0/24"},
+			},
+			expected: []http.AccessRule{
+				{Directive: http.AccessDeny, Address: "10.0.1.0/24"},
+				{Directive: http.AccessAllow, Address: "10.0.0.0/8"},
+				{Directive: http.AccessAllow, Address: "192.168.1.1"},
+				{Directive: http.AccessDeny, Address: "all"},
+			},
+			name: "allow and deny",
+		},
+		{
+			accessControl: &dataplane.AccessControl{
+				Deny: []string{"10.0.1.0/24", "2001:db8::/32"},
+			},
+			expected: []http.AccessRule{
+				{Directive: http.AccessDeny, Address: "10.0.1.0/24"},
+				{Directive: http.AccessDeny, Address: "2001:db8::/32"},
+			},
+			name: "deny only",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(createAccessRules(test.accessControl)).To(Equal(test.expected))
+		})
+	}
+}
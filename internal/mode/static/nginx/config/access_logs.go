@@ -0,0 +1,124 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	gotemplate "text/template"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+const (
+	// accessLogStdout is the path of the access logs that NGINX writes to stdout.
+	accessLogStdout = "/dev/stdout"
+
+	// The variables that the locations set to identify the listener, the route and the rule of a request
+	// in the access logs.
+	listenerVariable       = "nkg_listener"
+	routeNamespaceVariable = "nkg_route_namespace"
+	routeNameVariable      = "nkg_route_name"
+	routeRuleVariable      = "nkg_route_rule"
+)
+
+var accessLogsTemplate = gotemplate.Must(gotemplate.New("accessLogs").Parse(accessLogsTemplateText))
+
+// accessLogVariables are the variables that the log formats of NKG use. The http context declares them with
+// an empty default value for the requests that no location sets them for.
+var accessLogVariables = []string{
+	listenerVariable,
+	routeNamespaceVariable,
+	routeNameVariable,
+	routeRuleVariable,
+}
+
+// accessLogs holds the configuration of the access logs in the http context.
+type accessLogs struct {
+	// AccessLog is the access_log directive. If nil, the directive is omitted.
+	AccessLog *http.AccessLog
+	// Variables are the names of the variables to declare for the log formats.
+	Variables []string
+	// FormatsNeeded indicates whether any access_log directive uses the log formats of NKG.
+	FormatsNeeded bool
+}
+
+func executeAccessLogs(conf dataplane.Configuration) []byte {
+	logs := accessLogs{
+		AccessLog:     createAccessLog(conf.AccessLog),
+		Variables:     accessLogVariables,
+		FormatsNeeded: accessLogsConfigured(conf),
+	}
+
+	return execute(accessLogsTemplate, logs)
+}
+
+// accessLogsConfigured returns true if the Configuration or any of its MatchRules configures the access logs.
+func accessLogsConfigured(conf dataplane.Configuration) bool {
+	if conf.AccessLog != nil {
+		return true
+	}
+
+	for _, servers := range [][]dataplane.VirtualServer{conf.HTTPServers, conf.SSLServers} {
+		for _, s := range servers {
+			for _, rule := range s.PathRules {
+				for _, r := range rule.MatchRules {
+					if r.AccessLog != nil {
+						return true
+					}
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func createAccessLog(accessLog *dataplane.AccessLog) *http.AccessLog {
+	if accessLog == nil {
+		return nil
+	}
+
+	if accessLog.Disabled {
+		return &http.AccessLog{Off: true}
+	}
+
+	return &http.AccessLog{
+		Path:   createAccessLogPath(accessLog.Syslog),
+		Format: createAccessLogFormat(accessLog.Format),
+	}
+}
+
+func createAccessLogPath(syslog *dataplane.SyslogDestination) string {
+	if syslog == nil {
+		return accessLogStdout
+	}
+
+	path := "syslog:server=" + syslog.Server
+	if syslog.Tag != "" {
+		path += ",tag=" + syslog.Tag
+	}
+
+	return path
+}
+
+func createAccessLogFormat(format dataplane.AccessLogFormat) string {
+	switch format {
+	case dataplane.AccessLogFormatJSON:
+		return "nkg_json"
+	case dataplane.AccessLogFormatText:
+		return "nkg_text"
+	default:
+		panic(fmt.Sprintf("unsupported access log format: %s", format))
+	}
+}
+
+// createAccessLogVariables creates the variables that identify the listener, the route and the rule of the requests
+// that match the rule in the access logs.
+func createAccessLogVariables(rule dataplane.MatchRule) []http.Variable {
+	return []http.Variable{
+		{Name: listenerVariable, Value: rule.Listener},
+		{Name: routeNamespaceVariable, Value: rule.Source.Namespace},
+		{Name: routeNameVariable, Value: rule.Source.Name},
+		{Name: routeRuleVariable, Value: strconv.Itoa(rule.RuleIdx)},
+	}
+}
@@ -0,0 +1,41 @@
+package config
+
+var accessLogsTemplateText = `
+{{- if .FormatsNeeded }}
+    {{- range $v := .Variables }}
+map $host ${{ $v }} {
+    default "";
+}
+    {{- end }}
+
+log_format nkg_json escape=json
+    '{'
+    '"time":"$time_iso8601",'
+    '"remote_addr":"$remote_addr",'
+    '"request_id":"$request_id",'
+    '"host":"$host",'
+    '"request_method":"$request_method",'
+    '"request_uri":"$request_uri",'
+    '"server_protocol":"$server_protocol",'
+    '"status":"$status",'
+    '"body_bytes_sent":"$body_bytes_sent",'
+    '"request_time":"$request_time",'
+    '"upstream_addr":"$upstream_addr",'
+    '"upstream_status":"$upstream_status",'
+    '"upstream_response_time":"$upstream_response_time",'
+    '"http_referer":"$http_referer",'
+    '"http_user_agent":"$http_user_agent",'
+    '"gateway_listener":"$nkg_listener",'
+    '"route_namespace":"$nkg_route_namespace",'
+    '"route_name":"$nkg_route_name",'
+    '"route_rule":"$nkg_route_rule"'
+    '}';
+
+log_format nkg_text '$remote_addr - $remote_user [$time_local] "$request" $status $body_bytes_sent '
+    '"$http_referer" "$http_user_agent" listener=$nkg_listener '
+    'route=$nkg_route_namespace/$nkg_route_name rule=$nkg_route_rule';
+{{- end }}
+{{- with .AccessLog }}
+access_log {{ if .Off }}off{{ else }}{{ .Path }} {{ .Format }}{{ end }};
+{{- end }}
+`
@@ -0,0 +1,151 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteAccessLogs(t *testing.T) {
+	conf := dataplane.Configuration{
+		AccessLog: &dataplane.AccessLog{
+			Syslog: &dataplane.SyslogDestination{
+				Server: "syslog.logging.svc:514",
+				Tag:    "gateway",
+			},
+			Format: dataplane.AccessLogFormatText,
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"map $host $nkg_listener {\n    default \"\";\n}":                       1,
+		"map $host $nkg_route_namespace {\n    default \"\";\n}":                1,
+		"map $host $nkg_route_name {\n    default \"\";\n}":                     1,
+		"map $host $nkg_route_rule {\n    default \"\";\n}":                     1,
+		"log_format nkg_json escape=json":                                       1,
+		`'"gateway_listener":"$nkg_listener",'`:                                 1,
+		"log_format nkg_text":                                                   1,
+		"access_log syslog:server=syslog.logging.svc:514,tag=gateway nkg_text;": 1,
+	}
+
+	logs := string(executeAccessLogs(conf))
+	for expSubStr, expCount := range expSubStrings {
+		if expCount != strings.Count(logs, expSubStr) {
+			t.Errorf(
+				"executeAccessLogs() did not generate access logs with substring %q %d times. Access logs: %v",
+				expSubStr,
+				expCount,
+				logs,
+			)
+		}
+	}
+
+	g := NewGomegaWithT(t)
+	g.Expect(strings.TrimSpace(string(executeAccessLogs(dataplane.Configuration{})))).To(BeEmpty())
+
+	// The access logs of a MatchRule need the log formats, but not the access_log directive of the http context.
+	routeConf := dataplane.Configuration{
+		SSLServers: []dataplane.VirtualServer{
+			{
+				PathRules: []dataplane.PathRule{
+					{
+						MatchRules: []dataplane.MatchRule{
+							{AccessLog: &dataplane.AccessLog{Format: dataplane.AccessLogFormatJSON}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	logs = string(executeAccessLogs(routeConf))
+	g.Expect(logs).To(ContainSubstring("log_format nkg_json"))
+	g.Expect(logs).ToNot(ContainSubstring("access_log"))
+
+	logs = string(executeAccessLogs(dataplane.Configuration{AccessLog: &dataplane.AccessLog{Disabled: true}}))
+	g.Expect(logs).To(ContainSubstring("access_log off;"))
+}
+
+func TestCreateAccessLog(t *testing.T) {
+	tests := []struct {
+		accessLog *dataplane.AccessLog
+		expected  *http.AccessLog
+		msg       string
+	}{
+		{
+			accessLog: nil,
+			expected:  nil,
+			msg:       "no access log",
+		},
+		{
+			accessLog: &dataplane.AccessLog{
+				Format:   dataplane.AccessLogFormatJSON,
+				Disabled: true,
+			},
+			expected: &http.AccessLog{Off: true},
+			msg:      "disabled",
+		},
+		{
+			accessLog: &dataplane.AccessLog{
+				Format: dataplane.AccessLogFormatJSON,
+			},
+			expected: &http.AccessLog{
+				Path:   "/dev/stdout",
+				Format: "nkg_json",
+			},
+			msg: "stdout",
+		},
+		{
+			accessLog: &dataplane.AccessLog{
+				Syslog: &dataplane.SyslogDestination{
+					Server: "10.0.0.1:514",
+				},
+				Format: dataplane.AccessLogFormatText,
+			},
+			expected: &http.AccessLog{
+				Path:   "syslog:server=10.0.0.1:514",
+				Format: "nkg_text",
+			},
+			msg: "syslog without tag",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(createAccessLog(test.accessLog)).To(Equal(test.expected))
+		})
+	}
+
+	g := NewGomegaWithT(t)
+	g.Expect(func() { createAccessLogFormat("unsupported") }).To(Panic())
+}
+
+func TestCreateAccessLogVariables(t *testing.T) {
+	rule := dataplane.MatchRule{
+		Source: &v1beta1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "hr",
+			},
+		},
+		Listener: "listener-80",
+		RuleIdx:  2,
+	}
+
+	expected := []http.Variable{
+		{Name: "nkg_listener", Value: "listener-80"},
+		{Name: "nkg_route_namespace", Value: "test"},
+		{Name: "nkg_route_name", Value: "hr"},
+		{Name: "nkg_route_rule", Value: "2"},
+	}
+
+	g := NewGomegaWithT(t)
+	g.Expect(createAccessLogVariables(rule)).To(Equal(expected))
+}
@@ -0,0 +1,176 @@
+package config
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+const (
+	// jwtValidationPathPrefix is the prefix of the paths of the internal locations that validate
+	// the JSON Web Tokens.
+	jwtValidationPathPrefix = "/_nkg_jwt_"
+
+	// The variables that configure the validation of the JSON Web Tokens in the jwt njs module.
+	jwtJWKSFileVariable       = "jwt_jwks_file"
+	jwtRealmVariable          = "jwt_realm"
+	jwtRequiredClaimsVariable = "jwt_required_claims"
+
+	// externalAuthPathPrefix is the prefix of the paths of the internal locations that proxy the subrequests to
+	// the external authorization services.
+	externalAuthPathPrefix = "/_nkg_ext_auth_"
+	// externalAuthVariablePrefix is the prefix of the variables that hold the headers of the responses of
+	// the external authorization services.
+	externalAuthVariablePrefix = "ext_auth_"
+	// wwwAuthenticateHeader is the header of the responses of the external authorization services that NGINX
+	// returns to the clients.
+	wwwAuthenticateHeader = "WWW-Authenticate"
+)
+
+// createAuthBasic returns the configuration of HTTP basic authentication or nil if the authentication is not
+// basic authentication.
+func createAuthBasic(auth *dataplane.Authentication) *http.AuthBasic {
+	if auth == nil || auth.JWT != nil || auth.External != nil {
+		return nil
+	}
+
+	return &http.AuthBasic{
+		Realm:    auth.Realm,
+		UserFile: generateAuthFileName(auth.FileID),
+	}
+}
+
+// createAuthJWT returns the URI of the internal location that validates the JSON Web Tokens or an empty string
+// if the authentication doesn't validate JSON Web Tokens.
+func createAuthJWT(auth *dataplane.Authentication) string {
+	if auth == nil || auth.JWT == nil {
+		return ""
+	}
+
+	return generateJWTValidationPath(auth.FileID)
+}
+
+// createJWTValidationLocation creates the internal location that validates the JSON Web Tokens of
+// the auth_request subrequests of the authentication.
+func createJWTValidationLocation(auth dataplane.Authentication) http.Location {
+	variables := []http.Variable{
+		{Name: jwtJWKSFileVariable, Value: generateAuthFileName(auth.FileID)},
+		{Name: jwtRealmVariable, Value: auth.Realm},
+	}
+
+	if len(auth.JWT.RequiredClaims) > 0 {
+		variables = append(variables, http.Variable{
+			Name:  jwtRequiredClaimsVariable,
+			Value: encodeRequiredClaims(auth.JWT.RequiredClaims),
+		})
+	}
+
+	return http.Location{
+		Path:          exactPath(generateJWTValidationPath(auth.FileID)),
+		Internal:      true,
+		JWTValidation: true,
+		Variables:     variables,
+	}
+}
+
+// encodeRequiredClaims encodes the claims as a base64-encoded JSON object, so that the names and the values
+// of the claims can include any characters without escaping them in the NGINX configuration.
+func encodeRequiredClaims(claims map[string]string) string {
+	b, err := json.Marshal(claims)
+	if err != nil {
+		// panic is safe here because a map of strings is always marshaled successfully.
+		panic(fmt.Errorf("could not marshal required claims: %w", err))
+	}
+
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func generateJWTValidationPath(id dataplane.AuthFileID) string {
+	return jwtValidationPathPrefix + string(id)
+}
+
+// createAuthExternal returns the configuration of the external authorization of the requests of the rule or nil
+// if the rule doesn't use external authorization.
+func createAuthExternal(rule dataplane.MatchRule) *http.AuthExternal {
+	auth := rule.Filters.Authentication
+	if auth == nil || auth.External == nil {
+		return nil
+	}
+
+	variables := make([]http.Variable, 0, len(auth.External.ResponseHeaders)+1)
+	variables = append(variables, createExternalAuthVariable(wwwAuthenticateHeader))
+
+	for _, name := range auth.External.ResponseHeaders {
+		variables = append(variables, createExternalAuthVariable(name))
+	}
+
+	return &http.AuthExternal{
+		URI:       generateExternalAuthPath(rule.Source, rule.RuleIdx),
+		Variables: variables,
+	}
+}
+
+// createExternalAuthProxySetHeaders returns the headers that add the headers of the response of the external
+// authorization service to the requests to the backends. If the response doesn't include a header, the value
+// is empty, so NGINX doesn't pass the header of the client to the backends.
+func createExternalAuthProxySetHeaders(auth *dataplane.Authentication) []http.Header {
+	if auth == nil || auth.External == nil {
+		return nil
+	}
+
+	headers := make([]http.Header, 0, len(auth.External.ResponseHeaders))
+
+	for _, name := range auth.External.ResponseHeaders {
+		headers = append(headers, http.Header{
+			Name:  name,
+			Value: "$" + generateExternalAuthVariableName(name),
+		})
+	}
+
+	return headers
+}
+
+// createExternalAuthLocation creates the internal location that proxies the auth_request subrequests of a rule
+// to the external authorization service.
+func createExternalAuthLocation(path string, external dataplane.ExternalAuthentication) http.Location {
+	headers := make([]http.Header, 0, len(external.RequestHeaders))
+
+	for _, name := range external.RequestHeaders {
+		headers = append(headers, http.Header{
+			Name:  name,
+			Value: "$http_" + strings.ToLower(convertStringToSafeVariableName(name)),
+		})
+	}
+
+	return http.Location{
+		Path:     exactPath(path),
+		Internal: true,
+		ExternalAuthSubrequest: &http.ExternalAuthSubrequest{
+			ProxyPass: "http://" + external.UpstreamName + external.Path,
+			Headers:   headers,
+		},
+	}
+}
+
+func createExternalAuthVariable(headerName string) http.Variable {
+	return http.Variable{
+		Name:  generateExternalAuthVariableName(headerName),
+		Value: "$upstream_http_" + strings.ToLower(convertStringToSafeVariableName(headerName)),
+	}
+}
+
+func generateExternalAuthVariableName(headerName string) string {
+	return externalAuthVariablePrefix + strings.ToLower(convertStringToSafeVariableName(headerName))
+}
+
+// generateExternalAuthPath generates the path of the internal location for the external authorization of
+// the rule of the HTTPRoute. The path is unique for every rule.
+func generateExternalAuthPath(route *v1beta1.HTTPRoute, ruleIdx int) string {
+	return fmt.Sprintf("%s%s_%s_%d", externalAuthPathPrefix, route.Namespace, route.Name, ruleIdx)
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"encoding/base64"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+func TestCreateAuthBasicAndJWT(t *testing.T) {
+	basic := &dataplane.Authentication{
+		Realm:  "Restricted",
+		FileID: "auth_test_basic",
+	}
+	jwt := &dataplane.Authentication{
+		JWT:    &dataplane.JWTAuthentication{},
+		Realm:  "Restricted",
+		FileID: "auth_test_jwt",
+	}
+
+	tests := []struct {
+		auth         *dataplane.Authentication
+		expAuthBasic *http.AuthBasic
+		msg          string
+		expAuthJWT   string
+	}{
+		{
+			auth: nil,
+			msg:  "no authentication",
+		},
+		{
+			auth: basic,
+			expAuthBasic: &http.AuthBasic{
+				Realm:    "Restricted",
+				UserFile: "/etc/nginx/secrets/auth_test_basic",
+			},
+			msg: "basic authentication",
+		},
+		{
+			auth:       jwt,
+			expAuthJWT: "/_nkg_jwt_auth_test_jwt",
+			msg:        "JWT validation",
+		},
+		{
+			auth: &dataplane.Authentication{
+				External: &dataplane.ExternalAuthentication{},
+			},
+			msg: "external authorization",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(createAuthBasic(test.auth)).To(Equal(test.expAuthBasic))
+			g.Expect(createAuthJWT(test.auth)).To(Equal(test.expAuthJWT))
+		})
+	}
+}
+
+func TestEncodeRequiredClaims(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	encoded := encodeRequiredClaims(map[string]string{"iss": `"issuer" $var`, "aud": "api"})
+
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(decoded)).To(Equal(`{"aud":"api","iss":"\"issuer\" $var"}`))
+}
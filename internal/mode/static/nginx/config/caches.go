@@ -0,0 +1,107 @@
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	gotemplate "text/template"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+const (
+	// cacheFolder is the folder with the directories of the cache zones.
+	cacheFolder = "/var/cache/nginx"
+	// defaultCacheKey is the key of the cached responses if the Cache doesn't set the key.
+	// Unlike the NGINX default $scheme$proxy_host$request_uri, it includes the host of the request rather than
+	// the upstream, because the responses of the same upstream can depend on the host.
+	defaultCacheKey = "$scheme$host$request_uri"
+)
+
+var cacheZonesTemplate = gotemplate.Must(gotemplate.New("cacheZones").Parse(cacheZonesTemplateText))
+
+func executeCacheZones(conf dataplane.Configuration) []byte {
+	zones := createCacheZones(conf.CacheZones)
+
+	return execute(cacheZonesTemplate, zones)
+}
+
+func createCacheZones(cacheZones []dataplane.CacheZone) []http.CacheZone {
+	if len(cacheZones) == 0 {
+		return nil
+	}
+
+	zones := make([]http.CacheZone, 0, len(cacheZones))
+
+	for _, z := range cacheZones {
+		zones = append(zones, http.CacheZone{
+			Name:     z.Name,
+			Path:     filepath.Join(cacheFolder, z.Name),
+			KeysSize: z.KeysSize,
+			MaxSize:  z.MaxSize,
+			Inactive: z.Inactive,
+		})
+	}
+
+	return zones
+}
+
+func createLocationCache(cache *dataplane.Cache) *http.LocationCache {
+	if cache == nil {
+		return nil
+	}
+
+	result := &http.LocationCache{
+		Zone:             cache.Zone,
+		Key:              cache.Key,
+		BackgroundUpdate: cache.StaleWhileRevalidate,
+	}
+
+	if result.Key == "" {
+		result.Key = defaultCacheKey
+	}
+
+	for _, v := range cache.Valid {
+		params := make([]string, 0, len(v.Codes)+1)
+
+		for _, code := range v.Codes {
+			params = append(params, strconv.Itoa(int(code)))
+		}
+
+		result.Valid = append(result.Valid, strings.Join(append(params, v.Duration), " "))
+	}
+
+	bypass := make([]string, 0, len(cache.Bypass))
+	for _, b := range cache.Bypass {
+		bypass = append(bypass, createCacheBypassVariable(b))
+	}
+	result.Bypass = strings.Join(bypass, " ")
+
+	var useStale []string
+	if cache.StaleIfError {
+		useStale = append(useStale, "error", "timeout", "http_500", "http_502", "http_503", "http_504")
+	}
+	if cache.StaleWhileRevalidate {
+		useStale = append(useStale, "updating")
+	}
+	result.UseStale = strings.Join(useStale, " ")
+
+	return result
+}
+
+func createCacheBypassVariable(bypass dataplane.CacheBypass) string {
+	name := strings.ReplaceAll(strings.ToLower(bypass.Name), "-", "_")
+
+	switch bypass.Type {
+	case dataplane.CacheBypassTypeHeader:
+		return "$http_" + name
+	case dataplane.CacheBypassTypeCookie:
+		return "$cookie_" + name
+	case dataplane.CacheBypassTypeQueryParam:
+		return "$arg_" + name
+	default:
+		panic(fmt.Sprintf("unsupported cache bypass type: %s", bypass.Type))
+	}
+}
@@ -0,0 +1,140 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteCacheZones(t *testing.T) {
+	conf := dataplane.Configuration{
+		CacheZones: []dataplane.CacheZone{
+			{
+				Name:     "cache_api",
+				KeysSize: "10m",
+				MaxSize:  "1g",
+				Inactive: "1h",
+			},
+			{
+				Name:     "cache_static",
+				KeysSize: "1m",
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"proxy_cache_path /var/cache/nginx/cache_api levels=1:2 keys_zone=cache_api:10m max_size=1g inactive=1h;": 1,
+		"proxy_cache_path /var/cache/nginx/cache_static levels=1:2 keys_zone=cache_static:1m;":                    1,
+	}
+
+	zones := string(executeCacheZones(conf))
+	for expSubStr, expCount := range expSubStrings {
+		if expCount != strings.Count(zones, expSubStr) {
+			t.Errorf(
+				"executeCacheZones() did not generate zones with substring %q %d times. Zones: %v",
+				expSubStr,
+				expCount,
+				zones,
+			)
+		}
+	}
+
+	g := NewGomegaWithT(t)
+	g.Expect(strings.TrimSpace(string(executeCacheZones(dataplane.Configuration{})))).To(BeEmpty())
+}
+
+func TestCreateLocationCache(t *testing.T) {
+	tests := []struct {
+		cache    *dataplane.Cache
+		expected *http.LocationCache
+		msg      string
+	}{
+		{
+			cache:    nil,
+			expected: nil,
+			msg:      "no cache",
+		},
+		{
+			cache: &dataplane.Cache{
+				Zone: "cache_api",
+			},
+			expected: &http.LocationCache{
+				Zone: "cache_api",
+				Key:  "$scheme$host$request_uri",
+			},
+			msg: "default key",
+		},
+		{
+			cache: &dataplane.Cache{
+				Zone: "cache_api",
+				Key:  "$host$uri$arg_page",
+				Valid: []dataplane.CacheValid{
+					{Codes: []int32{200, 301}, Duration: "10m"},
+					{Duration: "1m"},
+				},
+				Bypass: []dataplane.CacheBypass{
+					{Type: dataplane.CacheBypassTypeHeader, Name: "X-No-Cache"},
+					{Type: dataplane.CacheBypassTypeCookie, Name: "nocache"},
+				},
+				StaleWhileRevalidate: true,
+				StaleIfError:         true,
+			},
+			expected: &http.LocationCache{
+				Zone:             "cache_api",
+				Key:              "$host$uri$arg_page",
+				Valid:            []string{"200 301 10m", "1m"},
+				Bypass:           "$http_x_no_cache $cookie_nocache",
+				UseStale:         "error timeout http_500 http_502 http_503 http_504 updating",
+				BackgroundUpdate: true,
+			},
+			msg: "all settings",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(createLocationCache(test.cache)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestCreateCacheBypassVariable(t *testing.T) {
+	tests := []struct {
+		msg      string
+		expected string
+		bypass   dataplane.CacheBypass
+	}{
+		{
+			msg:      "header",
+			bypass:   dataplane.CacheBypass{Type: dataplane.CacheBypassTypeHeader, Name: "X-No-Cache"},
+			expected: "$http_x_no_cache",
+		},
+		{
+			msg:      "cookie",
+			bypass:   dataplane.CacheBypass{Type: dataplane.CacheBypassTypeCookie, Name: "NoCache"},
+			expected: "$cookie_nocache",
+		},
+		{
+			msg:      "query param",
+			bypass:   dataplane.CacheBypass{Type: dataplane.CacheBypassTypeQueryParam, Name: "no_cache"},
+			expected: "$arg_no_cache",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(createCacheBypassVariable(test.bypass)).To(Equal(test.expected))
+		})
+	}
+
+	g := NewGomegaWithT(t)
+	g.Expect(func() {
+		createCacheBypassVariable(dataplane.CacheBypass{Type: "unknown"})
+	}).To(Panic())
+}
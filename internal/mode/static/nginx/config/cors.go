@@ -0,0 +1,215 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+const (
+	// corsPreflightVariable is 1 for the CORS preflight requests and 0 for any other requests.
+	corsPreflightVariable = "$cors_preflight"
+	// corsOriginVariablePrefix is the prefix of the variables that hold the Origin header of a request if
+	// the origin is allowed or an empty string otherwise.
+	corsOriginVariablePrefix = "$cors_origin_"
+
+	corsAll           = "*"
+	corsWildcardLabel = "*."
+)
+
+// createCORS returns the CORS configuration of the location of the rule or nil if the rule doesn't configure CORS.
+func createCORS(rule dataplane.MatchRule) *http.CORS {
+	cors := rule.CORS
+	if cors == nil {
+		return nil
+	}
+
+	origin := corsAll
+	if !allowsAllOrigins(cors) {
+		origin = generateCORSOriginVariable(rule)
+	}
+
+	preflightHeaders := []http.Header{
+		{Name: "Access-Control-Allow-Origin", Value: origin},
+	}
+	headers := []http.Header{
+		{Name: "Access-Control-Allow-Origin", Value: origin},
+	}
+
+	if cors.AllowCredentials {
+		credentials := http.Header{Name: "Access-Control-Allow-Credentials", Value: "true"}
+		preflightHeaders = append(preflightHeaders, credentials)
+		headers = append(headers, credentials)
+	}
+
+	if len(cors.AllowMethods) > 0 {
+		// * is not a wildcard for the requests with credentials, so NGINX allows the requested method instead.
+		preflightHeaders = append(preflightHeaders, http.Header{
+			Name:  "Access-Control-Allow-Methods",
+			Value: joinCORSValues(cors.AllowMethods, "$http_access_control_request_method"),
+		})
+	}
+
+	if len(cors.AllowHeaders) > 0 {
+		preflightHeaders = append(preflightHeaders, http.Header{
+			Name:  "Access-Control-Allow-Headers",
+			Value: joinCORSValues(cors.AllowHeaders, "$http_access_control_request_headers"),
+		})
+	}
+
+	if cors.MaxAge != nil {
+		preflightHeaders = append(preflightHeaders, http.Header{
+			Name:  "Access-Control-Max-Age",
+			Value: strconv.Itoa(int(*cors.MaxAge)),
+		})
+	}
+
+	if len(cors.ExposeHeaders) > 0 {
+		headers = append(headers, http.Header{
+			Name:  "Access-Control-Expose-Headers",
+			Value: strings.Join(cors.ExposeHeaders, ", "),
+		})
+	}
+
+	if origin != corsAll {
+		// The Access-Control-Allow-Origin header depends on the Origin header of the request.
+		vary := http.Header{Name: "Vary", Value: "Origin"}
+		preflightHeaders = append(preflightHeaders, vary)
+		headers = append(headers, vary)
+	}
+
+	return &http.CORS{
+		PreflightHeaders: preflightHeaders,
+		Headers:          headers,
+	}
+}
+
+// joinCORSValues joins the values for a CORS header or returns the variable with the values of the request
+// if the values include *.
+func joinCORSValues(values []string, requestVariable string) string {
+	for _, v := range values {
+		if v == corsAll {
+			return requestVariable
+		}
+	}
+
+	return strings.Join(values, ", ")
+}
+
+func allowsAllOrigins(cors *dataplane.CORS) bool {
+	for _, origin := range cors.AllowOrigins {
+		if origin == corsAll {
+			return true
+		}
+	}
+
+	return false
+}
+
+// buildCORSMaps builds the map that detects the CORS preflight requests and, for every rule that doesn't allow
+// all origins, the map that checks whether the origin of a request is allowed.
+func buildCORSMaps(servers []dataplane.VirtualServer) []http.Map {
+	var maps []http.Map
+	corsExists := false
+	originVariables := make(map[string]struct{})
+
+	for _, s := range servers {
+		for _, pr := range s.PathRules {
+			for _, mr := range pr.MatchRules {
+				if mr.CORS == nil {
+					continue
+				}
+
+				corsExists = true
+
+				if allowsAllOrigins(mr.CORS) {
+					continue
+				}
+
+				variable := generateCORSOriginVariable(mr)
+				if _, exists := originVariables[variable]; exists {
+					continue
+				}
+
+				originVariables[variable] = struct{}{}
+				maps = append(maps, createCORSOriginMap(variable, mr.CORS.AllowOrigins))
+			}
+		}
+	}
+
+	if !corsExists {
+		return nil
+	}
+
+	return append(maps, createCORSPreflightMap())
+}
+
+// createCORSPreflightMap creates the map that detects the CORS preflight requests: the OPTIONS requests with
+// the Access-Control-Request-Method and Origin headers.
+func createCORSPreflightMap() http.Map {
+	return http.Map{
+		Source:   "$request_method:$http_access_control_request_method:$http_origin",
+		Variable: corsPreflightVariable,
+		Parameters: []http.MapParameter{
+			{
+				Value:  "default",
+				Result: "0",
+			},
+			{
+				Value:  `"~^OPTIONS:[^:]+:.+$"`,
+				Result: "1",
+			},
+		},
+	}
+}
+
+// createCORSOriginMap creates the map that sets the variable to the Origin header of a request if the origin is
+// allowed or to an empty string otherwise, so that NGINX doesn't add the Access-Control-Allow-Origin header.
+func createCORSOriginMap(variable string, origins []string) http.Map {
+	params := make([]http.MapParameter, 0, len(origins)+1)
+	params = append(params, http.MapParameter{
+		Value:  "default",
+		Result: "''",
+	})
+
+	for _, origin := range origins {
+		params = append(params, http.MapParameter{
+			Value:  createCORSOriginMapValue(origin),
+			Result: "$http_origin",
+		})
+	}
+
+	return http.Map{
+		Source:     "$http_origin",
+		Variable:   variable,
+		Parameters: params,
+	}
+}
+
+// createCORSOriginMapValue returns the value of the map parameter that matches the origin.
+// An origin with a wildcard label becomes a regular expression that matches one or more labels.
+// Validation ensures that '.' is the only character of an origin that is special in regular expressions,
+// besides the leading '*' of the wildcard label.
+func createCORSOriginMapValue(origin string) string {
+	schemeEnd := strings.Index(origin, "://") + len("://")
+
+	if !strings.HasPrefix(origin[schemeEnd:], corsWildcardLabel) {
+		return fmt.Sprintf("%q", origin)
+	}
+
+	scheme := origin[:schemeEnd]
+	domain := strings.ReplaceAll(origin[schemeEnd+len(corsWildcardLabel):], ".", `\.`)
+
+	return fmt.Sprintf(`"~^%s[A-Za-z0-9-]+(\.[A-Za-z0-9-]+)*\.%s$"`, scheme, domain)
+}
+
+// generateCORSOriginVariable generates the variable that holds the allowed origin of a request for the rule of
+// the HTTPRoute. The variable is unique for every rule.
+func generateCORSOriginVariable(rule dataplane.MatchRule) string {
+	return corsOriginVariablePrefix + convertStringToSafeVariableName(
+		fmt.Sprintf("%s__%s_rule%d", rule.Source.Namespace, rule.Source.Name, rule.RuleIdx),
+	)
+}
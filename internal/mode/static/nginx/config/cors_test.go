@@ -0,0 +1,159 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+func TestCreateCORS(t *testing.T) {
+	route := &v1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "route-1",
+		},
+	}
+
+	tests := []struct {
+		cors     *dataplane.CORS
+		expected *http.CORS
+		name     string
+	}{
+		{
+			cors:     nil,
+			expected: nil,
+			name:     "no CORS",
+		},
+		{
+			cors: &dataplane.CORS{
+				AllowOrigins:     []string{"https://example.com", "https://*.example.com"},
+				AllowMethods:     []string{"GET", "PUT"},
+				AllowHeaders:     []string{"Content-Type", "X-Request-ID"},
+				ExposeHeaders:    []string{"X-Request-ID", "X-Version"},
+				AllowCredentials: true,
+				MaxAge:           helpers.GetPointer[int32](600),
+			},
+			expected: &http.CORS{
+				PreflightHeaders: []http.Header{
+					{Name: "Access-Control-Allow-Origin", Value: "$cors_origin_test__route_1_rule1"},
+					{Name: "Access-Control-Allow-Credentials", Value: "true"},
+					{Name: "Access-Control-Allow-Methods", Value: "GET, PUT"},
+					{Name: "Access-Control-Allow-Headers", Value: "Content-Type, X-Request-ID"},
+					{Name: "Access-Control-Max-Age", Value: "600"},
+					{Name: "Vary", Value: "Origin"},
+				},
+				Headers: []http.Header{
+					{Name: "Access-Control-Allow-Origin", Value: "$cors_origin_test__route_1_rule1"},
+					{Name: "Access-Control-Allow-Credentials", Value: "true"},
+					{Name: "Access-Control-Expose-Headers", Value: "X-Request-ID, X-Version"},
+					{Name: "Vary", Value: "Origin"},
+				},
+			},
+			name: "specific origins",
+		},
+		{
+			cors: &dataplane.CORS{
+				AllowOrigins: []string{"*"},
+				AllowMethods: []string{"GET", "*"},
+				AllowHeaders: []string{"*"},
+			},
+			expected: &http.CORS{
+				PreflightHeaders: []http.Header{
+					{Name: "Access-Control-Allow-Origin", Value: "*"},
+					{Name: "Access-Control-Allow-Methods", Value: "$http_access_control_request_method"},
+					{Name: "Access-Control-Allow-Headers", Value: "$http_access_control_request_headers"},
+				},
+				Headers: []http.Header{
+					{Name: "Access-Control-Allow-Origin", Value: "*"},
+				},
+			},
+			name: "any origin, method and header",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			rule := dataplane.MatchRule{
+				Source:  route,
+				RuleIdx: 1,
+				CORS:    test.cors,
+			}
+
+			g.Expect(createCORS(rule)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestBuildCORSMaps(t *testing.T) {
+	g := NewWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "route1",
+		},
+	}
+
+	cors := &dataplane.CORS{
+		AllowOrigins: []string{"https://example.com", "https://*.example.com:8443"},
+	}
+
+	servers := []dataplane.VirtualServer{
+		{
+			PathRules: []dataplane.PathRule{
+				{
+					MatchRules: []dataplane.MatchRule{
+						// two matches of the same rule share the map
+						{Source: route, CORS: cors},
+						{Source: route, MatchIdx: 1, CORS: cors},
+						{Source: route, RuleIdx: 1, CORS: &dataplane.CORS{AllowOrigins: []string{"*"}}},
+						{Source: route, RuleIdx: 2},
+					},
+				},
+			},
+		},
+	}
+
+	expected := []http.Map{
+		{
+			Source:   "$http_origin",
+			Variable: "$cors_origin_test__route1_rule0",
+			Parameters: []http.MapParameter{
+				{Value: "default", Result: "''"},
+				{Value: `"https://example.com"`, Result: "$http_origin"},
+				{
+					Value:  `"~^https://[A-Za-z0-9-]+(\.[A-Za-z0-9-]+)*\.example\.com:8443$"`,
+					Result: "$http_origin",
+				},
+			},
+		},
+		{
+			Source:   "$request_method:$http_access_control_request_method:$http_origin",
+			Variable: "$cors_preflight",
+			Parameters: []http.MapParameter{
+				{Value: "default", Result: "0"},
+				{Value: `"~^OPTIONS:[^:]+:.+$"`, Result: "1"},
+			},
+		},
+	}
+
+	g.Expect(buildCORSMaps(servers)).To(Equal(expected))
+
+	maps := string(executeMaps(dataplane.Configuration{HTTPServers: servers}))
+	g.Expect(strings.Count(maps, "map $http_origin $cors_origin_test__route1_rule0 {")).To(Equal(1))
+	g.Expect(maps).To(ContainSubstring(
+		"map $request_method:$http_access_control_request_method:$http_origin $cors_preflight {",
+	))
+
+	servers[0].PathRules[0].MatchRules = []dataplane.MatchRule{{Source: route}}
+	g.Expect(buildCORSMaps(servers)).To(BeNil())
+}
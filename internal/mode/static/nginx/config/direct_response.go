@@ -0,0 +1,36 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+// directResponseBodyEscaper escapes the body of a direct response for a quoted string in the NGINX config.
+// The validation ensures that the body doesn't contain any '$'.
+var directResponseBodyEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// createReturnValForDirectResponse creates the return directive of a direct response.
+func createReturnValForDirectResponse(dr dataplane.DirectResponse) *http.Return {
+	return &http.Return{
+		Code: http.StatusCode(dr.StatusCode),
+		Body: directResponseBodyEscaper.Replace(dr.Body),
+	}
+}
+
+// createDirectResponse creates the content type and the headers of a direct response.
+func createDirectResponse(dr dataplane.DirectResponse) *http.DirectResponse {
+	result := &http.DirectResponse{
+		ContentType: dr.ContentType,
+	}
+
+	if len(dr.Headers) > 0 {
+		result.Headers = make([]http.Header, 0, len(dr.Headers))
+		for _, h := range dr.Headers {
+			result.Headers = append(result.Headers, http.Header{Name: h.Name, Value: h.Value})
+		}
+	}
+
+	return result
+}
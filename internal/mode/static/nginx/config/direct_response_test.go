@@ -0,0 +1,73 @@
+package config
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+func TestCreateReturnValForDirectResponse(t *testing.T) {
+	tests := []struct {
+		expected *http.Return
+		name     string
+		dr       dataplane.DirectResponse
+	}{
+		{
+			dr:       dataplane.DirectResponse{StatusCode: 200},
+			expected: &http.Return{Code: 200},
+			name:     "no body",
+		},
+		{
+			dr: dataplane.DirectResponse{
+				Body:       "<p>Under maintenance</p>\n",
+				StatusCode: 503,
+			},
+			expected: &http.Return{Code: 503, Body: "<p>Under maintenance</p>\n"},
+			name:     "body without special characters",
+		},
+		{
+			dr: dataplane.DirectResponse{
+				Body:       `{"path": "C:\\data"}`,
+				StatusCode: 200,
+			},
+			expected: &http.Return{Code: 200, Body: `{\"path\": \"C:\\\\data\"}`},
+			name:     "body with double quotes and backslashes",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(createReturnValForDirectResponse(test.dr)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestCreateDirectResponse(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(createDirectResponse(dataplane.DirectResponse{ContentType: "text/plain"})).
+		To(Equal(&http.DirectResponse{ContentType: "text/plain"}))
+
+	dr := dataplane.DirectResponse{
+		ContentType: "text/html",
+		Headers: []dataplane.HTTPHeader{
+			{Name: "Cache-Control", Value: "no-store"},
+			{Name: "Retry-After", Value: "120"},
+		},
+	}
+
+	expected := &http.DirectResponse{
+		ContentType: "text/html",
+		Headers: []http.Header{
+			{Name: "Cache-Control", Value: "no-store"},
+			{Name: "Retry-After", Value: "120"},
+		},
+	}
+
+	g.Expect(createDirectResponse(dr)).To(Equal(expected))
+}
@@ -0,0 +1,89 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+const (
+	// errorPagePathPrefix is the prefix of the paths of the internal locations with the bodies of the responses of
+	// the error pages.
+	errorPagePathPrefix = "/_nkg-error-page/"
+	// defaultErrorPageContentType is the content type of the responses of the error pages that don't set it.
+	defaultErrorPageContentType = "text/html"
+)
+
+// createErrorPages creates the error_page directives for the error pages.
+func createErrorPages(pages []dataplane.ErrorPage) []http.ErrorPage {
+	if len(pages) == 0 {
+		return nil
+	}
+
+	result := make([]http.ErrorPage, 0, len(pages))
+
+	for _, page := range pages {
+		codes := make([]string, 0, len(page.Codes))
+		for _, code := range page.Codes {
+			codes = append(codes, strconv.Itoa(int(code)))
+		}
+
+		errorPage := http.ErrorPage{
+			Codes: strings.Join(codes, " "),
+		}
+
+		var statusCode int32
+
+		switch {
+		case page.Response != nil:
+			errorPage.URI = generateErrorPagePath(page.Response.FileID)
+			statusCode = page.Response.StatusCode
+		case page.Redirect != nil:
+			errorPage.URI = page.Redirect.URL
+			statusCode = page.Redirect.StatusCode
+		}
+
+		if statusCode != 0 {
+			errorPage.Response = fmt.Sprintf("=%d", statusCode)
+		}
+
+		result = append(result, errorPage)
+	}
+
+	return result
+}
+
+// createErrorPageLocations creates the internal locations that respond with the bodies of the error pages.
+// The redirects don't need locations.
+func createErrorPageLocations(pages []dataplane.ErrorPage) []http.Location {
+	var locs []http.Location
+
+	for _, page := range pages {
+		if page.Response == nil {
+			continue
+		}
+
+		contentType := page.Response.ContentType
+		if contentType == "" {
+			contentType = defaultErrorPageContentType
+		}
+
+		locs = append(locs, http.Location{
+			Path:     "= " + generateErrorPagePath(page.Response.FileID),
+			Internal: true,
+			ErrorPageResponse: &http.ErrorPageResponse{
+				ContentType: contentType,
+				File:        generateErrorPageFileName(page.Response.FileID),
+			},
+		})
+	}
+
+	return locs
+}
+
+func generateErrorPagePath(id dataplane.ErrorPageFileID) string {
+	return errorPagePathPrefix + string(id)
+}
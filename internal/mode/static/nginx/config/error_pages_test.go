@@ -0,0 +1,81 @@
+package config
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+func TestCreateErrorPages(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(createErrorPages(nil)).To(BeNil())
+
+	pages := []dataplane.ErrorPage{
+		{
+			Codes: []int32{500, 502},
+			Response: &dataplane.ErrorPageResponse{
+				FileID: "error_page_test_epp_0",
+			},
+		},
+		{
+			Codes: []int32{404},
+			Response: &dataplane.ErrorPageResponse{
+				FileID:      "error_page_test_epp_1",
+				ContentType: "application/json",
+				StatusCode:  200,
+			},
+		},
+		{
+			Codes: []int32{503},
+			Redirect: &dataplane.ErrorPageRedirect{
+				URL:        "https://example.com/maintenance",
+				StatusCode: 301,
+			},
+		},
+	}
+
+	expected := []http.ErrorPage{
+		{
+			Codes: "500 502",
+			URI:   "/_nkg-error-page/error_page_test_epp_0",
+		},
+		{
+			Codes:    "404",
+			Response: "=200",
+			URI:      "/_nkg-error-page/error_page_test_epp_1",
+		},
+		{
+			Codes:    "503",
+			Response: "=301",
+			URI:      "https://example.com/maintenance",
+		},
+	}
+
+	g.Expect(createErrorPages(pages)).To(Equal(expected))
+
+	expectedLocs := []http.Location{
+		{
+			Path:     "= /_nkg-error-page/error_page_test_epp_0",
+			Internal: true,
+			ErrorPageResponse: &http.ErrorPageResponse{
+				ContentType: "text/html",
+				File:        "/etc/nginx/error-pages/error_page_test_epp_0",
+			},
+		},
+		{
+			Path:     "= /_nkg-error-page/error_page_test_epp_1",
+			Internal: true,
+			ErrorPageResponse: &http.ErrorPageResponse{
+				ContentType: "application/json",
+				File:        "/etc/nginx/error-pages/error_page_test_epp_1",
+			},
+		},
+	}
+
+	g.Expect(createErrorPageLocations(pages)).To(Equal(expectedLocs))
+	g.Expect(createErrorPageLocations(nil)).To(BeNil())
+}
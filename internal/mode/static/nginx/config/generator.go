@@ -15,15 +15,18 @@ const (
 
 	// httpFolder is the folder where NGINX HTTP configuration files are stored.
 	httpFolder = configFolder + "/conf.d"
-	// secretsFolder is the folder where secrets (like TLS certs/keys) are stored.
+	// secretsFolder is the folder where secrets (like TLS certs/keys and htpasswd files) are stored.
 	secretsFolder = configFolder + "/secrets"
 
+	// errorPagesFolder is the folder where the bodies of the responses of the error pages are stored.
+	errorPagesFolder = configFolder + "/error-pages"
+
 	// httpConfigFile is the path to the configuration file with HTTP configuration.
 	httpConfigFile = httpFolder + "/http.conf"
 )
 
 // ConfigFolders is a list of folders where NGINX configuration files are stored.
-var ConfigFolders = []string{httpFolder, secretsFolder}
+var ConfigFolders = []string{httpFolder, secretsFolder, errorPagesFolder}
 
 // Generator generates NGINX configuration files.
 // This interface is used for testing purposes only.
@@ -37,6 +40,7 @@ type Generator interface {
 // It generates files to be written to the following locations, which must exist and available for writing:
 // - httpFolder, for HTTP configuration files.
 // - secretsFolder, for secrets.
+// - errorPagesFolder, for the bodies of the responses of the error pages.
 //
 // It also expects that the main NGINX configuration file nginx.conf is located in configFolder and nginx.conf
 // includes (https://nginx.org/en/docs/ngx_core_module.html#include) the files from httpFolder.
@@ -55,12 +59,24 @@ type executeFunc func(configuration dataplane.Configuration) []byte
 // In case of invalid configuration, NGINX will fail to reload or could be configured with malicious configuration.
 // To validate, use the validators from the validation package.
 func (g GeneratorImpl) Generate(conf dataplane.Configuration) []file.File {
-	files := make([]file.File, 0, len(conf.SSLKeyPairs)+1 /* http config */)
+	files := make(
+		[]file.File,
+		0,
+		len(conf.SSLKeyPairs)+len(conf.AuthFiles)+len(conf.ErrorPageFiles)+1, /* http config */
+	)
 
 	for id, pair := range conf.SSLKeyPairs {
 		files = append(files, generatePEM(id, pair.Cert, pair.Key))
 	}
 
+	for id, content := range conf.AuthFiles {
+		files = append(files, generateAuthFile(id, content))
+	}
+
+	for id, content := range conf.ErrorPageFiles {
+		files = append(files, generateErrorPageFile(id, content))
+	}
+
 	files = append(files, generateHTTPConfig(conf))
 
 	return files
@@ -83,6 +99,30 @@ func generatePEMFileName(id dataplane.SSLKeyPairID) string {
 	return filepath.Join(secretsFolder, string(id)+".pem")
 }
 
+func generateAuthFile(id dataplane.AuthFileID, content []byte) file.File {
+	return file.File{
+		Content: content,
+		Path:    generateAuthFileName(id),
+		Type:    file.TypeSecret,
+	}
+}
+
+func generateAuthFileName(id dataplane.AuthFileID) string {
+	return filepath.Join(secretsFolder, string(id))
+}
+
+func generateErrorPageFile(id dataplane.ErrorPageFileID, content []byte) file.File {
+	return file.File{
+		Content: content,
+		Path:    generateErrorPageFileName(id),
+		Type:    file.TypeRegular,
+	}
+}
+
+func generateErrorPageFileName(id dataplane.ErrorPageFileID) string {
+	return filepath.Join(errorPagesFolder, string(id))
+}
+
 func generateHTTPConfig(conf dataplane.Configuration) file.File {
 	var c []byte
 	for _, execute := range getExecuteFuncs() {
@@ -99,8 +139,13 @@ func generateHTTPConfig(conf dataplane.Configuration) file.File {
 func getExecuteFuncs() []executeFunc {
 	return []executeFunc{
 		executeUpstreams,
+		executeRateLimitZones,
+		executeCacheZones,
 		executeSplitClients,
+		executeTracing,
+		executeAccessLogs,
 		executeServers,
 		executeMaps,
+		executeHTTPSnippet,
 	}
 }
@@ -58,6 +58,12 @@ func TestGenerate(t *testing.T) {
 				Key:  []byte("test-key"),
 			},
 		},
+		AuthFiles: map[dataplane.AuthFileID][]byte{
+			"auth_test_basic": []byte("user:hash"),
+		},
+		ErrorPageFiles: map[dataplane.ErrorPageFileID][]byte{
+			"error_page_test_epp_0": []byte("<p>error</p>"),
+		},
 	}
 	g := NewGomegaWithT(t)
 
@@ -65,7 +71,7 @@ func TestGenerate(t *testing.T) {
 
 	files := generator.Generate(conf)
 
-	g.Expect(files).To(HaveLen(2))
+	g.Expect(files).To(HaveLen(4))
 
 	g.Expect(files[0]).To(Equal(file.File{
 		Type:    file.TypeSecret,
@@ -73,9 +79,21 @@ func TestGenerate(t *testing.T) {
 		Content: []byte("test-cert\ntest-key"),
 	}))
 
-	g.Expect(files[1].Type).To(Equal(file.TypeRegular))
-	g.Expect(files[1].Path).To(Equal("/etc/nginx/conf.d/http.conf"))
-	httpCfg := string(files[1].Content) // converting to string so that on failure gomega prints strings not byte arrays
+	g.Expect(files[1]).To(Equal(file.File{
+		Type:    file.TypeSecret,
+		Path:    "/etc/nginx/secrets/auth_test_basic",
+		Content: []byte("user:hash"),
+	}))
+
+	g.Expect(files[2]).To(Equal(file.File{
+		Type:    file.TypeRegular,
+		Path:    "/etc/nginx/error-pages/error_page_test_epp_0",
+		Content: []byte("<p>error</p>"),
+	}))
+
+	g.Expect(files[3].Type).To(Equal(file.TypeRegular))
+	g.Expect(files[3].Path).To(Equal("/etc/nginx/conf.d/http.conf"))
+	httpCfg := string(files[3].Content) // converting to string so that on failure gomega prints strings not byte arrays
 	// Note: this only verifies that Generate() returns a byte array with upstream, server, and split_client blocks.
 	// It does not test the correctness of those blocks. That functionality is covered by other tests in this package.
 	g.Expect(httpCfg).To(ContainSubstring("listen 80"))
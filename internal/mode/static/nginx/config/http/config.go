@@ -2,22 +2,222 @@ package http
 
 // Server holds all configuration for an HTTP server.
 type Server struct {
-	SSL           *SSL
-	ServerName    string
-	Locations     []Location
-	IsDefaultHTTP bool
-	IsDefaultSSL  bool
-	Port          int32
+	SSL            *SSL
+	ClientSettings *ClientSettings
+	Gzip           *Gzip
+	ErrorPages     []ErrorPage
+	ServerName     string
+	Snippet        string
+	Locations      []Location
+	IsDefaultHTTP  bool
+	IsDefaultSSL   bool
+	Port           int32
 }
 
 // Location holds all configuration for an HTTP location.
 type Location struct {
-	Return          *Return
-	Path            string
-	ProxyPass       string
-	HTTPMatchVar    string
+	Return            *Return
+	ProxyNextUpstream *ProxyNextUpstream
+	RateLimit         *LocationRateLimit
+	ClientSettings    *ClientSettings
+	Path              string
+	ProxyPass         string
+	ProxyHTTPVersion  string
+	HTTPMatchVar      string
+	// AccessLog is the access_log directive of the location. If nil, the directive is omitted.
+	AccessLog *AccessLog
+	// OTelTrace is the value of the otel_trace directive. If empty, the directive is omitted.
+	OTelTrace       string
 	ProxySetHeaders []Header
-	Internal        bool
+	// AccessRules are the allow and deny directives of the location in the order of their evaluation.
+	AccessRules []AccessRule
+	// Cache configures the caching of the responses. If nil, the directives are omitted.
+	Cache *LocationCache
+	// Gzip configures the compression of the responses. If nil, the directives are omitted.
+	Gzip *Gzip
+	// ErrorPages are the error_page directives of the location.
+	ErrorPages []ErrorPage
+	// Snippet is the snippet of the location. If empty, it is omitted.
+	Snippet string
+	// DirectResponse configures the content type and the headers of the response of the Return directive.
+	// If nil, the directives are omitted.
+	DirectResponse *DirectResponse
+	// ErrorPageResponse configures the internal location that responds with the body of an error page.
+	// If nil, the directives are omitted.
+	ErrorPageResponse *ErrorPageResponse
+	// Variables are the variables that the location sets with the set directive.
+	Variables []Variable
+	// CORS configures Cross-Origin Resource Sharing. If nil, the directives are omitted.
+	CORS *CORS
+	// ProxyTimeouts configures the timeouts of proxying the requests. If nil, the directives are omitted.
+	ProxyTimeouts *ProxyTimeouts
+	// AuthBasic configures HTTP basic authentication. If nil, the directives are omitted.
+	AuthBasic *AuthBasic
+	// AuthJWT is the URI of the internal location that validates the JSON Web Token of a request with
+	// the auth_request directive. If empty, the directives are omitted.
+	AuthJWT string
+	// AuthExternal configures the authorization of the requests by an external authorization service with
+	// the auth_request directive. If nil, the directives are omitted.
+	AuthExternal *AuthExternal
+	// ExternalAuthSubrequest configures the proxying of the auth_request subrequests to an external authorization
+	// service. If nil, the directives are omitted.
+	ExternalAuthSubrequest *ExternalAuthSubrequest
+	Internal               bool
+	// JWTValidation indicates whether the location validates the JSON Web Tokens of the auth_request subrequests
+	// with the jwt njs module.
+	JWTValidation bool
+}
+
+// AccessDirective is an access control directive.
+type AccessDirective string
+
+const (
+	// AccessAllow is the allow directive.
+	AccessAllow AccessDirective = "allow"
+	// AccessDeny is the deny directive.
+	AccessDeny AccessDirective = "deny"
+)
+
+// AccessRule holds the configuration of an allow or a deny directive.
+type AccessRule struct {
+	// Directive is the directive of the rule.
+	Directive AccessDirective
+	// Address is the IP address, the CIDR block or all.
+	Address string
+}
+
+// CORS holds the configuration of Cross-Origin Resource Sharing of a location.
+type CORS struct {
+	// PreflightHeaders are the headers of the responses to the preflight requests, to which NGINX responds itself.
+	PreflightHeaders []Header
+	// Headers are the headers that NGINX adds to the responses to the other requests.
+	Headers []Header
+}
+
+// AuthExternal holds the configuration of the auth_request directives of a location for external authorization.
+type AuthExternal struct {
+	// URI is the URI of the internal location that proxies the subrequests to the authorization service.
+	URI string
+	// Variables are the variables that the location sets with the auth_request_set directive from the response
+	// of the authorization service. For example, {Name: ext_auth_x_user_id, Value: $upstream_http_x_user_id}.
+	Variables []Variable
+}
+
+// ExternalAuthSubrequest holds the configuration of the proxying of the auth_request subrequests to an external
+// authorization service.
+type ExternalAuthSubrequest struct {
+	// ProxyPass is the value of the proxy_pass directive, including the path of the subrequests.
+	ProxyPass string
+	// Headers are the headers of the requests that are forwarded to the authorization service.
+	Headers []Header
+}
+
+// AuthBasic holds the configuration of the auth_basic directives of a location.
+type AuthBasic struct {
+	// Realm is the value of the auth_basic directive.
+	Realm string
+	// UserFile is the path of the htpasswd file.
+	UserFile string
+}
+
+// AccessLog holds the configuration of an access_log directive.
+type AccessLog struct {
+	// Path is the path of the log. For example, /dev/stdout or syslog:server=syslog.logging.svc:514,tag=nginx.
+	Path string
+	// Format is the name of the log_format.
+	Format string
+	// Off indicates whether the directive disables the access logs.
+	Off bool
+}
+
+// Variable defines a variable that is set with the set directive.
+type Variable struct {
+	Name  string
+	Value string
+}
+
+// ProxyNextUpstream holds the configuration of the proxy_next_upstream directives of a location.
+type ProxyNextUpstream struct {
+	// Conditions are the parameters of the proxy_next_upstream directive. For example, error timeout http_502.
+	Conditions string
+	// Timeout is the value of the proxy_next_upstream_timeout directive. If empty, the directive is omitted.
+	Timeout string
+	// Tries is the value of the proxy_next_upstream_tries directive. If 0, the directive is omitted.
+	Tries int32
+}
+
+// ProxyTimeouts holds the configuration of the timeouts of proxying the requests of a location.
+type ProxyTimeouts struct {
+	// Backend is the value of the proxy_connect_timeout, proxy_send_timeout and proxy_read_timeout directives.
+	Backend string
+}
+
+// ClientSettings holds the configuration of the directives that control the handling of the clients of a server
+// or a location. Empty and zero fields mean that the directive is omitted.
+type ClientSettings struct {
+	// BodyMaxSize is the value of the client_max_body_size directive.
+	BodyMaxSize string
+	// BodyTimeout is the value of the client_body_timeout directive.
+	BodyTimeout string
+	// HeaderTimeout is the value of the client_header_timeout directive. It is only allowed in a server.
+	HeaderTimeout string
+	// KeepAliveTime is the value of the keepalive_time directive.
+	KeepAliveTime string
+	// KeepAliveTimeout is the value of the keepalive_timeout directive. For example, 75s 60s.
+	KeepAliveTimeout string
+	// KeepAliveRequests is the value of the keepalive_requests directive.
+	KeepAliveRequests int32
+}
+
+// Gzip holds the configuration of the gzip directives of a server or a location.
+// Empty and zero fields mean that the directive is omitted.
+type Gzip struct {
+	// Types is the value of the gzip_types directive. For example, application/json text/css.
+	Types string
+	// MinLength is the value of the gzip_min_length directive.
+	MinLength string
+	// Vary is the value of the gzip_vary directive: on or off.
+	Vary string
+	// Level is the value of the gzip_comp_level directive.
+	Level int32
+	// Enable is the value of the gzip directive.
+	Enable bool
+}
+
+// DirectResponse holds the configuration of the content type and the headers of a direct response.
+type DirectResponse struct {
+	// ContentType is the value of the default_type directive.
+	ContentType string
+	// Headers are the add_header directives.
+	Headers []Header
+}
+
+// ErrorPage holds the configuration of an error_page directive.
+type ErrorPage struct {
+	// Codes are the status codes of the error responses. For example, 500 502.
+	Codes string
+	// Response is the =response parameter. For example, =200. If empty, the parameter is omitted.
+	Response string
+	// URI is the URI of the internal location with the body of the response or the URL of the redirect.
+	URI string
+}
+
+// ErrorPageResponse holds the configuration of the internal location that responds with the body of an error page.
+type ErrorPageResponse struct {
+	// ContentType is the value of the default_type directive.
+	ContentType string
+	// File is the path of the file with the body.
+	File string
+}
+
+// LocationRateLimit holds the configuration of the limit_req directives of a location.
+type LocationRateLimit struct {
+	// Zone is the name of the limit_req_zone.
+	Zone string
+	// Burst is the value of the burst parameter. If 0, the parameter is omitted.
+	Burst int32
+	// DryRun indicates whether the limit_req_dry_run directive is enabled.
+	DryRun bool
 }
 
 // Header defines a HTTP header to be passed to the proxied server.
@@ -52,13 +252,105 @@ const (
 
 // Upstream holds all configuration for an HTTP upstream.
 type Upstream struct {
-	Name    string
-	Servers []UpstreamServer
+	// KeepAlive configures the keep-alive connections to the servers. If nil, keep-alive connections are disabled.
+	KeepAlive *UpstreamKeepAlive
+	Name      string
+	// LoadBalancingMethod is the load-balancing directive with its parameters. For example, hash $remote_addr.
+	// If empty, NGINX uses round-robin.
+	LoadBalancingMethod string
+	// ZoneSize is the size of the shared memory zone of the upstream. For example, 64k.
+	ZoneSize string
+	Servers  []UpstreamServer
+}
+
+// UpstreamKeepAlive holds the configuration of the keep-alive connections to the servers of an upstream.
+// Zero values of the fields, except for Connections, mean that the NGINX defaults are used.
+type UpstreamKeepAlive struct {
+	Time        string
+	Timeout     string
+	Connections int32
+	Requests    int32
 }
 
 // UpstreamServer holds all configuration for an HTTP upstream server.
 type UpstreamServer struct {
-	Address string
+	// MaxFails is the value of the max_fails parameter. If nil, the parameter is omitted.
+	MaxFails *int32
+	Address  string
+	// FailTimeout is the value of the fail_timeout parameter. If empty, the parameter is omitted.
+	FailTimeout string
+	// MaxConnections limits the number of simultaneous active connections to the server. If 0, there is no limit.
+	MaxConnections int32
+}
+
+// RateLimitZone holds all configuration for an HTTP limit_req_zone.
+type RateLimitZone struct {
+	// Name is the name of the zone.
+	Name string
+	// Key is the key of the zone. For example, $binary_remote_addr.
+	Key string
+	// Size is the size of the zone. For example, 10m.
+	Size string
+	// Rate is the maximum rate of the requests. For example, 10r/s.
+	Rate string
+}
+
+// CacheZone holds all configuration for an HTTP proxy_cache_path.
+type CacheZone struct {
+	// Name is the name of the shared memory zone with the keys.
+	Name string
+	// Path is the directory of the cached responses.
+	Path string
+	// KeysSize is the size of the shared memory zone with the keys. For example, 10m.
+	KeysSize string
+	// MaxSize is the value of the max_size parameter. If empty, the parameter is omitted.
+	MaxSize string
+	// Inactive is the value of the inactive parameter. If empty, the parameter is omitted.
+	Inactive string
+}
+
+// LocationCache holds the configuration of the proxy_cache directives of a location.
+type LocationCache struct {
+	// Zone is the name of the zone of the proxy_cache_path.
+	Zone string
+	// Key is the value of the proxy_cache_key directive.
+	Key string
+	// Bypass is the value of the proxy_cache_bypass directive. If empty, the directive is omitted.
+	Bypass string
+	// UseStale is the value of the proxy_cache_use_stale directive. If empty, the directive is omitted.
+	UseStale string
+	// Valid are the values of the proxy_cache_valid directives. For example, 200 301 10m.
+	Valid []string
+	// BackgroundUpdate indicates whether the proxy_cache_background_update directive is enabled.
+	BackgroundUpdate bool
+}
+
+// Tracing holds all configuration for the OpenTelemetry tracing of the requests.
+type Tracing struct {
+	// Endpoint is the address of the OTLP/gRPC endpoint of the exporter.
+	Endpoint string
+	// Interval is the maximum interval between two exports. If empty, the directive is omitted.
+	Interval string
+	// ServiceName is the value of the otel_service_name directive. If empty, the directive is omitted.
+	ServiceName string
+	// Context is the value of the otel_trace_context directive.
+	Context string
+	// Trace is the value of the otel_trace directive. For example, on, off or $otel_trace_ratio_10.
+	Trace string
+	// Ratios are the ratios of the requests to trace that require a split_clients.
+	Ratios []TracingRatio
+	// BatchSize is the maximum number of spans in a batch. If 0, the directive is omitted.
+	BatchSize int32
+	// BatchCount is the number of pending batches per worker. If 0, the directive is omitted.
+	BatchCount int32
+}
+
+// TracingRatio holds the configuration of a split_clients that enables tracing for Percent of the traces.
+type TracingRatio struct {
+	// VariableName is the name of the variable, without $, that the split_clients sets to on or off.
+	VariableName string
+	// Percent is the percentage of the traces for which the variable is on.
+	Percent int32
 }
 
 // SplitClient holds all configuration for an HTTP split client.
@@ -16,7 +16,7 @@ func executeMaps(conf dataplane.Configuration) []byte {
 }
 
 func createMaps(servers []dataplane.VirtualServer) []http.Map {
-	return buildAddHeaderMaps(servers)
+	return append(buildAddHeaderMaps(servers), buildCORSMaps(servers)...)
 }
 
 func buildAddHeaderMaps(servers []dataplane.VirtualServer) []http.Map {
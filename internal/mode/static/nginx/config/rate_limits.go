@@ -0,0 +1,66 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	gotemplate "text/template"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+const (
+	// rateLimitZoneSize is the size of the shared memory zone of a rate limit. One megabyte zone can keep
+	// about 16 thousand states of 64 bytes.
+	rateLimitZoneSize = "10m"
+)
+
+var rateLimitZonesTemplate = gotemplate.Must(gotemplate.New("rateLimitZones").Parse(rateLimitZonesTemplateText))
+
+func executeRateLimitZones(conf dataplane.Configuration) []byte {
+	zones := createRateLimitZones(conf.RateLimitZones)
+
+	return execute(rateLimitZonesTemplate, zones)
+}
+
+func createRateLimitZones(rateLimitZones []dataplane.RateLimitZone) []http.RateLimitZone {
+	if len(rateLimitZones) == 0 {
+		return nil
+	}
+
+	zones := make([]http.RateLimitZone, 0, len(rateLimitZones))
+
+	for _, z := range rateLimitZones {
+		zones = append(zones, http.RateLimitZone{
+			Name: z.Name,
+			Key:  createRateLimitKey(z.Key),
+			Size: rateLimitZoneSize,
+			Rate: z.Rate,
+		})
+	}
+
+	return zones
+}
+
+func createRateLimitKey(key dataplane.RateLimitKey) string {
+	switch key.Type {
+	case dataplane.RateLimitKeyTypeClientIP:
+		return "$binary_remote_addr"
+	case dataplane.RateLimitKeyTypeHeader:
+		return "$http_" + strings.ReplaceAll(strings.ToLower(key.Name), "-", "_")
+	default:
+		panic(fmt.Sprintf("unsupported rate limit key type: %s", key.Type))
+	}
+}
+
+func createLocationRateLimit(rateLimit *dataplane.RateLimit) *http.LocationRateLimit {
+	if rateLimit == nil {
+		return nil
+	}
+
+	return &http.LocationRateLimit{
+		Zone:   rateLimit.Zone,
+		Burst:  rateLimit.Burst,
+		DryRun: rateLimit.DryRun,
+	}
+}
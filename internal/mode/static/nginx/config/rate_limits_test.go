@@ -0,0 +1,114 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteRateLimitZones(t *testing.T) {
+	conf := dataplane.Configuration{
+		RateLimitZones: []dataplane.RateLimitZone{
+			{
+				Name: "ratelimit_test_client-ip",
+				Rate: "10r/s",
+				Key:  dataplane.RateLimitKey{Type: dataplane.RateLimitKeyTypeClientIP},
+			},
+			{
+				Name: "ratelimit_test_header",
+				Rate: "100r/m",
+				Key:  dataplane.RateLimitKey{Type: dataplane.RateLimitKeyTypeHeader, Name: "X-User-ID"},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"limit_req_zone $binary_remote_addr zone=ratelimit_test_client-ip:10m rate=10r/s;": 1,
+		"limit_req_zone $http_x_user_id zone=ratelimit_test_header:10m rate=100r/m;":       1,
+	}
+
+	zones := string(executeRateLimitZones(conf))
+	for expSubStr, expCount := range expSubStrings {
+		if expCount != strings.Count(zones, expSubStr) {
+			t.Errorf(
+				"executeRateLimitZones() did not generate zones with substring %q %d times. Zones: %v",
+				expSubStr,
+				expCount,
+				zones,
+			)
+		}
+	}
+
+	g := NewGomegaWithT(t)
+	g.Expect(strings.TrimSpace(string(executeRateLimitZones(dataplane.Configuration{})))).To(BeEmpty())
+}
+
+func TestCreateRateLimitKey(t *testing.T) {
+	tests := []struct {
+		msg      string
+		expected string
+		key      dataplane.RateLimitKey
+	}{
+		{
+			key:      dataplane.RateLimitKey{Type: dataplane.RateLimitKeyTypeClientIP},
+			expected: "$binary_remote_addr",
+			msg:      "client IP",
+		},
+		{
+			key:      dataplane.RateLimitKey{Type: dataplane.RateLimitKeyTypeHeader, Name: "X-API-Key"},
+			expected: "$http_x_api_key",
+			msg:      "header",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(createRateLimitKey(test.key)).To(Equal(test.expected))
+		})
+	}
+
+	g := NewGomegaWithT(t)
+	g.Expect(func() {
+		createRateLimitKey(dataplane.RateLimitKey{Type: "unsupported"})
+	}).To(Panic())
+}
+
+func TestCreateLocationRateLimit(t *testing.T) {
+	tests := []struct {
+		rateLimit *dataplane.RateLimit
+		expected  *http.LocationRateLimit
+		msg       string
+	}{
+		{
+			rateLimit: nil,
+			expected:  nil,
+			msg:       "no rate limit",
+		},
+		{
+			rateLimit: &dataplane.RateLimit{
+				Zone:   "ratelimit_test_policy",
+				Key:    dataplane.RateLimitKey{Type: dataplane.RateLimitKeyTypeHeader, Name: "X-User-ID"},
+				Burst:  10,
+				DryRun: true,
+			},
+			expected: &http.LocationRateLimit{
+				Zone:   "ratelimit_test_policy",
+				Burst:  10,
+				DryRun: true,
+			},
+			msg: "header key",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(createLocationRateLimit(test.rateLimit)).To(Equal(test.expected))
+		})
+	}
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"strconv"
 	"strings"
 	gotemplate "text/template"
 
@@ -21,26 +22,31 @@ const (
 )
 
 func executeServers(conf dataplane.Configuration) []byte {
-	servers := createServers(conf.HTTPServers, conf.SSLServers)
+	servers := createServers(conf.HTTPServers, conf.SSLServers, conf.Upstreams)
 
 	return execute(serversTemplate, servers)
 }
 
-func createServers(httpServers, sslServers []dataplane.VirtualServer) []http.Server {
+func createServers(httpServers, sslServers []dataplane.VirtualServer, upstreams []dataplane.Upstream) []http.Server {
 	servers := make([]http.Server, 0, len(httpServers)+len(sslServers))
 
+	upstreamsByName := make(map[string]dataplane.Upstream, len(upstreams))
+	for _, u := range upstreams {
+		upstreamsByName[u.Name] = u
+	}
+
 	for _, s := range httpServers {
-		servers = append(servers, createServer(s))
+		servers = append(servers, createServer(s, upstreamsByName))
 	}
 
 	for _, s := range sslServers {
-		servers = append(servers, createSSLServer(s))
+		servers = append(servers, createSSLServer(s, upstreamsByName))
 	}
 
 	return servers
 }
 
-func createSSLServer(virtualServer dataplane.VirtualServer) http.Server {
+func createSSLServer(virtualServer dataplane.VirtualServer, upstreams map[string]dataplane.Upstream) http.Server {
 	if virtualServer.IsDefault {
 		return http.Server{
 			IsDefaultSSL: true,
@@ -54,31 +60,65 @@ func createSSLServer(virtualServer dataplane.VirtualServer) http.Server {
 			Certificate:    generatePEMFileName(virtualServer.SSL.KeyPairID),
 			CertificateKey: generatePEMFileName(virtualServer.SSL.KeyPairID),
 		},
-		Locations: createLocations(virtualServer.PathRules, virtualServer.Port),
-		Port:      virtualServer.Port,
+		Locations:      createServerLocations(virtualServer, upstreams),
+		ClientSettings: createClientSettings(virtualServer.ClientSettings),
+		Gzip:           createGzip(virtualServer.Compression),
+		ErrorPages:     createErrorPages(virtualServer.ErrorPages),
+		Snippet:        virtualServer.Snippet,
+		Port:           virtualServer.Port,
 	}
 }
 
-func createServer(virtualServer dataplane.VirtualServer) http.Server {
+func createServer(virtualServer dataplane.VirtualServer, upstreams map[string]dataplane.Upstream) http.Server {
 	if virtualServer.IsDefault {
 		return http.Server{
-			IsDefaultHTTP: true,
-			Port:          virtualServer.Port,
+			IsDefaultHTTP:  true,
+			Locations:      createErrorPageLocations(virtualServer.ErrorPages),
+			ClientSettings: createClientSettings(virtualServer.ClientSettings),
+			ErrorPages:     createErrorPages(virtualServer.ErrorPages),
+			Port:           virtualServer.Port,
 		}
 	}
 
 	return http.Server{
-		ServerName: virtualServer.Hostname,
-		Locations:  createLocations(virtualServer.PathRules, virtualServer.Port),
-		Port:       virtualServer.Port,
+		ServerName:     virtualServer.Hostname,
+		Locations:      createServerLocations(virtualServer, upstreams),
+		ClientSettings: createClientSettings(virtualServer.ClientSettings),
+		Gzip:           createGzip(virtualServer.Compression),
+		ErrorPages:     createErrorPages(virtualServer.ErrorPages),
+		Snippet:        virtualServer.Snippet,
+		Port:           virtualServer.Port,
 	}
 }
 
-func createLocations(pathRules []dataplane.PathRule, listenerPort int32) []http.Location {
+// createServerLocations creates the locations of the routes of the server and the locations with the bodies of
+// the error pages of the server.
+func createServerLocations(
+	virtualServer dataplane.VirtualServer,
+	upstreams map[string]dataplane.Upstream,
+) []http.Location {
+	locs := createLocations(virtualServer.PathRules, virtualServer.Port, upstreams)
+	return append(locs, createErrorPageLocations(virtualServer.ErrorPages)...)
+}
+
+func createLocations(
+	pathRules []dataplane.PathRule,
+	listenerPort int32,
+	upstreams map[string]dataplane.Upstream,
+) []http.Location {
 	maxLocs, pathsAndTypes := getMaxLocationCountAndPathMap(pathRules)
 	locs := make([]http.Location, 0, maxLocs)
 	var rootPathExists bool
 
+	// authLocations are the internal locations for the auth_request subrequests: one per authentication that
+	// validates the JSON Web Tokens and one per rule that uses external authorization.
+	var authLocations []http.Location
+	authLocationExists := make(map[string]struct{})
+
+	// errorPageLocations are the internal locations with the bodies of the error pages of the routes.
+	var errorPageLocations []http.Location
+	errorPageLocationExists := make(map[string]struct{})
+
 	for _, rule := range pathRules {
 		matches := make([]httpMatch, 0, len(rule.MatchRules))
 
@@ -94,10 +134,36 @@ func createLocations(pathRules []dataplane.PathRule, listenerPort int32) []http.
 			buildLocations := extLocations
 			if len(rule.MatchRules) != 1 || !isPathOnlyMatch(m) {
 				intLocation, match := initializeInternalLocation(rule, matchRuleIdx, m)
+				match.CORS = r.CORS != nil
 				buildLocations = []http.Location{intLocation}
 				matches = append(matches, match)
 			}
 
+			accessRules := createAccessRules(r.AccessControl)
+			errorPages := createErrorPages(r.ErrorPages)
+			for i := range buildLocations {
+				buildLocations[i].AccessRules = accessRules
+				buildLocations[i].ErrorPages = errorPages
+				buildLocations[i].Snippet = r.Snippet
+			}
+
+			for _, loc := range createErrorPageLocations(r.ErrorPages) {
+				if _, exists := errorPageLocationExists[loc.Path]; !exists {
+					errorPageLocationExists[loc.Path] = struct{}{}
+					errorPageLocations = append(errorPageLocations, loc)
+				}
+			}
+
+			if r.AccessLog != nil {
+				accessLog := createAccessLog(r.AccessLog)
+				variables := createAccessLogVariables(r)
+
+				for i := range buildLocations {
+					buildLocations[i].AccessLog = accessLog
+					buildLocations[i].Variables = variables
+				}
+			}
+
 			if r.Filters.InvalidFilter != nil {
 				for i := range buildLocations {
 					buildLocations[i].Return = &http.Return{Code: http.StatusInternalServerError}
@@ -115,6 +181,18 @@ func createLocations(pathRules []dataplane.PathRule, listenerPort int32) []http.
 			// for checking the imported Webhook validation catches the case above.
 			// https://github.com/nginxinc/nginx-kubernetes-gateway/issues/660
 
+			// A direct response and proxying are mutually exclusive.
+			if r.Filters.DirectResponse != nil {
+				ret := createReturnValForDirectResponse(*r.Filters.DirectResponse)
+				directResponse := createDirectResponse(*r.Filters.DirectResponse)
+				for i := range buildLocations {
+					buildLocations[i].Return = ret
+					buildLocations[i].DirectResponse = directResponse
+				}
+				locs = append(locs, buildLocations...)
+				continue
+			}
+
 			// RequestRedirect and proxying are mutually exclusive.
 			if r.Filters.RequestRedirect != nil {
 				ret := createReturnValForRedirectFilter(r.Filters.RequestRedirect, listenerPort)
@@ -126,8 +204,57 @@ func createLocations(pathRules []dataplane.PathRule, listenerPort int32) []http.
 			}
 
 			proxySetHeaders := generateProxySetHeaders(r.Filters.RequestHeaderModifiers)
+			proxySetHeaders = append(proxySetHeaders, createExternalAuthProxySetHeaders(r.Filters.Authentication)...)
+
+			groupUpstreams := getBackendGroupUpstreams(r.BackendGroup, upstreams)
+
+			var proxyHTTPVersion string
+			if keepAliveEnabled(groupUpstreams) {
+				// Keep-alive connections to the upstream require HTTP/1.1 and the empty Connection header,
+				// because NGINX uses HTTP/1.0 with "Connection: close" by default.
+				proxyHTTPVersion = "1.1"
+				proxySetHeaders = append(proxySetHeaders, http.Header{Name: "Connection", Value: ""})
+			}
+
+			var proxyNextUpstream *http.ProxyNextUpstream
+			if r.Retry != nil {
+				// NGINX retries a request within the upstream that split_clients picked for it,
+				// so the retries work the same way for the locations that split the traffic.
+				proxyNextUpstream = convertNextUpstream(*r.Retry)
+			} else {
+				proxyNextUpstream = createProxyNextUpstream(groupUpstreams)
+			}
+
 			for i := range buildLocations {
 				buildLocations[i].ProxySetHeaders = proxySetHeaders
+				buildLocations[i].ProxyHTTPVersion = proxyHTTPVersion
+				buildLocations[i].ProxyNextUpstream = proxyNextUpstream
+				buildLocations[i].ProxyTimeouts = createProxyTimeouts(r.Timeouts)
+				buildLocations[i].RateLimit = createLocationRateLimit(r.RateLimit)
+				buildLocations[i].ClientSettings = createClientSettings(r.ClientSettings)
+				buildLocations[i].Gzip = createGzip(r.Compression)
+				buildLocations[i].OTelTrace = createLocationOTelTrace(r.TracingRatio)
+				buildLocations[i].Cache = createLocationCache(r.Cache)
+				buildLocations[i].CORS = createCORS(r)
+				buildLocations[i].AuthBasic = createAuthBasic(r.Filters.Authentication)
+				buildLocations[i].AuthJWT = createAuthJWT(r.Filters.Authentication)
+				buildLocations[i].AuthExternal = createAuthExternal(r)
+			}
+
+			if auth := r.Filters.Authentication; auth != nil && auth.JWT != nil {
+				path := generateJWTValidationPath(auth.FileID)
+				if _, exists := authLocationExists[path]; !exists {
+					authLocationExists[path] = struct{}{}
+					authLocations = append(authLocations, createJWTValidationLocation(*auth))
+				}
+			}
+
+			if auth := r.Filters.Authentication; auth != nil && auth.External != nil {
+				path := generateExternalAuthPath(r.Source, r.RuleIdx)
+				if _, exists := authLocationExists[path]; !exists {
+					authLocationExists[path] = struct{}{}
+					authLocations = append(authLocations, createExternalAuthLocation(path, *auth.External))
+				}
 			}
 
 			proxyPass := createProxyPass(r.BackendGroup)
@@ -146,6 +273,9 @@ func createLocations(pathRules []dataplane.PathRule, listenerPort int32) []http.
 		}
 	}
 
+	locs = append(locs, authLocations...)
+	locs = append(locs, errorPageLocations...)
+
 	if !rootPathExists {
 		locs = append(locs, createDefaultRootLocation())
 	}
@@ -295,6 +425,9 @@ type httpMatch struct {
 	QueryParams []string `json:"params,omitempty"`
 	// Any represents a match with no match conditions.
 	Any bool `json:"any,omitempty"`
+	// CORS indicates whether the rule of the match configures CORS. If so, the NJS httpmatches module tests
+	// the CORS preflight requests against the method of the actual request and doesn't test their headers.
+	CORS bool `json:"cors,omitempty"`
 }
 
 func createHTTPMatch(match v1beta1.HTTPRouteMatch, redirectPath string) httpMatch {
@@ -371,6 +504,138 @@ func createProxyPass(backendGroup dataplane.BackendGroup) string {
 	return "http://" + backendName
 }
 
+// getBackendGroupUpstreams returns the upstreams of the valid backends of the group in the order of the backends.
+func getBackendGroupUpstreams(
+	group dataplane.BackendGroup,
+	upstreams map[string]dataplane.Upstream,
+) []dataplane.Upstream {
+	var result []dataplane.Upstream
+
+	for _, b := range group.Backends {
+		if !b.Valid {
+			continue
+		}
+
+		if up, exists := upstreams[b.UpstreamName]; exists {
+			result = append(result, up)
+		}
+	}
+
+	return result
+}
+
+// keepAliveEnabled returns true if any of the upstreams keeps the connections to its endpoints alive.
+func keepAliveEnabled(upstreams []dataplane.Upstream) bool {
+	for _, up := range upstreams {
+		if up.KeepAlive != nil {
+			return true
+		}
+	}
+	return false
+}
+
+var nextUpstreamConditions = map[dataplane.NextUpstreamCondition]string{
+	dataplane.NextUpstreamConditionError:         "error",
+	dataplane.NextUpstreamConditionTimeout:       "timeout",
+	dataplane.NextUpstreamConditionInvalidHeader: "invalid_header",
+	dataplane.NextUpstreamConditionHTTP500:       "http_500",
+	dataplane.NextUpstreamConditionHTTP502:       "http_502",
+	dataplane.NextUpstreamConditionHTTP503:       "http_503",
+	dataplane.NextUpstreamConditionHTTP504:       "http_504",
+	dataplane.NextUpstreamConditionHTTP403:       "http_403",
+	dataplane.NextUpstreamConditionHTTP404:       "http_404",
+	dataplane.NextUpstreamConditionHTTP429:       "http_429",
+	dataplane.NextUpstreamConditionNonIdempotent: "non_idempotent",
+	dataplane.NextUpstreamConditionOff:           "off",
+}
+
+// createProxyTimeouts returns the proxy timeouts of a location.
+func createProxyTimeouts(timeouts *dataplane.Timeouts) *http.ProxyTimeouts {
+	if timeouts == nil {
+		return nil
+	}
+
+	return &http.ProxyTimeouts{
+		Backend: timeouts.BackendRequest,
+	}
+}
+
+// createProxyNextUpstream returns the proxy_next_upstream configuration of the first upstream that configures it.
+// A request is passed to the next endpoint within the upstream that was picked for the request,
+// so when a location splits the traffic among several upstreams with different configuration,
+// only one of the configurations can be used.
+func createProxyNextUpstream(upstreams []dataplane.Upstream) *http.ProxyNextUpstream {
+	for _, up := range upstreams {
+		if up.NextUpstream != nil {
+			return convertNextUpstream(*up.NextUpstream)
+		}
+	}
+
+	return nil
+}
+
+func convertNextUpstream(nextUpstream dataplane.NextUpstream) *http.ProxyNextUpstream {
+	conds := make([]string, 0, len(nextUpstream.Conditions))
+	for _, c := range nextUpstream.Conditions {
+		cond, exists := nextUpstreamConditions[c]
+		if !exists {
+			panic(fmt.Sprintf("unsupported next upstream condition: %s", c))
+		}
+		conds = append(conds, cond)
+	}
+
+	return &http.ProxyNextUpstream{
+		Conditions: strings.Join(conds, " "),
+		Tries:      nextUpstream.Tries,
+		Timeout:    nextUpstream.Timeout,
+	}
+}
+
+func createClientSettings(settings *dataplane.ClientSettings) *http.ClientSettings {
+	if settings == nil {
+		return nil
+	}
+
+	keepAliveTimeout := settings.KeepAliveTimeout
+	if settings.KeepAliveHeaderTimeout != "" {
+		keepAliveTimeout += " " + settings.KeepAliveHeaderTimeout
+	}
+
+	return &http.ClientSettings{
+		BodyMaxSize:       settings.BodyMaxSize,
+		BodyTimeout:       settings.BodyTimeout,
+		HeaderTimeout:     settings.HeaderTimeout,
+		KeepAliveTime:     settings.KeepAliveTime,
+		KeepAliveTimeout:  keepAliveTimeout,
+		KeepAliveRequests: settings.KeepAliveRequests,
+	}
+}
+
+func createGzip(compression *dataplane.Compression) *http.Gzip {
+	if compression == nil {
+		return nil
+	}
+
+	result := &http.Gzip{
+		Enable: compression.Enable,
+		Types:  strings.Join(compression.Types, " "),
+		Level:  compression.Level,
+	}
+
+	if compression.MinLength != nil {
+		result.MinLength = strconv.Itoa(int(*compression.MinLength))
+	}
+
+	if compression.Vary != nil {
+		result.Vary = "off"
+		if *compression.Vary {
+			result.Vary = "on"
+		}
+	}
+
+	return result
+}
+
 func createMatchLocation(path string) http.Location {
 	return http.Location{
 		Path:     path,
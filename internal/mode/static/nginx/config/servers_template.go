@@ -1,6 +1,51 @@
 package config
 
 var serversTemplateText = `
+{{- define "clientSettings" }}
+    {{- if .BodyMaxSize }}
+    client_max_body_size {{ .BodyMaxSize }};
+    {{- end }}
+    {{- if .BodyTimeout }}
+    client_body_timeout {{ .BodyTimeout }};
+    {{- end }}
+    {{- if .HeaderTimeout }}
+    client_header_timeout {{ .HeaderTimeout }};
+    {{- end }}
+    {{- if .KeepAliveRequests }}
+    keepalive_requests {{ .KeepAliveRequests }};
+    {{- end }}
+    {{- if .KeepAliveTime }}
+    keepalive_time {{ .KeepAliveTime }};
+    {{- end }}
+    {{- if .KeepAliveTimeout }}
+    keepalive_timeout {{ .KeepAliveTimeout }};
+    {{- end }}
+{{- end }}
+{{- define "gzip" }}
+    gzip {{ if .Enable }}on{{ else }}off{{ end }};
+    {{- if .Types }}
+    gzip_types {{ .Types }};
+    {{- end }}
+    {{- if .MinLength }}
+    gzip_min_length {{ .MinLength }};
+    {{- end }}
+    {{- if .Level }}
+    gzip_comp_level {{ .Level }};
+    {{- end }}
+    {{- if .Vary }}
+    gzip_vary {{ .Vary }};
+    {{- end }}
+{{- end }}
+{{- define "errorPages" }}
+    {{- range $p := . }}
+    error_page {{ $p.Codes }}{{ if $p.Response }} {{ $p.Response }}{{ end }} "{{ $p.URI }}";
+    {{- end }}
+    proxy_intercept_errors on;
+{{- end }}
+{{- define "errorPageResponse" }}
+        default_type "{{ .ContentType }}";
+        alias {{ .File }};
+{{- end }}
 {{- range $s := . -}}
     {{ if $s.IsDefaultSSL -}}
 server {
@@ -11,9 +56,29 @@ server {
     {{- else if $s.IsDefaultHTTP }}
 server {
     listen {{ $s.Port }} default_server;
+        {{- if $s.ClientSettings }}
+            {{- template "clientSettings" $s.ClientSettings }}
+        {{- end }}
+        {{- if $s.ErrorPages }}
+            {{- template "errorPages" $s.ErrorPages }}
+        {{- end }}
 
     default_type text/html;
+        {{- if $s.Locations }}
+
+    location / {
+        return 404;
+    }
+            {{- range $l := $s.Locations }}
+
+    location {{ $l.Path }} {
+        internal;
+                {{- template "errorPageResponse" $l.ErrorPageResponse }}
+    }
+            {{- end }}
+        {{- else }}
     return 404;
+        {{- end }}
 }
     {{- else }}
 server {
@@ -30,6 +95,19 @@ server {
         {{- end }}
 
     server_name {{ $s.ServerName }};
+        {{- if $s.ClientSettings }}
+            {{- template "clientSettings" $s.ClientSettings }}
+        {{- end }}
+        {{- if $s.Gzip }}
+            {{- template "gzip" $s.Gzip }}
+        {{- end }}
+        {{- if $s.ErrorPages }}
+            {{- template "errorPages" $s.ErrorPages }}
+        {{- end }}
+        {{- if $s.Snippet }}
+
+    {{ $s.Snippet }}
+        {{- end }}
 
         {{ range $l := $s.Locations }}
     location {{ $l.Path }} {
@@ -37,20 +115,175 @@ server {
         internal;
         {{ end }}
 
+        {{- range $v := $l.Variables }}
+        set ${{ $v.Name }} "{{ $v.Value }}";
+        {{- end }}
+        {{- if $l.JWTValidation }}
+        js_content jwt.validate;
+        {{- end }}
+        {{- with $l.ExternalAuthSubrequest }}
+        proxy_pass_request_body off;
+        proxy_pass_request_headers off;
+        proxy_set_header Content-Length "";
+        proxy_set_header X-Original-URI $request_uri;
+        proxy_set_header X-Original-Method $request_method;
+            {{- range $h := .Headers }}
+        proxy_set_header {{ $h.Name }} "{{ $h.Value }}";
+            {{- end }}
+        proxy_pass {{ .ProxyPass }};
+        {{- end }}
+        {{- if $l.AccessLog }}
+        access_log {{ if $l.AccessLog.Off }}off{{ else }}{{ $l.AccessLog.Path }} {{ $l.AccessLog.Format }}{{ end }};
+        {{ end }}
+
+        {{- if $l.AccessRules }}
+            {{- range $r := $l.AccessRules }}
+        {{ $r.Directive }} {{ $r.Address }};
+            {{- end }}
+        {{ end }}
+
+        {{- if $l.ErrorPages }}
+            {{- range $p := $l.ErrorPages }}
+        error_page {{ $p.Codes }}{{ if $p.Response }} {{ $p.Response }}{{ end }} "{{ $p.URI }}";
+            {{- end }}
+        proxy_intercept_errors on;
+        {{ end }}
+
+        {{- if $l.Snippet }}
+        {{ $l.Snippet }}
+        {{ end }}
+
+        {{- with $l.DirectResponse }}
+        types { }
+        default_type "{{ .ContentType }}";
+            {{- range $h := .Headers }}
+        add_header {{ $h.Name }} "{{ $h.Value }}" always;
+            {{- end }}
+        {{ end }}
+
         {{- if $l.Return -}}
         return {{ $l.Return.Code }} "{{ $l.Return.Body }}";
         {{ end }}
 
+        {{- with $l.ErrorPageResponse }}
+            {{- template "errorPageResponse" . }}
+        {{ end }}
+
         {{- if $l.HTTPMatchVar -}}
         set $http_matches {{ $l.HTTPMatchVar | printf "%q" }};
         js_content httpmatches.redirect;
         {{ end }}
 
         {{- if $l.ProxyPass -}}
-            {{ range $h := $l.ProxySetHeaders }}
+            {{ if $l.ProxyHTTPVersion -}}
+        proxy_http_version {{ $l.ProxyHTTPVersion }};
+            {{- end }}
+            {{- range $h := $l.ProxySetHeaders }}
         proxy_set_header {{ $h.Name }} "{{ $h.Value }}";
             {{- end }}
         proxy_set_header Host $gw_api_compliant_host;
+            {{- with $l.CORS }}
+        if ($cors_preflight) {
+                {{- range $h := .PreflightHeaders }}
+            add_header {{ $h.Name }} "{{ $h.Value }}" always;
+                {{- end }}
+            return 204;
+        }
+        proxy_hide_header Access-Control-Allow-Origin;
+        proxy_hide_header Access-Control-Allow-Credentials;
+        proxy_hide_header Access-Control-Expose-Headers;
+                {{- range $h := .Headers }}
+        add_header {{ $h.Name }} "{{ $h.Value }}" always;
+                {{- end }}
+            {{- end }}
+            {{- if $l.ProxyNextUpstream }}
+        proxy_next_upstream {{ $l.ProxyNextUpstream.Conditions }};
+                {{- if $l.ProxyNextUpstream.Tries }}
+        proxy_next_upstream_tries {{ $l.ProxyNextUpstream.Tries }};
+                {{- end }}
+                {{- if $l.ProxyNextUpstream.Timeout }}
+        proxy_next_upstream_timeout {{ $l.ProxyNextUpstream.Timeout }};
+                {{- end }}
+            {{- end }}
+            {{- with $l.ProxyTimeouts }}
+        proxy_connect_timeout {{ .Backend }};
+        proxy_send_timeout {{ .Backend }};
+        proxy_read_timeout {{ .Backend }};
+            {{- end }}
+            {{- with $l.ClientSettings }}
+                {{- if .BodyMaxSize }}
+        client_max_body_size {{ .BodyMaxSize }};
+                {{- end }}
+                {{- if .BodyTimeout }}
+        client_body_timeout {{ .BodyTimeout }};
+                {{- end }}
+                {{- if .KeepAliveRequests }}
+        keepalive_requests {{ .KeepAliveRequests }};
+                {{- end }}
+                {{- if .KeepAliveTime }}
+        keepalive_time {{ .KeepAliveTime }};
+                {{- end }}
+                {{- if .KeepAliveTimeout }}
+        keepalive_timeout {{ .KeepAliveTimeout }};
+                {{- end }}
+            {{- end }}
+            {{- with $l.Gzip }}
+        gzip {{ if .Enable }}on{{ else }}off{{ end }};
+                {{- if .Types }}
+        gzip_types {{ .Types }};
+                {{- end }}
+                {{- if .MinLength }}
+        gzip_min_length {{ .MinLength }};
+                {{- end }}
+                {{- if .Level }}
+        gzip_comp_level {{ .Level }};
+                {{- end }}
+                {{- if .Vary }}
+        gzip_vary {{ .Vary }};
+                {{- end }}
+            {{- end }}
+            {{- if $l.RateLimit }}
+        limit_req zone={{ $l.RateLimit.Zone }}{{ if $l.RateLimit.Burst }} burst={{ $l.RateLimit.Burst }}{{ end }};
+        limit_req_status 429;
+                {{- if $l.RateLimit.DryRun }}
+        limit_req_dry_run on;
+                {{- end }}
+            {{- end }}
+            {{- if $l.OTelTrace }}
+        otel_trace {{ $l.OTelTrace }};
+            {{- end }}
+            {{- with $l.Cache }}
+        proxy_cache {{ .Zone }};
+        proxy_cache_key "{{ .Key }}";
+                {{- range $v := .Valid }}
+        proxy_cache_valid {{ $v }};
+                {{- end }}
+                {{- if .Bypass }}
+        proxy_cache_bypass {{ .Bypass }};
+                {{- end }}
+                {{- if .UseStale }}
+        proxy_cache_use_stale {{ .UseStale }};
+                {{- end }}
+                {{- if .BackgroundUpdate }}
+        proxy_cache_background_update on;
+                {{- end }}
+            {{- end }}
+            {{- if $l.AuthBasic }}
+        auth_basic "{{ $l.AuthBasic.Realm }}";
+        auth_basic_user_file {{ $l.AuthBasic.UserFile }};
+            {{- end }}
+            {{- if $l.AuthJWT }}
+        auth_request {{ $l.AuthJWT }};
+        auth_request_set $jwt_www_authenticate $sent_http_www_authenticate;
+        add_header WWW-Authenticate $jwt_www_authenticate always;
+            {{- end }}
+            {{- if $l.AuthExternal }}
+        auth_request {{ $l.AuthExternal.URI }};
+                {{- range $v := $l.AuthExternal.Variables }}
+        auth_request_set ${{ $v.Name }} {{ $v.Value }};
+                {{- end }}
+        add_header WWW-Authenticate $ext_auth_www_authenticate always;
+            {{- end }}
         proxy_pass {{ $l.ProxyPass }}$request_uri;
         {{- end }}
     }
@@ -854,7 +854,7 @@ func TestCreateServers(t *testing.T) {
 
 	g := NewGomegaWithT(t)
 
-	result := createServers(httpServers, sslServers)
+	result := createServers(httpServers, sslServers, nil)
 	g.Expect(helpers.Diff(expectedServers, result)).To(BeEmpty())
 }
 
@@ -1140,7 +1140,7 @@ func TestCreateServersConflicts(t *testing.T) {
 
 			g := NewGomegaWithT(t)
 
-			result := createServers(httpServers, []dataplane.VirtualServer{})
+			result := createServers(httpServers, []dataplane.VirtualServer{}, nil)
 			g.Expect(helpers.Diff(expectedServers, result)).To(BeEmpty())
 		})
 	}
@@ -1311,7 +1311,7 @@ func TestCreateLocationsRootPath(t *testing.T) {
 	}
 
 	for _, test := range tests {
-		locs := createLocations(test.pathRules, 80)
+		locs := createLocations(test.pathRules, 80, nil)
 		g.Expect(locs).To(Equal(test.expLocations), fmt.Sprintf("test case: %s", test.name))
 	}
 }
@@ -1777,75 +1777,1772 @@ func TestCreateProxyPass(t *testing.T) {
 	}
 }
 
-func TestCreateMatchLocation(t *testing.T) {
+func TestGetBackendGroupUpstreams(t *testing.T) {
 	g := NewGomegaWithT(t)
 
-	expected := http.Location{
-		Path:     "/path",
-		Internal: true,
+	upstreams := map[string]dataplane.Upstream{
+		"foo": {Name: "foo"},
+		"bar": {Name: "bar"},
 	}
 
-	result := createMatchLocation("/path")
-	g.Expect(result).To(Equal(expected))
+	group := dataplane.BackendGroup{
+		Backends: []dataplane.Backend{
+			{UpstreamName: "bar", Valid: true, Weight: 1},
+			{UpstreamName: "foo", Valid: true, Weight: 1},
+			{UpstreamName: "invalid", Valid: false, Weight: 1},
+			{UpstreamName: "not-found", Valid: true, Weight: 1},
+		},
+	}
+
+	g.Expect(getBackendGroupUpstreams(group, upstreams)).To(Equal([]dataplane.Upstream{
+		{Name: "bar"},
+		{Name: "foo"},
+	}))
+	g.Expect(getBackendGroupUpstreams(dataplane.BackendGroup{}, upstreams)).To(BeNil())
 }
 
-func TestCreatePathForMatch(t *testing.T) {
+func TestKeepAliveEnabled(t *testing.T) {
 	g := NewGomegaWithT(t)
 
+	g.Expect(keepAliveEnabled(nil)).To(BeFalse())
+	g.Expect(keepAliveEnabled([]dataplane.Upstream{{Name: "foo"}})).To(BeFalse())
+	g.Expect(keepAliveEnabled([]dataplane.Upstream{
+		{Name: "foo"},
+		{Name: "bar", KeepAlive: &dataplane.UpstreamKeepAlive{Connections: 1}},
+	})).To(BeTrue())
+}
+
+func TestCreateProxyNextUpstream(t *testing.T) {
 	tests := []struct {
-		expected string
-		pathType dataplane.PathType
-		panic    bool
+		expected  *http.ProxyNextUpstream
+		msg       string
+		upstreams []dataplane.Upstream
 	}{
 		{
-			expected: "/path_prefix_route1",
-			pathType: dataplane.PathTypePrefix,
+			upstreams: nil,
+			expected:  nil,
+			msg:       "no upstreams",
 		},
 		{
-			expected: "/path_exact_route1",
-			pathType: dataplane.PathTypeExact,
+			upstreams: []dataplane.Upstream{{Name: "foo"}},
+			expected:  nil,
+			msg:       "no next upstream",
+		},
+		{
+			upstreams: []dataplane.Upstream{
+				{Name: "foo"},
+				{
+					Name: "bar",
+					NextUpstream: &dataplane.NextUpstream{
+						Conditions: []dataplane.NextUpstreamCondition{
+							dataplane.NextUpstreamConditionError,
+							dataplane.NextUpstreamConditionTimeout,
+							dataplane.NextUpstreamConditionInvalidHeader,
+							dataplane.NextUpstreamConditionHTTP502,
+							dataplane.NextUpstreamConditionNonIdempotent,
+						},
+						Tries:   3,
+						Timeout: "10s",
+					},
+				},
+				{
+					Name: "baz",
+					NextUpstream: &dataplane.NextUpstream{
+						Conditions: []dataplane.NextUpstreamCondition{dataplane.NextUpstreamConditionOff},
+					},
+				},
+			},
+			expected: &http.ProxyNextUpstream{
+				Conditions: "error timeout invalid_header http_502 non_idempotent",
+				Tries:      3,
+				Timeout:    "10s",
+			},
+			msg: "first upstream with next upstream",
 		},
 	}
 
 	for _, tc := range tests {
-		result := createPathForMatch("/path", tc.pathType, 1)
-		g.Expect(result).To(Equal(tc.expected))
+		t.Run(tc.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(createProxyNextUpstream(tc.upstreams)).To(Equal(tc.expected))
+		})
 	}
 }
 
-func TestGenerateProxySetHeaders(t *testing.T) {
+func TestCreateProxyTimeouts(t *testing.T) {
 	g := NewGomegaWithT(t)
 
-	filters := dataplane.HTTPHeaderFilter{
-		Add: []dataplane.HTTPHeader{
+	g.Expect(createProxyTimeouts(nil)).To(BeNil())
+	g.Expect(createProxyTimeouts(&dataplane.Timeouts{BackendRequest: "10s"})).To(
+		Equal(&http.ProxyTimeouts{Backend: "10s"}),
+	)
+}
+
+func TestCreateLocationsTimeouts(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source: route,
+					BackendGroup: dataplane.BackendGroup{
+						Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+						Backends: []dataplane.Backend{
+							{
+								UpstreamName: "test_foo_80",
+								Valid:        true,
+								Weight:       1,
+							},
+						},
+					},
+					Timeouts: &dataplane.Timeouts{BackendRequest: "10s"},
+				},
+			},
+		},
+	}
+
+	upstreams := []dataplane.Upstream{{Name: "test_foo_80"}}
+
+	expLocations := []http.Location{
+		{
+			Path:          "/",
+			ProxyPass:     "http://test_foo_80",
+			ProxyTimeouts: &http.ProxyTimeouts{Backend: "10s"},
+		},
+	}
+
+	upstreamsByName := map[string]dataplane.Upstream{"test_foo_80": upstreams[0]}
+	g.Expect(createLocations(pathRules, 80, upstreamsByName)).To(Equal(expLocations))
+
+	servers := string(executeServers(dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
 			{
-				Name:  "Authorization",
-				Value: "my-auth",
+				Hostname:  "example.com",
+				PathRules: pathRules,
+				Port:      8080,
 			},
 		},
-		Set: []dataplane.HTTPHeader{
+		Upstreams: upstreams,
+	}))
+
+	g.Expect(servers).To(ContainSubstring("proxy_connect_timeout 10s;"))
+	g.Expect(servers).To(ContainSubstring("proxy_send_timeout 10s;"))
+	g.Expect(servers).To(ContainSubstring("proxy_read_timeout 10s;"))
+	g.Expect(servers).ToNot(ContainSubstring("proxy_next_upstream_timeout"))
+}
+
+func TestCreateLocationsUpstreamSettings(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source: route,
+					BackendGroup: dataplane.BackendGroup{
+						Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+						Backends: []dataplane.Backend{
+							{
+								UpstreamName: "test_foo_80",
+								Valid:        true,
+								Weight:       1,
+							},
+						},
+					},
+					Filters: dataplane.Filters{
+						RequestHeaderModifiers: &dataplane.HTTPHeaderFilter{
+							Set: []dataplane.HTTPHeader{
+								{Name: "X-Foo", Value: "bar"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	upstreams := []dataplane.Upstream{
+		{
+			Name:      "test_foo_80",
+			KeepAlive: &dataplane.UpstreamKeepAlive{Connections: 16},
+			NextUpstream: &dataplane.NextUpstream{
+				Conditions: []dataplane.NextUpstreamCondition{
+					dataplane.NextUpstreamConditionError,
+					dataplane.NextUpstreamConditionHTTP503,
+				},
+				Tries:   2,
+				Timeout: "5s",
+			},
+		},
+	}
+
+	expLocations := []http.Location{
+		{
+			Path:             "/",
+			ProxyPass:        "http://test_foo_80",
+			ProxyHTTPVersion: "1.1",
+			ProxySetHeaders: []http.Header{
+				{Name: "X-Foo", Value: "bar"},
+				{Name: "Connection", Value: ""},
+			},
+			ProxyNextUpstream: &http.ProxyNextUpstream{
+				Conditions: "error http_503",
+				Tries:      2,
+				Timeout:    "5s",
+			},
+		},
+	}
+
+	upstreamsByName := map[string]dataplane.Upstream{"test_foo_80": upstreams[0]}
+	g.Expect(createLocations(pathRules, 80, upstreamsByName)).To(Equal(expLocations))
+
+	servers := string(executeServers(dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
 			{
-				Name:  "Accept-Encoding",
-				Value: "gzip",
+				Hostname:  "example.com",
+				PathRules: pathRules,
+				Port:      8080,
+			},
+		},
+		Upstreams: upstreams,
+	}))
+
+	g.Expect(servers).To(ContainSubstring("proxy_http_version 1.1;"))
+	g.Expect(servers).To(ContainSubstring(`proxy_set_header Connection "";`))
+	g.Expect(servers).To(ContainSubstring("proxy_next_upstream error http_503;"))
+	g.Expect(servers).To(ContainSubstring("proxy_next_upstream_tries 2;"))
+	g.Expect(servers).To(ContainSubstring("proxy_next_upstream_timeout 5s;"))
+}
+
+func TestCreateLocationsRetry(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+							},
+						},
+					},
+				},
 			},
 		},
-		Remove: []string{"my-header"},
 	}
-	expectedHeaders := []http.Header{
+
+	// The retry of the rule overrides the next upstream configuration of the upstreams,
+	// including for the traffic split between the upstreams.
+	pathRules := []dataplane.PathRule{
 		{
-			Name:  "Authorization",
-			Value: "${authorization_header_var}my-auth",
+			Path:     "/",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source: route,
+					BackendGroup: dataplane.BackendGroup{
+						Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+						Backends: []dataplane.Backend{
+							{
+								UpstreamName: "test_foo_80",
+								Valid:        true,
+								Weight:       1,
+							},
+							{
+								UpstreamName: "test_bar_80",
+								Valid:        true,
+								Weight:       1,
+							},
+						},
+					},
+					Retry: &dataplane.NextUpstream{
+						Conditions: []dataplane.NextUpstreamCondition{
+							dataplane.NextUpstreamConditionError,
+							dataplane.NextUpstreamConditionHTTP502,
+							dataplane.NextUpstreamConditionHTTP503,
+						},
+						Tries:   3,
+						Timeout: "10s",
+					},
+				},
+			},
+		},
+	}
+
+	upstreams := map[string]dataplane.Upstream{
+		"test_foo_80": {
+			Name: "test_foo_80",
+			NextUpstream: &dataplane.NextUpstream{
+				Conditions: []dataplane.NextUpstreamCondition{dataplane.NextUpstreamConditionOff},
+			},
+		},
+		"test_bar_80": {
+			Name: "test_bar_80",
 		},
+	}
+
+	expLocations := []http.Location{
 		{
-			Name:  "Accept-Encoding",
-			Value: "gzip",
+			Path:      "/",
+			ProxyPass: "http://$test__route1_rule0",
+			ProxyNextUpstream: &http.ProxyNextUpstream{
+				Conditions: "error http_502 http_503",
+				Tries:      3,
+				Timeout:    "10s",
+			},
+		},
+	}
+
+	g.Expect(createLocations(pathRules, 80, upstreams)).To(Equal(expLocations))
+}
+
+func TestCreateLocationsRateLimit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+							},
+						},
+					},
+				},
+			},
 		},
+	}
+
+	pathRules := []dataplane.PathRule{
 		{
-			Name:  "my-header",
-			Value: "",
+			Path:     "/",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source: route,
+					BackendGroup: dataplane.BackendGroup{
+						Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+						Backends: []dataplane.Backend{
+							{
+								UpstreamName: "test_foo_80",
+								Valid:        true,
+								Weight:       1,
+							},
+						},
+					},
+					RateLimit: &dataplane.RateLimit{
+						Zone: "ratelimit_test_policy",
+						Key: dataplane.RateLimitKey{
+							Type: dataplane.RateLimitKeyTypeHeader,
+							Name: "X-User-ID",
+						},
+						Burst:  5,
+						DryRun: true,
+					},
+				},
+			},
 		},
 	}
 
-	headers := generateProxySetHeaders(&filters)
-	g.Expect(headers).To(Equal(expectedHeaders))
+	upstreams := map[string]dataplane.Upstream{
+		"test_foo_80": {
+			Name: "test_foo_80",
+		},
+	}
+
+	expLocations := []http.Location{
+		{
+			Path:      "/",
+			ProxyPass: "http://test_foo_80",
+			RateLimit: &http.LocationRateLimit{
+				Zone:   "ratelimit_test_policy",
+				Burst:  5,
+				DryRun: true,
+			},
+		},
+	}
+
+	locs := createLocations(pathRules, 80, upstreams)
+	g.Expect(locs).To(Equal(expLocations))
+
+	servers := string(execute(serversTemplate, []http.Server{{ServerName: "example.com", Locations: locs}}))
+
+	g.Expect(servers).To(ContainSubstring("limit_req zone=ratelimit_test_policy burst=5;"))
+	g.Expect(servers).To(ContainSubstring("limit_req_status 429;"))
+	g.Expect(servers).To(ContainSubstring("limit_req_dry_run on;"))
+}
+
+func TestCreateLocationsTracing(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source: route,
+					BackendGroup: dataplane.BackendGroup{
+						Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+						Backends: []dataplane.Backend{
+							{
+								UpstreamName: "test_foo_80",
+								Valid:        true,
+								Weight:       1,
+							},
+						},
+					},
+					TracingRatio: helpers.GetPointer[int32](25),
+				},
+			},
+		},
+	}
+
+	upstreams := map[string]dataplane.Upstream{
+		"test_foo_80": {
+			Name: "test_foo_80",
+		},
+	}
+
+	expLocations := []http.Location{
+		{
+			Path:      "/",
+			ProxyPass: "http://test_foo_80",
+			OTelTrace: "$otel_trace_ratio_25",
+		},
+	}
+
+	locs := createLocations(pathRules, 80, upstreams)
+	g.Expect(locs).To(Equal(expLocations))
+
+	servers := string(execute(serversTemplate, []http.Server{{ServerName: "example.com", Locations: locs}}))
+
+	g.Expect(servers).To(ContainSubstring("otel_trace $otel_trace_ratio_25;"))
+}
+
+func TestCreateLocationsAccessLog(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "route1",
+		},
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source:   route,
+					Listener: "listener-80",
+					BackendGroup: dataplane.BackendGroup{
+						Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+						Backends: []dataplane.Backend{
+							{
+								UpstreamName: "test_foo_80",
+								Valid:        true,
+								Weight:       1,
+							},
+						},
+					},
+					AccessLog: &dataplane.AccessLog{
+						Format: dataplane.AccessLogFormatJSON,
+					},
+				},
+			},
+		},
+	}
+
+	upstreams := map[string]dataplane.Upstream{
+		"test_foo_80": {
+			Name: "test_foo_80",
+		},
+	}
+
+	expLocations := []http.Location{
+		{
+			Path:      "/",
+			ProxyPass: "http://test_foo_80",
+			AccessLog: &http.AccessLog{
+				Path:   "/dev/stdout",
+				Format: "nkg_json",
+			},
+			Variables: []http.Variable{
+				{Name: "nkg_listener", Value: "listener-80"},
+				{Name: "nkg_route_namespace", Value: "test"},
+				{Name: "nkg_route_name", Value: "route1"},
+				{Name: "nkg_route_rule", Value: "0"},
+			},
+		},
+	}
+
+	locs := createLocations(pathRules, 80, upstreams)
+	g.Expect(locs).To(Equal(expLocations))
+
+	servers := string(execute(serversTemplate, []http.Server{{ServerName: "example.com", Locations: locs}}))
+
+	g.Expect(servers).To(ContainSubstring(`set $nkg_listener "listener-80";`))
+	g.Expect(servers).To(ContainSubstring(`set $nkg_route_namespace "test";`))
+	g.Expect(servers).To(ContainSubstring(`set $nkg_route_name "route1";`))
+	g.Expect(servers).To(ContainSubstring(`set $nkg_route_rule "0";`))
+	g.Expect(servers).To(ContainSubstring("access_log /dev/stdout nkg_json;"))
+}
+
+func TestCreateServersClientSettings(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/upload"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	serverSettings := &dataplane.ClientSettings{
+		BodyMaxSize:   "10m",
+		HeaderTimeout: "30s",
+		KeepAliveTime: "1h",
+	}
+
+	httpServers := []dataplane.VirtualServer{
+		{
+			IsDefault:      true,
+			Port:           80,
+			ClientSettings: serverSettings,
+		},
+		{
+			Hostname: "cafe.example.com",
+			PathRules: []dataplane.PathRule{
+				{
+					Path:     "/upload",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Source: route,
+							BackendGroup: dataplane.BackendGroup{
+								Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+								Backends: []dataplane.Backend{
+									{
+										UpstreamName: "test_foo_80",
+										Valid:        true,
+										Weight:       1,
+									},
+								},
+							},
+							ClientSettings: &dataplane.ClientSettings{
+								BodyMaxSize:            "100m",
+								BodyTimeout:            "2m",
+								KeepAliveRequests:      100,
+								KeepAliveTimeout:       "75s",
+								KeepAliveHeaderTimeout: "60s",
+							},
+						},
+					},
+				},
+			},
+			Port:           80,
+			ClientSettings: serverSettings,
+		},
+	}
+
+	expServerSettings := &http.ClientSettings{
+		BodyMaxSize:   "10m",
+		HeaderTimeout: "30s",
+		KeepAliveTime: "1h",
+	}
+
+	expServers := []http.Server{
+		{
+			IsDefaultHTTP:  true,
+			Port:           80,
+			ClientSettings: expServerSettings,
+		},
+		{
+			ServerName: "cafe.example.com",
+			Locations: []http.Location{
+				{
+					Path:      "/upload/",
+					ProxyPass: "http://test_foo_80",
+					ClientSettings: &http.ClientSettings{
+						BodyMaxSize:       "100m",
+						BodyTimeout:       "2m",
+						KeepAliveRequests: 100,
+						KeepAliveTimeout:  "75s 60s",
+					},
+				},
+				{
+					Path:      "= /upload",
+					ProxyPass: "http://test_foo_80",
+					ClientSettings: &http.ClientSettings{
+						BodyMaxSize:       "100m",
+						BodyTimeout:       "2m",
+						KeepAliveRequests: 100,
+						KeepAliveTimeout:  "75s 60s",
+					},
+				},
+				createDefaultRootLocation(),
+			},
+			Port:           80,
+			ClientSettings: expServerSettings,
+		},
+	}
+
+	servers := createServers(httpServers, nil, nil)
+	g.Expect(servers).To(Equal(expServers))
+
+	expSubStrings := map[string]int{
+		"client_max_body_size 10m;":  2,
+		"client_header_timeout 30s;": 2,
+		"keepalive_time 1h;":         2,
+		"client_max_body_size 100m;": 2,
+		"client_body_timeout 2m;":    2,
+		"keepalive_requests 100;":    2,
+		"keepalive_timeout 75s 60s;": 2,
+	}
+
+	cfg := string(execute(serversTemplate, servers))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(cfg, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestCreateClientSettings(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(createClientSettings(nil)).To(BeNil())
+
+	settings := &dataplane.ClientSettings{
+		KeepAliveTimeout: "0",
+	}
+
+	g.Expect(createClientSettings(settings)).To(Equal(&http.ClientSettings{KeepAliveTimeout: "0"}))
+}
+
+func TestCreateServersGzip(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/images"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	sslServers := []dataplane.VirtualServer{
+		{
+			IsDefault: true,
+			Port:      443,
+		},
+		{
+			Hostname: "cafe.example.com",
+			SSL:      &dataplane.SSL{KeyPairID: "test-keypair"},
+			PathRules: []dataplane.PathRule{
+				{
+					Path:     "/images",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Source: route,
+							BackendGroup: dataplane.BackendGroup{
+								Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+								Backends: []dataplane.Backend{
+									{
+										UpstreamName: "test_foo_80",
+										Valid:        true,
+										Weight:       1,
+									},
+								},
+							},
+							Compression: &dataplane.Compression{},
+						},
+					},
+				},
+			},
+			Port: 443,
+			Compression: &dataplane.Compression{
+				Enable:    true,
+				Types:     []string{"application/json", "text/css"},
+				MinLength: helpers.GetPointer[int32](1000),
+				Level:     5,
+				Vary:      helpers.GetPointer(true),
+			},
+		},
+	}
+
+	servers := createServers(nil, sslServers, nil)
+
+	g.Expect(servers).To(HaveLen(2))
+	g.Expect(servers[0].Gzip).To(BeNil())
+	g.Expect(servers[1].Gzip).To(Equal(&http.Gzip{
+		Enable:    true,
+		Types:     "application/json text/css",
+		MinLength: "1000",
+		Level:     5,
+		Vary:      "on",
+	}))
+
+	for _, loc := range servers[1].Locations[:2] {
+		g.Expect(loc.Gzip).To(Equal(&http.Gzip{}))
+	}
+
+	expSubStrings := map[string]int{
+		"gzip on;":                              1,
+		"gzip_types application/json text/css;": 1,
+		"gzip_min_length 1000;":                 1,
+		"gzip_comp_level 5;":                    1,
+		"gzip_vary on;":                         1,
+		"gzip off;":                             2,
+	}
+
+	cfg := string(execute(serversTemplate, servers))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(cfg, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestCreateServersSnippets(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/images"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	httpServers := []dataplane.VirtualServer{
+		{
+			IsDefault: true,
+			Port:      80,
+		},
+		{
+			Hostname: "cafe.example.com",
+			PathRules: []dataplane.PathRule{
+				{
+					Path:     "/images",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Source: route,
+							BackendGroup: dataplane.BackendGroup{
+								Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+								Backends: []dataplane.Backend{
+									{
+										UpstreamName: "test_foo_80",
+										Valid:        true,
+										Weight:       1,
+									},
+								},
+							},
+							Snippet: "proxy_buffer_size 16k;",
+						},
+					},
+				},
+			},
+			Snippet: "large_client_header_buffers 4 16k;",
+			Port:    80,
+		},
+	}
+
+	servers := createServers(httpServers, nil, nil)
+
+	g.Expect(servers).To(HaveLen(2))
+	g.Expect(servers[0].Snippet).To(BeEmpty())
+	g.Expect(servers[1].Snippet).To(Equal("large_client_header_buffers 4 16k;"))
+
+	// the locations of the route, but not the default root location
+	g.Expect(servers[1].Locations).To(HaveLen(3))
+	for _, loc := range servers[1].Locations[:2] {
+		g.Expect(loc.Snippet).To(Equal("proxy_buffer_size 16k;"))
+	}
+	g.Expect(servers[1].Locations[2].Snippet).To(BeEmpty())
+
+	expSubStrings := map[string]int{
+		"\n    large_client_header_buffers 4 16k;\n": 1,
+		"\n        proxy_buffer_size 16k;\n":         2,
+	}
+
+	cfg := string(execute(serversTemplate, servers))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(cfg, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestCreateServersErrorPages(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/coffee"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	gatewayPages := []dataplane.ErrorPage{
+		{
+			Codes:    []int32{404},
+			Response: &dataplane.ErrorPageResponse{FileID: "error_page_test_gateway_0"},
+		},
+	}
+
+	routePages := []dataplane.ErrorPage{
+		{
+			Codes:    []int32{502, 503},
+			Response: &dataplane.ErrorPageResponse{FileID: "error_page_test_route_0", StatusCode: 200},
+		},
+		{
+			Codes:    []int32{504},
+			Redirect: &dataplane.ErrorPageRedirect{URL: "https://example.com/timeout", StatusCode: 302},
+		},
+	}
+
+	httpServers := []dataplane.VirtualServer{
+		{
+			IsDefault:  true,
+			Port:       80,
+			ErrorPages: gatewayPages,
+		},
+		{
+			Hostname: "cafe.example.com",
+			PathRules: []dataplane.PathRule{
+				{
+					Path:     "/coffee",
+					PathType: dataplane.PathTypePrefix,
+					MatchRules: []dataplane.MatchRule{
+						{
+							Source: route,
+							BackendGroup: dataplane.BackendGroup{
+								Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+								Backends: []dataplane.Backend{
+									{
+										UpstreamName: "test_foo_80",
+										Valid:        true,
+										Weight:       1,
+									},
+								},
+							},
+							ErrorPages: routePages,
+						},
+					},
+				},
+			},
+			Port:       80,
+			ErrorPages: gatewayPages,
+		},
+	}
+
+	servers := createServers(httpServers, nil, nil)
+
+	g.Expect(servers).To(HaveLen(2))
+
+	gatewayErrorPages := []http.ErrorPage{
+		{Codes: "404", URI: "/_nkg-error-page/error_page_test_gateway_0"},
+	}
+
+	gatewayErrorPageLoc := http.Location{
+		Path:     "= /_nkg-error-page/error_page_test_gateway_0",
+		Internal: true,
+		ErrorPageResponse: &http.ErrorPageResponse{
+			ContentType: "text/html",
+			File:        "/etc/nginx/error-pages/error_page_test_gateway_0",
+		},
+	}
+
+	g.Expect(servers[0].ErrorPages).To(Equal(gatewayErrorPages))
+	g.Expect(servers[0].Locations).To(Equal([]http.Location{gatewayErrorPageLoc}))
+
+	g.Expect(servers[1].ErrorPages).To(Equal(gatewayErrorPages))
+
+	// The locations of the route, the error page location of the route, the default root location and
+	// the error page location of the server.
+	locs := servers[1].Locations
+	g.Expect(locs).To(HaveLen(5))
+
+	for _, loc := range locs[:2] {
+		g.Expect(loc.ErrorPages).To(Equal([]http.ErrorPage{
+			{Codes: "502 503", Response: "=200", URI: "/_nkg-error-page/error_page_test_route_0"},
+			{Codes: "504", Response: "=302", URI: "https://example.com/timeout"},
+		}))
+	}
+
+	g.Expect(locs[2].Path).To(Equal("= /_nkg-error-page/error_page_test_route_0"))
+	g.Expect(locs[4]).To(Equal(gatewayErrorPageLoc))
+
+	expSubStrings := map[string]int{
+		`error_page 404 "/_nkg-error-page/error_page_test_gateway_0";`:        2,
+		`error_page 502 503 =200 "/_nkg-error-page/error_page_test_route_0";`: 2,
+		`error_page 504 =302 "https://example.com/timeout";`:                  2,
+		"proxy_intercept_errors on;":                                          4,
+		"location = /_nkg-error-page/error_page_test_gateway_0 {":             2,
+		"location = /_nkg-error-page/error_page_test_route_0 {":               1,
+		`default_type "text/html";`:                                           3,
+		"alias /etc/nginx/error-pages/error_page_test_gateway_0;":             2,
+		"alias /etc/nginx/error-pages/error_page_test_route_0;":               1,
+		"location / {\n        return 404;\n    }":                            1,
+	}
+
+	cfg := string(execute(serversTemplate, servers))
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(cfg, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestCreateGzip(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(createGzip(nil)).To(BeNil())
+
+	compression := &dataplane.Compression{
+		Enable:    true,
+		MinLength: helpers.GetPointer[int32](0),
+		Vary:      helpers.GetPointer(false),
+	}
+
+	g.Expect(createGzip(compression)).To(Equal(&http.Gzip{Enable: true, MinLength: "0", Vary: "off"}))
+}
+
+func TestCreateMatchLocation(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	expected := http.Location{
+		Path:     "/path",
+		Internal: true,
+	}
+
+	result := createMatchLocation("/path")
+	g.Expect(result).To(Equal(expected))
+}
+
+func TestCreatePathForMatch(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	tests := []struct {
+		expected string
+		pathType dataplane.PathType
+		panic    bool
+	}{
+		{
+			expected: "/path_prefix_route1",
+			pathType: dataplane.PathTypePrefix,
+		},
+		{
+			expected: "/path_exact_route1",
+			pathType: dataplane.PathTypeExact,
+		},
+	}
+
+	for _, tc := range tests {
+		result := createPathForMatch("/path", tc.pathType, 1)
+		g.Expect(result).To(Equal(tc.expected))
+	}
+}
+
+func TestGenerateProxySetHeaders(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	filters := dataplane.HTTPHeaderFilter{
+		Add: []dataplane.HTTPHeader{
+			{
+				Name:  "Authorization",
+				Value: "my-auth",
+			},
+		},
+		Set: []dataplane.HTTPHeader{
+			{
+				Name:  "Accept-Encoding",
+				Value: "gzip",
+			},
+		},
+		Remove: []string{"my-header"},
+	}
+	expectedHeaders := []http.Header{
+		{
+			Name:  "Authorization",
+			Value: "${authorization_header_var}my-auth",
+		},
+		{
+			Name:  "Accept-Encoding",
+			Value: "gzip",
+		},
+		{
+			Name:  "my-header",
+			Value: "",
+		},
+	}
+
+	headers := generateProxySetHeaders(&filters)
+	g.Expect(headers).To(Equal(expectedHeaders))
+}
+
+func TestCreateLocationsAuthentication(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "route1",
+		},
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/dashboard"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchExact),
+							},
+						},
+					},
+				},
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/api"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchExact),
+							},
+						},
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/v2/api"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchExact),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	backendGroup := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+
+	basicAuth := &dataplane.Authentication{
+		Realm:  "Dashboards",
+		FileID: "auth_test_basic",
+	}
+
+	jwtAuth := &dataplane.Authentication{
+		JWT: &dataplane.JWTAuthentication{
+			RequiredClaims: map[string]string{"iss": "issuer"},
+		},
+		Realm:  "API",
+		FileID: "auth_test_jwt",
+	}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/dashboard",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source:       route,
+					BackendGroup: backendGroup,
+					Filters: dataplane.Filters{
+						Authentication: basicAuth,
+					},
+				},
+			},
+		},
+		{
+			Path:     "/api",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source:       route,
+					RuleIdx:      1,
+					BackendGroup: backendGroup,
+					Filters: dataplane.Filters{
+						Authentication: jwtAuth,
+					},
+				},
+			},
+		},
+		{
+			Path:     "/v2/api",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source:       route,
+					MatchIdx:     1,
+					RuleIdx:      1,
+					BackendGroup: backendGroup,
+					Filters: dataplane.Filters{
+						Authentication: jwtAuth,
+					},
+				},
+			},
+		},
+	}
+
+	upstreams := map[string]dataplane.Upstream{
+		"test_foo_80": {
+			Name: "test_foo_80",
+		},
+	}
+
+	expLocations := []http.Location{
+		{
+			Path:      "= /dashboard",
+			ProxyPass: "http://test_foo_80",
+			AuthBasic: &http.AuthBasic{
+				Realm:    "Dashboards",
+				UserFile: "/etc/nginx/secrets/auth_test_basic",
+			},
+		},
+		{
+			Path:      "= /api",
+			ProxyPass: "http://test_foo_80",
+			AuthJWT:   "/_nkg_jwt_auth_test_jwt",
+		},
+		{
+			Path:      "= /v2/api",
+			ProxyPass: "http://test_foo_80",
+			AuthJWT:   "/_nkg_jwt_auth_test_jwt",
+		},
+		{
+			Path:          "= /_nkg_jwt_auth_test_jwt",
+			Internal:      true,
+			JWTValidation: true,
+			Variables: []http.Variable{
+				{Name: "jwt_jwks_file", Value: "/etc/nginx/secrets/auth_test_jwt"},
+				{Name: "jwt_realm", Value: "API"},
+				{Name: "jwt_required_claims", Value: "eyJpc3MiOiJpc3N1ZXIifQ=="},
+			},
+		},
+		{
+			Path:   "/",
+			Return: &http.Return{Code: http.StatusNotFound},
+		},
+	}
+
+	locs := createLocations(pathRules, 80, upstreams)
+	g.Expect(locs).To(Equal(expLocations))
+
+	servers := string(execute(serversTemplate, []http.Server{{ServerName: "example.com", Locations: locs}}))
+
+	g.Expect(servers).To(ContainSubstring(`auth_basic "Dashboards";`))
+	g.Expect(servers).To(ContainSubstring("auth_basic_user_file /etc/nginx/secrets/auth_test_basic;"))
+	g.Expect(strings.Count(servers, "auth_request /_nkg_jwt_auth_test_jwt;")).To(Equal(2))
+	g.Expect(servers).To(ContainSubstring("auth_request_set $jwt_www_authenticate $sent_http_www_authenticate;"))
+	g.Expect(servers).To(ContainSubstring("add_header WWW-Authenticate $jwt_www_authenticate always;"))
+	g.Expect(strings.Count(servers, "location = /_nkg_jwt_auth_test_jwt {")).To(Equal(1))
+	g.Expect(servers).To(ContainSubstring(`set $jwt_jwks_file "/etc/nginx/secrets/auth_test_jwt";`))
+	g.Expect(servers).To(ContainSubstring(`set $jwt_realm "API";`))
+	g.Expect(servers).To(ContainSubstring("js_content jwt.validate;"))
+}
+
+func TestCreateLocationsExternalAuthentication(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "route1",
+		},
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/api"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchExact),
+							},
+						},
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/v2/api"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchExact),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	backendGroup := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+
+	externalAuth := &dataplane.Authentication{
+		External: &dataplane.ExternalAuthentication{
+			UpstreamName:    "ext_auth_test_filter",
+			Path:            "/auth",
+			RequestHeaders:  []string{"Authorization"},
+			ResponseHeaders: []string{"X-User-Id"},
+		},
+	}
+
+	// both paths belong to the same rule, so they share the internal location
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/api",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source:       route,
+					BackendGroup: backendGroup,
+					Filters: dataplane.Filters{
+						Authentication: externalAuth,
+					},
+				},
+			},
+		},
+		{
+			Path:     "/v2/api",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source:       route,
+					MatchIdx:     1,
+					BackendGroup: backendGroup,
+					Filters: dataplane.Filters{
+						Authentication: externalAuth,
+					},
+				},
+			},
+		},
+	}
+
+	upstreams := map[string]dataplane.Upstream{
+		"test_foo_80": {
+			Name: "test_foo_80",
+		},
+	}
+
+	authExternal := &http.AuthExternal{
+		URI: "/_nkg_ext_auth_test_route1_0",
+		Variables: []http.Variable{
+			{Name: "ext_auth_www_authenticate", Value: "$upstream_http_www_authenticate"},
+			{Name: "ext_auth_x_user_id", Value: "$upstream_http_x_user_id"},
+		},
+	}
+	proxySetHeaders := []http.Header{
+		{Name: "X-User-Id", Value: "$ext_auth_x_user_id"},
+	}
+
+	expLocations := []http.Location{
+		{
+			Path:            "= /api",
+			ProxyPass:       "http://test_foo_80",
+			ProxySetHeaders: proxySetHeaders,
+			AuthExternal:    authExternal,
+		},
+		{
+			Path:            "= /v2/api",
+			ProxyPass:       "http://test_foo_80",
+			ProxySetHeaders: proxySetHeaders,
+			AuthExternal:    authExternal,
+		},
+		{
+			Path:     "= /_nkg_ext_auth_test_route1_0",
+			Internal: true,
+			ExternalAuthSubrequest: &http.ExternalAuthSubrequest{
+				ProxyPass: "http://ext_auth_test_filter/auth",
+				Headers: []http.Header{
+					{Name: "Authorization", Value: "$http_authorization"},
+				},
+			},
+		},
+		{
+			Path:   "/",
+			Return: &http.Return{Code: http.StatusNotFound},
+		},
+	}
+
+	locs := createLocations(pathRules, 80, upstreams)
+	g.Expect(locs).To(Equal(expLocations))
+
+	servers := string(execute(serversTemplate, []http.Server{{ServerName: "example.com", Locations: locs}}))
+
+	g.Expect(strings.Count(servers, "auth_request /_nkg_ext_auth_test_route1_0;")).To(Equal(2))
+	g.Expect(servers).To(ContainSubstring("auth_request_set $ext_auth_x_user_id $upstream_http_x_user_id;"))
+	g.Expect(servers).To(ContainSubstring(`proxy_set_header X-User-Id "$ext_auth_x_user_id";`))
+	g.Expect(servers).To(ContainSubstring("add_header WWW-Authenticate $ext_auth_www_authenticate always;"))
+	g.Expect(strings.Count(servers, "location = /_nkg_ext_auth_test_route1_0 {")).To(Equal(1))
+	g.Expect(servers).To(ContainSubstring("proxy_pass_request_body off;"))
+	g.Expect(servers).To(ContainSubstring("proxy_pass_request_headers off;"))
+	g.Expect(servers).To(ContainSubstring(`proxy_set_header Authorization "$http_authorization";`))
+	g.Expect(servers).To(ContainSubstring("proxy_pass http://ext_auth_test_filter/auth;"))
+}
+
+func TestCreateLocationsCORS(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "route1",
+		},
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/api"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchExact),
+							},
+							Method: helpers.GetPointer(v1beta1.HTTPMethodPut),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/api",
+			PathType: dataplane.PathTypeExact,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source: route,
+					BackendGroup: dataplane.BackendGroup{
+						Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+						Backends: []dataplane.Backend{
+							{
+								UpstreamName: "test_foo_80",
+								Valid:        true,
+								Weight:       1,
+							},
+						},
+					},
+					CORS: &dataplane.CORS{
+						AllowOrigins: []string{"https://example.com"},
+						AllowMethods: []string{"PUT"},
+					},
+				},
+			},
+		},
+	}
+
+	upstreams := map[string]dataplane.Upstream{
+		"test_foo_80": {
+			Name: "test_foo_80",
+		},
+	}
+
+	// The match of the rule with CORS lets the NJS httpmatches module redirect the preflight requests
+	// to the internal location, which responds to them.
+	expMatches := []httpMatch{
+		{
+			Method:       v1beta1.HTTPMethodPut,
+			RedirectPath: "/api_exact_route0",
+			CORS:         true,
+		},
+	}
+
+	expLocations := []http.Location{
+		{
+			Path:      "/api_exact_route0",
+			Internal:  true,
+			ProxyPass: "http://test_foo_80",
+			CORS: &http.CORS{
+				PreflightHeaders: []http.Header{
+					{Name: "Access-Control-Allow-Origin", Value: "$cors_origin_test__route1_rule0"},
+					{Name: "Access-Control-Allow-Methods", Value: "PUT"},
+					{Name: "Vary", Value: "Origin"},
+				},
+				Headers: []http.Header{
+					{Name: "Access-Control-Allow-Origin", Value: "$cors_origin_test__route1_rule0"},
+					{Name: "Vary", Value: "Origin"},
+				},
+			},
+		},
+		{
+			Path:         "= /api",
+			HTTPMatchVar: convertMatchesToString(expMatches),
+		},
+		{
+			Path:   "/",
+			Return: &http.Return{Code: http.StatusNotFound},
+		},
+	}
+
+	locs := createLocations(pathRules, 80, upstreams)
+	g.Expect(helpers.Diff(expLocations, locs)).To(BeEmpty())
+
+	servers := string(execute(serversTemplate, []http.Server{{ServerName: "example.com", Locations: locs}}))
+
+	g.Expect(servers).To(ContainSubstring("if ($cors_preflight) {"))
+	g.Expect(servers).To(ContainSubstring(
+		`add_header Access-Control-Allow-Methods "PUT" always;
+            add_header Vary "Origin" always;
+            return 204;
+        }`))
+	g.Expect(servers).To(ContainSubstring("proxy_hide_header Access-Control-Allow-Origin;"))
+	g.Expect(servers).To(ContainSubstring(
+		`add_header Access-Control-Allow-Origin "$cors_origin_test__route1_rule0" always;`,
+	))
+	g.Expect(servers).To(ContainSubstring(`\"cors\":true`))
+}
+
+func TestCreateLocationsAccessControl(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source: route,
+					BackendGroup: dataplane.BackendGroup{
+						Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+						Backends: []dataplane.Backend{
+							{
+								UpstreamName: "test_foo_80",
+								Valid:        true,
+								Weight:       1,
+							},
+						},
+					},
+					AccessControl: &dataplane.AccessControl{
+						Allow: []string{"10.0.0.0/8"},
+						Deny:  []string{"10.0.1.0/24"},
+					},
+				},
+			},
+		},
+	}
+
+	upstreams := map[string]dataplane.Upstream{
+		"test_foo_80": {
+			Name: "test_foo_80",
+		},
+	}
+
+	expLocations := []http.Location{
+		{
+			Path:      "/",
+			ProxyPass: "http://test_foo_80",
+			AccessRules: []http.AccessRule{
+				{Directive: http.AccessDeny, Address: "10.0.1.0/24"},
+				{Directive: http.AccessAllow, Address: "10.0.0.0/8"},
+				{Directive: http.AccessDeny, Address: "all"},
+			},
+		},
+	}
+
+	locs := createLocations(pathRules, 80, upstreams)
+	g.Expect(locs).To(Equal(expLocations))
+
+	servers := string(execute(serversTemplate, []http.Server{{ServerName: "example.com", Locations: locs}}))
+
+	g.Expect(servers).To(ContainSubstring(`deny 10.0.1.0/24;
+        allow 10.0.0.0/8;
+        deny all;`))
+}
+
+func TestCreateLocationsCache(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source: route,
+					BackendGroup: dataplane.BackendGroup{
+						Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+						Backends: []dataplane.Backend{
+							{
+								UpstreamName: "test_foo_80",
+								Valid:        true,
+								Weight:       1,
+							},
+						},
+					},
+					Cache: &dataplane.Cache{
+						Zone: "cache_api",
+						Valid: []dataplane.CacheValid{
+							{Codes: []int32{200}, Duration: "10m"},
+						},
+						Bypass: []dataplane.CacheBypass{
+							{Type: dataplane.CacheBypassTypeHeader, Name: "X-No-Cache"},
+						},
+						StaleWhileRevalidate: true,
+					},
+				},
+			},
+		},
+	}
+
+	upstreams := map[string]dataplane.Upstream{
+		"test_foo_80": {
+			Name: "test_foo_80",
+		},
+	}
+
+	expLocations := []http.Location{
+		{
+			Path:      "/",
+			ProxyPass: "http://test_foo_80",
+			Cache: &http.LocationCache{
+				Zone:             "cache_api",
+				Key:              "$scheme$host$request_uri",
+				Valid:            []string{"200 10m"},
+				Bypass:           "$http_x_no_cache",
+				UseStale:         "updating",
+				BackgroundUpdate: true,
+			},
+		},
+	}
+
+	locs := createLocations(pathRules, 80, upstreams)
+	g.Expect(locs).To(Equal(expLocations))
+
+	servers := string(execute(serversTemplate, []http.Server{{ServerName: "example.com", Locations: locs}}))
+
+	g.Expect(servers).To(ContainSubstring(`proxy_cache cache_api;
+        proxy_cache_key "$scheme$host$request_uri";
+        proxy_cache_valid 200 10m;
+        proxy_cache_bypass $http_x_no_cache;
+        proxy_cache_use_stale updating;
+        proxy_cache_background_update on;`))
+}
+
+func TestCreateLocationsDirectResponse(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	route := &v1beta1.HTTPRoute{
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/"),
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	pathRules := []dataplane.PathRule{
+		{
+			Path:     "/",
+			PathType: dataplane.PathTypePrefix,
+			MatchRules: []dataplane.MatchRule{
+				{
+					Source: route,
+					BackendGroup: dataplane.BackendGroup{
+						Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+					},
+					Filters: dataplane.Filters{
+						DirectResponse: &dataplane.DirectResponse{
+							Body:        `{"status": "maintenance"}`,
+							ContentType: "application/json",
+							Headers: []dataplane.HTTPHeader{
+								{Name: "Retry-After", Value: "120"},
+							},
+							StatusCode: 503,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expLocations := []http.Location{
+		{
+			Path: "/",
+			Return: &http.Return{
+				Code: 503,
+				Body: `{\"status\": \"maintenance\"}`,
+			},
+			DirectResponse: &http.DirectResponse{
+				ContentType: "application/json",
+				Headers: []http.Header{
+					{Name: "Retry-After", Value: "120"},
+				},
+			},
+		},
+	}
+
+	locs := createLocations(pathRules, 80, nil)
+	g.Expect(locs).To(Equal(expLocations))
+
+	servers := string(execute(serversTemplate, []http.Server{{ServerName: "example.com", Locations: locs}}))
+
+	g.Expect(servers).To(ContainSubstring(`types { }
+        default_type "application/json";
+        add_header Retry-After "120" always;
+        return 503 "{\"status\": \"maintenance\"}";`))
+	g.Expect(servers).ToNot(ContainSubstring("proxy_pass"))
 }
@@ -0,0 +1,15 @@
+package config
+
+import (
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+// executeHTTPSnippet generates the snippet of the http context. The snippet is validated by the validation package,
+// so it is included as is.
+func executeHTTPSnippet(conf dataplane.Configuration) []byte {
+	if conf.HTTPSnippet == "" {
+		return nil
+	}
+
+	return []byte("\n" + conf.HTTPSnippet + "\n")
+}
@@ -0,0 +1,18 @@
+package config
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteHTTPSnippet(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(executeHTTPSnippet(dataplane.Configuration{})).To(BeEmpty())
+
+	result := executeHTTPSnippet(dataplane.Configuration{HTTPSnippet: "map_hash_bucket_size 128;"})
+	g.Expect(string(result)).To(Equal("\nmap_hash_bucket_size 128;\n"))
+}
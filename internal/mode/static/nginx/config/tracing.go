@@ -0,0 +1,112 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	gotemplate "text/template"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+var tracingTemplate = gotemplate.Must(gotemplate.New("tracing").Parse(tracingTemplateText))
+
+func executeTracing(conf dataplane.Configuration) []byte {
+	tracing := createTracing(conf)
+
+	return execute(tracingTemplate, tracing)
+}
+
+func createTracing(conf dataplane.Configuration) *http.Tracing {
+	if conf.Tracing == nil {
+		return nil
+	}
+
+	tracing := &http.Tracing{
+		Endpoint:    conf.Tracing.Endpoint,
+		Interval:    conf.Tracing.Interval,
+		ServiceName: conf.Tracing.ServiceName,
+		Context:     string(conf.Tracing.Context),
+		Trace:       createOTelTrace(conf.Tracing.Ratio),
+		BatchSize:   conf.Tracing.BatchSize,
+		BatchCount:  conf.Tracing.BatchCount,
+	}
+
+	// The ratios of the routes override the ratio of the Gateway in the locations.
+	ratios := map[int32]struct{}{conf.Tracing.Ratio: {}}
+
+	for _, s := range conf.HTTPServers {
+		addTracingRatios(ratios, s)
+	}
+	for _, s := range conf.SSLServers {
+		addTracingRatios(ratios, s)
+	}
+
+	tracing.Ratios = createTracingRatios(ratios)
+
+	return tracing
+}
+
+func addTracingRatios(ratios map[int32]struct{}, server dataplane.VirtualServer) {
+	for _, rule := range server.PathRules {
+		for _, r := range rule.MatchRules {
+			if r.TracingRatio != nil {
+				ratios[*r.TracingRatio] = struct{}{}
+			}
+		}
+	}
+}
+
+// createTracingRatios creates a split_clients for every ratio that NGINX cannot express with otel_trace on or off.
+// The split_clients hashes the trace ID, so that all spans of a trace share the decision.
+func createTracingRatios(ratios map[int32]struct{}) []http.TracingRatio {
+	sorted := make([]int32, 0, len(ratios))
+	for ratio := range ratios {
+		if ratio > 0 && ratio < 100 {
+			sorted = append(sorted, ratio)
+		}
+	}
+
+	if len(sorted) == 0 {
+		return nil
+	}
+
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	result := make([]http.TracingRatio, 0, len(sorted))
+
+	for _, ratio := range sorted {
+		result = append(result, http.TracingRatio{
+			VariableName: generateTracingRatioVariableName(ratio),
+			Percent:      ratio,
+		})
+	}
+
+	return result
+}
+
+// createOTelTrace returns the value of the otel_trace directive for the percentage of the requests to trace.
+func createOTelTrace(ratio int32) string {
+	switch {
+	case ratio <= 0:
+		return "off"
+	case ratio >= 100:
+		return "on"
+	default:
+		return "$" + generateTracingRatioVariableName(ratio)
+	}
+}
+
+// createLocationOTelTrace returns the value of the otel_trace directive of a location, which overrides
+// the directive of the http context. If the ratio is nil, the directive is omitted.
+func createLocationOTelTrace(ratio *int32) string {
+	if ratio == nil {
+		return ""
+	}
+
+	return createOTelTrace(*ratio)
+}
+
+func generateTracingRatioVariableName(ratio int32) string {
+	return fmt.Sprintf("otel_trace_ratio_%d", ratio)
+}
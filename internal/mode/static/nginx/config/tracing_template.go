@@ -0,0 +1,30 @@
+package config
+
+var tracingTemplateText = `
+{{- if . }}
+otel_exporter {
+    endpoint {{ .Endpoint }};
+    {{- if .Interval }}
+    interval {{ .Interval }};
+    {{- end }}
+    {{- if .BatchSize }}
+    batch_size {{ .BatchSize }};
+    {{- end }}
+    {{- if .BatchCount }}
+    batch_count {{ .BatchCount }};
+    {{- end }}
+}
+    {{- if .ServiceName }}
+otel_service_name {{ .ServiceName }};
+    {{- end }}
+otel_trace {{ .Trace }};
+otel_trace_context {{ .Context }};
+    {{- range $r := .Ratios }}
+
+split_clients $otel_trace_id ${{ $r.VariableName }} {
+    {{ $r.Percent }}% on;
+    * off;
+}
+    {{- end }}
+{{- end }}
+`
@@ -0,0 +1,171 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+)
+
+func TestExecuteTracing(t *testing.T) {
+	conf := dataplane.Configuration{
+		Tracing: &dataplane.Tracing{
+			Endpoint:    "otel-collector:4317",
+			Interval:    "5s",
+			ServiceName: "my-gateway",
+			Context:     dataplane.TraceContextPropagate,
+			BatchSize:   512,
+			BatchCount:  4,
+			Ratio:       10,
+		},
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				PathRules: []dataplane.PathRule{
+					{
+						MatchRules: []dataplane.MatchRule{
+							{TracingRatio: helpers.GetPointer[int32](50)},
+							{TracingRatio: helpers.GetPointer[int32](100)},
+							{},
+						},
+					},
+				},
+			},
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{
+				PathRules: []dataplane.PathRule{
+					{
+						MatchRules: []dataplane.MatchRule{
+							{TracingRatio: helpers.GetPointer[int32](50)},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"otel_exporter {":                  1,
+		"endpoint otel-collector:4317;":    1,
+		"interval 5s;":                     1,
+		"batch_size 512;":                  1,
+		"batch_count 4;":                   1,
+		"otel_service_name my-gateway;":    1,
+		"otel_trace $otel_trace_ratio_10;": 1,
+		"otel_trace_context propagate;":    1,
+		"split_clients $otel_trace_id $otel_trace_ratio_10 {\n    10% on;\n    * off;\n}": 1,
+		"split_clients $otel_trace_id $otel_trace_ratio_50 {\n    50% on;\n    * off;\n}": 1,
+		"split_clients": 2,
+	}
+
+	tracing := string(executeTracing(conf))
+	for expSubStr, expCount := range expSubStrings {
+		if expCount != strings.Count(tracing, expSubStr) {
+			t.Errorf(
+				"executeTracing() did not generate tracing with substring %q %d times. Tracing: %v",
+				expSubStr,
+				expCount,
+				tracing,
+			)
+		}
+	}
+
+	g := NewGomegaWithT(t)
+	g.Expect(strings.TrimSpace(string(executeTracing(dataplane.Configuration{})))).To(BeEmpty())
+}
+
+func TestCreateTracing(t *testing.T) {
+	tests := []struct {
+		conf     dataplane.Configuration
+		expected *http.Tracing
+		msg      string
+	}{
+		{
+			conf:     dataplane.Configuration{},
+			expected: nil,
+			msg:      "no tracing",
+		},
+		{
+			conf: dataplane.Configuration{
+				Tracing: &dataplane.Tracing{
+					Endpoint: "otel-collector:4317",
+					Context:  dataplane.TraceContextIgnore,
+					Ratio:    100,
+				},
+			},
+			expected: &http.Tracing{
+				Endpoint: "otel-collector:4317",
+				Context:  "ignore",
+				Trace:    "on",
+			},
+			msg: "trace all requests",
+		},
+		{
+			conf: dataplane.Configuration{
+				Tracing: &dataplane.Tracing{
+					Endpoint: "otel-collector:4317",
+					Context:  dataplane.TraceContextExtract,
+					Ratio:    0,
+				},
+				HTTPServers: []dataplane.VirtualServer{
+					{
+						PathRules: []dataplane.PathRule{
+							{
+								MatchRules: []dataplane.MatchRule{
+									{TracingRatio: helpers.GetPointer[int32](75)},
+									{TracingRatio: helpers.GetPointer[int32](5)},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &http.Tracing{
+				Endpoint: "otel-collector:4317",
+				Context:  "extract",
+				Trace:    "off",
+				Ratios: []http.TracingRatio{
+					{VariableName: "otel_trace_ratio_5", Percent: 5},
+					{VariableName: "otel_trace_ratio_75", Percent: 75},
+				},
+			},
+			msg: "route ratios",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(createTracing(test.conf)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestCreateOTelTrace(t *testing.T) {
+	tests := []struct {
+		expected string
+		ratio    int32
+	}{
+		{ratio: 0, expected: "off"},
+		{ratio: 1, expected: "$otel_trace_ratio_1"},
+		{ratio: 99, expected: "$otel_trace_ratio_99"},
+		{ratio: 100, expected: "on"},
+	}
+
+	for _, test := range tests {
+		g := NewGomegaWithT(t)
+		g.Expect(createOTelTrace(test.ratio)).To(Equal(test.expected))
+	}
+}
+
+func TestCreateLocationOTelTrace(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(createLocationOTelTrace(nil)).To(BeEmpty())
+	g.Expect(createLocationOTelTrace(helpers.GetPointer[int32](0))).To(Equal("off"))
+	g.Expect(createLocationOTelTrace(helpers.GetPointer[int32](30))).To(Equal("$otel_trace_ratio_30"))
+}
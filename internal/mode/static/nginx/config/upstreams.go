@@ -17,6 +17,14 @@ const (
 	nginx500Server = "unix:/var/lib/nginx/nginx-500-server.sock"
 	// invalidBackendRef is used as an upstream name for invalid backend references.
 	invalidBackendRef = "invalid-backend-ref"
+	// defaultLoadBalancingMethod is the load-balancing directive for upstreams that don't configure the method.
+	defaultLoadBalancingMethod = "random two least_conn"
+	// minZoneSizeKB is the minimum size of the shared memory zone of an upstream in kilobytes. NGINX requires
+	// at least 8 memory pages, and a part of the zone is used by the slab allocator itself.
+	minZoneSizeKB = 64
+	// zoneSizePerServerKB is the size of the shared memory zone of an upstream that each server takes,
+	// in kilobytes. A server takes about 800 bytes: 512k supports up to 648 servers.
+	zoneSizePerServerKB = 1
 )
 
 func executeUpstreams(conf dataplane.Configuration) []byte {
@@ -41,7 +49,9 @@ func createUpstreams(upstreams []dataplane.Upstream) []http.Upstream {
 func createUpstream(up dataplane.Upstream) http.Upstream {
 	if len(up.Endpoints) == 0 {
 		return http.Upstream{
-			Name: up.Name,
+			Name:                up.Name,
+			LoadBalancingMethod: createLoadBalancingMethod(up),
+			ZoneSize:            createZoneSize(1),
 			Servers: []http.UpstreamServer{
 				{
 					Address: nginx502Server,
@@ -53,19 +63,67 @@ func createUpstream(up dataplane.Upstream) http.Upstream {
 	upstreamServers := make([]http.UpstreamServer, len(up.Endpoints))
 	for idx, ep := range up.Endpoints {
 		upstreamServers[idx] = http.UpstreamServer{
-			Address: fmt.Sprintf("%s:%d", ep.Address, ep.Port),
+			Address:        fmt.Sprintf("%s:%d", ep.Address, ep.Port),
+			MaxConnections: up.MaxConnections,
+			MaxFails:       up.MaxFails,
+			FailTimeout:    up.FailTimeout,
 		}
 	}
 
 	return http.Upstream{
-		Name:    up.Name,
-		Servers: upstreamServers,
+		Name:                up.Name,
+		LoadBalancingMethod: createLoadBalancingMethod(up),
+		ZoneSize:            createZoneSize(len(upstreamServers)),
+		KeepAlive:           createUpstreamKeepAlive(up.KeepAlive),
+		Servers:             upstreamServers,
+	}
+}
+
+// createZoneSize returns the size of the shared memory zone for an upstream with the number of servers.
+func createZoneSize(servers int) string {
+	return fmt.Sprintf("%dk", minZoneSizeKB+zoneSizePerServerKB*servers)
+}
+
+func createUpstreamKeepAlive(keepAlive *dataplane.UpstreamKeepAlive) *http.UpstreamKeepAlive {
+	if keepAlive == nil {
+		return nil
+	}
+
+	return &http.UpstreamKeepAlive{
+		Connections: keepAlive.Connections,
+		Requests:    keepAlive.Requests,
+		Time:        keepAlive.Time,
+		Timeout:     keepAlive.Timeout,
+	}
+}
+
+// createLoadBalancingMethod returns the load-balancing directive with its parameters for the upstream.
+// For the round-robin method, it returns an empty string, because round-robin is the NGINX default
+// and doesn't have a directive.
+func createLoadBalancingMethod(up dataplane.Upstream) string {
+	switch up.LoadBalancingMethod {
+	case "", dataplane.LoadBalancingMethodRandomTwoLeastConn:
+		return defaultLoadBalancingMethod
+	case dataplane.LoadBalancingMethodRoundRobin:
+		return ""
+	case dataplane.LoadBalancingMethodLeastConn:
+		return "least_conn"
+	case dataplane.LoadBalancingMethodIPHash:
+		return "ip_hash"
+	case dataplane.LoadBalancingMethodHash:
+		return "hash " + up.HashMethodKey
+	case dataplane.LoadBalancingMethodHashConsistent:
+		return "hash " + up.HashMethodKey + " consistent"
+	default:
+		panic(fmt.Sprintf("unsupported load-balancing method: %s", up.LoadBalancingMethod))
 	}
 }
 
 func createInvalidBackendRefUpstream() http.Upstream {
 	return http.Upstream{
-		Name: invalidBackendRef,
+		Name:                invalidBackendRef,
+		LoadBalancingMethod: defaultLoadBalancingMethod,
+		ZoneSize:            createZoneSize(1),
 		Servers: []http.UpstreamServer{
 			{
 				Address: nginx500Server,
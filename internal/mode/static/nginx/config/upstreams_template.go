@@ -1,15 +1,30 @@
 package config
 
-// FIXME(kate-osborn): Dynamically calculate upstream zone size based on the number of upstreams.
-// 512k will support up to 648 upstream servers.
-// https://github.com/nginxinc/nginx-kubernetes-gateway/issues/483
 var upstreamsTemplateText = `
 {{ range $u := . }}
 upstream {{ $u.Name }} {
-    random two least_conn;
-    zone {{ $u.Name }} 512k;
+    {{- if $u.LoadBalancingMethod }}
+    {{ $u.LoadBalancingMethod }};
+    {{- end }}
+    zone {{ $u.Name }} {{ $u.ZoneSize }};
     {{ range $server := $u.Servers }} 
-    server {{ $server.Address }};
+    server {{ $server.Address }}
+        {{- if $server.MaxConnections }} max_conns={{ $server.MaxConnections }}{{ end }}
+        {{- if $server.MaxFails }} max_fails={{ $server.MaxFails }}{{ end }}
+        {{- if $server.FailTimeout }} fail_timeout={{ $server.FailTimeout }}{{ end }};
+    {{- end }}
+    {{- if $u.KeepAlive }}
+
+    keepalive {{ $u.KeepAlive.Connections }};
+        {{- if $u.KeepAlive.Requests }}
+    keepalive_requests {{ $u.KeepAlive.Requests }};
+        {{- end }}
+        {{- if $u.KeepAlive.Time }}
+    keepalive_time {{ $u.KeepAlive.Time }};
+        {{- end }}
+        {{- if $u.KeepAlive.Timeout }}
+    keepalive_timeout {{ $u.KeepAlive.Timeout }};
+        {{- end }}
     {{- end }}
 }
 {{ end -}}
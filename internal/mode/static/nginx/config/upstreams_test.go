@@ -5,7 +5,9 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	. "github.com/onsi/gomega"
 
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/http"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/resolver"
@@ -35,16 +37,66 @@ func TestExecuteUpstreams(t *testing.T) {
 			Name:      "up3",
 			Endpoints: []resolver.Endpoint{},
 		},
+		{
+			Name: "up4",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "12.0.0.0",
+					Port:    80,
+				},
+			},
+			LoadBalancingMethod: dataplane.LoadBalancingMethodHashConsistent,
+			HashMethodKey:       "$http_x_user_id",
+		},
+		{
+			Name: "up5",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "13.0.0.0",
+					Port:    80,
+				},
+			},
+			KeepAlive: &dataplane.UpstreamKeepAlive{
+				Connections: 32,
+				Requests:    1000,
+				Time:        "1h",
+				Timeout:     "60s",
+			},
+			MaxConnections: 100,
+		},
+		{
+			Name: "up6",
+			Endpoints: []resolver.Endpoint{
+				{
+					Address: "14.0.0.0",
+					Port:    80,
+				},
+			},
+			MaxFails:    helpers.GetPointer[int32](0),
+			FailTimeout: "30s",
+		},
 	}
 
 	expectedSubStrings := []string{
 		"upstream up1",
 		"upstream up2",
 		"upstream up3",
+		"upstream up4",
+		"upstream up5",
 		"upstream invalid-backend-ref",
 		"server 10.0.0.0:80;",
 		"server 11.0.0.0:80;",
+		"server 12.0.0.0:80;",
 		"server unix:/var/lib/nginx/nginx-502-server.sock;",
+		"random two least_conn;",
+		"hash $http_x_user_id consistent;",
+		"zone up1 65k;",
+		"server 13.0.0.0:80 max_conns=100;",
+		"server 14.0.0.0:80 max_fails=0 fail_timeout=30s;",
+		"keepalive 32;",
+		"keepalive_requests 1000;",
+		"keepalive_time 1h;",
+		"keepalive_timeout 60s;",
 	}
 
 	upstreams := string(executeUpstreams(dataplane.Configuration{Upstreams: stateUpstreams}))
@@ -95,7 +147,9 @@ func TestCreateUpstreams(t *testing.T) {
 
 	expUpstreams := []http.Upstream{
 		{
-			Name: "up1",
+			Name:                "up1",
+			LoadBalancingMethod: defaultLoadBalancingMethod,
+			ZoneSize:            "67k",
 			Servers: []http.UpstreamServer{
 				{
 					Address: "10.0.0.0:80",
@@ -109,7 +163,9 @@ func TestCreateUpstreams(t *testing.T) {
 			},
 		},
 		{
-			Name: "up2",
+			Name:                "up2",
+			LoadBalancingMethod: defaultLoadBalancingMethod,
+			ZoneSize:            "65k",
 			Servers: []http.UpstreamServer{
 				{
 					Address: "11.0.0.0:80",
@@ -117,7 +173,9 @@ func TestCreateUpstreams(t *testing.T) {
 			},
 		},
 		{
-			Name: "up3",
+			Name:                "up3",
+			LoadBalancingMethod: defaultLoadBalancingMethod,
+			ZoneSize:            "65k",
 			Servers: []http.UpstreamServer{
 				{
 					Address: nginx502Server,
@@ -125,7 +183,9 @@ func TestCreateUpstreams(t *testing.T) {
 			},
 		},
 		{
-			Name: invalidBackendRef,
+			Name:                invalidBackendRef,
+			LoadBalancingMethod: defaultLoadBalancingMethod,
+			ZoneSize:            "65k",
 			Servers: []http.UpstreamServer{
 				{
 					Address: nginx500Server,
@@ -152,7 +212,9 @@ func TestCreateUpstream(t *testing.T) {
 				Endpoints: nil,
 			},
 			expectedUpstream: http.Upstream{
-				Name: "nil-endpoints",
+				Name:                "nil-endpoints",
+				LoadBalancingMethod: defaultLoadBalancingMethod,
+				ZoneSize:            "65k",
 				Servers: []http.UpstreamServer{
 					{
 						Address: nginx502Server,
@@ -167,7 +229,9 @@ func TestCreateUpstream(t *testing.T) {
 				Endpoints: []resolver.Endpoint{},
 			},
 			expectedUpstream: http.Upstream{
-				Name: "no-endpoints",
+				Name:                "no-endpoints",
+				LoadBalancingMethod: defaultLoadBalancingMethod,
+				ZoneSize:            "65k",
 				Servers: []http.UpstreamServer{
 					{
 						Address: nginx502Server,
@@ -195,7 +259,9 @@ func TestCreateUpstream(t *testing.T) {
 				},
 			},
 			expectedUpstream: http.Upstream{
-				Name: "multiple-endpoints",
+				Name:                "multiple-endpoints",
+				LoadBalancingMethod: defaultLoadBalancingMethod,
+				ZoneSize:            "67k",
 				Servers: []http.UpstreamServer{
 					{
 						Address: "10.0.0.1:80",
@@ -210,6 +276,71 @@ func TestCreateUpstream(t *testing.T) {
 			},
 			msg: "multiple endpoints",
 		},
+		{
+			stateUpstream: dataplane.Upstream{
+				Name: "keepalive",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.1",
+						Port:    80,
+					},
+				},
+				KeepAlive: &dataplane.UpstreamKeepAlive{
+					Connections: 16,
+					Requests:    100,
+					Time:        "1h",
+					Timeout:     "30s",
+				},
+				MaxConnections: 50,
+			},
+			expectedUpstream: http.Upstream{
+				Name:                "keepalive",
+				LoadBalancingMethod: defaultLoadBalancingMethod,
+				ZoneSize:            "65k",
+				KeepAlive: &http.UpstreamKeepAlive{
+					Connections: 16,
+					Requests:    100,
+					Time:        "1h",
+					Timeout:     "30s",
+				},
+				Servers: []http.UpstreamServer{
+					{
+						Address:        "10.0.0.1:80",
+						MaxConnections: 50,
+					},
+				},
+			},
+			msg: "keep-alive and max connections",
+		},
+		{
+			stateUpstream: dataplane.Upstream{
+				Name: "health",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.1",
+						Port:    80,
+					},
+				},
+				MaxFails:    helpers.GetPointer[int32](3),
+				FailTimeout: "30s",
+				NextUpstream: &dataplane.NextUpstream{
+					Conditions: []dataplane.NextUpstreamCondition{dataplane.NextUpstreamConditionError},
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:                "health",
+				LoadBalancingMethod: defaultLoadBalancingMethod,
+				ZoneSize:            "65k",
+				Servers: []http.UpstreamServer{
+					{
+						Address:     "10.0.0.1:80",
+						MaxFails:    helpers.GetPointer[int32](3),
+						FailTimeout: "30s",
+					},
+				},
+			},
+			msg: "passive health checks",
+		},
 	}
 
 	for _, test := range tests {
@@ -219,3 +350,74 @@ func TestCreateUpstream(t *testing.T) {
 		}
 	}
 }
+
+func TestCreateLoadBalancingMethod(t *testing.T) {
+	tests := []struct {
+		msg      string
+		expected string
+		upstream dataplane.Upstream
+	}{
+		{
+			upstream: dataplane.Upstream{},
+			expected: "random two least_conn",
+			msg:      "unset",
+		},
+		{
+			upstream: dataplane.Upstream{LoadBalancingMethod: dataplane.LoadBalancingMethodRandomTwoLeastConn},
+			expected: "random two least_conn",
+			msg:      "random two least_conn",
+		},
+		{
+			upstream: dataplane.Upstream{LoadBalancingMethod: dataplane.LoadBalancingMethodRoundRobin},
+			expected: "",
+			msg:      "round-robin",
+		},
+		{
+			upstream: dataplane.Upstream{LoadBalancingMethod: dataplane.LoadBalancingMethodLeastConn},
+			expected: "least_conn",
+			msg:      "least_conn",
+		},
+		{
+			upstream: dataplane.Upstream{LoadBalancingMethod: dataplane.LoadBalancingMethodIPHash},
+			expected: "ip_hash",
+			msg:      "ip_hash",
+		},
+		{
+			upstream: dataplane.Upstream{
+				LoadBalancingMethod: dataplane.LoadBalancingMethodHash,
+				HashMethodKey:       "$cookie_session",
+			},
+			expected: "hash $cookie_session",
+			msg:      "hash",
+		},
+		{
+			upstream: dataplane.Upstream{
+				LoadBalancingMethod: dataplane.LoadBalancingMethodHashConsistent,
+				HashMethodKey:       "$http_x_user_id",
+			},
+			expected: "hash $http_x_user_id consistent",
+			msg:      "hash consistent",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(createLoadBalancingMethod(test.upstream)).To(Equal(test.expected))
+		})
+	}
+
+	t.Run("unsupported method", func(t *testing.T) {
+		g := NewWithT(t)
+		upstream := dataplane.Upstream{LoadBalancingMethod: "unsupported"}
+		g.Expect(func() { createLoadBalancingMethod(upstream) }).To(Panic())
+	})
+}
+
+func TestCreateZoneSize(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(createZoneSize(1)).To(Equal("65k"))
+	g.Expect(createZoneSize(10)).To(Equal("74k"))
+	g.Expect(createZoneSize(648)).To(Equal("712k"))
+}
@@ -1,5 +1,14 @@
 package validation
 
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+)
+
 // HTTPRedirectValidator validates values for a redirect, which in NGINX is done with the return directive.
 // For example, return 302 "https://example.com:8080";
 type HTTPRedirectValidator struct{}
@@ -8,6 +17,14 @@ type HTTPRedirectValidator struct{}
 // which in NGINX is done with the proxy_set_header directive.
 type HTTPRequestHeaderValidator struct{}
 
+// HTTPAuthenticationValidator validates values for the authentication of requests, which in NGINX is done with
+// the auth_basic directive or, for JSON Web Tokens and external authorization, with the auth_request directive.
+type HTTPAuthenticationValidator struct{}
+
+// HTTPDirectResponseValidator validates values for a direct response, which in NGINX is done with the return
+// directive and the add_header directives for the headers. For example, return 503 "Under maintenance";
+type HTTPDirectResponseValidator struct{}
+
 var supportedRedirectSchemes = map[string]struct{}{
 	"http":  {},
 	"https": {},
@@ -54,3 +71,149 @@ func (HTTPRequestHeaderValidator) ValidateRequestHeaderValue(value string) error
 	// Variables in header values are supported by NGINX but not required by the Gateway API.
 	return validateEscapedStringNoVarExpansion(value, requestHeaderValueExamples)
 }
+
+const (
+	// authRealmFmt doesn't allow double quotes, because the realm is a quoted string in the WWW-Authenticate header.
+	authRealmFmt    = `[^"$\\]*`
+	authRealmErrMsg = `must not contain '"' (double quotes), '$' or '\' (backslash)`
+)
+
+var (
+	authRealmFmtRegexp = regexp.MustCompile("^" + authRealmFmt + "$")
+	authRealmExamples  = []string{"Restricted", "Internal dashboards"}
+)
+
+// ValidateAuthenticationRealm validates the realm of an authentication, which in NGINX is configured with
+// the auth_basic directive for basic authentication. For example, auth_basic "Restricted";
+func (HTTPAuthenticationValidator) ValidateAuthenticationRealm(realm string) error {
+	if !authRealmFmtRegexp.MatchString(realm) {
+		return errors.New(k8svalidation.RegexError(authRealmErrMsg, authRealmFmt, authRealmExamples...))
+	}
+	return nil
+}
+
+const (
+	// externalAuthPathFmt doesn't allow '$', so that the path doesn't include variables, and '"' and '\',
+	// so that it can be quoted.
+	externalAuthPathFmt    = `/[^\s{};$"\\]*`
+	externalAuthPathErrMsg = `must start with '/' and must not contain whitespace, '{', '}', ';', '$', '"' or '\'`
+)
+
+var (
+	externalAuthPathFmtRegexp = regexp.MustCompile("^" + externalAuthPathFmt + "$")
+	externalAuthPathExamples  = []string{"/", "/auth", "/oauth2/auth"}
+)
+
+// ValidateExternalAuthPath validates the path of the subrequests to an external authorization service,
+// which in NGINX is the URI of the proxy_pass directive of the internal location of the subrequests.
+// For example, proxy_pass http://ext_auth_default_filter/auth;
+func (HTTPAuthenticationValidator) ValidateExternalAuthPath(path string) error {
+	if !externalAuthPathFmtRegexp.MatchString(path) {
+		return errors.New(k8svalidation.RegexError(externalAuthPathErrMsg, externalAuthPathFmt, externalAuthPathExamples...))
+	}
+	return nil
+}
+
+const (
+	// externalAuthHeaderNameFmt only allows the characters that NGINX keeps in the names of the $http_ and
+	// $upstream_http_ variables of the headers.
+	externalAuthHeaderNameFmt    = `[A-Za-z0-9-]+`
+	externalAuthHeaderNameErrMsg = "must consist of alphanumeric characters or '-'"
+)
+
+var (
+	externalAuthHeaderNameFmtRegexp = regexp.MustCompile("^" + externalAuthHeaderNameFmt + "$")
+	externalAuthHeaderNameExamples  = []string{"Authorization", "X-User-Id"}
+)
+
+// ValidateExternalAuthHeaderName validates the name of a header that NGINX forwards to an external authorization
+// service or copies from its responses to the requests to the backends. In NGINX, the headers are set with
+// the proxy_set_header directive and the values are taken from variables. For example,
+// proxy_set_header Authorization $http_authorization;
+func (HTTPAuthenticationValidator) ValidateExternalAuthHeaderName(name string) error {
+	if err := validateHeaderName(name); err != nil {
+		return err
+	}
+	if !externalAuthHeaderNameFmtRegexp.MatchString(name) {
+		msg := k8svalidation.RegexError(
+			externalAuthHeaderNameErrMsg,
+			externalAuthHeaderNameFmt,
+			externalAuthHeaderNameExamples...,
+		)
+		return errors.New(msg)
+	}
+	return nil
+}
+
+// unsupportedDirectResponseStatusCodes are the status codes for which the return directive doesn't send
+// the response: NGINX redirects for the redirect status codes and closes the connection for 444, and it uses
+// the 494-499 status codes internally.
+var unsupportedDirectResponseStatusCodes = map[int32]struct{}{
+	301: {},
+	302: {},
+	303: {},
+	307: {},
+	308: {},
+	444: {},
+	494: {},
+	495: {},
+	496: {},
+	497: {},
+	498: {},
+	499: {},
+}
+
+// ValidateDirectResponseStatusCode validates the status code of a direct response.
+// For example, return 503 "Under maintenance";
+func (HTTPDirectResponseValidator) ValidateDirectResponseStatusCode(statusCode int32) error {
+	if statusCode < 200 || statusCode > 599 {
+		return errors.New("must be between 200 and 599")
+	}
+	if _, unsupported := unsupportedDirectResponseStatusCodes[statusCode]; unsupported {
+		return errors.New("redirect status codes and the NGINX status codes 444 and 494-499 are not supported")
+	}
+	return nil
+}
+
+// maxDirectResponseBodyLength is the maximum length of the body of a direct response in the NGINX config.
+// NGINX rejects the parameters of the directives that don't fit into its buffer of 4096 bytes.
+const maxDirectResponseBodyLength = 4000
+
+// ValidateDirectResponseBody validates the body of a direct response, which NGINX config includes as a quoted
+// string with escaped '"' and '\'. For example, return 200 "{\"status\": \"ok\"}";
+func (HTTPDirectResponseValidator) ValidateDirectResponseBody(body string) error {
+	if strings.Contains(body, "$") {
+		return errors.New("must not contain '$', because NGINX expands variables in the body")
+	}
+
+	// '"' and '\' are escaped with a '\'.
+	escapedLen := len(body) + strings.Count(body, `"`) + strings.Count(body, `\`)
+	if escapedLen > maxDirectResponseBodyLength {
+		return fmt.Errorf(
+			"must be no more than %d bytes, counting '\"' (double quotes) and '\\' (backslashes) twice",
+			maxDirectResponseBodyLength,
+		)
+	}
+
+	return nil
+}
+
+// ValidateResponseHeaderName validates the name of a header of a direct response.
+// For example, add_header Cache-Control "no-store" always;
+func (HTTPDirectResponseValidator) ValidateResponseHeaderName(name string) error {
+	if len(name) > maxHeaderLength {
+		return errors.New(k8svalidation.MaxLenError(maxHeaderLength))
+	}
+	if msg := k8svalidation.IsHTTPHeaderName(name); msg != nil {
+		return errors.New(msg[0])
+	}
+	return nil
+}
+
+var responseHeaderValueExamples = []string{"no-store", "text/html; charset=utf-8"}
+
+// ValidateResponseHeaderValue validates the value of a header of a direct response, including its Content-Type.
+// For example, add_header Cache-Control "no-store" always;
+func (HTTPDirectResponseValidator) ValidateResponseHeaderValue(value string) error {
+	return validateEscapedStringNoVarExpansion(value, responseHeaderValueExamples)
+}
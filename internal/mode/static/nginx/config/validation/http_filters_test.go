@@ -2,6 +2,7 @@ package validation
 
 import (
 	"math"
+	"strings"
 	"testing"
 )
 
@@ -68,3 +69,111 @@ func TestValidateRequestHeaderValue(t *testing.T) {
 		"$Content-Encoding",
 		`"example"`)
 }
+
+func TestValidateAuthenticationRealm(t *testing.T) {
+	validator := HTTPAuthenticationValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateAuthenticationRealm,
+		"Restricted",
+		"Internal dashboards",
+		"")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateAuthenticationRealm,
+		`"Restricted"`,
+		"$realm",
+		`realm\`)
+}
+
+func TestValidateExternalAuthPath(t *testing.T) {
+	validator := HTTPAuthenticationValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateExternalAuthPath,
+		"/",
+		"/auth",
+		"/oauth2/auth?check=true")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateExternalAuthPath,
+		"",
+		"auth",
+		"/auth path",
+		"/auth;",
+		"/$uri",
+		`/auth"`)
+}
+
+func TestValidateExternalAuthHeaderName(t *testing.T) {
+	validator := HTTPAuthenticationValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateExternalAuthHeaderName,
+		"Authorization",
+		"X-User-Id",
+		"x-user-123")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateExternalAuthHeaderName,
+		"",
+		"Host",
+		"X_User",
+		"X.User",
+		"X-User:")
+}
+
+func TestValidateDirectResponseStatusCode(t *testing.T) {
+	validator := HTTPDirectResponseValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateDirectResponseStatusCode,
+		int32(200),
+		int32(204),
+		int32(304),
+		int32(503),
+		int32(599))
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateDirectResponseStatusCode,
+		int32(199),
+		int32(301),
+		int32(308),
+		int32(444),
+		int32(499),
+		int32(600))
+}
+
+func TestValidateDirectResponseBody(t *testing.T) {
+	validator := HTTPDirectResponseValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateDirectResponseBody,
+		"",
+		`{"status": "ok"}`,
+		"<html>\n<body>Under maintenance</body>\n</html>",
+		`C:\path`,
+		strings.Repeat("a", 4000))
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateDirectResponseBody,
+		"$host",
+		"price: 5$",
+		strings.Repeat("a", 4001),
+		strings.Repeat(`"`, 2001))
+}
+
+func TestValidateResponseHeaderName(t *testing.T) {
+	validator := HTTPDirectResponseValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateResponseHeaderName,
+		"Cache-Control",
+		"X-Maintenance")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateResponseHeaderName,
+		"",
+		"X-Maintenance:",
+		strings.Repeat("a", 257))
+}
+
+func TestValidateResponseHeaderValue(t *testing.T) {
+	validator := HTTPDirectResponseValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateResponseHeaderValue,
+		"no-store",
+		"text/html; charset=utf-8")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateResponseHeaderValue,
+		"$host",
+		`no-store"`)
+}
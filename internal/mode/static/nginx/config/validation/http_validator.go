@@ -11,6 +11,8 @@ type HTTPValidator struct {
 	HTTPNJSMatchValidator
 	HTTPRedirectValidator
 	HTTPRequestHeaderValidator
+	HTTPAuthenticationValidator
+	HTTPDirectResponseValidator
 }
 
 var _ validation.HTTPFieldsValidator = HTTPValidator{}
@@ -0,0 +1,549 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// PolicyValidator validates values of NKG policies that will propagate into the NGINX configuration.
+// The validation rules are based on the nginx/config/http types and how they are used in the configuration templates
+// of the nginx/config package. Changes to those might require changing the validation rules.
+type PolicyValidator struct {
+	UpstreamSettingsValidator
+	UpstreamHealthValidator
+	RateLimitValidator
+	DurationValidator
+	SizeValidator
+	TracingValidator
+	AccessLogValidator
+	CORSValidator
+	AccessControlValidator
+	CacheValidator
+	CompressionValidator
+	ErrorPageValidator
+}
+
+var _ validation.PolicyValidator = PolicyValidator{}
+
+// UpstreamSettingsValidator validates values for the settings of upstreams, which in NGINX are configured with
+// the directives in the upstream context. For example, hash $http_x_user_id consistent;
+type UpstreamSettingsValidator struct{}
+
+var supportedLoadBalancingMethods = map[string]struct{}{
+	"RandomTwoLeastConn": {},
+	"RoundRobin":         {},
+	"LeastConn":          {},
+	"IPHash":             {},
+	"Hash":               {},
+	"HashConsistent":     {},
+}
+
+// ValidateLoadBalancingMethod validates a load-balancing method, which determines the directive in the upstream
+// context. For example, LeastConn results in least_conn;
+func (UpstreamSettingsValidator) ValidateLoadBalancingMethod(method string) (valid bool, supportedValues []string) {
+	return validateInSupportedValues(method, supportedLoadBalancingMethods)
+}
+
+const (
+	// hashKeyFmt allows a combination of NGINX variables (like $http_x_user_id) and text that doesn't include any
+	// characters that have a special meaning for NGINX, so that the key can be used unquoted in the hash directive.
+	hashKeyFmt    = `(\$[a-zA-Z_][a-zA-Z0-9_]*|[^$\s"'{};#\\])+`
+	hashKeyErrMsg = "must be a combination of NGINX variables and text without whitespace, " +
+		`quotes, '{', '}', ';', '#' or '\' (backslash)`
+)
+
+var (
+	hashKeyFmtRegexp = regexp.MustCompile("^" + hashKeyFmt + "$")
+	hashKeyExamples  = []string{"$remote_addr", "$http_x_user_id", "$cookie_session", "$request_uri$arg_id"}
+
+	// keyVariableRegexp matches a variable the same way NGINX does: the name is as long as possible.
+	keyVariableRegexp = regexp.MustCompile(`\$[a-zA-Z_][a-zA-Z0-9_]*`)
+)
+
+// supportedKeyVariables are the variables that the keys of the hash and proxy_cache_key directives support.
+// NGINX fails to reload if a key includes an unknown variable, so the keys only support the variables that
+// are always defined.
+var supportedKeyVariables = map[string]struct{}{
+	"$remote_addr": {},
+	"$request_uri": {},
+	"$uri":         {},
+	"$host":        {},
+	"$scheme":      {},
+}
+
+// supportedKeyVariablePrefixes are the prefixes of the variables of the request headers, cookies and arguments,
+// which the keys support with any name.
+var supportedKeyVariablePrefixes = []string{"$http_", "$cookie_", "$arg_"}
+
+// validateKey validates a key of the hash or proxy_cache_key directive.
+func validateKey(key string, examples []string) error {
+	if !hashKeyFmtRegexp.MatchString(key) {
+		return errors.New(k8svalidation.RegexError(hashKeyErrMsg, hashKeyFmt, examples...))
+	}
+
+	for _, variable := range keyVariableRegexp.FindAllString(key, -1) {
+		if !isSupportedKeyVariable(variable) {
+			return fmt.Errorf(
+				"variable %s is not supported, supported variables: %s, and the variables with the prefixes %s",
+				variable,
+				strings.Join(getSortedKeysAsString(supportedKeyVariables), ", "),
+				strings.Join(supportedKeyVariablePrefixes, ", "),
+			)
+		}
+	}
+
+	return nil
+}
+
+func isSupportedKeyVariable(variable string) bool {
+	if _, exists := supportedKeyVariables[variable]; exists {
+		return true
+	}
+
+	for _, prefix := range supportedKeyVariablePrefixes {
+		if strings.HasPrefix(variable, prefix) && len(variable) > len(prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateLoadBalancingHashKey validates the key of the hash directive. For example, hash $http_x_user_id;
+func (UpstreamSettingsValidator) ValidateLoadBalancingHashKey(key string) error {
+	return validateKey(key, hashKeyExamples)
+}
+
+// UpstreamHealthValidator validates values for the passive health checks of upstreams, which in NGINX are
+// configured with the parameters of the server directive and the proxy_next_upstream directives.
+// For example, proxy_next_upstream error timeout http_502;
+type UpstreamHealthValidator struct{}
+
+var supportedNextUpstreamConditions = map[string]struct{}{
+	"Error":         {},
+	"Timeout":       {},
+	"InvalidHeader": {},
+	"HTTP500":       {},
+	"HTTP502":       {},
+	"HTTP503":       {},
+	"HTTP504":       {},
+	"HTTP403":       {},
+	"HTTP404":       {},
+	"HTTP429":       {},
+	"NonIdempotent": {},
+	"Off":           {},
+}
+
+// ValidateNextUpstreamCondition validates a condition of the proxy_next_upstream directive.
+// For example, HTTP502 results in proxy_next_upstream http_502;
+func (UpstreamHealthValidator) ValidateNextUpstreamCondition(condition string) (valid bool, supportedValues []string) {
+	return validateInSupportedValues(condition, supportedNextUpstreamConditions)
+}
+
+// RateLimitValidator validates values for rate limits, which in NGINX are configured with the limit_req_zone and
+// limit_req directives. For example, limit_req_zone $http_x_api_key zone=zone:10m rate=10r/s;
+type RateLimitValidator struct{}
+
+const (
+	rateFmt    = `[0-9]{1,7}r/[sm]`
+	rateErrMsg = "must be a number of up to 7 digits followed by r/s or r/m"
+)
+
+var (
+	rateFmtRegexp = regexp.MustCompile("^" + rateFmt + "$")
+	rateExamples  = []string{"10r/s", "300r/m"}
+)
+
+// ValidateRateLimitRate validates the rate parameter of the limit_req_zone directive.
+func (RateLimitValidator) ValidateRateLimitRate(rate string) error {
+	if !rateFmtRegexp.MatchString(rate) {
+		return errors.New(k8svalidation.RegexError(rateErrMsg, rateFmt, rateExamples...))
+	}
+	return nil
+}
+
+const (
+	// rateLimitKeyNameFmt allows only the characters that can be part of an NGINX variable name (after the
+	// conversion of '-' to '_'), because a header name becomes part of a variable name like $http_x_api_key.
+	rateLimitKeyNameFmt    = `[A-Za-z0-9_-]+`
+	rateLimitKeyNameErrMsg = "must contain only alphanumeric characters, '-' or '_'"
+)
+
+var (
+	rateLimitKeyNameFmtRegexp = regexp.MustCompile("^" + rateLimitKeyNameFmt + "$")
+	rateLimitKeyNameExamples  = []string{"X-API-Key", "sub"}
+)
+
+// ValidateRateLimitKeyName validates the name of a request header or a JWT claim that identifies the clients
+// of a rate limit.
+func (RateLimitValidator) ValidateRateLimitKeyName(name string) error {
+	if !rateLimitKeyNameFmtRegexp.MatchString(name) {
+		return errors.New(
+			k8svalidation.RegexError(rateLimitKeyNameErrMsg, rateLimitKeyNameFmt, rateLimitKeyNameExamples...),
+		)
+	}
+	return nil
+}
+
+// DurationValidator validates durations, which NGINX uses in the directives that configure timeouts.
+// For example, keepalive_timeout 30s;
+type DurationValidator struct{}
+
+const (
+	durationFmt    = `[0-9]{1,4}(ms|s|m|h)?`
+	durationErrMsg = "must be a number of up to 4 digits followed by an optional unit: ms, s, m or h"
+)
+
+var (
+	durationFmtRegexp = regexp.MustCompile("^" + durationFmt + "$")
+	durationExamples  = []string{"500ms", "30s", "5m", "1h", "10"}
+)
+
+// ValidateDuration validates a duration.
+func (DurationValidator) ValidateDuration(duration string) error {
+	if !durationFmtRegexp.MatchString(duration) {
+		return errors.New(k8svalidation.RegexError(durationErrMsg, durationFmt, durationExamples...))
+	}
+	return nil
+}
+
+// SizeValidator validates sizes, which NGINX uses in the directives that configure buffers and limits.
+// For example, client_max_body_size 10m;
+type SizeValidator struct{}
+
+const (
+	sizeFmt    = `[0-9]{1,4}(k|m|g)?`
+	sizeErrMsg = "must be a number of up to 4 digits followed by an optional unit: k, m or g"
+)
+
+var (
+	sizeFmtRegexp = regexp.MustCompile("^" + sizeFmt + "$")
+	sizeExamples  = []string{"1024", "64k", "10m", "1g"}
+)
+
+// ValidateSize validates a size.
+func (SizeValidator) ValidateSize(size string) error {
+	if !sizeFmtRegexp.MatchString(size) {
+		return errors.New(k8svalidation.RegexError(sizeErrMsg, sizeFmt, sizeExamples...))
+	}
+	return nil
+}
+
+// TracingValidator validates values for the OpenTelemetry tracing, which in NGINX is configured with the otel_*
+// directives. For example, otel_trace_context propagate;
+type TracingValidator struct{}
+
+const (
+	// tracingEndpointFmt allows a host, which is a DNS name or an IPv4 address, followed by a port.
+	tracingEndpointFmt    = `[A-Za-z0-9.-]+:[0-9]{1,5}`
+	tracingEndpointErrMsg = "must be a host followed by ':' and a port"
+)
+
+var (
+	tracingEndpointFmtRegexp = regexp.MustCompile("^" + tracingEndpointFmt + "$")
+	tracingEndpointExamples  = []string{"otel-collector.monitoring.svc:4317", "10.0.0.1:4317"}
+)
+
+// ValidateTracingEndpoint validates the endpoint directive of the otel_exporter block.
+func (TracingValidator) ValidateTracingEndpoint(endpoint string) error {
+	if !tracingEndpointFmtRegexp.MatchString(endpoint) {
+		return errors.New(
+			k8svalidation.RegexError(tracingEndpointErrMsg, tracingEndpointFmt, tracingEndpointExamples...),
+		)
+	}
+	return nil
+}
+
+const (
+	// tracingServiceNameFmt doesn't allow any characters that have a special meaning for NGINX, so that the name
+	// can be used unquoted in the otel_service_name directive.
+	tracingServiceNameFmt    = `[A-Za-z0-9_.:/-]+`
+	tracingServiceNameErrMsg = "must contain only alphanumeric characters, '_', '.', ':', '/' or '-'"
+)
+
+var (
+	tracingServiceNameFmtRegexp = regexp.MustCompile("^" + tracingServiceNameFmt + "$")
+	tracingServiceNameExamples  = []string{"my-gateway", "cafe:gateway"}
+)
+
+// ValidateTracingServiceName validates the service name of the otel_service_name directive.
+func (TracingValidator) ValidateTracingServiceName(name string) error {
+	if !tracingServiceNameFmtRegexp.MatchString(name) {
+		return errors.New(
+			k8svalidation.RegexError(tracingServiceNameErrMsg, tracingServiceNameFmt, tracingServiceNameExamples...),
+		)
+	}
+	return nil
+}
+
+var supportedTraceContexts = map[string]struct{}{
+	"Extract":   {},
+	"Inject":    {},
+	"Propagate": {},
+	"Ignore":    {},
+}
+
+// ValidateTraceContext validates a trace context, which determines the parameter of the otel_trace_context
+// directive. For example, Propagate results in otel_trace_context propagate;
+func (TracingValidator) ValidateTraceContext(context string) (valid bool, supportedValues []string) {
+	return validateInSupportedValues(context, supportedTraceContexts)
+}
+
+// AccessLogValidator validates values for the access logs, which in NGINX are configured with the access_log
+// directive. For example, access_log syslog:server=syslog.logging.svc:514,tag=gateway nkg_json;
+type AccessLogValidator struct{}
+
+var supportedAccessLogFormats = map[string]struct{}{
+	"JSON": {},
+	"Text": {},
+}
+
+// ValidateAccessLogFormat validates an access log format, which determines the format parameter of
+// the access_log directive. For example, JSON results in the nkg_json log_format.
+func (AccessLogValidator) ValidateAccessLogFormat(format string) (valid bool, supportedValues []string) {
+	return validateInSupportedValues(format, supportedAccessLogFormats)
+}
+
+var supportedAccessLogDestinationTypes = map[string]struct{}{
+	"Stdout": {},
+	"Syslog": {},
+}
+
+// ValidateAccessLogDestinationType validates an access log destination type, which determines the path parameter
+// of the access_log directive. For example, Stdout results in /dev/stdout.
+func (AccessLogValidator) ValidateAccessLogDestinationType(
+	destinationType string,
+) (valid bool, supportedValues []string) {
+	return validateInSupportedValues(destinationType, supportedAccessLogDestinationTypes)
+}
+
+const (
+	// syslogServerFmt allows a host, which is a DNS name or an IPv4 address, followed by a port.
+	syslogServerFmt    = `[A-Za-z0-9.-]+:[0-9]{1,5}`
+	syslogServerErrMsg = "must be a host followed by ':' and a port"
+)
+
+var (
+	syslogServerFmtRegexp = regexp.MustCompile("^" + syslogServerFmt + "$")
+	syslogServerExamples  = []string{"syslog.logging.svc:514", "10.0.0.1:514"}
+)
+
+// ValidateSyslogServer validates the server parameter of the syslog: prefix of the access_log directive.
+func (AccessLogValidator) ValidateSyslogServer(server string) error {
+	if !syslogServerFmtRegexp.MatchString(server) {
+		return errors.New(k8svalidation.RegexError(syslogServerErrMsg, syslogServerFmt, syslogServerExamples...))
+	}
+	return nil
+}
+
+const (
+	// syslogTagFmt follows the requirements of NGINX for the tag parameter of the syslog: prefix.
+	syslogTagFmt    = `[A-Za-z0-9_]{1,32}`
+	syslogTagErrMsg = "must contain only alphanumeric characters or '_' and be at most 32 characters long"
+)
+
+var (
+	syslogTagFmtRegexp = regexp.MustCompile("^" + syslogTagFmt + "$")
+	syslogTagExamples  = []string{"nginx", "cafe_gateway"}
+)
+
+// ValidateSyslogTag validates the tag parameter of the syslog: prefix of the access_log directive.
+func (AccessLogValidator) ValidateSyslogTag(tag string) error {
+	if !syslogTagFmtRegexp.MatchString(tag) {
+		return errors.New(k8svalidation.RegexError(syslogTagErrMsg, syslogTagFmt, syslogTagExamples...))
+	}
+	return nil
+}
+
+// CORSValidator validates values for CORS, which in NGINX are configured with the map blocks that match
+// the Origin header of the requests and the add_header directives of the locations.
+type CORSValidator struct{}
+
+const (
+	// corsOriginFmt allows *, or an http or https origin with an optional port, whose host can start with
+	// a wildcard label. Because the origins become regular expressions in map blocks, the format doesn't allow
+	// any characters that are special in regular expressions, except for '.' and the leading '*', which NKG escapes.
+	corsOriginFmt    = `\*|https?://(\*\.)?[A-Za-z0-9-]+(\.[A-Za-z0-9-]+)*(:[0-9]{1,5})?`
+	corsOriginErrMsg = "must be * or a scheme (http or https), a host, which can start with '*.', and an optional port"
+)
+
+var (
+	corsOriginFmtRegexp = regexp.MustCompile("^(" + corsOriginFmt + ")$")
+	corsOriginExamples  = []string{"*", "https://example.com", "https://*.example.com", "http://localhost:8080"}
+)
+
+// ValidateCORSOrigin validates an origin that can access the resources.
+func (CORSValidator) ValidateCORSOrigin(origin string) error {
+	if !corsOriginFmtRegexp.MatchString(origin) {
+		return errors.New(k8svalidation.RegexError(corsOriginErrMsg, corsOriginFmt, corsOriginExamples...))
+	}
+	return nil
+}
+
+const (
+	corsHeaderNameFmt    = `\*|[A-Za-z0-9-]+`
+	corsHeaderNameErrMsg = "must be * or contain only alphanumeric characters or '-'"
+)
+
+var (
+	corsHeaderNameFmtRegexp = regexp.MustCompile("^(" + corsHeaderNameFmt + ")$")
+	corsHeaderNameExamples  = []string{"*", "Content-Type", "X-Request-ID"}
+)
+
+// ValidateCORSHeaderName validates the name of a header in the Access-Control-Allow-Headers and
+// Access-Control-Expose-Headers headers.
+func (CORSValidator) ValidateCORSHeaderName(name string) error {
+	if !corsHeaderNameFmtRegexp.MatchString(name) {
+		return errors.New(k8svalidation.RegexError(corsHeaderNameErrMsg, corsHeaderNameFmt, corsHeaderNameExamples...))
+	}
+	return nil
+}
+
+// AccessControlValidator validates values for the access control, which in NGINX is configured with the allow and
+// deny directives. For example, allow 10.0.0.0/8;
+type AccessControlValidator struct{}
+
+// ValidateIPAddressOrCIDR validates the address parameter of the allow and deny directives, which is an IPv4 or
+// IPv6 address or a CIDR block.
+func (AccessControlValidator) ValidateIPAddressOrCIDR(address string) error {
+	if net.ParseIP(address) != nil {
+		return nil
+	}
+
+	if _, _, err := net.ParseCIDR(address); err != nil {
+		return errors.New("must be an IP address or a CIDR block, for example, 10.0.0.1 or 10.0.0.0/8")
+	}
+
+	return nil
+}
+
+// CacheValidator validates values for the caching of the responses, which in NGINX is configured with
+// the proxy_cache_path directive in the http context and the proxy_cache directives of the locations.
+// For example, proxy_cache_key $scheme$host$request_uri;
+type CacheValidator struct{}
+
+const (
+	// cacheZoneNameFmt allows only the characters that are safe in both the name of a shared memory zone and
+	// a directory name.
+	cacheZoneNameFmt    = `[a-z0-9]([a-z0-9_-]*[a-z0-9])?`
+	cacheZoneNameErrMsg = "must consist of lower case alphanumeric characters, '-' or '_', and must start and " +
+		"end with an alphanumeric character"
+)
+
+var (
+	cacheZoneNameFmtRegexp = regexp.MustCompile("^" + cacheZoneNameFmt + "$")
+	cacheZoneNameExamples  = []string{"api", "static-files"}
+)
+
+// ValidateCacheZoneName validates the name of a cache zone, which NKG uses in the name of the shared memory zone
+// and the directory of the proxy_cache_path directive.
+func (CacheValidator) ValidateCacheZoneName(name string) error {
+	if !cacheZoneNameFmtRegexp.MatchString(name) {
+		return errors.New(k8svalidation.RegexError(cacheZoneNameErrMsg, cacheZoneNameFmt, cacheZoneNameExamples...))
+	}
+	return nil
+}
+
+var cacheKeyExamples = []string{"$scheme$host$request_uri", "$host$uri$arg_page", "$request_uri|$cookie_lang"}
+
+// ValidateCacheKey validates the key of the proxy_cache_key directive. The key has the same format and supports
+// the same variables as the key of the hash directive.
+func (CacheValidator) ValidateCacheKey(key string) error {
+	return validateKey(key, cacheKeyExamples)
+}
+
+const (
+	// cacheBypassNameFmt allows only the characters that can be part of an NGINX variable name (after the
+	// conversion of '-' to '_'), because the name becomes part of a variable name like $http_x_no_cache.
+	cacheBypassNameFmt    = `[A-Za-z0-9_-]+`
+	cacheBypassNameErrMsg = "must contain only alphanumeric characters, '-' or '_'"
+)
+
+var (
+	cacheBypassNameFmtRegexp = regexp.MustCompile("^" + cacheBypassNameFmt + "$")
+	cacheBypassNameExamples  = []string{"X-No-Cache", "nocache"}
+)
+
+// ValidateCacheBypassName validates the name of a header, a cookie or a query parameter that makes NGINX bypass
+// the cache with the proxy_cache_bypass directive.
+func (CacheValidator) ValidateCacheBypassName(name string) error {
+	if !cacheBypassNameFmtRegexp.MatchString(name) {
+		return errors.New(
+			k8svalidation.RegexError(cacheBypassNameErrMsg, cacheBypassNameFmt, cacheBypassNameExamples...),
+		)
+	}
+	return nil
+}
+
+// CompressionValidator validates values for the compression of the responses, which in NGINX is configured with
+// the gzip directives. For example, gzip_types application/json;
+type CompressionValidator struct{}
+
+const (
+	mimeTypeFmt    = `\*|[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]*/[A-Za-z0-9][A-Za-z0-9!#$&^_.+-]*`
+	mimeTypeErrMsg = "must be * or a type and a subtype, separated by '/', without parameters"
+)
+
+var (
+	mimeTypeFmtRegexp = regexp.MustCompile("^(" + mimeTypeFmt + ")$")
+	mimeTypeExamples  = []string{"application/json", "text/css", "image/svg+xml", "*"}
+)
+
+// ValidateMIMEType validates a MIME type of the gzip_types directive.
+func (CompressionValidator) ValidateMIMEType(mimeType string) error {
+	if !mimeTypeFmtRegexp.MatchString(mimeType) {
+		return errors.New(k8svalidation.RegexError(mimeTypeErrMsg, mimeTypeFmt, mimeTypeExamples...))
+	}
+	return nil
+}
+
+// ErrorPageValidator validates values for the error pages, which in NGINX are configured with the error_page
+// directives and the internal locations with the bodies of the responses.
+// For example, error_page 404 =301 "https://example.com/not-found";
+type ErrorPageValidator struct{}
+
+const (
+	contentTypeFmt = `[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*/[A-Za-z0-9][A-Za-z0-9!#&^_.+-]*` +
+		`( ?; ?[A-Za-z0-9_-]+=[A-Za-z0-9_.-]+)*`
+	contentTypeErrMsg = "must be a type and a subtype, separated by '/', with optional parameters"
+)
+
+var (
+	contentTypeFmtRegexp = regexp.MustCompile("^" + contentTypeFmt + "$")
+	contentTypeExamples  = []string{"text/html", "application/json", "text/plain; charset=utf-8"}
+)
+
+// ValidateContentType validates the content type of a response, which NKG uses in the default_type directive.
+func (ErrorPageValidator) ValidateContentType(contentType string) error {
+	if !contentTypeFmtRegexp.MatchString(contentType) {
+		return errors.New(k8svalidation.RegexError(contentTypeErrMsg, contentTypeFmt, contentTypeExamples...))
+	}
+	return nil
+}
+
+const (
+	// redirectURLFmt doesn't allow '$', because NGINX interprets it as the start of a variable, and
+	// the characters that can break the quoted parameter of the error_page directive.
+	redirectURLFmt    = `https?://[^\s"'$;{}\\]+`
+	redirectURLErrMsg = "must be an http or https URL without whitespace, quotes, '$', ';', '{', '}' or '\\'"
+)
+
+var (
+	redirectURLFmtRegexp = regexp.MustCompile("^" + redirectURLFmt + "$")
+	redirectURLExamples  = []string{"https://example.com", "https://example.com/maintenance?from=gateway"}
+)
+
+// ValidateRedirectURL validates the URL of a redirect of the error_page directive.
+func (ErrorPageValidator) ValidateRedirectURL(url string) error {
+	if !redirectURLFmtRegexp.MatchString(url) {
+		return errors.New(k8svalidation.RegexError(redirectURLErrMsg, redirectURLFmt, redirectURLExamples...))
+	}
+	return nil
+}
@@ -0,0 +1,427 @@
+package validation
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestValidateLoadBalancingMethod(t *testing.T) {
+	validator := UpstreamSettingsValidator{}
+
+	testValidValuesForSupportedValuesValidator(t, validator.ValidateLoadBalancingMethod,
+		"RandomTwoLeastConn",
+		"RoundRobin",
+		"LeastConn",
+		"IPHash",
+		"Hash",
+		"HashConsistent")
+
+	testInvalidValuesForSupportedValuesValidator(t, validator.ValidateLoadBalancingMethod,
+		supportedLoadBalancingMethods,
+		"least_conn",
+		"Random")
+}
+
+func TestValidateLoadBalancingHashKey(t *testing.T) {
+	validator := UpstreamSettingsValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateLoadBalancingHashKey,
+		"$remote_addr",
+		"$http_x_user_id",
+		"$cookie_session",
+		"$request_uri$arg_id",
+		"user-$cookie_user_id",
+		"$host:$request_uri",
+		"$uri|$scheme",
+		"$arg_page")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateLoadBalancingHashKey,
+		"",
+		"$",
+		"$1abc",
+		"$http_x_user_id consistent",
+		"$cookie_session;",
+		`"$remote_addr"`,
+		"${host}",
+		`$host\`,
+		"#$remote_addr",
+		"$remote_addr#comment",
+		"$unknown",
+		"$hostname",
+		"$host_name",
+		"$http_",
+		"$remote_user",
+		"$request_body")
+}
+
+func TestValidateLoadBalancingHashKeyUnsupportedVariable(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	err := UpstreamSettingsValidator{}.ValidateLoadBalancingHashKey("$host$unknown")
+	g.Expect(err).To(MatchError(
+		"variable $unknown is not supported, supported variables: $host, $remote_addr, $request_uri, $scheme, " +
+			"$uri, and the variables with the prefixes $http_, $cookie_, $arg_",
+	))
+}
+
+func TestValidateNextUpstreamCondition(t *testing.T) {
+	validator := UpstreamHealthValidator{}
+
+	testValidValuesForSupportedValuesValidator(t, validator.ValidateNextUpstreamCondition,
+		"Error",
+		"Timeout",
+		"InvalidHeader",
+		"HTTP500",
+		"HTTP502",
+		"HTTP503",
+		"HTTP504",
+		"HTTP403",
+		"HTTP404",
+		"HTTP429",
+		"NonIdempotent",
+		"Off")
+
+	testInvalidValuesForSupportedValuesValidator(t, validator.ValidateNextUpstreamCondition,
+		supportedNextUpstreamConditions,
+		"http_502",
+		"HTTP400")
+}
+
+func TestValidateRateLimitRate(t *testing.T) {
+	validator := RateLimitValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateRateLimitRate,
+		"10r/s",
+		"300r/m",
+		"9999999r/s")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateRateLimitRate,
+		"",
+		"10",
+		"10r/h",
+		"10r/s;",
+		"r/s",
+		"10000000r/s",
+		"10 r/s")
+}
+
+func TestValidateRateLimitKeyName(t *testing.T) {
+	validator := RateLimitValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateRateLimitKeyName,
+		"X-API-Key",
+		"sub",
+		"tenant_id")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateRateLimitKeyName,
+		"",
+		"x.api",
+		"sub;",
+		`"sub"`,
+		"$sub",
+		"x api")
+}
+
+func TestValidateDuration(t *testing.T) {
+	validator := DurationValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateDuration,
+		"500ms",
+		"30s",
+		"5m",
+		"1h",
+		"10",
+		"9999s")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateDuration,
+		"",
+		"s",
+		"10000s",
+		"1d",
+		"1.5s",
+		"-1s",
+		"1h30m",
+		"10 s")
+}
+
+func TestValidateSize(t *testing.T) {
+	validator := SizeValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateSize,
+		"0",
+		"1024",
+		"64k",
+		"10m",
+		"1g",
+		"9999m")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateSize,
+		"",
+		"m",
+		"10000m",
+		"1t",
+		"1.5m",
+		"-1m",
+		"10 m",
+		"10M")
+}
+
+func TestValidateTracingEndpoint(t *testing.T) {
+	validator := TracingValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateTracingEndpoint,
+		"otel-collector.monitoring.svc:4317",
+		"10.0.0.1:4317",
+		"localhost:4317")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateTracingEndpoint,
+		"",
+		"otel-collector",
+		"http://otel-collector:4317",
+		"otel-collector:4317;",
+		"otel-collector:port")
+}
+
+func TestValidateTracingServiceName(t *testing.T) {
+	validator := TracingValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateTracingServiceName,
+		"my-gateway",
+		"cafe:gateway",
+		"team/gateway_1.0")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateTracingServiceName,
+		"",
+		"my gateway",
+		"gateway;",
+		`"gateway"`,
+		"$host")
+}
+
+func TestValidateTraceContext(t *testing.T) {
+	validator := TracingValidator{}
+
+	testValidValuesForSupportedValuesValidator(t, validator.ValidateTraceContext,
+		"Extract",
+		"Inject",
+		"Propagate",
+		"Ignore")
+
+	testInvalidValuesForSupportedValuesValidator(t, validator.ValidateTraceContext,
+		supportedTraceContexts,
+		"propagate",
+		"W3C")
+}
+
+func TestValidateAccessLogFormat(t *testing.T) {
+	validator := AccessLogValidator{}
+
+	testValidValuesForSupportedValuesValidator(t, validator.ValidateAccessLogFormat,
+		"JSON",
+		"Text")
+
+	testInvalidValuesForSupportedValuesValidator(t, validator.ValidateAccessLogFormat,
+		supportedAccessLogFormats,
+		"json",
+		"combined")
+}
+
+func TestValidateAccessLogDestinationType(t *testing.T) {
+	validator := AccessLogValidator{}
+
+	testValidValuesForSupportedValuesValidator(t, validator.ValidateAccessLogDestinationType,
+		"Stdout",
+		"Syslog")
+
+	testInvalidValuesForSupportedValuesValidator(t, validator.ValidateAccessLogDestinationType,
+		supportedAccessLogDestinationTypes,
+		"stdout",
+		"File")
+}
+
+func TestValidateSyslogServer(t *testing.T) {
+	validator := AccessLogValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateSyslogServer,
+		"syslog.logging.svc:514",
+		"10.0.0.1:514")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateSyslogServer,
+		"",
+		"syslog",
+		"syslog:514,tag=evil",
+		"unix:/dev/log")
+}
+
+func TestValidateSyslogTag(t *testing.T) {
+	validator := AccessLogValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateSyslogTag,
+		"nginx",
+		"cafe_gateway_1")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateSyslogTag,
+		"",
+		"cafe-gateway",
+		"tag,severity=error",
+		"a123456789012345678901234567890123")
+}
+
+func TestValidateCORSOrigin(t *testing.T) {
+	validator := CORSValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateCORSOrigin,
+		"*",
+		"https://example.com",
+		"https://*.example.com",
+		"http://localhost:8080")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateCORSOrigin,
+		"",
+		"example.com",
+		"ftp://example.com",
+		"https://example.com/",
+		"https://exa*mple.com",
+		"https://*",
+		"http://example.com*",
+		"https://example.com$")
+}
+
+func TestValidateCORSHeaderName(t *testing.T) {
+	validator := CORSValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateCORSHeaderName,
+		"*",
+		"Content-Type",
+		"X-Request-ID")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateCORSHeaderName,
+		"",
+		"Content Type",
+		"X-*",
+		"X-Header;")
+}
+
+func TestValidateIPAddressOrCIDR(t *testing.T) {
+	validator := AccessControlValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateIPAddressOrCIDR,
+		"10.0.0.1",
+		"10.0.0.0/8",
+		"2001:db8::1",
+		"2001:db8::/32")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateIPAddressOrCIDR,
+		"",
+		"all",
+		"10.0.0.0/33",
+		"10.0.0",
+		"10.0.0.0/8; allow all")
+}
+
+func TestValidateCacheZoneName(t *testing.T) {
+	validator := CacheValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateCacheZoneName,
+		"api",
+		"static-files",
+		"zone_1")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateCacheZoneName,
+		"",
+		"API",
+		"-api",
+		"api/../etc",
+		"api:10m")
+}
+
+func TestValidateCacheKey(t *testing.T) {
+	validator := CacheValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateCacheKey,
+		"$scheme$host$request_uri",
+		"$host$uri$arg_page",
+		"$request_uri|$cookie_lang")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateCacheKey,
+		"",
+		"$host $uri",
+		`$host"`,
+		"$host;",
+		"${host}",
+		"#$host",
+		"$host#$uri",
+		"$proxy_host$request_uri",
+		"$upstream_addr",
+		"$hostname")
+}
+
+func TestValidateCacheBypassName(t *testing.T) {
+	validator := CacheValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateCacheBypassName,
+		"X-No-Cache",
+		"nocache",
+		"no_cache")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateCacheBypassName,
+		"",
+		"X No Cache",
+		"$nocache",
+		"nocache;")
+}
+
+func TestValidateMIMEType(t *testing.T) {
+	validator := CompressionValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateMIMEType,
+		"application/json",
+		"text/css",
+		"image/svg+xml",
+		"application/vnd.api+json",
+		"*")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateMIMEType,
+		"",
+		"json",
+		"text/*",
+		"application/json; charset=utf-8",
+		"text/css;")
+}
+
+func TestValidateContentType(t *testing.T) {
+	validator := ErrorPageValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateContentType,
+		"text/html",
+		"application/json",
+		"application/problem+json",
+		"text/plain; charset=utf-8",
+		"text/plain;charset=utf-8")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateContentType,
+		"",
+		"html",
+		"text/html;",
+		`text/html"`,
+		"text/$html")
+}
+
+func TestValidateRedirectURL(t *testing.T) {
+	validator := ErrorPageValidator{}
+
+	testValidValuesForSimpleValidator(t, validator.ValidateRedirectURL,
+		"https://example.com",
+		"http://example.com:8080/maintenance",
+		"https://example.com/errors?code=404#top")
+
+	testInvalidValuesForSimpleValidator(t, validator.ValidateRedirectURL,
+		"",
+		"/maintenance",
+		"ftp://example.com",
+		"https://example.com/$uri",
+		`https://example.com/"`,
+		"https://example.com/; return 200",
+		"https://example.com/ page")
+}
@@ -0,0 +1,289 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// SnippetsValidator validates the NGINX configuration snippets of SnippetsPolicies.
+//
+// It checks that a snippet closes all blocks that it opens and doesn't close any other blocks, so that the snippet
+// cannot escape its context, and that the snippet doesn't include the directives that read or write files or
+// the arguments that are absolute paths or include '..', so that the snippet cannot read the files of NGINX and the
+// control plane. The snippets that pass the validation are
+// tested with nginx -t in the whole NGINX configuration before NGINX reloads it.
+type SnippetsValidator struct{}
+
+var _ validation.SnippetsValidator = SnippetsValidator{}
+
+var supportedSnippetContexts = map[string]struct{}{
+	string(nkgAPI.SnippetContextHTTP):     {},
+	string(nkgAPI.SnippetContextServer):   {},
+	string(nkgAPI.SnippetContextLocation): {},
+}
+
+// ValidateSnippet validates a snippet for the context: HTTP, Server or Location.
+func (SnippetsValidator) ValidateSnippet(snippetContext string, snippet string) error {
+	if valid, supportedValues := validateInSupportedValues(snippetContext, supportedSnippetContexts); !valid {
+		return fmt.Errorf("context %q is not supported, supported values: %s", snippetContext, supportedValues)
+	}
+
+	directives, err := parseSnippet(snippet)
+	if err != nil {
+		return err
+	}
+
+	return validateSnippetDirectives(directives)
+}
+
+// snippetDirective is a directive of a snippet.
+type snippetDirective struct {
+	name string
+	args []string
+}
+
+// parseSnippet parses the directives of the snippet, including the directives in its blocks. It validates that
+// the snippet closes all blocks that it opens, doesn't close any other blocks and ends every directive. It reads
+// the snippet the same way NGINX reads its configuration: the braces in quotes, comments and variables like ${var},
+// and after a backslash don't open or close blocks.
+func parseSnippet(snippet string) ([]snippetDirective, error) {
+	var (
+		directives                                                     []snippetDirective
+		words                                                          []string
+		word                                                           strings.Builder
+		depth                                                          int
+		comment, quoted, dQuoted, sQuoted, variable, needSpace, inWord bool
+	)
+	lastSpace := true
+
+	endWord := func() {
+		words = append(words, word.String())
+		word.Reset()
+		inWord = false
+	}
+
+	endDirective := func() {
+		if len(words) > 0 {
+			directives = append(directives, snippetDirective{name: words[0], args: words[1:]})
+		}
+		words = nil
+	}
+
+	for _, ch := range snippet {
+		if comment {
+			comment = ch != '\n'
+			continue
+		}
+
+		if quoted {
+			quoted = false
+			// Like NGINX, unescape only the quotes and the backslash.
+			if ch != '"' && ch != '\'' && ch != '\\' {
+				word.WriteRune('\\')
+			}
+			word.WriteRune(ch)
+			continue
+		}
+
+		isSpace := ch == ' ' || ch == '\t' || ch == '\r' || ch == '\n'
+
+		if needSpace {
+			needSpace = false
+			lastSpace = true
+
+			switch {
+			case isSpace, ch == ')':
+			case ch == ';':
+				endDirective()
+			case ch == '{':
+				depth++
+				endDirective()
+			default:
+				return nil, errors.New("must separate a quoted string from the next one")
+			}
+
+			continue
+		}
+
+		if lastSpace {
+			if isSpace {
+				continue
+			}
+
+			switch ch {
+			case ';':
+				endDirective()
+				continue
+			case '{':
+				depth++
+				endDirective()
+				continue
+			case '}':
+				if depth == 0 {
+					return nil, errors.New("must not close a block that it doesn't open")
+				}
+				depth--
+				endDirective()
+				continue
+			case '#':
+				comment = true
+				continue
+			case '\\':
+				quoted = true
+			case '"':
+				dQuoted = true
+			case '\'':
+				sQuoted = true
+			case '$':
+				variable = true
+				word.WriteRune(ch)
+			default:
+				word.WriteRune(ch)
+			}
+
+			lastSpace = false
+			inWord = true
+
+			continue
+		}
+
+		if ch == '{' && variable {
+			word.WriteRune(ch)
+			continue
+		}
+
+		variable = false
+
+		switch {
+		case ch == '\\':
+			quoted = true
+		case ch == '$':
+			variable = true
+			word.WriteRune(ch)
+		case dQuoted:
+			if ch == '"' {
+				dQuoted = false
+				needSpace = true
+				endWord()
+			} else {
+				word.WriteRune(ch)
+			}
+		case sQuoted:
+			if ch == '\'' {
+				sQuoted = false
+				needSpace = true
+				endWord()
+			} else {
+				word.WriteRune(ch)
+			}
+		case isSpace:
+			lastSpace = true
+			endWord()
+		case ch == ';':
+			lastSpace = true
+			endWord()
+			endDirective()
+		case ch == '{':
+			lastSpace = true
+			depth++
+			endWord()
+			endDirective()
+		default:
+			word.WriteRune(ch)
+		}
+	}
+
+	switch {
+	case dQuoted || sQuoted:
+		return nil, errors.New("must close all quotes")
+	case quoted:
+		return nil, errors.New(`must not end with a '\' (backslash)`)
+	case depth > 0:
+		return nil, errors.New("must close all blocks that it opens")
+	case inWord || len(words) > 0:
+		return nil, errors.New("must end every directive with ';' or a block")
+	}
+
+	return directives, nil
+}
+
+// fileDirectives are the directives that read or write files. A snippet must not include them, so that it can't
+// read the files of NGINX and the control plane, like the Secrets and the token of the service account, or
+// overwrite them.
+var fileDirectives = map[string]struct{}{
+	"include":           {},
+	"load_module":       {},
+	"root":              {},
+	"alias":             {},
+	"try_files":         {},
+	"index":             {},
+	"random_index":      {},
+	"autoindex":         {},
+	"ssi":               {},
+	"dav_methods":       {},
+	"access_log":        {},
+	"error_log":         {},
+	"js_import":         {},
+	"js_path":           {},
+	"js_preload_object": {},
+}
+
+// fileDirectiveSuffixes are the suffixes of the names of the directives that read or write files. For example,
+// auth_basic_user_file, proxy_cache_path, ssl_certificate_key and proxy_store.
+var fileDirectiveSuffixes = []string{
+	"_file",
+	"_files",
+	"_path",
+	"_store",
+	"certificate",
+	"certificate_key",
+	"_crl",
+	"_dhparam",
+	"_session_ticket_key",
+	"_conf_command",
+}
+
+// validateSnippetDirectives validates that the directives don't read or write files and that their arguments are
+// not absolute paths and don't include '..', so that a directive that isn't known to read files can't be pointed
+// at the files either. The only exception is access_log off, which disables the access log.
+func validateSnippetDirectives(directives []snippetDirective) error {
+	for _, d := range directives {
+		if d.name == "access_log" && len(d.args) == 1 && d.args[0] == "off" {
+			continue
+		}
+
+		if isFileDirective(d.name) {
+			return fmt.Errorf("must not include the %q directive, which reads or writes files", d.name)
+		}
+
+		for _, arg := range d.args {
+			if strings.HasPrefix(arg, "/") || strings.Contains(arg, "..") {
+				return fmt.Errorf(
+					"the arguments of the %q directive must not be absolute paths or include '..', got %q",
+					d.name,
+					arg,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isFileDirective(name string) bool {
+	if _, exists := fileDirectives[name]; exists {
+		return true
+	}
+
+	for _, suffix := range fileDirectiveSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
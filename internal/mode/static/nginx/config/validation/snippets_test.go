@@ -0,0 +1,167 @@
+package validation
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestValidateSnippet(t *testing.T) {
+	validator := SnippetsValidator{}
+
+	validateLocationSnippet := func(snippet string) error {
+		return validator.ValidateSnippet("Location", snippet)
+	}
+
+	testValidValuesForSimpleValidator(t, validateLocationSnippet,
+		"proxy_buffer_size 16k;",
+		"if ($http_x_debug) {\n    add_header X-Debug on;\n}",
+	)
+
+	testInvalidValuesForSimpleValidator(t, validateLocationSnippet,
+		"} location /admin {",
+		"include /var/run/secrets/kubernetes.io/serviceaccount/token;",
+	)
+
+	g := NewGomegaWithT(t)
+	g.Expect(validator.ValidateSnippet("Stream", "proxy_buffer_size 16k;")).ToNot(Succeed())
+}
+
+func TestParseSnippet(t *testing.T) {
+	tests := []struct {
+		name     string
+		snippet  string
+		expected []snippetDirective
+	}{
+		{
+			name:    "empty",
+			snippet: "",
+		},
+		{
+			name:    "directives",
+			snippet: "proxy_buffer_size 16k;\nproxy_buffers 8 16k; # a comment with }",
+			expected: []snippetDirective{
+				{name: "proxy_buffer_size", args: []string{"16k"}},
+				{name: "proxy_buffers", args: []string{"8", "16k"}},
+			},
+		},
+		{
+			name:    "blocks",
+			snippet: "location /status {\n    stub_status;\n}\nif ($request_method = POST) { return 405; }",
+			expected: []snippetDirective{
+				{name: "location", args: []string{"/status"}},
+				{name: "stub_status", args: []string{}},
+				{name: "if", args: []string{"($request_method", "=", "POST)"}},
+				{name: "return", args: []string{"405"}},
+			},
+		},
+		{
+			name:    "quotes and escapes",
+			snippet: `"inc\lude" "a\"}" 'b' \"c ${host};`,
+			expected: []snippetDirective{
+				{name: `inc\lude`, args: []string{`a"}`, "b", `"c`, "${host}"}},
+			},
+		},
+		{
+			name:    "quoted name",
+			snippet: `"include" /etc/passwd;`,
+			expected: []snippetDirective{
+				{name: "include", args: []string{"/etc/passwd"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			directives, err := parseSnippet(test.snippet)
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(directives).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestParseSnippetBlocks(t *testing.T) {
+	validateSnippetBlocks := func(snippet string) error {
+		_, err := parseSnippet(snippet)
+		return err
+	}
+
+	testValidValuesForSimpleValidator(t, validateSnippetBlocks,
+		"",
+		"proxy_buffer_size 16k;",
+		"proxy_buffer_size 16k;\nproxy_buffers 8 16k;",
+		"if ($http_x_debug) {\n    add_header X-Debug on;\n}",
+		"location /status {\n    stub_status;\n}",
+		`add_header X-Brace "}";`,
+		`add_header X-Brace '{';`,
+		`add_header X-Brace \};`,
+		"add_header X-Brace a};",
+		`add_header X-Host ${host};`,
+		"proxy_buffer_size 16k; # a comment with }",
+		`return 200 "a\"}";`,
+		"if ($request_method = POST) { return 405; }",
+	)
+
+	testInvalidValuesForSimpleValidator(t, validateSnippetBlocks,
+		"}",
+		"} location /admin {",
+		"location /admin {",
+		"proxy_buffer_size 16k",
+		"proxy_buffer_size 16k; # a comment\nproxy_buffers 8 16k",
+		`add_header X-Quote "a;`,
+		`add_header X-Quote 'a;`,
+		`add_header X-Quote "a""b";`,
+		`proxy_buffer_size 16k;\`,
+		"if ($http_x_debug) { add_header X-Debug on; }}",
+	)
+}
+
+func TestValidateSnippetDirectives(t *testing.T) {
+	validateSnippet := func(snippet string) error {
+		directives, err := parseSnippet(snippet)
+		if err != nil {
+			return err
+		}
+		return validateSnippetDirectives(directives)
+	}
+
+	testValidValuesForSimpleValidator(t, validateSnippet,
+		"proxy_buffer_size 16k;",
+		"access_log off;",
+		"proxy_cache_key $host$request_uri;",
+		"return 301 https://example.com$request_uri;",
+		"rewrite ^/old(.*)$ new$1;",
+	)
+
+	testInvalidValuesForSimpleValidator(t, validateSnippet,
+		"include /var/run/secrets/kubernetes.io/serviceaccount/token;",
+		`"include" /etc/nginx/secrets/cert.pem;`,
+		"load_module modules/ngx_foo_module.so;",
+		"auth_basic_user_file /etc/passwd;",
+		"ssl_certificate /etc/nginx/secrets/cert.pem;",
+		"ssl_certificate_key secrets/key.pem;",
+		"proxy_ssl_trusted_certificate /etc/ssl/ca.pem;",
+		"proxy_cache_path /tmp/cache keys_zone=z:1m;",
+		"client_body_temp_path /etc/nginx/conf.d;",
+		"root /;",
+		"location /files { alias /etc/nginx/; }",
+		"access_log /etc/nginx/conf.d/http.conf;",
+		"error_log /etc/nginx/nginx.conf;",
+		"js_import /etc/nginx/secrets/module.js;",
+		"ssl_conf_command ChainCAFile /etc/passwd;",
+		"try_files $uri /index.html;",
+		"index ../../var/run/secrets/kubernetes.io/serviceaccount/token;",
+		"random_index on;",
+		"ssi on;",
+		"autoindex on;",
+		"dav_methods PUT;",
+		"proxy_store on;",
+		"fastcgi_store on;",
+		"return 301 /new;",
+		"add_header X-Token ../secrets/token;",
+		`if ($uri) { set $file "/etc/passwd"; }`,
+	)
+}
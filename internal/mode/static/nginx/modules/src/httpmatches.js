@@ -96,13 +96,21 @@ function testMatch(r, match) {
     return true;
   }
 
+  // A CORS preflight request carries the method of the actual request in the
+  // Access-Control-Request-Method header and doesn't carry its non-safelisted headers.
+  // For a match that configures CORS, the preflight request is tested against the method of the
+  // actual request and its headers are not tested, so that NGINX responds to the preflight request
+  // in the location of the match.
+  const preflight = match.cors && isPreflight(r);
+
   // check method
-  if (match.method && r.method !== match.method) {
+  const method = preflight ? r.headersIn['Access-Control-Request-Method'] : r.method;
+  if (match.method && method !== match.method) {
     return false;
   }
 
   // check headers
-  if (match.headers) {
+  if (match.headers && !preflight) {
     try {
       let found = headersMatch(r.headersIn, match.headers);
       if (!found) {
@@ -129,6 +137,14 @@ function testMatch(r, match) {
   return true;
 }
 
+function isPreflight(r) {
+  return (
+    r.method === 'OPTIONS' &&
+    !!r.headersIn['Origin'] &&
+    !!r.headersIn['Access-Control-Request-Method']
+  );
+}
+
 function headersMatch(requestHeaders, headers) {
   for (let i = 0; i < headers.length; i++) {
     const h = headers[i];
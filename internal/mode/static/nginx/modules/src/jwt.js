@@ -0,0 +1,148 @@
+import fs from 'fs';
+
+const JWKS_FILE_VARIABLE = 'jwt_jwks_file';
+const REALM_VARIABLE = 'jwt_realm';
+const REQUIRED_CLAIMS_VARIABLE = 'jwt_required_claims';
+const BEARER_PREFIX = 'Bearer ';
+
+// algorithms maps the alg header of a token to the Web Crypto parameters that import the key and verify
+// the signature.
+const algorithms = {
+  RS256: { name: 'RSASSA-PKCS1-v1_5', hash: 'SHA-256' },
+  RS384: { name: 'RSASSA-PKCS1-v1_5', hash: 'SHA-384' },
+  RS512: { name: 'RSASSA-PKCS1-v1_5', hash: 'SHA-512' },
+  PS256: { name: 'RSA-PSS', hash: 'SHA-256', saltLength: 32 },
+  PS384: { name: 'RSA-PSS', hash: 'SHA-384', saltLength: 48 },
+  PS512: { name: 'RSA-PSS', hash: 'SHA-512', saltLength: 64 },
+  ES256: { name: 'ECDSA', namedCurve: 'P-256', hash: 'SHA-256' },
+  ES384: { name: 'ECDSA', namedCurve: 'P-384', hash: 'SHA-384' },
+  ES512: { name: 'ECDSA', namedCurve: 'P-521', hash: 'SHA-512' },
+  HS256: { name: 'HMAC', hash: 'SHA-256' },
+  HS384: { name: 'HMAC', hash: 'SHA-384' },
+  HS512: { name: 'HMAC', hash: 'SHA-512' },
+};
+
+// validate is a content handler for the auth_request subrequests. It validates the JSON Web Token in
+// the Authorization header of the request against the JSON Web Key Set in the file from the jwt_jwks_file variable
+// and the required claims from the jwt_required_claims variable, which is a base64-encoded JSON object.
+// It responds with 204 if the token is valid. Otherwise, it responds with 401 and the WWW-Authenticate header
+// with the realm from the jwt_realm variable.
+async function validate(r) {
+  try {
+    await validateToken(r, Date.now() / 1000);
+  } catch (e) {
+    r.warn(`JWT validation failed: ${e.message}`);
+    r.headersOut['WWW-Authenticate'] = `Bearer realm="${r.variables[REALM_VARIABLE]}"`;
+    r.return(401);
+    return;
+  }
+
+  r.return(204);
+}
+
+async function validateToken(r, now) {
+  const authorization = r.headersIn['Authorization'];
+  if (!authorization || !authorization.startsWith(BEARER_PREFIX)) {
+    throw Error('the request does not have a Bearer token in the Authorization header');
+  }
+
+  const parts = authorization.slice(BEARER_PREFIX.length).trim().split('.');
+  if (parts.length !== 3) {
+    throw Error('the token is malformed');
+  }
+
+  const header = decodeTokenPart(parts[0], 'header');
+  const payload = decodeTokenPart(parts[1], 'payload');
+
+  const algorithm = algorithms[header.alg];
+  if (!algorithm) {
+    throw Error(`the algorithm ${header.alg} of the token is not supported`);
+  }
+
+  const jwks = JSON.parse(fs.readFileSync(r.variables[JWKS_FILE_VARIABLE], 'utf8'));
+  const jwkCandidates = jwks.keys.filter(
+    (jwk) => (!header.kid || jwk.kid === header.kid) && (!jwk.alg || jwk.alg === header.alg),
+  );
+
+  const data = Buffer.from(`${parts[0]}.${parts[1]}`);
+  const signature = Buffer.from(parts[2], 'base64url');
+
+  // A JWKS can include keys that don't fit the algorithm of the token, for example, the keys of
+  // other types without the alg parameter. Such keys fail to import, so they are skipped.
+  let verified = false;
+  for (const jwk of jwkCandidates) {
+    try {
+      const key = await crypto.subtle.importKey('jwk', jwk, algorithm, false, ['verify']);
+      if (await crypto.subtle.verify(algorithm, key, signature, data)) {
+        verified = true;
+        break;
+      }
+    } catch (e) {
+      r.warn(`skipping the JWK${jwk.kid ? ` ${jwk.kid}` : ''}: ${e.message}`);
+    }
+  }
+
+  if (!verified) {
+    throw Error('the signature of the token is invalid');
+  }
+
+  validateClaims(payload, getRequiredClaims(r), now);
+}
+
+function decodeTokenPart(part, name) {
+  let decoded;
+  try {
+    decoded = JSON.parse(Buffer.from(part, 'base64url').toString());
+  } catch (e) {
+    throw Error(`the ${name} of the token is invalid: ${e.message}`);
+  }
+
+  if (typeof decoded !== 'object' || decoded === null) {
+    throw Error(`the ${name} of the token is not an object`);
+  }
+
+  return decoded;
+}
+
+function getRequiredClaims(r) {
+  const claims = r.variables[REQUIRED_CLAIMS_VARIABLE];
+  if (!claims) {
+    return {};
+  }
+
+  return JSON.parse(Buffer.from(claims, 'base64').toString());
+}
+
+// validateClaims checks that the token is not expired and is already valid at the time now (in seconds)
+// and that it includes the required claims. A required claim matches an array claim if the array includes
+// its value.
+function validateClaims(payload, requiredClaims, now) {
+  if (payload.exp !== undefined && now >= payload.exp) {
+    throw Error('the token is expired');
+  }
+
+  if (payload.nbf !== undefined && now < payload.nbf) {
+    throw Error('the token is not valid yet');
+  }
+
+  for (const name in requiredClaims) {
+    const value = payload[name];
+    const expected = requiredClaims[name];
+
+    const matches = Array.isArray(value)
+      ? value.some((v) => String(v) === expected)
+      : value !== undefined && value !== null && String(value) === expected;
+
+    if (!matches) {
+      throw Error(`the claim ${name} of the token is missing or has an unexpected value`);
+    }
+  }
+}
+
+export default {
+  validate,
+  validateClaims,
+  JWKS_FILE_VARIABLE,
+  REALM_VARIABLE,
+  REQUIRED_CLAIMS_VARIABLE,
+};
@@ -134,6 +134,39 @@ describe('testMatch', () => {
       request: createRequest({ method: 'GET', headers: { header: 'value' } }), // no params set on request
       expected: false,
     },
+    {
+      name: 'returns true if the method of the actual request of a CORS preflight request matches',
+      match: { method: 'PUT', headers: ['header:value'], cors: true },
+      request: createRequest({
+        method: 'OPTIONS',
+        headers: { Origin: 'https://example.com', 'Access-Control-Request-Method': 'PUT' },
+      }),
+      expected: true,
+    },
+    {
+      name: 'returns false if the method of the actual request of a CORS preflight request does not match',
+      match: { method: 'PUT', cors: true },
+      request: createRequest({
+        method: 'OPTIONS',
+        headers: { Origin: 'https://example.com', 'Access-Control-Request-Method': 'DELETE' },
+      }),
+      expected: false,
+    },
+    {
+      name: 'returns false for a CORS preflight request if the match does not configure CORS',
+      match: { method: 'PUT' },
+      request: createRequest({
+        method: 'OPTIONS',
+        headers: { Origin: 'https://example.com', 'Access-Control-Request-Method': 'PUT' },
+      }),
+      expected: false,
+    },
+    {
+      name: 'tests an OPTIONS request without the CORS headers as a regular request',
+      match: { method: 'PUT', cors: true },
+      request: createRequest({ method: 'OPTIONS', headers: { Origin: 'https://example.com' } }),
+      expected: false,
+    },
     {
       name: 'throws if headers are malformed',
       match: { headers: ['malformedheader'] },
@@ -0,0 +1,200 @@
+import { default as jwt } from '../src/jwt.js';
+import { expect } from 'chai';
+import { createHmac } from 'crypto';
+import fs from 'fs';
+import os from 'os';
+import path from 'path';
+
+function encode(obj) {
+  return Buffer.from(JSON.stringify(obj)).toString('base64url');
+}
+
+describe('validateClaims', () => {
+  const now = 1000;
+
+  const tests = [
+    {
+      name: 'accepts a token without time claims and required claims',
+      payload: { sub: 'user' },
+      requiredClaims: {},
+    },
+    {
+      name: 'accepts a token that is not expired',
+      payload: { exp: now + 1, nbf: now },
+      requiredClaims: {},
+    },
+    {
+      name: 'accepts a token with the required claims',
+      payload: { iss: 'issuer', aud: ['dashboards', 'api'], level: 3 },
+      requiredClaims: { iss: 'issuer', aud: 'api', level: '3' },
+    },
+    {
+      name: 'rejects an expired token',
+      payload: { exp: now },
+      requiredClaims: {},
+      errSubstring: 'expired',
+    },
+    {
+      name: 'rejects a token that is not valid yet',
+      payload: { nbf: now + 1 },
+      requiredClaims: {},
+      errSubstring: 'not valid yet',
+    },
+    {
+      name: 'rejects a token without a required claim',
+      payload: { sub: 'user' },
+      requiredClaims: { iss: 'issuer' },
+      errSubstring: 'claim iss',
+    },
+    {
+      name: 'rejects a token with an unexpected value of a required claim',
+      payload: { aud: ['other'] },
+      requiredClaims: { aud: 'api' },
+      errSubstring: 'claim aud',
+    },
+  ];
+
+  tests.forEach((test) => {
+    it(test.name, () => {
+      const validate = () => jwt.validateClaims(test.payload, test.requiredClaims, now);
+      if (test.errSubstring) {
+        expect(validate).to.throw(test.errSubstring);
+      } else {
+        expect(validate).to.not.throw();
+      }
+    });
+  });
+});
+
+describe('validate', () => {
+  const secret = 'secret';
+  const jwksFile = path.join(os.tmpdir(), 'jwt-test-jwks.json');
+  const mixedJwksFile = path.join(os.tmpdir(), 'jwt-test-mixed-jwks.json');
+
+  before(() => {
+    const jwks = {
+      keys: [{ kty: 'oct', kid: 'key', alg: 'HS256', k: Buffer.from(secret).toString('base64url') }],
+    };
+    fs.writeFileSync(jwksFile, JSON.stringify(jwks));
+
+    // The keys without the alg parameter are candidates for any token without a kid. The RSA and EC
+    // keys cannot be imported for HS256, and the other oct key doesn't verify the signature.
+    const mixedJwks = {
+      keys: [
+        { kty: 'RSA', kid: 'rsa', n: Buffer.from('modulus').toString('base64url'), e: 'AQAB' },
+        { kty: 'EC', kid: 'ec', crv: 'P-256', x: 'x', y: 'y' },
+        { kty: 'oct', kid: 'other', k: Buffer.from('other').toString('base64url') },
+        { kty: 'oct', kid: 'key', k: Buffer.from(secret).toString('base64url') },
+      ],
+    };
+    fs.writeFileSync(mixedJwksFile, JSON.stringify(mixedJwks));
+  });
+
+  after(() => {
+    fs.unlinkSync(jwksFile);
+    fs.unlinkSync(mixedJwksFile);
+  });
+
+  function createSignedToken(payload, key = secret, kid = 'key') {
+    const header = kid ? { alg: 'HS256', typ: 'JWT', kid } : { alg: 'HS256', typ: 'JWT' };
+    const unsigned = `${encode(header)}.${encode(payload)}`;
+    const signature = createHmac('sha256', key).update(unsigned).digest('base64url');
+    return `${unsigned}.${signature}`;
+  }
+
+  // Creates a NGINX HTTP Request Object of an auth_request subrequest for testing.
+  function createAuthRequest({ authorization = '', requiredClaims = null, jwks = jwksFile } = {}) {
+    let r = {
+      // Test mocks
+      warn(msg) {
+        console.log('\tngx_warn:', msg);
+      },
+      return(status) {
+        r.status = status;
+      },
+      headersIn: {},
+      headersOut: {},
+      variables: {
+        [jwt.JWKS_FILE_VARIABLE]: jwks,
+        [jwt.REALM_VARIABLE]: 'Dashboards',
+      },
+    };
+
+    if (authorization) {
+      r.headersIn['Authorization'] = authorization;
+    }
+
+    if (requiredClaims) {
+      r.variables[jwt.REQUIRED_CLAIMS_VARIABLE] = Buffer.from(
+        JSON.stringify(requiredClaims),
+      ).toString('base64');
+    }
+
+    return r;
+  }
+
+  const tests = [
+    {
+      name: 'accepts a valid token',
+      request: createAuthRequest({
+        authorization: `Bearer ${createSignedToken({ iss: 'issuer' })}`,
+        requiredClaims: { iss: 'issuer' },
+      }),
+      expectedStatus: 204,
+    },
+    {
+      name: 'rejects a request without a token',
+      request: createAuthRequest(),
+      expectedStatus: 401,
+    },
+    {
+      name: 'rejects a malformed token',
+      request: createAuthRequest({ authorization: 'Bearer malformed' }),
+      expectedStatus: 401,
+    },
+    {
+      name: 'rejects a token with an invalid signature',
+      request: createAuthRequest({
+        authorization: `Bearer ${createSignedToken({ iss: 'issuer' }, 'other')}`,
+      }),
+      expectedStatus: 401,
+    },
+    {
+      name: 'accepts a token without a kid after skipping the keys that cannot be imported',
+      request: createAuthRequest({
+        authorization: `Bearer ${createSignedToken({ iss: 'issuer' }, secret, '')}`,
+        jwks: mixedJwksFile,
+      }),
+      expectedStatus: 204,
+    },
+    {
+      name: 'rejects a token that none of the keys of a mixed JWKS verify',
+      request: createAuthRequest({
+        authorization: `Bearer ${createSignedToken({ iss: 'issuer' }, 'unknown', '')}`,
+        jwks: mixedJwksFile,
+      }),
+      expectedStatus: 401,
+    },
+    {
+      name: 'rejects a token without the required claims',
+      request: createAuthRequest({
+        authorization: `Bearer ${createSignedToken({ iss: 'other' })}`,
+        requiredClaims: { iss: 'issuer' },
+      }),
+      expectedStatus: 401,
+    },
+  ];
+
+  tests.forEach((test) => {
+    it(test.name, async () => {
+      await jwt.validate(test.request);
+
+      expect(test.request.status).to.equal(test.expectedStatus);
+      if (test.expectedStatus === 401) {
+        expect(test.request.headersOut['WWW-Authenticate']).to.equal('Bearer realm="Dashboards"');
+      } else {
+        expect(test.request.headersOut['WWW-Authenticate']).to.be.undefined;
+      }
+    });
+  });
+});
@@ -0,0 +1,120 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package runtimefakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/file"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/runtime"
+)
+
+type FakeConfigTester struct {
+	TestStub        func(context.Context, []file.File) error
+	testMutex       sync.RWMutex
+	testArgsForCall []struct {
+		arg1 context.Context
+		arg2 []file.File
+	}
+	testReturns struct {
+		result1 error
+	}
+	testReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeConfigTester) Test(arg1 context.Context, arg2 []file.File) error {
+	var arg2Copy []file.File
+	if arg2 != nil {
+		arg2Copy = make([]file.File, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.testMutex.Lock()
+	ret, specificReturn := fake.testReturnsOnCall[len(fake.testArgsForCall)]
+	fake.testArgsForCall = append(fake.testArgsForCall, struct {
+		arg1 context.Context
+		arg2 []file.File
+	}{arg1, arg2Copy})
+	stub := fake.TestStub
+	fakeReturns := fake.testReturns
+	fake.recordInvocation("Test", []interface{}{arg1, arg2Copy})
+	fake.testMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfigTester) TestCallCount() int {
+	fake.testMutex.RLock()
+	defer fake.testMutex.RUnlock()
+	return len(fake.testArgsForCall)
+}
+
+func (fake *FakeConfigTester) TestCalls(stub func(context.Context, []file.File) error) {
+	fake.testMutex.Lock()
+	defer fake.testMutex.Unlock()
+	fake.TestStub = stub
+}
+
+func (fake *FakeConfigTester) TestArgsForCall(i int) (context.Context, []file.File) {
+	fake.testMutex.RLock()
+	defer fake.testMutex.RUnlock()
+	argsForCall := fake.testArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeConfigTester) TestReturns(result1 error) {
+	fake.testMutex.Lock()
+	defer fake.testMutex.Unlock()
+	fake.TestStub = nil
+	fake.testReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfigTester) TestReturnsOnCall(i int, result1 error) {
+	fake.testMutex.Lock()
+	defer fake.testMutex.Unlock()
+	fake.TestStub = nil
+	if fake.testReturnsOnCall == nil {
+		fake.testReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.testReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfigTester) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.testMutex.RLock()
+	defer fake.testMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeConfigTester) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ runtime.ConfigTester = new(FakeConfigTester)
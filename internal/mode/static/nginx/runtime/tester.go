@@ -0,0 +1,117 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/file"
+)
+
+const (
+	configFolder      = "/etc/nginx"
+	mainConfigFile    = configFolder + "/nginx.conf"
+	configTestTimeout = 10 * time.Second
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . ConfigTester
+
+// ConfigTester tests the NGINX configuration.
+type ConfigTester interface {
+	// Test tests the NGINX configuration with the files with nginx -t. It doesn't modify the configuration
+	// folder of NGINX. It returns an error if the configuration fails the test or if the test fails to run.
+	// The error includes the output of nginx -t.
+	Test(ctx context.Context, files []file.File) error
+}
+
+// ConfigTesterImpl implements ConfigTester. It runs the NGINX binary in the container of the control plane,
+// which must have the same NGINX modules, main configuration file and NJS modules as the NGINX container.
+//
+// ConfigTesterImpl writes the main configuration file and the files to a temporary folder, which it uses as
+// the prefix of NGINX. The paths to the configuration folder of NGINX in the files are replaced with the paths
+// to the temporary folder, so that the test doesn't read the files that NGINX currently uses.
+type ConfigTesterImpl struct {
+	nginxPath      string
+	mainConfigFile string
+	configFolder   string
+}
+
+// NewConfigTesterImpl creates a new ConfigTesterImpl that runs the NGINX binary at nginxPath.
+func NewConfigTesterImpl(nginxPath string) *ConfigTesterImpl {
+	return &ConfigTesterImpl{
+		nginxPath:      nginxPath,
+		mainConfigFile: mainConfigFile,
+		configFolder:   configFolder,
+	}
+}
+
+func (t *ConfigTesterImpl) Test(ctx context.Context, files []file.File) error {
+	prefix, err := os.MkdirTemp("", "nginx-config-test-")
+	if err != nil {
+		return fmt.Errorf("failed to create the folder for the test: %w", err)
+	}
+	defer os.RemoveAll(prefix)
+
+	configFile, err := t.writeFiles(prefix, files)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, configTestTimeout)
+	defer cancel()
+
+	// -q suppresses the non-error messages, -e sets the error log that nginx uses before it reads the configuration.
+	cmd := exec.CommandContext(ctx, t.nginxPath, "-t", "-q", "-e", "stderr", "-p", prefix, "-c", configFile)
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("nginx -t failed: %w: %s", err, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// writeFiles writes the main configuration file and the files to the prefix folder and returns the path to
+// the main configuration file in the prefix folder.
+func (t *ConfigTesterImpl) writeFiles(prefix string, files []file.File) (string, error) {
+	replacer := strings.NewReplacer(t.configFolder+"/", prefix+"/")
+
+	mainConfig, err := os.ReadFile(t.mainConfigFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the main configuration file: %w", err)
+	}
+
+	configFile := filepath.Join(prefix, filepath.Base(t.mainConfigFile))
+
+	toWrite := make([]file.File, 0, len(files)+1)
+	toWrite = append(toWrite, file.File{Path: configFile, Content: mainConfig, Type: file.TypeRegular})
+
+	for _, f := range files {
+		rel, err := filepath.Rel(t.configFolder, f.Path)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			return "", fmt.Errorf("file %q is not in the configuration folder %q", f.Path, t.configFolder)
+		}
+
+		toWrite = append(toWrite, file.File{Path: filepath.Join(prefix, rel), Content: f.Content, Type: f.Type})
+	}
+
+	for _, f := range toWrite {
+		mode := os.FileMode(0o644)
+		if f.Type == file.TypeSecret {
+			mode = 0o600
+		}
+
+		if err := os.MkdirAll(filepath.Dir(f.Path), 0o755); err != nil {
+			return "", fmt.Errorf("failed to create the folder for the file %q: %w", f.Path, err)
+		}
+
+		if err := os.WriteFile(f.Path, []byte(replacer.Replace(string(f.Content))), mode); err != nil {
+			return "", fmt.Errorf("failed to write the file %q: %w", f.Path, err)
+		}
+	}
+
+	return configFile, nil
+}
@@ -0,0 +1,120 @@
+package runtime
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/file"
+)
+
+func TestConfigTesterImpl(t *testing.T) {
+	createFakeNginx := func(script string) string {
+		nginxPath := filepath.Join(t.TempDir(), "nginx")
+		if err := os.WriteFile(nginxPath, []byte(script), 0o700); err != nil {
+			t.Fatalf("failed to write the fake nginx: %v", err)
+		}
+		return nginxPath
+	}
+
+	mainConfig := filepath.Join(t.TempDir(), "nginx.conf")
+	if err := os.WriteFile(mainConfig, []byte("http { include /etc/nginx/conf.d/*.conf; }\n"), 0o600); err != nil {
+		t.Fatalf("failed to write the main configuration file: %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		nginxPath   string
+		expectedErr string
+	}{
+		{
+			name:      "valid configuration",
+			nginxPath: createFakeNginx("#!/bin/sh\nexit 0\n"),
+		},
+		{
+			name: "invalid configuration",
+			nginxPath: createFakeNginx(
+				"#!/bin/sh\necho 'nginx: [emerg] unknown directive \"foo\" in /etc/nginx/conf.d/http.conf:5' >&2\nexit 1\n",
+			),
+			expectedErr: `nginx -t failed: exit status 1: nginx: [emerg] unknown directive "foo" in ` +
+				"/etc/nginx/conf.d/http.conf:5",
+		},
+		{
+			name:        "no nginx binary",
+			nginxPath:   filepath.Join(t.TempDir(), "nginx"),
+			expectedErr: "nginx -t failed: ",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			tester := NewConfigTesterImpl(test.nginxPath)
+			tester.mainConfigFile = mainConfig
+
+			err := tester.Test(context.Background(), nil)
+
+			if test.expectedErr == "" {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(HavePrefix(test.expectedErr))
+			}
+		})
+	}
+}
+
+func TestConfigTesterImplWritesFilesToPrefix(t *testing.T) {
+	dir := t.TempDir()
+
+	mainConfig := filepath.Join(dir, "nginx.conf")
+	if err := os.WriteFile(mainConfig, []byte("include /etc/nginx/conf.d/*.conf;"), 0o600); err != nil {
+		t.Fatalf("failed to write the main configuration file: %v", err)
+	}
+
+	// The fake nginx prints the arguments and the files in the prefix folder and fails, so that the test sees them.
+	nginxPath := filepath.Join(dir, "nginx")
+	script := "#!/bin/sh\necho \"$@\"\ncat \"$8\" \"$6/conf.d/http.conf\"\nls -l \"$6/secrets\"\nexit 1\n"
+	if err := os.WriteFile(nginxPath, []byte(script), 0o700); err != nil {
+		t.Fatalf("failed to write the fake nginx: %v", err)
+	}
+
+	tester := NewConfigTesterImpl(nginxPath)
+	tester.mainConfigFile = mainConfig
+
+	files := []file.File{
+		{
+			Path:    "/etc/nginx/conf.d/http.conf",
+			Content: []byte("ssl_certificate /etc/nginx/secrets/cert.pem;"),
+			Type:    file.TypeRegular,
+		},
+		{
+			Path:    "/etc/nginx/secrets/cert.pem",
+			Content: []byte("cert"),
+			Type:    file.TypeSecret,
+		},
+	}
+
+	g := NewGomegaWithT(t)
+
+	err := tester.Test(context.Background(), files)
+	g.Expect(err).To(HaveOccurred())
+
+	output := err.Error()
+	g.Expect(output).To(MatchRegexp(`-t -q -e stderr -p (\S+) -c \S+/nginx.conf`))
+
+	prefix := regexp.MustCompile(`-p (\S+)`).FindStringSubmatch(output)[1]
+	g.Expect(output).To(ContainSubstring("include " + prefix + "/conf.d/*.conf;"))
+	g.Expect(output).To(ContainSubstring("ssl_certificate " + prefix + "/secrets/cert.pem;"))
+	g.Expect(output).To(ContainSubstring("-rw------- "))
+	g.Expect(output).ToNot(ContainSubstring("/etc/nginx/"))
+	g.Expect(prefix).ToNot(BeADirectory())
+
+	err = tester.Test(context.Background(), []file.File{{Path: "/etc/passwd"}})
+	g.Expect(err).To(MatchError(`file "/etc/passwd" is not in the configuration folder "/etc/nginx"`))
+}
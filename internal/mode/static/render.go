@@ -0,0 +1,291 @@
+package static
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/go-logr/logr"
+	apiv1 "k8s.io/api/core/v1"
+	discoveryV1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/status"
+	ngxcfg "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config"
+	ngxvalidation "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/config/validation"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/file"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/graph"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/relationship"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/resolver"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// RenderConfig holds configuration parameters for Render.
+type RenderConfig struct {
+	// Logger is the logger for the change processor.
+	Logger logr.Logger
+	// Clock is used as a source of time for the LastTransitionTime field in Conditions in resource statuses.
+	Clock status.Clock
+	// GatewayCtlrName is the name of the Gateway controller.
+	GatewayCtlrName string
+	// GatewayClassName is the name of the GatewayClass resource.
+	GatewayClassName string
+	// PodIP is the IP address reported in the statuses of Gateways.
+	PodIP string
+}
+
+// RenderResult is the result of Render.
+type RenderResult struct {
+	// Graph is the Graph built from the resources.
+	Graph *graph.Graph
+	// Configuration is the dataplane configuration built from the Graph.
+	Configuration dataplane.Configuration
+	// Files are the generated NGINX configuration files.
+	Files []file.File
+	// StatusObjects are the resources with the statuses that the Gateway would write for them.
+	// Their apiVersion and kind are set.
+	StatusObjects []client.Object
+	// Events are the events that the Gateway would record, for example, for the rejected resources.
+	Events []string
+}
+
+// DecodeObjects decodes the resources from a stream of YAML or JSON documents.
+// It returns the resources that the Gateway processes in static mode. The descriptions of the other resources
+// are returned in ignored.
+// Like kubectl, DecodeObjects puts namespaced resources without a namespace into the default namespace.
+func DecodeObjects(r io.Reader) (objects []client.Object, ignored []string, err error) {
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return objects, ignored, nil
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read document: %w", err)
+		}
+
+		var meta metav1.PartialObjectMetadata
+		if err := yaml.Unmarshal(doc, &meta); err != nil {
+			return nil, nil, fmt.Errorf("failed to unmarshal document: %w", err)
+		}
+
+		if meta.APIVersion == "" && meta.Kind == "" {
+			// empty document, for example, the one before the first separator
+			continue
+		}
+
+		desc := fmt.Sprintf("%s %s %s", meta.APIVersion, meta.Kind, meta.Name)
+		if meta.Namespace != "" {
+			desc = fmt.Sprintf("%s %s %s", meta.APIVersion, meta.Kind, client.ObjectKeyFromObject(&meta))
+		}
+
+		runtimeObj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			if runtime.IsNotRegisteredError(err) {
+				ignored = append(ignored, desc)
+				continue
+			}
+			return nil, nil, fmt.Errorf("failed to decode %s: %w", desc, err)
+		}
+
+		obj, ok := runtimeObj.(client.Object)
+		if !ok || !isRenderSupported(obj) {
+			ignored = append(ignored, desc)
+			continue
+		}
+
+		if obj.GetNamespace() == "" && isNamespaced(obj) {
+			obj.SetNamespace(metav1.NamespaceDefault)
+		}
+
+		objects = append(objects, obj)
+	}
+}
+
+// isRenderSupported returns true if the Gateway processes the resource in static mode.
+// For any new supported type, make sure to also update the controller registration in StartManager.
+// The policies are supported through nkgAPI.PolicyKinds.
+func isRenderSupported(obj client.Object) bool {
+	if _, ok := nkgAPI.FindPolicyKind(obj); ok {
+		return true
+	}
+
+	switch obj.(type) {
+	case *gatewayv1beta1.GatewayClass,
+		*gatewayv1beta1.Gateway,
+		*gatewayv1beta1.HTTPRoute,
+		*gatewayv1beta1.ReferenceGrant,
+		*apiv1.Service,
+		*apiv1.Secret,
+		*apiv1.ConfigMap,
+		*apiv1.Namespace,
+		*discoveryV1.EndpointSlice,
+		*nkgAPI.AuthenticationFilter,
+		*nkgAPI.DirectResponseFilter:
+		return true
+	default:
+		return false
+	}
+}
+
+func isNamespaced(obj client.Object) bool {
+	switch obj.(type) {
+	case *gatewayv1beta1.GatewayClass, *apiv1.Namespace:
+		return false
+	default:
+		return true
+	}
+}
+
+// Render generates the NGINX configuration for the resources the same way the Gateway does in static mode,
+// but without a Kubernetes API server: Services are resolved using the EndpointSlices among the resources.
+// The statuses are prepared as if NGINX was successfully reloaded with the generated configuration.
+// The default values of the fields of the Gateway API resources are set like the API server sets them.
+// The resources must be supported by the Gateway. See DecodeObjects.
+func Render(ctx context.Context, cfg RenderConfig, objects []client.Object) (RenderResult, error) {
+	if len(objects) == 0 {
+		return RenderResult{}, errors.New("no resources to render")
+	}
+
+	// Like the API server, set the default values of the fields and the kinds before processing the resources.
+	// The copies keep the input objects unmodified.
+	defaultedObjects := make([]client.Object, 0, len(objects))
+	for _, obj := range objects {
+		gvk, err := apiutil.GVKForObject(obj, scheme)
+		if err != nil {
+			return RenderResult{}, fmt.Errorf("failed to get the kind of %T: %w", obj, err)
+		}
+
+		obj = obj.DeepCopyObject().(client.Object)
+		obj.GetObjectKind().SetGroupVersionKind(gvk)
+		setDefaults(obj)
+		defaultedObjects = append(defaultedObjects, obj)
+	}
+	objects = defaultedObjects
+
+	recorder := &eventCollector{}
+
+	processor := state.NewChangeProcessorImpl(state.ChangeProcessorConfig{
+		GatewayCtlrName:      cfg.GatewayCtlrName,
+		GatewayClassName:     cfg.GatewayClassName,
+		RelationshipCapturer: relationship.NewCapturerImpl(),
+		Logger:               cfg.Logger,
+		Validators: validation.Validators{
+			HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
+			PolicyValidator:     ngxvalidation.PolicyValidator{},
+		},
+		EventRecorder: recorder,
+		Scheme:        scheme,
+	})
+
+	var endpointSlices []discoveryV1.EndpointSlice
+
+	for _, obj := range objects {
+		if eps, ok := obj.(*discoveryV1.EndpointSlice); ok {
+			endpointSlices = append(endpointSlices, *eps)
+		}
+
+		processor.CaptureUpsertChange(obj)
+	}
+
+	changed, g := processor.Process()
+	if !changed {
+		// all resources were rejected
+		g = &graph.Graph{}
+	}
+
+	conf := dataplane.BuildConfiguration(ctx, g, resolver.NewStaticServiceResolver(endpointSlices), nil)
+	files := ngxcfg.NewGeneratorImpl().Generate(conf)
+
+	statuses := buildStatuses(g, nginxReloadResult{}, nil)
+
+	var statusObjects []client.Object
+
+	for _, obj := range objects {
+		nsname := client.ObjectKeyFromObject(obj)
+
+		var exists bool
+		switch obj.(type) {
+		case *gatewayv1beta1.GatewayClass:
+			_, exists = statuses.GatewayClassStatuses[nsname]
+		case *gatewayv1beta1.Gateway:
+			_, exists = statuses.GatewayStatuses[nsname]
+		case *gatewayv1beta1.HTTPRoute:
+			_, exists = statuses.HTTPRouteStatuses[nsname]
+		default:
+			if kind, ok := nkgAPI.FindPolicyKind(obj); ok {
+				key := status.PolicyKey{NsName: nsname, Kind: kind.Name}
+				_, exists = statuses.PolicyStatuses[key]
+			}
+		}
+
+		if exists {
+			statusObjects = append(statusObjects, obj.DeepCopyObject().(client.Object))
+		}
+	}
+
+	status.SetStatuses(
+		status.UpdaterConfig{
+			Clock:                    cfg.Clock,
+			GatewayCtlrName:          cfg.GatewayCtlrName,
+			GatewayClassName:         cfg.GatewayClassName,
+			PodIP:                    cfg.PodIP,
+			UpdateGatewayClassStatus: true,
+		},
+		statusObjects,
+		statuses,
+	)
+
+	return RenderResult{
+		Graph:         g,
+		Configuration: conf,
+		Files:         files,
+		StatusObjects: statusObjects,
+		Events:        recorder.events,
+	}, nil
+}
+
+// eventCollector implements record.EventRecorder.
+// Instead of sending the events to the Kubernetes API server, it collects them.
+type eventCollector struct {
+	events []string
+}
+
+func (c *eventCollector) Event(object runtime.Object, eventtype, reason, message string) {
+	desc := fmt.Sprintf("%T", object)
+
+	if obj, ok := object.(client.Object); ok {
+		if gvk, err := apiutil.GVKForObject(obj, scheme); err == nil {
+			desc = fmt.Sprintf("%s %s", gvk.Kind, client.ObjectKeyFromObject(obj))
+		}
+	}
+
+	c.events = append(c.events, fmt.Sprintf("%s %s %s: %s", eventtype, reason, desc, message))
+}
+
+func (c *eventCollector) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	c.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+func (c *eventCollector) AnnotatedEventf(
+	object runtime.Object,
+	_ map[string]string,
+	eventtype, reason, messageFmt string,
+	args ...interface{},
+) {
+	c.Eventf(object, eventtype, reason, messageFmt, args...)
+}
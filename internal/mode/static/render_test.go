@@ -0,0 +1,328 @@
+package static
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	discoveryV1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/status/statusfakes"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/nginx/file"
+)
+
+const renderManifests = `
+# the empty document before the first separator must be skipped
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: GatewayClass
+metadata:
+  name: nginx
+spec:
+  controllerName: k8s-gateway.nginx.org/nginx-gateway-controller
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: Gateway
+metadata:
+  name: gateway
+spec:
+  gatewayClassName: nginx
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+    allowedRoutes:
+      namespaces:
+        from: All
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: HTTPRoute
+metadata:
+  name: coffee
+  namespace: cafe
+spec:
+  parentRefs:
+  - name: gateway
+    namespace: default
+  hostnames:
+  - cafe.example.com
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /coffee
+    backendRefs:
+    - name: coffee
+      port: 80
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: coffee
+  namespace: cafe
+spec:
+  ports:
+  - name: http
+    port: 80
+    targetPort: 8080
+---
+apiVersion: discovery.k8s.io/v1
+kind: EndpointSlice
+metadata:
+  name: coffee-abc
+  namespace: cafe
+  labels:
+    kubernetes.io/service-name: coffee
+addressType: IPv4
+ports:
+- name: http
+  port: 8080
+endpoints:
+- addresses:
+  - 10.0.0.1
+  conditions:
+    ready: true
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  namespace: cafe
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: coffee
+`
+
+func TestDecodeObjects(t *testing.T) {
+	g := NewWithT(t)
+
+	objects, ignored, err := DecodeObjects(strings.NewReader(renderManifests))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(objects).To(HaveLen(6))
+	g.Expect(objects[0]).To(BeAssignableToTypeOf(&v1beta1.GatewayClass{}))
+	g.Expect(objects[0].GetNamespace()).To(BeEmpty())
+	g.Expect(objects[1]).To(BeAssignableToTypeOf(&v1beta1.Gateway{}))
+	g.Expect(objects[1].GetNamespace()).To(Equal(metav1.NamespaceDefault))
+	g.Expect(objects[2]).To(BeAssignableToTypeOf(&v1beta1.HTTPRoute{}))
+	g.Expect(objects[2].GetNamespace()).To(Equal("cafe"))
+	g.Expect(objects[3]).To(BeAssignableToTypeOf(&apiv1.Service{}))
+	g.Expect(objects[4]).To(BeAssignableToTypeOf(&discoveryV1.EndpointSlice{}))
+	g.Expect(objects[5]).To(BeAssignableToTypeOf(&apiv1.ConfigMap{}))
+	g.Expect(objects[5].GetNamespace()).To(Equal("cafe"))
+
+	g.Expect(ignored).To(Equal([]string{
+		"apps/v1 Deployment coffee",
+	}))
+}
+
+func TestDecodeObjectsInvalid(t *testing.T) {
+	g := NewWithT(t)
+
+	const manifests = `
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: GatewayClass
+metadata:
+  name: nginx
+spec:
+  controllerName: k8s-gateway.nginx.org/nginx-gateway-controller
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: Gateway
+metadata:
+  name: gateway
+spec:
+  listeners: invalid
+`
+
+	objects, ignored, err := DecodeObjects(strings.NewReader(manifests))
+	g.Expect(err).To(MatchError(ContainSubstring("failed to decode gateway.networking.k8s.io/v1beta1 Gateway gateway")))
+	g.Expect(objects).To(BeNil())
+	g.Expect(ignored).To(BeNil())
+}
+
+func TestRender(t *testing.T) {
+	g := NewWithT(t)
+
+	objects, _, err := DecodeObjects(strings.NewReader(renderManifests))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	fakeClockTime := metav1.Now()
+	fakeClock := &statusfakes.FakeClock{}
+	fakeClock.NowReturns(fakeClockTime)
+
+	res, err := Render(
+		context.Background(),
+		RenderConfig{
+			Logger:           zap.New(),
+			Clock:            fakeClock,
+			GatewayCtlrName:  "k8s-gateway.nginx.org/nginx-gateway-controller",
+			GatewayClassName: "nginx",
+			PodIP:            "1.2.3.4",
+		},
+		objects,
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Events).To(BeEmpty())
+
+	g.Expect(res.Files).To(HaveLen(1))
+	g.Expect(res.Files[0].Type).To(Equal(file.TypeRegular))
+
+	httpConf := string(res.Files[0].Content)
+	g.Expect(httpConf).To(ContainSubstring("upstream cafe_coffee_80"))
+	g.Expect(httpConf).To(ContainSubstring("server 10.0.0.1:8080;"))
+	g.Expect(httpConf).To(ContainSubstring("server_name cafe.example.com;"))
+	g.Expect(httpConf).To(ContainSubstring("location /coffee/"))
+
+	g.Expect(res.StatusObjects).To(HaveLen(3))
+
+	gc, ok := res.StatusObjects[0].(*v1beta1.GatewayClass)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(gc.Status.Conditions).ToNot(BeEmpty())
+
+	gw, ok := res.StatusObjects[1].(*v1beta1.Gateway)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(gw.Status.Addresses).To(HaveLen(1))
+	g.Expect(gw.Status.Addresses[0].Value).To(Equal("1.2.3.4"))
+	g.Expect(gw.Status.Listeners).To(HaveLen(1))
+	g.Expect(gw.Status.Listeners[0].AttachedRoutes).To(Equal(int32(1)))
+
+	hr, ok := res.StatusObjects[2].(*v1beta1.HTTPRoute)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(hr.Status.Parents).To(HaveLen(1))
+	g.Expect(hr.Status.Parents[0].Conditions).ToNot(BeEmpty())
+	g.Expect(hr.Status.Parents[0].Conditions[0].LastTransitionTime).To(Equal(fakeClockTime))
+
+	// the input objects must not be modified
+	g.Expect(objects[2].(*v1beta1.HTTPRoute).Status).To(Equal(v1beta1.HTTPRouteStatus{}))
+}
+
+func TestRenderDefaults(t *testing.T) {
+	g := NewWithT(t)
+
+	// the fields that the API server defaults are omitted: the allowedRoutes of the listener,
+	// the group and kind of the parentRef and the backendRef, the weight of the backendRef,
+	// the type of the path, the matches of the second rule and the status code of the redirect
+	const manifests = `
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: GatewayClass
+metadata:
+  name: nginx
+spec:
+  controllerName: k8s-gateway.nginx.org/nginx-gateway-controller
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: Gateway
+metadata:
+  name: gateway
+spec:
+  gatewayClassName: nginx
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+---
+apiVersion: gateway.networking.k8s.io/v1beta1
+kind: HTTPRoute
+metadata:
+  name: coffee
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - matches:
+    - path:
+        value: /coffee
+    backendRefs:
+    - name: coffee
+      port: 80
+  - filters:
+    - type: RequestRedirect
+      requestRedirect:
+        scheme: https
+`
+
+	objects, _, err := DecodeObjects(strings.NewReader(manifests))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	res, err := Render(
+		context.Background(),
+		RenderConfig{
+			Logger:           zap.New(),
+			Clock:            &statusfakes.FakeClock{},
+			GatewayCtlrName:  "k8s-gateway.nginx.org/nginx-gateway-controller",
+			GatewayClassName: "nginx",
+		},
+		objects,
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Events).To(BeEmpty())
+
+	g.Expect(res.Files).To(HaveLen(1))
+
+	httpConf := string(res.Files[0].Content)
+	g.Expect(httpConf).To(ContainSubstring("location /coffee/"))
+	g.Expect(httpConf).To(ContainSubstring("location / {"))
+	g.Expect(httpConf).To(ContainSubstring(`return 302 "https://$host$request_uri";`))
+	g.Expect(httpConf).ToNot(ContainSubstring(`return 404 "";`))
+
+	g.Expect(res.StatusObjects).To(HaveLen(3))
+
+	hr, ok := res.StatusObjects[2].(*v1beta1.HTTPRoute)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(hr.Spec.Rules[0].Matches[0].Path.Type).To(Equal(helpers.GetPointer(v1beta1.PathMatchPathPrefix)))
+	g.Expect(hr.Spec.Rules[1].Matches).To(HaveLen(1))
+	g.Expect(hr.Status.Parents).To(HaveLen(1))
+	g.Expect(hr.Status.Parents[0].Conditions[0].Status).To(Equal(metav1.ConditionTrue))
+
+	// the input objects must not be modified
+	g.Expect(objects[2].(*v1beta1.HTTPRoute).Spec.Rules[1].Matches).To(BeNil())
+}
+
+func TestRenderRejectedResource(t *testing.T) {
+	g := NewWithT(t)
+
+	invalidRoute := &v1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "hr",
+		},
+		Spec: v1beta1.HTTPRouteSpec{
+			CommonRouteSpec: v1beta1.CommonRouteSpec{
+				// duplicate parentRefs are rejected by the webhook validation
+				ParentRefs: []v1beta1.ParentReference{{Name: "gateway"}, {Name: "gateway"}},
+			},
+		},
+	}
+
+	res, err := Render(
+		context.Background(),
+		RenderConfig{
+			Logger:           zap.New(),
+			Clock:            &statusfakes.FakeClock{},
+			GatewayCtlrName:  "k8s-gateway.nginx.org/nginx-gateway-controller",
+			GatewayClassName: "nginx",
+		},
+		[]client.Object{invalidRoute},
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(res.Events).To(HaveLen(1))
+	g.Expect(res.Events[0]).To(HavePrefix("Warning Rejected HTTPRoute test/hr: "))
+	g.Expect(res.StatusObjects).To(BeEmpty())
+}
+
+func TestRenderNoResources(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := Render(context.Background(), RenderConfig{Logger: zap.New()}, nil)
+	g.Expect(err).To(MatchError("no resources to render"))
+}
@@ -0,0 +1,532 @@
+// Package routetest evaluates a synthetic HTTP request against the dataplane configuration to explain how NGINX
+// would route it.
+//
+// The evaluation mirrors how the NGINX configuration is generated from the dataplane configuration and how NGINX
+// processes a request:
+// - NGINX selects a server by the port and the server name: an exact name, then the longest wildcard name, then the
+// catch-all name, then the default server.
+// - NGINX selects a location of the server: an exact location or, otherwise, the longest prefix location. The
+// locations are generated by the nginx/config package from the path rules.
+// - If the location includes HTTP matches, the NJS httpmatches module selects the first match the request
+// satisfies. The matches are ordered by the precedence rules from the dataplane package. For a rule that configures
+// CORS, the module tests a CORS preflight request against the method of the actual request and doesn't test
+// its headers, and NGINX responds to the preflight request without proxying it.
+//
+// Any change to how the NGINX configuration is generated or to the NJS httpmatches module must be reflected here.
+package routetest
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/graph"
+)
+
+const (
+	wildcardHostname = "~^"
+	rootPath         = "/"
+)
+
+// Request is a synthetic HTTP request.
+type Request struct {
+	// Headers are the headers of the request.
+	Headers http.Header
+	// Query are the query parameters of the request.
+	Query url.Values
+	// Host is the value of the Host header of the request. It may include a port.
+	Host string
+	// Method is the method of the request.
+	Method string
+	// Path is the path of the request without the query.
+	Path string
+	// Port is the port NGINX receives the request on.
+	Port int32
+}
+
+// Result describes how NGINX would process a Request.
+type Result struct {
+	// Server is the selected server. Nil if no server listens on the port of the Request.
+	Server *dataplane.VirtualServer
+	// Listener is the Gateway listener that the selected server was generated for.
+	// Nil if the default server was selected.
+	Listener *Listener
+	// MatchRule is the selected routing rule. Nil if no rule matches the Request.
+	MatchRule *dataplane.MatchRule
+	// Message describes the outcome, for example, why no rule matches the Request.
+	Message string
+	// Location is the path of the selected NGINX location, including the '=' modifier for exact locations.
+	Location string
+	// Backends are the backends of the selected routing rule with their share of the traffic.
+	Backends []Backend
+	// StatusCode is the status code NGINX responds with without proxying the Request.
+	// It is 0 if NGINX proxies the Request to the Backends.
+	StatusCode int
+}
+
+// Listener identifies a Gateway listener.
+type Listener struct {
+	// Gateway is the namespaced name of the Gateway.
+	Gateway types.NamespacedName
+	// Name is the name of the listener.
+	Name string
+}
+
+// Backend is a backend of the selected routing rule.
+type Backend struct {
+	// UpstreamName is the name of the upstream for this backend.
+	UpstreamName string
+	// Percent is the share of the traffic the backend receives.
+	Percent float64
+	// Weight is the weight of the backend.
+	Weight int32
+	// Valid indicates whether the backend is valid. NGINX responds with 500 to the requests routed to
+	// invalid backends.
+	Valid bool
+}
+
+// location is an NGINX location generated for a path rule.
+type location struct {
+	path string
+	// pathRuleIdx is the index of the path rule in the server. It is -1 for the default root location.
+	pathRuleIdx int
+	exact       bool
+	internal    bool
+}
+
+// Evaluate evaluates the Request against the Configuration built from the Graph.
+func Evaluate(g *graph.Graph, conf dataplane.Configuration, req Request) Result {
+	server, exists := findServer(conf, req.Port, req.Host)
+	if !exists {
+		return Result{Message: "no server listens on the port"}
+	}
+
+	res := Result{Server: &server}
+
+	if server.IsDefault {
+		if isSSLPort(conf, req.Port) {
+			res.Message = "the host matches no server; the default server rejects the TLS handshake"
+			return res
+		}
+
+		res.StatusCode = http.StatusNotFound
+		res.Message = "the host matches no server; the default server responds with 404"
+		return res
+	}
+
+	loc, exists := findLocation(server.PathRules, req.Path)
+	if !exists {
+		res.StatusCode = http.StatusNotFound
+		res.Message = "the path matches no location"
+		return res
+	}
+
+	res.Location = loc.path
+	if loc.exact {
+		res.Location = "= " + loc.path
+	}
+
+	switch {
+	case loc.internal:
+		res.StatusCode = http.StatusNotFound
+		res.Message = "the path matches an internal location"
+		return res
+	case loc.pathRuleIdx == -1:
+		res.StatusCode = http.StatusNotFound
+		res.Message = "the path matches no path rule; the default root location responds with 404"
+		return res
+	}
+
+	pathRule := server.PathRules[loc.pathRuleIdx]
+
+	matchRule, exists := findMatchRule(pathRule.MatchRules, req)
+	if !exists {
+		res.StatusCode = http.StatusNotFound
+		res.Message = "the request satisfies no match of the path rule"
+		return res
+	}
+
+	res.MatchRule = &matchRule
+	res.Listener = findListener(g, server, matchRule.Source)
+
+	switch {
+	case matchRule.Filters.InvalidFilter != nil:
+		res.StatusCode = http.StatusInternalServerError
+		res.Message = "the routing rule has invalid filters"
+	case matchRule.Filters.DirectResponse != nil:
+		res.StatusCode = int(matchRule.Filters.DirectResponse.StatusCode)
+		res.Message = "the routing rule responds directly"
+	case matchRule.Filters.RequestRedirect != nil:
+		res.StatusCode = http.StatusFound
+		if matchRule.Filters.RequestRedirect.StatusCode != nil {
+			res.StatusCode = *matchRule.Filters.RequestRedirect.StatusCode
+		}
+		res.Message = "the routing rule redirects the request"
+	case matchRule.CORS != nil && isPreflight(req):
+		res.StatusCode = http.StatusNoContent
+		res.Message = "the routing rule responds to the CORS preflight request"
+	default:
+		res.Backends = buildBackends(matchRule.BackendGroup)
+		res.Message = "the request is proxied to the backends"
+		if auth := matchRule.Filters.Authentication; auth != nil {
+			res.Message += " " + describeAuthenticationCondition(*auth)
+		}
+	}
+
+	return res
+}
+
+// describeAuthenticationCondition describes when NGINX proxies a request that the Authentication applies to.
+func describeAuthenticationCondition(auth dataplane.Authentication) string {
+	if auth.External != nil {
+		return "only if the external authorization service responds with 2xx; otherwise, NGINX responds with " +
+			"401 or 403 of the service or with 500"
+	}
+
+	if auth.JWT != nil {
+		return "only if it has a valid JSON Web Token; otherwise, NGINX responds with 401"
+	}
+
+	return "only if it passes basic authentication; otherwise, NGINX responds with 401"
+}
+
+func isSSLPort(conf dataplane.Configuration, port int32) bool {
+	for _, s := range conf.SSLServers {
+		if s.Port == port {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findServer finds the server for the port and the host like NGINX does. See
+// https://nginx.org/en/docs/http/request_processing.html and https://nginx.org/en/docs/http/server_names.html
+func findServer(conf dataplane.Configuration, port int32, host string) (dataplane.VirtualServer, bool) {
+	host = normalizeHost(host)
+
+	var (
+		exact, wildcard, catchAll, defaultServer *dataplane.VirtualServer
+		portExists                               bool
+	)
+
+	servers := make([]dataplane.VirtualServer, 0, len(conf.HTTPServers)+len(conf.SSLServers))
+	servers = append(servers, conf.HTTPServers...)
+	servers = append(servers, conf.SSLServers...)
+
+	for i := range servers {
+		s := &servers[i]
+
+		if s.Port != port {
+			continue
+		}
+
+		portExists = true
+
+		switch {
+		case s.IsDefault:
+			defaultServer = s
+		case s.Hostname == wildcardHostname:
+			if catchAll == nil {
+				catchAll = s
+			}
+		case strings.HasPrefix(s.Hostname, "*."):
+			if strings.HasSuffix(host, s.Hostname[1:]) && (wildcard == nil || len(s.Hostname) > len(wildcard.Hostname)) {
+				wildcard = s
+			}
+		case s.Hostname == host:
+			exact = s
+		}
+	}
+
+	if !portExists {
+		return dataplane.VirtualServer{}, false
+	}
+
+	for _, s := range []*dataplane.VirtualServer{exact, wildcard, catchAll, defaultServer} {
+		if s != nil {
+			return *s, true
+		}
+	}
+
+	return dataplane.VirtualServer{}, false
+}
+
+func normalizeHost(host string) string {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	return strings.ToLower(strings.TrimSuffix(host, "."))
+}
+
+// buildLocations builds the locations for the path rules the same way the nginx/config package does.
+func buildLocations(pathRules []dataplane.PathRule) []location {
+	pathTypes := make(map[string]map[dataplane.PathType]struct{})
+	for _, rule := range pathRules {
+		if pathTypes[rule.Path] == nil {
+			pathTypes[rule.Path] = make(map[dataplane.PathType]struct{})
+		}
+		pathTypes[rule.Path][rule.PathType] = struct{}{}
+	}
+
+	pathTypeExists := func(path string, pathType dataplane.PathType) bool {
+		_, exists := pathTypes[path][pathType]
+		return exists
+	}
+
+	var (
+		locs           []location
+		rootPathExists bool
+	)
+
+	for idx, rule := range pathRules {
+		if rule.Path == rootPath {
+			rootPathExists = true
+		}
+
+		switch {
+		case rule.PathType == dataplane.PathTypeExact:
+			locs = append(locs, location{path: rule.Path, exact: true, pathRuleIdx: idx})
+		case !strings.HasSuffix(rule.Path, "/"):
+			// A prefix path without a trailing slash gets a trailing slash prefix location and an exact location,
+			// unless some other path rule already configures them.
+			if !pathTypeExists(rule.Path+"/", dataplane.PathTypePrefix) {
+				locs = append(locs, location{path: rule.Path + "/", pathRuleIdx: idx})
+			}
+			if !pathTypeExists(rule.Path, dataplane.PathTypeExact) {
+				locs = append(locs, location{path: rule.Path, exact: true, pathRuleIdx: idx})
+			}
+		default:
+			locs = append(locs, location{path: rule.Path, pathRuleIdx: idx})
+		}
+
+		if len(rule.MatchRules) != 1 || !isPathOnlyMatch(rule.MatchRules[0].GetMatch()) {
+			for matchRuleIdx := range rule.MatchRules {
+				locs = append(locs, location{
+					path:        createPathForMatch(rule.Path, rule.PathType, matchRuleIdx),
+					internal:    true,
+					pathRuleIdx: idx,
+				})
+			}
+		}
+	}
+
+	if !rootPathExists {
+		locs = append(locs, location{path: rootPath, pathRuleIdx: -1})
+	}
+
+	return locs
+}
+
+// findLocation finds the location for the path like NGINX does: an exact location wins, otherwise the longest
+// prefix location wins. See https://nginx.org/en/docs/http/ngx_http_core_module.html#location
+func findLocation(pathRules []dataplane.PathRule, path string) (location, bool) {
+	var (
+		longest location
+		found   bool
+	)
+
+	for _, loc := range buildLocations(pathRules) {
+		if loc.exact {
+			if loc.path == path {
+				return loc, true
+			}
+			continue
+		}
+
+		if strings.HasPrefix(path, loc.path) && (!found || len(loc.path) > len(longest.path)) {
+			longest = loc
+			found = true
+		}
+	}
+
+	return longest, found
+}
+
+// findMatchRule finds the routing rule for the Request.
+// The match rules are sorted by precedence. Like the NJS httpmatches module, it returns the first rule
+// the Request satisfies. For a rule that configures CORS, a CORS preflight request is tested against the method
+// of the actual request and its headers are not tested.
+func findMatchRule(matchRules []dataplane.MatchRule, req Request) (dataplane.MatchRule, bool) {
+	for _, r := range matchRules {
+		if testMatch(r.GetMatch(), req, r.CORS != nil && isPreflight(req)) {
+			return r, true
+		}
+	}
+
+	return dataplane.MatchRule{}, false
+}
+
+// isPreflight checks if the Request is a CORS preflight request like the NJS httpmatches module and
+// the $cors_preflight variable of the NGINX configuration do.
+func isPreflight(req Request) bool {
+	return req.Method == http.MethodOptions &&
+		req.Headers.Get("Origin") != "" &&
+		req.Headers.Get("Access-Control-Request-Method") != ""
+}
+
+func testMatch(match v1beta1.HTTPRouteMatch, req Request, preflight bool) bool {
+	method := req.Method
+	if preflight {
+		method = req.Headers.Get("Access-Control-Request-Method")
+	}
+
+	if match.Method != nil && string(*match.Method) != method {
+		return false
+	}
+
+	// Like the nginx/config package, we only consider the Exact header and query param matches.
+	// The other types are rejected during the validation.
+
+	// A CORS preflight request doesn't carry the non-safelisted headers of the actual request,
+	// so its headers are not tested.
+	if !preflight && !headersMatch(match.Headers, req.Headers) {
+		return false
+	}
+
+	for _, p := range match.QueryParams {
+		if p.Type != nil && *p.Type != v1beta1.QueryParamMatchExact {
+			continue
+		}
+
+		// According to the Gateway API spec, only the first value of a query param is matched.
+		if val := req.Query.Get(string(p.Name)); val == "" || val != p.Value {
+			return false
+		}
+	}
+
+	return true
+}
+
+func headersMatch(matches []v1beta1.HTTPHeaderMatch, headers http.Header) bool {
+	for _, h := range matches {
+		if h.Type != nil && *h.Type != v1beta1.HeaderMatchExact {
+			continue
+		}
+
+		// NGINX delimits multiple header values with commas.
+		val := strings.Join(headers.Values(string(h.Name)), ",")
+		if val == "" || !contains(strings.Split(val, ","), h.Value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findListener finds the listener that the server was generated for. Among the listeners that the route is attached
+// to for the server hostname, the listener with the most specific hostname wins.
+func findListener(g *graph.Graph, server dataplane.VirtualServer, route *v1beta1.HTTPRoute) *Listener {
+	if g == nil || g.Gateway == nil {
+		return nil
+	}
+
+	routeNsName := types.NamespacedName{Namespace: route.Namespace, Name: route.Name}
+
+	names := make([]string, 0, len(g.Gateway.Listeners))
+	for name := range g.Gateway.Listeners {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var (
+		result         string
+		resultHostname string
+	)
+
+	for _, name := range names {
+		l := g.Gateway.Listeners[name]
+		if !l.Valid || int32(l.Source.Port) != server.Port {
+			continue
+		}
+
+		r, attached := l.Routes[routeNsName]
+		if !attached || !acceptsHostname(r, name, server.Hostname) {
+			continue
+		}
+
+		var hostname string
+		if l.Source.Hostname != nil {
+			hostname = string(*l.Source.Hostname)
+		}
+
+		if result == "" || graph.GetMoreSpecificHostname(hostname, resultHostname) == hostname {
+			result = name
+			resultHostname = hostname
+		}
+	}
+
+	if result == "" {
+		return nil
+	}
+
+	return &Listener{
+		Gateway: types.NamespacedName{Namespace: g.Gateway.Source.Namespace, Name: g.Gateway.Source.Name},
+		Name:    result,
+	}
+}
+
+func acceptsHostname(r *graph.Route, listenerName, hostname string) bool {
+	for _, ref := range r.ParentRefs {
+		if ref.Attachment == nil {
+			continue
+		}
+
+		if contains(ref.Attachment.AcceptedHostnames[listenerName], hostname) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func buildBackends(group dataplane.BackendGroup) []Backend {
+	totalWeight := int32(0)
+	for _, b := range group.Backends {
+		totalWeight += b.Weight
+	}
+
+	backends := make([]Backend, 0, len(group.Backends))
+
+	for _, b := range group.Backends {
+		var percent float64
+		if totalWeight > 0 {
+			percent = float64(b.Weight) * 100 / float64(totalWeight)
+		}
+
+		backends = append(backends, Backend{
+			UpstreamName: b.UpstreamName,
+			Weight:       b.Weight,
+			Percent:      percent,
+			Valid:        b.Valid,
+		})
+	}
+
+	return backends
+}
+
+func isPathOnlyMatch(match v1beta1.HTTPRouteMatch) bool {
+	return match.Method == nil && match.Headers == nil && match.QueryParams == nil
+}
+
+func createPathForMatch(path string, pathType dataplane.PathType, routeIdx int) string {
+	return fmt.Sprintf("%s_%s_route%d", path, pathType, routeIdx)
+}
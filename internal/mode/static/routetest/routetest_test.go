@@ -0,0 +1,644 @@
+package routetest
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/dataplane"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/graph"
+)
+
+func TestEvaluate(t *testing.T) {
+	createRoute := func(name string, matches ...v1beta1.HTTPRouteMatch) *v1beta1.HTTPRoute {
+		return &v1beta1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      name,
+			},
+			Spec: v1beta1.HTTPRouteSpec{
+				Rules: []v1beta1.HTTPRouteRule{
+					{
+						Matches: matches,
+					},
+				},
+			},
+		}
+	}
+
+	prefixMatch := func(path string) v1beta1.HTTPRouteMatch {
+		return v1beta1.HTTPRouteMatch{
+			Path: &v1beta1.HTTPPathMatch{
+				Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+				Value: helpers.GetStringPointer(path),
+			},
+		}
+	}
+
+	coffeeRoute := createRoute("coffee", prefixMatch("/coffee"))
+
+	teaV2Match := prefixMatch("/tea")
+	teaV2Match.Headers = []v1beta1.HTTPHeaderMatch{
+		{
+			Type:  helpers.GetPointer(v1beta1.HeaderMatchExact),
+			Name:  "version",
+			Value: "v2",
+		},
+	}
+	teaV2Match.QueryParams = []v1beta1.HTTPQueryParamMatch{
+		{
+			Type:  helpers.GetPointer(v1beta1.QueryParamMatchExact),
+			Name:  "flavor",
+			Value: "green",
+		},
+	}
+	teaV2Route := createRoute("tea-v2", teaV2Match)
+	teaRoute := createRoute("tea", prefixMatch("/tea"))
+
+	postMatch := prefixMatch("/orders")
+	postMatch.Method = helpers.GetPointer(v1beta1.HTTPMethodPost)
+	ordersRoute := createRoute("orders", postMatch)
+
+	redirectRoute := createRoute("redirect", prefixMatch("/old"))
+	healthRoute := createRoute("health", prefixMatch("/health"))
+	adminRoute := createRoute("admin", prefixMatch("/admin"))
+	accountRoute := createRoute("account", prefixMatch("/account"))
+	billingRoute := createRoute("billing", prefixMatch("/billing"))
+
+	menuMatch := prefixMatch("/menu")
+	menuMatch.Method = helpers.GetPointer(v1beta1.HTTPMethodPut)
+	menuMatch.Headers = []v1beta1.HTTPHeaderMatch{
+		{
+			Type:  helpers.GetPointer(v1beta1.HeaderMatchExact),
+			Name:  "X-Api-Key",
+			Value: "secret",
+		},
+	}
+	menuRoute := createRoute("menu", menuMatch)
+	invalidRoute := createRoute("invalid", prefixMatch("/invalid"))
+	wildcardRoute := createRoute("wildcard", prefixMatch("/"))
+
+	routeNsName := func(r *v1beta1.HTTPRoute) types.NamespacedName {
+		return types.NamespacedName{Namespace: r.Namespace, Name: r.Name}
+	}
+
+	cafeServer := dataplane.VirtualServer{
+		Hostname: "cafe.example.com",
+		Port:     80,
+		PathRules: []dataplane.PathRule{
+			{
+				Path:     "/account",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Source: accountRoute,
+						BackendGroup: dataplane.BackendGroup{
+							Source:   routeNsName(accountRoute),
+							Backends: []dataplane.Backend{{UpstreamName: "test_account_80", Weight: 1, Valid: true}},
+						},
+						Filters: dataplane.Filters{
+							Authentication: &dataplane.Authentication{
+								JWT:   &dataplane.JWTAuthentication{},
+								Realm: "account",
+							},
+						},
+					},
+				},
+			},
+			{
+				Path:     "/admin",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Source: adminRoute,
+						BackendGroup: dataplane.BackendGroup{
+							Source:   routeNsName(adminRoute),
+							Backends: []dataplane.Backend{{UpstreamName: "test_admin_80", Weight: 1, Valid: true}},
+						},
+						Filters: dataplane.Filters{
+							Authentication: &dataplane.Authentication{Realm: "admin"},
+						},
+					},
+				},
+			},
+			{
+				Path:     "/billing",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Source: billingRoute,
+						BackendGroup: dataplane.BackendGroup{
+							Source:   routeNsName(billingRoute),
+							Backends: []dataplane.Backend{{UpstreamName: "test_billing_80", Weight: 1, Valid: true}},
+						},
+						Filters: dataplane.Filters{
+							Authentication: &dataplane.Authentication{
+								External: &dataplane.ExternalAuthentication{
+									UpstreamName: "ext_auth_test_auth",
+									Path:         "/",
+								},
+							},
+						},
+					},
+				},
+			},
+			{
+				Path:     "/coffee",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Source: coffeeRoute,
+						BackendGroup: dataplane.BackendGroup{
+							Source: routeNsName(coffeeRoute),
+							Backends: []dataplane.Backend{
+								{UpstreamName: "test_coffee-v1_80", Weight: 1, Valid: true},
+								{UpstreamName: "test_coffee-v2_80", Weight: 3, Valid: true},
+							},
+						},
+					},
+				},
+			},
+			{
+				Path:     "/health",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Source: healthRoute,
+						Filters: dataplane.Filters{
+							DirectResponse: &dataplane.DirectResponse{
+								StatusCode:  http.StatusOK,
+								ContentType: "text/plain",
+								Body:        "ok",
+							},
+						},
+					},
+				},
+			},
+			{
+				Path:     "/invalid",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Source:  invalidRoute,
+						Filters: dataplane.Filters{InvalidFilter: &dataplane.InvalidFilter{}},
+					},
+				},
+			},
+			{
+				Path:     "/menu",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Source: menuRoute,
+						BackendGroup: dataplane.BackendGroup{
+							Source:   routeNsName(menuRoute),
+							Backends: []dataplane.Backend{{UpstreamName: "test_menu_80", Weight: 1, Valid: true}},
+						},
+						CORS: &dataplane.CORS{},
+					},
+				},
+			},
+			{
+				Path:     "/old",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Source: redirectRoute,
+						Filters: dataplane.Filters{
+							RequestRedirect: &v1beta1.HTTPRequestRedirectFilter{
+								StatusCode: helpers.GetPointer(301),
+							},
+						},
+					},
+				},
+			},
+			{
+				Path:     "/orders",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{Source: ordersRoute},
+				},
+			},
+			{
+				Path:     "/tea",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Source: teaV2Route,
+						BackendGroup: dataplane.BackendGroup{
+							Source:   routeNsName(teaV2Route),
+							Backends: []dataplane.Backend{{UpstreamName: "test_tea-v2_80", Weight: 1, Valid: true}},
+						},
+					},
+					{
+						Source: teaRoute,
+						BackendGroup: dataplane.BackendGroup{
+							Source:   routeNsName(teaRoute),
+							Backends: []dataplane.Backend{{UpstreamName: "test_tea_80", Weight: 1, Valid: true}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	wildcardServer := dataplane.VirtualServer{
+		Hostname: "*.example.com",
+		Port:     80,
+		PathRules: []dataplane.PathRule{
+			{
+				Path:     "/",
+				PathType: dataplane.PathTypePrefix,
+				MatchRules: []dataplane.MatchRule{
+					{
+						Source: wildcardRoute,
+						BackendGroup: dataplane.BackendGroup{
+							Source:   routeNsName(wildcardRoute),
+							Backends: []dataplane.Backend{{UpstreamName: "invalid-backend-ref", Weight: 1}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{IsDefault: true, Port: 80},
+			wildcardServer,
+			cafeServer,
+		},
+		SSLServers: []dataplane.VirtualServer{
+			{IsDefault: true, Port: 443},
+		},
+	}
+
+	createGraphRoute := func(r *v1beta1.HTTPRoute, listenerName string, hostnames ...string) *graph.Route {
+		return &graph.Route{
+			Source: r,
+			ParentRefs: []graph.ParentRef{
+				{
+					Gateway: types.NamespacedName{Namespace: "test", Name: "gateway"},
+					Attachment: &graph.ParentRefAttachmentStatus{
+						AcceptedHostnames: map[string][]string{listenerName: hostnames},
+						Attached:          true,
+					},
+				},
+			},
+			Valid: true,
+		}
+	}
+
+	graphCfg := &graph.Graph{
+		Gateway: &graph.Gateway{
+			Source: &v1beta1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"},
+			},
+			Listeners: map[string]*graph.Listener{
+				"http": {
+					Source: v1beta1.Listener{Name: "http", Port: 80, Protocol: v1beta1.HTTPProtocolType},
+					Routes: map[types.NamespacedName]*graph.Route{
+						routeNsName(coffeeRoute):   createGraphRoute(coffeeRoute, "http", "cafe.example.com"),
+						routeNsName(wildcardRoute): createGraphRoute(wildcardRoute, "http", "*.example.com"),
+					},
+					Valid: true,
+				},
+				"http-cafe": {
+					Source: v1beta1.Listener{
+						Name:     "http-cafe",
+						Hostname: helpers.GetPointer[v1beta1.Hostname]("cafe.example.com"),
+						Port:     80,
+						Protocol: v1beta1.HTTPProtocolType,
+					},
+					Routes: map[types.NamespacedName]*graph.Route{
+						routeNsName(coffeeRoute): createGraphRoute(coffeeRoute, "http-cafe", "cafe.example.com"),
+					},
+					Valid: true,
+				},
+			},
+			Valid: true,
+		},
+	}
+
+	tests := []struct {
+		expectedMatchRoute *v1beta1.HTTPRoute
+		expectedListener   *Listener
+		request            Request
+		name               string
+		expectedLocation   string
+		expectedMessage    string
+		expectedBackends   []Backend
+		expectedStatusCode int
+	}{
+		{
+			request: Request{
+				Host: "cafe.example.com",
+				Port: 80,
+				Path: "/coffee/latte",
+			},
+			expectedMatchRoute: coffeeRoute,
+			expectedListener: &Listener{
+				Gateway: types.NamespacedName{Namespace: "test", Name: "gateway"},
+				Name:    "http-cafe",
+			},
+			expectedLocation: "/coffee/",
+			expectedBackends: []Backend{
+				{UpstreamName: "test_coffee-v1_80", Weight: 1, Percent: 25, Valid: true},
+				{UpstreamName: "test_coffee-v2_80", Weight: 3, Percent: 75, Valid: true},
+			},
+			expectedMessage: "the request is proxied to the backends",
+			name:            "prefix path, most specific listener",
+		},
+		{
+			request: Request{
+				Host: "CAFE.example.com:80",
+				Port: 80,
+				Path: "/coffee",
+			},
+			expectedMatchRoute: coffeeRoute,
+			expectedListener: &Listener{
+				Gateway: types.NamespacedName{Namespace: "test", Name: "gateway"},
+				Name:    "http-cafe",
+			},
+			expectedLocation: "= /coffee",
+			expectedBackends: []Backend{
+				{UpstreamName: "test_coffee-v1_80", Weight: 1, Percent: 25, Valid: true},
+				{UpstreamName: "test_coffee-v2_80", Weight: 3, Percent: 75, Valid: true},
+			},
+			expectedMessage: "the request is proxied to the backends",
+			name:            "exact location for prefix path without trailing slash, host with port",
+		},
+		{
+			request: Request{
+				Host:    "cafe.example.com",
+				Port:    80,
+				Path:    "/tea",
+				Headers: http.Header{"Version": []string{"v1,v2"}},
+				Query:   url.Values{"flavor": []string{"green", "black"}},
+			},
+			expectedMatchRoute: teaV2Route,
+			expectedLocation:   "= /tea",
+			expectedBackends: []Backend{
+				{UpstreamName: "test_tea-v2_80", Weight: 1, Percent: 100, Valid: true},
+			},
+			expectedMessage: "the request is proxied to the backends",
+			name:            "header and query param match",
+		},
+		{
+			request: Request{
+				Host:    "cafe.example.com",
+				Port:    80,
+				Path:    "/tea",
+				Headers: http.Header{"Version": []string{"v2"}},
+				Query:   url.Values{"flavor": []string{"black", "green"}},
+			},
+			expectedMatchRoute: teaRoute,
+			expectedLocation:   "= /tea",
+			expectedBackends: []Backend{
+				{UpstreamName: "test_tea_80", Weight: 1, Percent: 100, Valid: true},
+			},
+			expectedMessage: "the request is proxied to the backends",
+			name:            "only the first query param value is matched",
+		},
+		{
+			request: Request{
+				Host:   "cafe.example.com",
+				Port:   80,
+				Path:   "/orders",
+				Method: "GET",
+			},
+			expectedLocation:   "= /orders",
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "the request satisfies no match of the path rule",
+			name:               "method doesn't match",
+		},
+		{
+			request: Request{
+				Host:   "cafe.example.com",
+				Port:   80,
+				Path:   "/menu",
+				Method: "OPTIONS",
+				Headers: http.Header{
+					"Origin":                        []string{"https://cafe.example.com"},
+					"Access-Control-Request-Method": []string{"PUT"},
+				},
+			},
+			expectedMatchRoute: menuRoute,
+			expectedLocation:   "= /menu",
+			expectedStatusCode: http.StatusNoContent,
+			expectedMessage:    "the routing rule responds to the CORS preflight request",
+			name:               "CORS preflight",
+		},
+		{
+			request: Request{
+				Host:   "cafe.example.com",
+				Port:   80,
+				Path:   "/menu",
+				Method: "OPTIONS",
+				Headers: http.Header{
+					"Origin":                        []string{"https://cafe.example.com"},
+					"Access-Control-Request-Method": []string{"GET"},
+				},
+			},
+			expectedLocation:   "= /menu",
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "the request satisfies no match of the path rule",
+			name:               "CORS preflight for a method that doesn't match",
+		},
+		{
+			request: Request{
+				Host:    "cafe.example.com",
+				Port:    80,
+				Path:    "/menu",
+				Method:  "PUT",
+				Headers: http.Header{"X-Api-Key": []string{"secret"}},
+			},
+			expectedMatchRoute: menuRoute,
+			expectedLocation:   "= /menu",
+			expectedBackends: []Backend{
+				{UpstreamName: "test_menu_80", Weight: 1, Percent: 100, Valid: true},
+			},
+			expectedMessage: "the request is proxied to the backends",
+			name:            "actual request of CORS rule",
+		},
+		{
+			request: Request{
+				Host: "cafe.example.com",
+				Port: 80,
+				Path: "/orders_prefix_route0",
+			},
+			expectedLocation:   "/orders_prefix_route0",
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "the path matches an internal location",
+			name:               "internal location",
+		},
+		{
+			request: Request{
+				Host: "cafe.example.com",
+				Port: 80,
+				Path: "/old/menu",
+			},
+			expectedMatchRoute: redirectRoute,
+			expectedLocation:   "/old/",
+			expectedStatusCode: http.StatusMovedPermanently,
+			expectedMessage:    "the routing rule redirects the request",
+			name:               "redirect",
+		},
+		{
+			request: Request{
+				Host: "cafe.example.com",
+				Port: 80,
+				Path: "/admin",
+			},
+			expectedMatchRoute: adminRoute,
+			expectedLocation:   "= /admin",
+			expectedBackends: []Backend{
+				{UpstreamName: "test_admin_80", Weight: 1, Percent: 100, Valid: true},
+			},
+			expectedMessage: "the request is proxied to the backends only if it passes basic authentication; " +
+				"otherwise, NGINX responds with 401",
+			name: "basic authentication",
+		},
+		{
+			request: Request{
+				Host: "cafe.example.com",
+				Port: 80,
+				Path: "/account/orders",
+			},
+			expectedMatchRoute: accountRoute,
+			expectedLocation:   "/account/",
+			expectedBackends: []Backend{
+				{UpstreamName: "test_account_80", Weight: 1, Percent: 100, Valid: true},
+			},
+			expectedMessage: "the request is proxied to the backends only if it has a valid JSON Web Token; " +
+				"otherwise, NGINX responds with 401",
+			name: "JWT authentication",
+		},
+		{
+			request: Request{
+				Host: "cafe.example.com",
+				Port: 80,
+				Path: "/billing",
+			},
+			expectedMatchRoute: billingRoute,
+			expectedLocation:   "= /billing",
+			expectedBackends: []Backend{
+				{UpstreamName: "test_billing_80", Weight: 1, Percent: 100, Valid: true},
+			},
+			expectedMessage: "the request is proxied to the backends only if the external authorization service " +
+				"responds with 2xx; otherwise, NGINX responds with 401 or 403 of the service or with 500",
+			name: "external authorization",
+		},
+		{
+			request: Request{
+				Host: "cafe.example.com",
+				Port: 80,
+				Path: "/health",
+			},
+			expectedMatchRoute: healthRoute,
+			expectedLocation:   "= /health",
+			expectedStatusCode: http.StatusOK,
+			expectedMessage:    "the routing rule responds directly",
+			name:               "direct response",
+		},
+		{
+			request: Request{
+				Host: "cafe.example.com",
+				Port: 80,
+				Path: "/invalid",
+			},
+			expectedMatchRoute: invalidRoute,
+			expectedLocation:   "= /invalid",
+			expectedStatusCode: http.StatusInternalServerError,
+			expectedMessage:    "the routing rule has invalid filters",
+			name:               "invalid filters",
+		},
+		{
+			request: Request{
+				Host: "cafe.example.com",
+				Port: 80,
+				Path: "/unknown",
+			},
+			expectedLocation:   "/",
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "the path matches no path rule; the default root location responds with 404",
+			name:               "default root location",
+		},
+		{
+			request: Request{
+				Host: "foo.example.com",
+				Port: 80,
+				Path: "/",
+			},
+			expectedMatchRoute: wildcardRoute,
+			expectedListener: &Listener{
+				Gateway: types.NamespacedName{Namespace: "test", Name: "gateway"},
+				Name:    "http",
+			},
+			expectedLocation: "/",
+			expectedBackends: []Backend{
+				{UpstreamName: "invalid-backend-ref", Weight: 1, Percent: 100},
+			},
+			expectedMessage: "the request is proxied to the backends",
+			name:            "wildcard server",
+		},
+		{
+			request: Request{
+				Host: "example.org",
+				Port: 80,
+				Path: "/",
+			},
+			expectedStatusCode: http.StatusNotFound,
+			expectedMessage:    "the host matches no server; the default server responds with 404",
+			name:               "default server",
+		},
+		{
+			request: Request{
+				Host: "cafe.example.com",
+				Port: 443,
+				Path: "/",
+			},
+			expectedMessage: "the host matches no server; the default server rejects the TLS handshake",
+			name:            "default ssl server",
+		},
+		{
+			request: Request{
+				Host: "cafe.example.com",
+				Port: 8080,
+				Path: "/",
+			},
+			expectedMessage: "no server listens on the port",
+			name:            "no server for port",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			res := Evaluate(graphCfg, conf, test.request)
+
+			g.Expect(res.Message).To(Equal(test.expectedMessage))
+			g.Expect(res.StatusCode).To(Equal(test.expectedStatusCode))
+			g.Expect(res.Location).To(Equal(test.expectedLocation))
+			g.Expect(helpers.Diff(test.expectedBackends, res.Backends)).To(BeEmpty())
+
+			if test.expectedMatchRoute == nil {
+				g.Expect(res.MatchRule).To(BeNil())
+			} else {
+				g.Expect(res.MatchRule).ToNot(BeNil())
+				g.Expect(res.MatchRule.Source).To(Equal(test.expectedMatchRoute))
+			}
+
+			if test.expectedListener != nil {
+				g.Expect(res.Listener).To(Equal(test.expectedListener))
+			}
+		})
+	}
+}
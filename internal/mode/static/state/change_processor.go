@@ -17,6 +17,7 @@ import (
 
 	gwapivalidation "sigs.k8s.io/gateway-api/apis/v1beta1/validation"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/graph"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/relationship"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
@@ -90,6 +91,24 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 		Namespaces:      make(map[types.NamespacedName]*apiv1.Namespace),
 		ReferenceGrants: make(map[types.NamespacedName]*v1beta1.ReferenceGrant),
 		Secrets:         make(map[types.NamespacedName]*apiv1.Secret),
+		ConfigMaps:      make(map[types.NamespacedName]*apiv1.ConfigMap),
+
+		UpstreamSettingsPolicies: make(map[types.NamespacedName]*nkgAPI.UpstreamSettingsPolicy),
+		UpstreamHealthPolicies:   make(map[types.NamespacedName]*nkgAPI.UpstreamHealthPolicy),
+		RetryPolicies:            make(map[types.NamespacedName]*nkgAPI.RetryPolicy),
+		RateLimitPolicies:        make(map[types.NamespacedName]*nkgAPI.RateLimitPolicy),
+		ClientSettingsPolicies:   make(map[types.NamespacedName]*nkgAPI.ClientSettingsPolicy),
+		ObservabilityPolicies:    make(map[types.NamespacedName]*nkgAPI.ObservabilityPolicy),
+		CORSPolicies:             make(map[types.NamespacedName]*nkgAPI.CORSPolicy),
+		AccessControlPolicies:    make(map[types.NamespacedName]*nkgAPI.AccessControlPolicy),
+		CachePolicies:            make(map[types.NamespacedName]*nkgAPI.CachePolicy),
+		CompressionPolicies:      make(map[types.NamespacedName]*nkgAPI.CompressionPolicy),
+		ErrorPagePolicies:        make(map[types.NamespacedName]*nkgAPI.ErrorPagePolicy),
+		SnippetsPolicies:         make(map[types.NamespacedName]*nkgAPI.SnippetsPolicy),
+		TimeoutPolicies:          make(map[types.NamespacedName]*nkgAPI.TimeoutPolicy),
+
+		AuthenticationFilters: make(map[types.NamespacedName]*nkgAPI.AuthenticationFilter),
+		DirectResponseFilters: make(map[types.NamespacedName]*nkgAPI.DirectResponseFilter),
 	}
 
 	extractGVK := func(obj client.Object) schema.GroupVersionKind {
@@ -154,6 +173,86 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 				store:             newObjectStoreMapAdapter(clusterStore.Secrets),
 				trackUpsertDelete: false,
 			},
+			{
+				gvk:               extractGVK(&apiv1.ConfigMap{}),
+				store:             newObjectStoreMapAdapter(clusterStore.ConfigMaps),
+				trackUpsertDelete: false,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.UpstreamSettingsPolicy{}),
+				store:             newObjectStoreMapAdapter(clusterStore.UpstreamSettingsPolicies),
+				trackUpsertDelete: true,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.UpstreamHealthPolicy{}),
+				store:             newObjectStoreMapAdapter(clusterStore.UpstreamHealthPolicies),
+				trackUpsertDelete: true,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.RetryPolicy{}),
+				store:             newObjectStoreMapAdapter(clusterStore.RetryPolicies),
+				trackUpsertDelete: true,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.RateLimitPolicy{}),
+				store:             newObjectStoreMapAdapter(clusterStore.RateLimitPolicies),
+				trackUpsertDelete: true,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.ClientSettingsPolicy{}),
+				store:             newObjectStoreMapAdapter(clusterStore.ClientSettingsPolicies),
+				trackUpsertDelete: true,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.ObservabilityPolicy{}),
+				store:             newObjectStoreMapAdapter(clusterStore.ObservabilityPolicies),
+				trackUpsertDelete: true,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.CORSPolicy{}),
+				store:             newObjectStoreMapAdapter(clusterStore.CORSPolicies),
+				trackUpsertDelete: true,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.AccessControlPolicy{}),
+				store:             newObjectStoreMapAdapter(clusterStore.AccessControlPolicies),
+				trackUpsertDelete: true,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.CachePolicy{}),
+				store:             newObjectStoreMapAdapter(clusterStore.CachePolicies),
+				trackUpsertDelete: true,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.CompressionPolicy{}),
+				store:             newObjectStoreMapAdapter(clusterStore.CompressionPolicies),
+				trackUpsertDelete: true,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.ErrorPagePolicy{}),
+				store:             newObjectStoreMapAdapter(clusterStore.ErrorPagePolicies),
+				trackUpsertDelete: true,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.SnippetsPolicy{}),
+				store:             newObjectStoreMapAdapter(clusterStore.SnippetsPolicies),
+				trackUpsertDelete: true,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.TimeoutPolicy{}),
+				store:             newObjectStoreMapAdapter(clusterStore.TimeoutPolicies),
+				trackUpsertDelete: true,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.AuthenticationFilter{}),
+				store:             newObjectStoreMapAdapter(clusterStore.AuthenticationFilters),
+				trackUpsertDelete: true,
+			},
+			{
+				gvk:               extractGVK(&nkgAPI.DirectResponseFilter{}),
+				store:             newObjectStoreMapAdapter(clusterStore.DirectResponseFilters),
+				trackUpsertDelete: true,
+			},
 		},
 	)
 
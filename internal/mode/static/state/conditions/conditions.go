@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
@@ -23,6 +24,10 @@ const (
 	// Route rules has a backendRef with an unsupported value.
 	RouteReasonBackendRefUnsupportedValue = "UnsupportedValue"
 
+	// RouteReasonInvalidFilter is used with the "ResolvedRefs" condition when a filter of one of the Route rules
+	// references a resource that is not found or is invalid.
+	RouteReasonInvalidFilter = "InvalidFilter"
+
 	// RouteReasonInvalidGateway is used with the "Accepted" (false) condition when the Gateway the Route
 	// references is invalid.
 	RouteReasonInvalidGateway = "InvalidGateway"
@@ -51,6 +56,27 @@ const (
 	GatewayMessageFailedNginxReload = "The Gateway is not programmed due to a failure to " +
 		"reload nginx with the configuration"
 
+	// PolicyReasonSnippetsDisabled is used with the "Accepted" (false) condition of a SnippetsPolicy when
+	// the snippets are disabled.
+	PolicyReasonSnippetsDisabled v1alpha2.PolicyConditionReason = "SnippetsDisabled"
+
+	// PolicyMessageSnippetsDisabled is a message that describes PolicyReasonSnippetsDisabled.
+	PolicyMessageSnippetsDisabled = "The snippets are disabled. Enable them with the --snippets flag of NKG"
+
+	// PolicyReasonPartiallyApplied is used with the "Accepted" (true) condition of a policy that doesn't apply to
+	// some of the rules of its targets.
+	PolicyReasonPartiallyApplied v1alpha2.PolicyConditionReason = "PartiallyApplied"
+
+	// PolicyMessageSnippetsTestFailed is a message used with the "Accepted" (false) condition of a SnippetsPolicy
+	// with the snippets that make the NGINX configuration fail nginx -t.
+	PolicyMessageSnippetsTestFailed = "The snippets make the NGINX configuration fail the test with nginx -t. " +
+		"See the logs of NKG for the errors"
+
+	// PolicyMessageSnippetsNotTested is a message used with the "Accepted" (false) condition of a SnippetsPolicy
+	// when NKG can't test its snippets, because the NGINX configuration fails nginx -t without any snippets.
+	PolicyMessageSnippetsNotTested = "The snippets can't be tested, because the NGINX configuration fails " +
+		"the test with nginx -t without any snippets. See the logs of NKG for the errors"
+
 	// RouteMessageFailedNginxReload is a message used with RouteReasonGatewayNotProgrammed
 	// when nginx fails to reload.
 	RouteMessageFailedNginxReload = GatewayMessageFailedNginxReload + ". NGINX may still be configured " +
@@ -215,6 +241,17 @@ func NewRouteBackendRefUnsupportedValue(msg string) conditions.Condition {
 	}
 }
 
+// NewRouteInvalidFilter returns a Condition that indicates that the Route has a filter that references a resource
+// that is not found or is invalid.
+func NewRouteInvalidFilter(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1beta1.RouteConditionResolvedRefs),
+		Status:  metav1.ConditionFalse,
+		Reason:  RouteReasonInvalidFilter,
+		Message: msg,
+	}
+}
+
 // NewRouteInvalidGateway returns a Condition that indicates that the Route is not Accepted because the Gateway it
 // references is invalid.
 func NewRouteInvalidGateway() conditions.Condition {
@@ -409,16 +446,6 @@ func NewListenerRefNotPermitted(msg string) []conditions.Condition {
 	}
 }
 
-// NewGatewayClassInvalidParameters returns a Condition that indicates that the GatewayClass has invalid parameters.
-func NewGatewayClassInvalidParameters(msg string) conditions.Condition {
-	return conditions.Condition{
-		Type:    string(v1beta1.GatewayClassConditionStatusAccepted),
-		Status:  metav1.ConditionFalse,
-		Reason:  string(v1beta1.GatewayClassReasonInvalidParameters),
-		Message: msg,
-	}
-}
-
 // NewDefaultGatewayConditions returns the default Conditions that must be present in the status of a Gateway.
 func NewDefaultGatewayConditions() []conditions.Condition {
 	return []conditions.Condition{
@@ -541,3 +568,87 @@ func NewGatewayConflictNotProgrammed() conditions.Condition {
 		Message: GatewayMessageGatewayConflict,
 	}
 }
+
+// NewDefaultPolicyConditions returns the default conditions that must be present in the status of an NKG policy.
+func NewDefaultPolicyConditions() []conditions.Condition {
+	return []conditions.Condition{
+		NewPolicyAccepted(),
+	}
+}
+
+// NewPolicyAccepted returns a Condition that indicates that the policy is accepted.
+func NewPolicyAccepted() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(v1alpha2.PolicyReasonAccepted),
+		Message: "Policy is accepted",
+	}
+}
+
+// NewPolicyInvalid returns a Condition that indicates that the policy is not accepted because it is semantically or
+// syntactically invalid.
+func NewPolicyInvalid(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1alpha2.PolicyReasonInvalid),
+		Message: msg,
+	}
+}
+
+// NewPolicyConflicted returns a Condition that indicates that the policy is not accepted because it conflicts
+// with another policy that targets the same resource.
+func NewPolicyConflicted(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1alpha2.PolicyReasonConflicted),
+		Message: msg,
+	}
+}
+
+// NewPolicyTargetNotFound returns a Condition that indicates that the policy is not accepted because its target
+// resource does not exist or is not handled by NKG.
+func NewPolicyTargetNotFound(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(v1alpha2.PolicyReasonTargetNotFound),
+		Message: msg,
+	}
+}
+
+// NewPolicySnippetsDisabled returns a Condition that indicates that the SnippetsPolicy is not accepted because
+// the snippets are disabled.
+func NewPolicySnippetsDisabled() conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(PolicyReasonSnippetsDisabled),
+		Message: PolicyMessageSnippetsDisabled,
+	}
+}
+
+// NewPolicyPartiallyApplied returns a Condition that indicates that the policy is accepted, but it doesn't apply to
+// some of the rules of its targets.
+func NewPolicyPartiallyApplied(msg string) conditions.Condition {
+	return conditions.Condition{
+		Type:    string(v1alpha2.PolicyConditionAccepted),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(PolicyReasonPartiallyApplied),
+		Message: msg,
+	}
+}
+
+// NewPolicySnippetsTestFailed returns a Condition that indicates that the SnippetsPolicy is not accepted because
+// its snippets make the NGINX configuration fail nginx -t.
+func NewPolicySnippetsTestFailed() conditions.Condition {
+	return NewPolicyInvalid(PolicyMessageSnippetsTestFailed)
+}
+
+// NewPolicySnippetsNotTested returns a Condition that indicates that the SnippetsPolicy is not accepted because
+// its snippets can't be tested.
+func NewPolicySnippetsNotTested() conditions.Condition {
+	return NewPolicyInvalid(PolicyMessageSnippetsNotTested)
+}
@@ -7,8 +7,10 @@ import (
 
 	apiv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/graph"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/resolver"
 )
@@ -25,6 +27,10 @@ const (
 type Configuration struct {
 	// SSLKeyPairs holds all unique SSLKeyPairs.
 	SSLKeyPairs map[SSLKeyPairID]SSLKeyPair
+	// AuthFiles holds the files of the authentication filters: the htpasswd files and the JSON Web Key Sets.
+	AuthFiles map[AuthFileID][]byte
+	// ErrorPageFiles holds the files with the bodies of the responses of the error pages.
+	ErrorPageFiles map[ErrorPageFileID][]byte
 	// HTTPServers holds all HTTPServers.
 	HTTPServers []VirtualServer
 	// SSLServers holds all SSLServers.
@@ -33,8 +39,80 @@ type Configuration struct {
 	Upstreams []Upstream
 	// BackendGroups holds all unique BackendGroups.
 	BackendGroups []BackendGroup
+	// RateLimitZones holds the shared memory zones of the rate limits.
+	RateLimitZones []RateLimitZone
+	// CacheZones holds the zones of the cached responses.
+	CacheZones []CacheZone
+	// Tracing configures the OpenTelemetry tracing of the requests. If nil, tracing is disabled.
+	Tracing *Tracing
+	// AccessLog configures the access logs of the requests that no MatchRule handles.
+	// If nil, the NGINX defaults are used.
+	AccessLog *AccessLog
+	// HTTPSnippet is the snippet of the SnippetsPolicy for the Gateway to inject into the http context.
+	HTTPSnippet string
 }
 
+// AccessLog configures the access logs of the requests.
+type AccessLog struct {
+	// Syslog configures the syslog server of the log entries. If nil, NGINX writes the log entries to stdout.
+	Syslog *SyslogDestination
+	// Format is the format of the log entries.
+	Format AccessLogFormat
+	// Disabled indicates whether the access logs are disabled.
+	Disabled bool
+}
+
+// SyslogDestination configures the syslog server of the access logs.
+type SyslogDestination struct {
+	// Server is the address of the syslog server in the format host:port.
+	Server string
+	// Tag is the tag of the syslog messages. If empty, the NGINX default is used.
+	Tag string
+}
+
+// AccessLogFormat is the format of the access log entries.
+type AccessLogFormat string
+
+const (
+	// AccessLogFormatJSON formats a log entry as a JSON object.
+	AccessLogFormatJSON AccessLogFormat = "json"
+	// AccessLogFormatText formats a log entry as a line of text.
+	AccessLogFormatText AccessLogFormat = "text"
+)
+
+// Tracing configures the OpenTelemetry tracing of the requests.
+// Empty and zero fields, except for Ratio, mean that the NGINX defaults are used.
+type Tracing struct {
+	// Endpoint is the address of the OpenTelemetry collector in the format host:port.
+	Endpoint string
+	// Interval is the maximum interval between two exports of the spans.
+	Interval string
+	// ServiceName is the value of the service.name attribute of the spans.
+	ServiceName string
+	// Context is the propagation mode of the trace context.
+	Context TraceContext
+	// BatchSize is the maximum number of spans in one batch per worker.
+	BatchSize int32
+	// BatchCount is the number of pending batches per worker.
+	BatchCount int32
+	// Ratio is the percentage of the requests to trace. A MatchRule can override it.
+	Ratio int32
+}
+
+// TraceContext is the propagation mode of the trace context in the traceparent and tracestate headers.
+type TraceContext string
+
+const (
+	// TraceContextExtract uses the context of the request headers as the parent of the spans.
+	TraceContextExtract TraceContext = "extract"
+	// TraceContextInject adds the context of the spans to the requests to the backends.
+	TraceContextInject TraceContext = "inject"
+	// TraceContextPropagate combines TraceContextExtract and TraceContextInject.
+	TraceContextPropagate TraceContext = "propagate"
+	// TraceContextIgnore neither extracts nor injects the context.
+	TraceContextIgnore TraceContext = "ignore"
+)
+
 // SSLKeyPairID is a unique identifier for a SSLKeyPair.
 // The ID is safe to use as a file name.
 type SSLKeyPairID string
@@ -44,6 +122,75 @@ type SSLKeyPair struct {
 	Cert, Key []byte
 }
 
+// AuthFileID is a unique identifier for the file of an authentication filter.
+// The ID is safe to use as a file name.
+type AuthFileID string
+
+// ErrorPageFileID is a unique identifier for the file with the body of the response of an error page.
+// The ID is safe to use as a file name and in a URI.
+type ErrorPageFileID string
+
+// ErrorPage replaces the error responses with particular status codes with a response or a redirect.
+type ErrorPage struct {
+	// Response is the response that replaces the error responses. If nil, the Redirect replaces them.
+	Response *ErrorPageResponse
+	// Redirect is the redirect that replaces the error responses. If nil, the Response replaces them.
+	Redirect *ErrorPageRedirect
+	// Codes are the status codes of the error responses.
+	Codes []int32
+}
+
+// ErrorPageResponse is a response of an error page with the body from a file.
+type ErrorPageResponse struct {
+	// FileID is the ID of the file with the body.
+	FileID ErrorPageFileID
+	// ContentType is the content type of the response. If empty, the default content type is used.
+	ContentType string
+	// StatusCode is the status code of the response. If 0, the response keeps the status code of the error response.
+	StatusCode int32
+}
+
+// ErrorPageRedirect is a redirect of an error page.
+type ErrorPageRedirect struct {
+	// URL is the URL to redirect to.
+	URL string
+	// StatusCode is the status code of the redirect. If 0, the default status code is used.
+	StatusCode int32
+}
+
+// Authentication configures the authentication of the requests.
+type Authentication struct {
+	// JWT configures the validation of JSON Web Tokens. If JWT and External are nil, the requests are
+	// authenticated with HTTP basic authentication.
+	JWT *JWTAuthentication
+	// External configures the authorization of the requests by an external authorization service.
+	External *ExternalAuthentication
+	// Realm is the realm of the authentication. It is empty for external authorization.
+	Realm string
+	// FileID is the ID of the htpasswd file for basic authentication or the JSON Web Key Set for JWT validation.
+	// It is empty for external authorization.
+	FileID AuthFileID
+}
+
+// ExternalAuthentication configures the authorization of the requests by an external authorization service.
+type ExternalAuthentication struct {
+	// UpstreamName is the name of the Upstream of the authorization service.
+	UpstreamName string
+	// Path is the path of the subrequests to the authorization service.
+	Path string
+	// RequestHeaders are the headers of the requests that are forwarded to the authorization service.
+	RequestHeaders []string
+	// ResponseHeaders are the headers of the responses of the authorization service that are added to
+	// the requests to the backends.
+	ResponseHeaders []string
+}
+
+// JWTAuthentication configures the validation of JSON Web Tokens.
+type JWTAuthentication struct {
+	// RequiredClaims are the claims that a token must include with the exact values.
+	RequiredClaims map[string]string
+}
+
 // VirtualServer is a virtual server.
 type VirtualServer struct {
 	// SSL holds the SSL configuration for the server.
@@ -54,10 +201,56 @@ type VirtualServer struct {
 	PathRules []PathRule
 	// IsDefault indicates whether the server is the default server.
 	IsDefault bool
+	// ClientSettings configures the handling of the clients of the server. If nil, the NGINX defaults are used.
+	ClientSettings *ClientSettings
+	// Compression configures the compression of the responses of the server. If nil, the responses are
+	// not compressed.
+	Compression *Compression
+	// ErrorPages are the error pages of the server. The SSL default server doesn't use them, because it rejects
+	// the TLS handshakes.
+	ErrorPages []ErrorPage
+	// Snippet is the snippet of the SnippetsPolicy for the Gateway to inject into the server. The default servers
+	// don't use it.
+	Snippet string
 	// Port is the port of the server.
 	Port int32
 }
 
+// ClientSettings configures how NGINX handles the connections and the requests of clients.
+// Empty and zero fields mean that the setting is inherited from the enclosing context.
+type ClientSettings struct {
+	// BodyMaxSize is the maximum size of a request body.
+	BodyMaxSize string
+	// BodyTimeout is the timeout for reading a request body.
+	BodyTimeout string
+	// HeaderTimeout is the timeout for reading a request header.
+	HeaderTimeout string
+	// KeepAliveTime is the maximum time during which requests can be processed through one keep-alive connection.
+	KeepAliveTime string
+	// KeepAliveTimeout is the time during which an idle keep-alive connection stays open.
+	KeepAliveTimeout string
+	// KeepAliveHeaderTimeout is the value of the "Keep-Alive: timeout=<time>" response header.
+	// It can only be set together with KeepAliveTimeout.
+	KeepAliveHeaderTimeout string
+	// KeepAliveRequests is the maximum number of requests that can be served through one keep-alive connection.
+	KeepAliveRequests int32
+}
+
+// Compression configures the gzip compression of the responses.
+// Nil and zero fields mean that the setting is inherited from the enclosing context.
+type Compression struct {
+	// MinLength is the minimum length of a response to compress.
+	MinLength *int32
+	// Vary enables the "Vary: Accept-Encoding" response header.
+	Vary *bool
+	// Types are the MIME types of the responses to compress in addition to text/html.
+	Types []string
+	// Level is the compression level.
+	Level int32
+	// Enable enables the compression.
+	Enable bool
+}
+
 // Upstream is a pool of endpoints to be load balanced.
 type Upstream struct {
 	// Name is the name of the Upstream. Will be unique for each service/port combination.
@@ -66,6 +259,140 @@ type Upstream struct {
 	ErrorMsg string
 	// Endpoints are the endpoints of the Upstream.
 	Endpoints []resolver.Endpoint
+	// LoadBalancingMethod is the load-balancing method of the Upstream.
+	// If empty, LoadBalancingMethodRandomTwoLeastConn is used.
+	LoadBalancingMethod LoadBalancingMethod
+	// HashMethodKey is the key of the LoadBalancingMethodHash and LoadBalancingMethodHashConsistent methods.
+	HashMethodKey string
+	// KeepAlive configures the keep-alive connections to the Endpoints.
+	// If nil, keep-alive connections are disabled.
+	KeepAlive *UpstreamKeepAlive
+	// MaxConnections limits the number of simultaneous active connections to each Endpoint.
+	// If 0, the number is not limited.
+	MaxConnections int32
+	// MaxFails is the number of unsuccessful attempts to communicate with an Endpoint within FailTimeout after
+	// which the Endpoint is considered unavailable. If nil, the NGINX default is used.
+	MaxFails *int32
+	// FailTimeout is the time during which the unsuccessful attempts should happen and the time the Endpoint is
+	// considered unavailable. If empty, the NGINX default is used.
+	FailTimeout string
+	// NextUpstream configures in which cases a request is passed to the next Endpoint.
+	// If nil, the NGINX default is used.
+	NextUpstream *NextUpstream
+}
+
+// NextUpstream configures in which cases a request is passed to the next Endpoint of an Upstream.
+type NextUpstream struct {
+	// Timeout limits the time during which a request can be passed to the next Endpoint.
+	// If empty, the time is not limited.
+	Timeout string
+	// Conditions are the cases in which a request is passed to the next Endpoint.
+	Conditions []NextUpstreamCondition
+	// Tries limits the number of tries. If 0, the number is not limited.
+	Tries int32
+}
+
+// NextUpstreamCondition is a case in which a request is passed to the next Endpoint of an Upstream.
+type NextUpstreamCondition string
+
+const (
+	// NextUpstreamConditionError is an error while communicating with an Endpoint.
+	NextUpstreamConditionError NextUpstreamCondition = "error"
+	// NextUpstreamConditionTimeout is a timeout while communicating with an Endpoint.
+	NextUpstreamConditionTimeout NextUpstreamCondition = "timeout"
+	// NextUpstreamConditionInvalidHeader is an empty or invalid response of an Endpoint.
+	NextUpstreamConditionInvalidHeader NextUpstreamCondition = "invalid-header"
+	// NextUpstreamConditionHTTP500 is a response with the 500 status code.
+	NextUpstreamConditionHTTP500 NextUpstreamCondition = "http-500"
+	// NextUpstreamConditionHTTP502 is a response with the 502 status code.
+	NextUpstreamConditionHTTP502 NextUpstreamCondition = "http-502"
+	// NextUpstreamConditionHTTP503 is a response with the 503 status code.
+	NextUpstreamConditionHTTP503 NextUpstreamCondition = "http-503"
+	// NextUpstreamConditionHTTP504 is a response with the 504 status code.
+	NextUpstreamConditionHTTP504 NextUpstreamCondition = "http-504"
+	// NextUpstreamConditionHTTP403 is a response with the 403 status code.
+	NextUpstreamConditionHTTP403 NextUpstreamCondition = "http-403"
+	// NextUpstreamConditionHTTP404 is a response with the 404 status code.
+	NextUpstreamConditionHTTP404 NextUpstreamCondition = "http-404"
+	// NextUpstreamConditionHTTP429 is a response with the 429 status code.
+	NextUpstreamConditionHTTP429 NextUpstreamCondition = "http-429"
+	// NextUpstreamConditionNonIdempotent enables passing requests with non-idempotent methods.
+	NextUpstreamConditionNonIdempotent NextUpstreamCondition = "non-idempotent"
+	// NextUpstreamConditionOff disables passing requests to the next Endpoint.
+	NextUpstreamConditionOff NextUpstreamCondition = "off"
+)
+
+// UpstreamKeepAlive configures the keep-alive connections to the Endpoints of an Upstream.
+type UpstreamKeepAlive struct {
+	// Time is the maximum time during which requests can be processed through one connection.
+	// If empty, the NGINX default is used.
+	Time string
+	// Timeout is the time during which an idle connection stays open. If empty, the NGINX default is used.
+	Timeout string
+	// Connections is the maximum number of idle connections that each NGINX worker process keeps open.
+	Connections int32
+	// Requests is the maximum number of requests that can be served through one connection.
+	// If 0, the NGINX default is used.
+	Requests int32
+}
+
+// LoadBalancingMethod is the load-balancing method of an Upstream.
+type LoadBalancingMethod string
+
+const (
+	// LoadBalancingMethodRandomTwoLeastConn picks two random endpoints and then the one with the least number
+	// of active connections.
+	LoadBalancingMethodRandomTwoLeastConn LoadBalancingMethod = "random-two-least-conn"
+	// LoadBalancingMethodRoundRobin picks the endpoints in turn.
+	LoadBalancingMethodRoundRobin LoadBalancingMethod = "round-robin"
+	// LoadBalancingMethodLeastConn picks the endpoint with the least number of active connections.
+	LoadBalancingMethodLeastConn LoadBalancingMethod = "least-conn"
+	// LoadBalancingMethodIPHash picks the endpoint based on the client IP address.
+	LoadBalancingMethodIPHash LoadBalancingMethod = "ip-hash"
+	// LoadBalancingMethodHash picks the endpoint based on the hash of the HashMethodKey.
+	LoadBalancingMethodHash LoadBalancingMethod = "hash"
+	// LoadBalancingMethodHashConsistent picks the endpoint based on the consistent hash of the HashMethodKey.
+	LoadBalancingMethodHashConsistent LoadBalancingMethod = "hash-consistent"
+)
+
+// RateLimitZone is a shared memory zone that keeps the state of the requests for a rate limit.
+type RateLimitZone struct {
+	// Name is the name of the zone. It is unique for each RateLimitPolicy.
+	Name string
+	// Rate is the maximum rate of the requests of a client. For example, 10r/s.
+	Rate string
+	// Key identifies the client whose requests are limited.
+	Key RateLimitKey
+}
+
+// RateLimitKey identifies the client whose requests are limited.
+type RateLimitKey struct {
+	// Type is the type of the key.
+	Type RateLimitKeyType
+	// Name is the name of the header for RateLimitKeyTypeHeader. It is empty for RateLimitKeyTypeClientIP.
+	Name string
+}
+
+// RateLimitKeyType is the type of the key of a rate limit.
+type RateLimitKeyType string
+
+const (
+	// RateLimitKeyTypeClientIP identifies a client by its IP address.
+	RateLimitKeyTypeClientIP RateLimitKeyType = "client-ip"
+	// RateLimitKeyTypeHeader identifies a client by the value of a request header.
+	RateLimitKeyTypeHeader RateLimitKeyType = "header"
+)
+
+// RateLimit limits the rate of the requests that match a rule.
+type RateLimit struct {
+	// Zone is the name of the RateLimitZone.
+	Zone string
+	// Key is the key of the RateLimitZone.
+	Key RateLimitKey
+	// Burst is the number of requests above the rate that are delayed rather than rejected.
+	Burst int32
+	// DryRun indicates whether the requests are only accounted but not limited.
+	DryRun bool
 }
 
 // SSL is the SSL configuration for a server.
@@ -102,6 +429,29 @@ type Filters struct {
 	InvalidFilter          *InvalidFilter
 	RequestRedirect        *v1beta1.HTTPRequestRedirectFilter
 	RequestHeaderModifiers *HTTPHeaderFilter
+	// Authentication configures the authentication of the requests. If nil, the requests are not authenticated.
+	Authentication *Authentication
+	// DirectResponse configures the response to the requests instead of proxying them to the backends.
+	// If nil, the requests are proxied.
+	DirectResponse *DirectResponse
+}
+
+// Timeouts limits the time of passing requests to the backends.
+type Timeouts struct {
+	// BackendRequest limits every request to a backend.
+	BackendRequest string
+}
+
+// DirectResponse is a fixed response to the requests.
+type DirectResponse struct {
+	// Body is the body of the response.
+	Body string
+	// ContentType is the content type of the response.
+	ContentType string
+	// Headers are the headers of the response.
+	Headers []HTTPHeader
+	// StatusCode is the status code of the response.
+	StatusCode int32
 }
 
 // MatchRule represents a routing rule. It corresponds directly to a Match in the HTTPRoute resource.
@@ -109,10 +459,45 @@ type Filters struct {
 // If no rule or match is specified by the user, the default rule {{path:{ type: "PathPrefix", value: "/"}}}
 // is set by the schema.
 type MatchRule struct {
+	// Retry configures the retries of the requests that match the rule. If set, it overrides the NextUpstream
+	// configuration of the upstreams of the BackendGroup.
+	Retry *NextUpstream
+	// Timeouts limits the time of passing the requests that match the rule to the backends.
+	// If nil, NGINX uses its default timeouts.
+	Timeouts *Timeouts
+	// CORS configures Cross-Origin Resource Sharing for the requests that match the rule.
+	// If nil, NGINX doesn't handle CORS.
+	CORS *CORS
+	// RateLimit limits the rate of the requests that match the rule. If nil, the rate is not limited.
+	RateLimit *RateLimit
+	// AccessControl allows or denies the access of the clients to the rule. If nil, all clients can access it.
+	AccessControl *AccessControl
+	// Cache configures the caching of the responses to the requests that match the rule.
+	// If nil, the responses are not cached.
+	Cache *Cache
+	// ClientSettings configures the handling of the clients for the requests that match the rule.
+	// If nil, the settings of the VirtualServer apply.
+	ClientSettings *ClientSettings
+	// Compression configures the compression of the responses to the requests that match the rule.
+	// If nil, the Compression of the VirtualServer applies.
+	Compression *Compression
+	// ErrorPages are the error pages of the requests that match the rule.
+	// If nil, the ErrorPages of the VirtualServer apply.
+	ErrorPages []ErrorPage
+	// TracingRatio is the percentage of the requests that match the rule to trace.
+	// If nil, the Ratio of the Tracing of the Configuration applies.
+	TracingRatio *int32
+	// AccessLog configures the access logs of the requests that match the rule.
+	// If nil, the AccessLog of the Configuration applies.
+	AccessLog *AccessLog
 	// Filters holds the filters for the MatchRule.
 	Filters Filters
 	// Source is the corresponding HTTPRoute resource.
 	Source *v1beta1.HTTPRoute
+	// Listener is the name of the Gateway listener that the HTTPRoute is attached to.
+	Listener string
+	// Snippet is the snippet of the SnippetsPolicy for the HTTPRoute to inject into the locations of the rule.
+	Snippet string
 	// BackendGroup is the group of Backends that the rule routes to.
 	BackendGroup BackendGroup
 	// MatchIdx is the index of the rule in the Rule.Matches.
@@ -121,6 +506,91 @@ type MatchRule struct {
 	RuleIdx int
 }
 
+// CacheZone is a zone of the cached responses.
+type CacheZone struct {
+	// Name is the name of the zone. It is unique for each zone of the CachePolicy for the Gateway.
+	Name string
+	// KeysSize is the size of the shared memory zone with the keys of the cached responses.
+	KeysSize string
+	// MaxSize limits the size of the cached responses. If empty, the size is not limited.
+	MaxSize string
+	// Inactive is the time after which the responses that are not accessed are removed.
+	// If empty, the NGINX default is used.
+	Inactive string
+}
+
+// Cache configures the caching of the responses.
+type Cache struct {
+	// Zone is the name of the CacheZone.
+	Zone string
+	// Key is the key of a cached response. If empty, the default key is used.
+	Key string
+	// Valid are the times during which the responses with particular status codes stay fresh.
+	Valid []CacheValid
+	// Bypass are the parts of the requests whose values make NGINX bypass the cache.
+	Bypass []CacheBypass
+	// StaleWhileRevalidate enables the responses with the stale responses while they are updated.
+	StaleWhileRevalidate bool
+	// StaleIfError enables the responses with the stale responses if the backend is unavailable or fails.
+	StaleIfError bool
+}
+
+// CacheValid is the time during which the responses with particular status codes stay fresh.
+type CacheValid struct {
+	// Duration is the time during which the responses stay fresh.
+	Duration string
+	// Codes are the status codes of the responses. If empty, the NGINX default codes are used.
+	Codes []int32
+}
+
+// CacheBypass is a part of a request whose value makes NGINX bypass the cache.
+type CacheBypass struct {
+	// Type is the type of the part of the request.
+	Type CacheBypassType
+	// Name is the name of the header, the cookie or the query parameter.
+	Name string
+}
+
+// CacheBypassType is the type of the part of a request in a CacheBypass.
+type CacheBypassType string
+
+const (
+	// CacheBypassTypeHeader is a request header.
+	CacheBypassTypeHeader CacheBypassType = "header"
+	// CacheBypassTypeCookie is a cookie of the request.
+	CacheBypassTypeCookie CacheBypassType = "cookie"
+	// CacheBypassTypeQueryParam is a query parameter of the request.
+	CacheBypassTypeQueryParam CacheBypassType = "query-param"
+)
+
+// AccessControl allows or denies the access of the clients based on their IP addresses.
+type AccessControl struct {
+	// Allow are the IP addresses or the CIDR blocks of the clients that can access the rule.
+	// If not empty, the access of all other clients is denied.
+	Allow []string
+	// Deny are the IP addresses or the CIDR blocks of the clients that cannot access the rule.
+	// Deny takes precedence over Allow.
+	Deny []string
+}
+
+// CORS configures Cross-Origin Resource Sharing.
+type CORS struct {
+	// MaxAge is the number of seconds during which the clients can cache the response to a preflight request.
+	// If nil, the clients use their defaults.
+	MaxAge *int32
+	// AllowOrigins are the origins that can access the resources. * allows any origin.
+	// The host of an origin can start with a wildcard label. For example, https://*.example.com.
+	AllowOrigins []string
+	// AllowMethods are the methods that the origins can use. * allows any method.
+	AllowMethods []string
+	// AllowHeaders are the headers that the origins can include in the requests. * allows any header.
+	AllowHeaders []string
+	// ExposeHeaders are the headers of the responses that the origins can access.
+	ExposeHeaders []string
+	// AllowCredentials allows the origins to include credentials in the requests.
+	AllowCredentials bool
+}
+
 // BackendGroup represents a group of Backends for a routing rule in an HTTPRoute.
 type BackendGroup struct {
 	// Source is the NamespacedName of the HTTPRoute the group belongs to.
@@ -157,8 +627,15 @@ func (r *MatchRule) GetMatch() v1beta1.HTTPRouteMatch {
 	return r.Source.Spec.Rules[r.RuleIdx].Matches[r.MatchIdx]
 }
 
-// BuildConfiguration builds the Configuration from the Graph.
-func BuildConfiguration(ctx context.Context, g *graph.Graph, resolver resolver.ServiceResolver) Configuration {
+// BuildConfiguration builds the Configuration from the Graph. The Configuration doesn't include the snippets of
+// the SnippetsPolicies in excludedSnippets, so that the snippets can be tested in different combinations without
+// modifying the Graph.
+func BuildConfiguration(
+	ctx context.Context,
+	g *graph.Graph,
+	resolver resolver.ServiceResolver,
+	excludedSnippets map[types.NamespacedName]struct{},
+) Configuration {
 	if g.GatewayClass == nil || !g.GatewayClass.Valid {
 		return Configuration{}
 	}
@@ -168,16 +645,37 @@ func BuildConfiguration(ctx context.Context, g *graph.Graph, resolver resolver.S
 	}
 
 	upstreams := buildUpstreams(ctx, g.Gateway.Listeners, resolver)
-	httpServers, sslServers := buildServers(g.Gateway.Listeners)
+	httpServers, sslServers := buildServers(g.Gateway.Listeners, excludedSnippets)
+	addClientSettingsToServers(httpServers, g.Gateway.ClientSettings)
+	addClientSettingsToServers(sslServers, g.Gateway.ClientSettings)
+	addCompressionToServers(httpServers, g.Gateway.Compression)
+	addCompressionToServers(sslServers, g.Gateway.Compression)
+	addErrorPagesToServers(httpServers, g.Gateway.ErrorPages)
+	addErrorPagesToServers(sslServers, g.Gateway.ErrorPages)
+	addSnippetToServers(httpServers, getSnippet(g.Gateway.Snippets, nkgAPI.SnippetContextServer, excludedSnippets))
+	addSnippetToServers(sslServers, getSnippet(g.Gateway.Snippets, nkgAPI.SnippetContextServer, excludedSnippets))
 	backendGroups := buildBackendGroups(append(httpServers, sslServers...))
 	keyPairs := buildSSLKeyPairs(g.ReferencedSecrets, g.Gateway.Listeners)
+	authFiles := buildAuthFiles(g.Gateway.Listeners)
+	errorPageFiles := buildErrorPageFiles(g.Gateway)
+	rateLimitZones := buildRateLimitZones(g.RateLimitPolicies)
+	cacheZones := buildCacheZones(g.CachePolicies)
+	tracing := convertTracing(g.Gateway.Tracing)
+	accessLog := convertAccessLog(g.Gateway.AccessLog)
 
 	config := Configuration{
-		HTTPServers:   httpServers,
-		SSLServers:    sslServers,
-		Upstreams:     upstreams,
-		BackendGroups: backendGroups,
-		SSLKeyPairs:   keyPairs,
+		HTTPServers:    httpServers,
+		SSLServers:     sslServers,
+		Upstreams:      upstreams,
+		BackendGroups:  backendGroups,
+		SSLKeyPairs:    keyPairs,
+		AuthFiles:      authFiles,
+		ErrorPageFiles: errorPageFiles,
+		RateLimitZones: rateLimitZones,
+		CacheZones:     cacheZones,
+		Tracing:        tracing,
+		AccessLog:      accessLog,
+		HTTPSnippet:    getSnippet(g.Gateway.Snippets, nkgAPI.SnippetContextHTTP, excludedSnippets),
 	}
 
 	return config
@@ -207,6 +705,70 @@ func buildSSLKeyPairs(
 	return keyPairs
 }
 
+// buildAuthFiles builds the files of the AuthenticationFilters. It will only include the filters that are referenced
+// by the routes of valid listeners. If there are no such filters, it returns nil.
+func buildAuthFiles(listeners map[string]*graph.Listener) map[AuthFileID][]byte {
+	var authFiles map[AuthFileID][]byte
+
+	for _, l := range listeners {
+		if !l.Valid {
+			continue
+		}
+
+		for _, r := range l.Routes {
+			for _, rule := range r.Rules {
+				// The filters for external authorization don't have files.
+				if !rule.ValidFilters || rule.Authentication == nil || rule.Authentication.Service != nil {
+					continue
+				}
+
+				if authFiles == nil {
+					authFiles = make(map[AuthFileID][]byte)
+				}
+
+				nsname := client.ObjectKeyFromObject(rule.Authentication.Source)
+				authFiles[generateAuthFileID(nsname)] = rule.Authentication.Data
+			}
+		}
+	}
+
+	return authFiles
+}
+
+// buildErrorPageFiles builds the files with the bodies of the responses of the error pages of the Gateway and
+// the routes of its valid listeners. If there are no such error pages, it returns nil.
+func buildErrorPageFiles(gw *graph.Gateway) map[ErrorPageFileID][]byte {
+	var files map[ErrorPageFileID][]byte
+
+	addFiles := func(pages *graph.ErrorPages) {
+		if pages == nil {
+			return
+		}
+
+		for i, body := range pages.Bodies {
+			if files == nil {
+				files = make(map[ErrorPageFileID][]byte)
+			}
+
+			files[generateErrorPageFileID(pages.Policy, i)] = body
+		}
+	}
+
+	addFiles(gw.ErrorPages)
+
+	for _, l := range gw.Listeners {
+		if !l.Valid {
+			continue
+		}
+
+		for _, r := range l.Routes {
+			addFiles(r.ErrorPages)
+		}
+	}
+
+	return files
+}
+
 func buildBackendGroups(servers []VirtualServer) []BackendGroup {
 	type key struct {
 		nsname  types.NamespacedName
@@ -254,7 +816,7 @@ func newBackendGroup(refs []graph.BackendRef, sourceNsName types.NamespacedName,
 
 	for _, ref := range refs {
 		backends = append(backends, Backend{
-			UpstreamName: ref.ServicePortReference(),
+			UpstreamName: ref.UpstreamName(),
 			Weight:       ref.Weight,
 			Valid:        ref.Valid,
 		})
@@ -267,13 +829,26 @@ func newBackendGroup(refs []graph.BackendRef, sourceNsName types.NamespacedName,
 	}
 }
 
-func buildServers(listeners map[string]*graph.Listener) (http, ssl []VirtualServer) {
+func buildServers(
+	listeners map[string]*graph.Listener,
+	excludedSnippets map[types.NamespacedName]struct{},
+) (http, ssl []VirtualServer) {
 	rulesForProtocol := map[v1beta1.ProtocolType]portPathRules{
 		v1beta1.HTTPProtocolType:  make(portPathRules),
 		v1beta1.HTTPSProtocolType: make(portPathRules),
 	}
 
-	for _, l := range listeners {
+	// We upsert the listeners in the order of their names, so that the match rules that several listeners add
+	// for the same route have a stable order.
+	names := make([]string, 0, len(listeners))
+	for name := range listeners {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		l := listeners[name]
+
 		if l.Valid {
 			rules := rulesForProtocol[l.Source.Protocol][l.Source.Port]
 			if rules == nil {
@@ -281,7 +856,7 @@ func buildServers(listeners map[string]*graph.Listener) (http, ssl []VirtualServ
 				rulesForProtocol[l.Source.Protocol][l.Source.Port] = rules
 			}
 
-			rules.upsertListener(l)
+			rules.upsertListener(l, excludedSnippets)
 		}
 	}
 
@@ -330,7 +905,7 @@ func newHostPathRules() *hostPathRules {
 	}
 }
 
-func (hpr *hostPathRules) upsertListener(l *graph.Listener) {
+func (hpr *hostPathRules) upsertListener(l *graph.Listener, excludedSnippets map[types.NamespacedName]struct{}) {
 	hpr.listenersExist = true
 	hpr.port = int32(l.Source.Port)
 
@@ -366,9 +941,21 @@ func (hpr *hostPathRules) upsertListener(l *graph.Listener) {
 				continue
 			}
 
+			rateLimitPolicy := r.RateLimit
+			if rateLimitPolicy == nil {
+				rateLimitPolicy = l.RateLimit
+			}
+
+			accessControlPolicy := r.AccessControl
+			if accessControlPolicy == nil {
+				accessControlPolicy = l.AccessControl
+			}
+
 			var filters Filters
 			if r.Rules[i].ValidFilters {
 				filters = createFilters(rule.Filters)
+				filters.Authentication = convertAuthentication(r.Rules[i].Authentication)
+				filters.DirectResponse = convertDirectResponse(r.Rules[i].DirectResponse)
 			} else {
 				filters = Filters{
 					InvalidFilter: &InvalidFilter{},
@@ -391,11 +978,24 @@ func (hpr *hostPathRules) upsertListener(l *graph.Listener) {
 					}
 
 					rule.MatchRules = append(rule.MatchRules, MatchRule{
-						MatchIdx:     j,
-						RuleIdx:      i,
-						Source:       r.Source,
-						BackendGroup: newBackendGroup(r.Rules[i].BackendRefs, routeNsName, i),
-						Filters:      filters,
+						MatchIdx:       j,
+						RuleIdx:        i,
+						Source:         r.Source,
+						BackendGroup:   newBackendGroup(r.Rules[i].BackendRefs, routeNsName, i),
+						Filters:        filters,
+						Retry:          convertRetry(r.Rules[i].Retry),
+						Timeouts:       convertTimeouts(r.Rules[i].Timeouts),
+						CORS:           convertCORS(r.Rules[i].CORS),
+						RateLimit:      convertRateLimit(rateLimitPolicy),
+						AccessControl:  convertAccessControl(accessControlPolicy),
+						Cache:          convertCache(r.Rules[i].Cache),
+						ClientSettings: convertClientSettings(r.ClientSettings),
+						Compression:    convertCompression(r.Compression),
+						ErrorPages:     convertErrorPages(r.ErrorPages),
+						TracingRatio:   getTracingRatio(r.Tracing),
+						AccessLog:      convertAccessLog(r.AccessLog),
+						Listener:       string(l.Source.Name),
+						Snippet:        getSnippet(r.Snippets, nkgAPI.SnippetContextLocation, excludedSnippets),
 					})
 
 					hpr.rulesPerHost[h][key] = rule
@@ -512,7 +1112,7 @@ func buildUpstreams(
 				}
 				for _, br := range rule.BackendRefs {
 					if br.Valid {
-						upstreamName := br.ServicePortReference()
+						upstreamName := br.UpstreamName()
 						_, exist := uniqueUpstreams[upstreamName]
 
 						if exist {
@@ -526,11 +1126,29 @@ func buildUpstreams(
 							errMsg = err.Error()
 						}
 
-						uniqueUpstreams[upstreamName] = Upstream{
+						up := Upstream{
 							Name:      upstreamName,
 							Endpoints: eps,
 							ErrorMsg:  errMsg,
 						}
+
+						if br.UpstreamSettings != nil {
+							setUpstreamSettings(&up, *br.UpstreamSettings)
+						}
+
+						if br.UpstreamHealth != nil {
+							setUpstreamHealth(&up, *br.UpstreamHealth)
+						}
+
+						uniqueUpstreams[upstreamName] = up
+					}
+				}
+
+				if auth := rule.Authentication; auth != nil && auth.Service != nil {
+					upstreamName := generateExternalAuthUpstreamName(client.ObjectKeyFromObject(auth.Source))
+
+					if _, exist := uniqueUpstreams[upstreamName]; !exist {
+						uniqueUpstreams[upstreamName] = buildExternalAuthUpstream(ctx, upstreamName, auth, resolver)
 					}
 				}
 			}
@@ -610,6 +1228,168 @@ func convertPathType(pathType v1beta1.PathMatchType) PathType {
 	}
 }
 
+func setUpstreamSettings(up *Upstream, settings graph.UpstreamSettings) {
+	if settings.LoadBalancingMethod != nil {
+		up.LoadBalancingMethod = convertLoadBalancingMethod(*settings.LoadBalancingMethod)
+		up.HashMethodKey = settings.HashMethodKey
+	}
+
+	if settings.KeepAlive != nil {
+		up.KeepAlive = convertUpstreamKeepAlive(*settings.KeepAlive)
+	}
+
+	if settings.MaxConnections != nil {
+		up.MaxConnections = *settings.MaxConnections
+	}
+}
+
+func setUpstreamHealth(up *Upstream, health nkgAPI.UpstreamHealthPolicySpec) {
+	up.MaxFails = health.MaxFails
+
+	if health.FailTimeout != nil {
+		up.FailTimeout = string(*health.FailTimeout)
+	}
+
+	if health.NextUpstream != nil {
+		up.NextUpstream = convertNextUpstream(*health.NextUpstream)
+	}
+}
+
+func convertNextUpstream(nextUpstream nkgAPI.NextUpstream) *NextUpstream {
+	result := &NextUpstream{
+		Conditions: make([]NextUpstreamCondition, 0, len(nextUpstream.Conditions)),
+	}
+
+	for _, cond := range nextUpstream.Conditions {
+		result.Conditions = append(result.Conditions, convertNextUpstreamCondition(cond))
+	}
+
+	if nextUpstream.Tries != nil {
+		result.Tries = *nextUpstream.Tries
+	}
+	if nextUpstream.Timeout != nil {
+		result.Timeout = string(*nextUpstream.Timeout)
+	}
+
+	return result
+}
+
+func convertRetry(retry *nkgAPI.RetryPolicySpec) *NextUpstream {
+	if retry == nil {
+		return nil
+	}
+
+	return convertNextUpstream(nkgAPI.NextUpstream{
+		Conditions: retry.Conditions,
+		Tries:      retry.Attempts,
+		Timeout:    retry.Timeout,
+	})
+}
+
+func convertTimeouts(timeouts *nkgAPI.TimeoutPolicySpec) *Timeouts {
+	if timeouts == nil {
+		return nil
+	}
+
+	return &Timeouts{
+		BackendRequest: string(timeouts.BackendRequest),
+	}
+}
+
+func convertCORS(cors *nkgAPI.CORSPolicySpec) *CORS {
+	if cors == nil {
+		return nil
+	}
+
+	result := &CORS{
+		MaxAge:        cors.MaxAge,
+		AllowOrigins:  cors.AllowOrigins,
+		AllowHeaders:  cors.AllowHeaders,
+		ExposeHeaders: cors.ExposeHeaders,
+	}
+
+	if len(cors.AllowMethods) > 0 {
+		result.AllowMethods = make([]string, 0, len(cors.AllowMethods))
+		for _, m := range cors.AllowMethods {
+			result.AllowMethods = append(result.AllowMethods, string(m))
+		}
+	}
+
+	if cors.AllowCredentials != nil {
+		result.AllowCredentials = *cors.AllowCredentials
+	}
+
+	return result
+}
+
+func convertNextUpstreamCondition(cond nkgAPI.NextUpstreamCondition) NextUpstreamCondition {
+	switch cond {
+	case nkgAPI.NextUpstreamConditionError:
+		return NextUpstreamConditionError
+	case nkgAPI.NextUpstreamConditionTimeout:
+		return NextUpstreamConditionTimeout
+	case nkgAPI.NextUpstreamConditionInvalidHeader:
+		return NextUpstreamConditionInvalidHeader
+	case nkgAPI.NextUpstreamConditionHTTP500:
+		return NextUpstreamConditionHTTP500
+	case nkgAPI.NextUpstreamConditionHTTP502:
+		return NextUpstreamConditionHTTP502
+	case nkgAPI.NextUpstreamConditionHTTP503:
+		return NextUpstreamConditionHTTP503
+	case nkgAPI.NextUpstreamConditionHTTP504:
+		return NextUpstreamConditionHTTP504
+	case nkgAPI.NextUpstreamConditionHTTP403:
+		return NextUpstreamConditionHTTP403
+	case nkgAPI.NextUpstreamConditionHTTP404:
+		return NextUpstreamConditionHTTP404
+	case nkgAPI.NextUpstreamConditionHTTP429:
+		return NextUpstreamConditionHTTP429
+	case nkgAPI.NextUpstreamConditionNonIdempotent:
+		return NextUpstreamConditionNonIdempotent
+	case nkgAPI.NextUpstreamConditionOff:
+		return NextUpstreamConditionOff
+	default:
+		panic(fmt.Sprintf("unsupported next upstream condition: %s", cond))
+	}
+}
+
+func convertUpstreamKeepAlive(keepAlive nkgAPI.UpstreamKeepAlive) *UpstreamKeepAlive {
+	result := &UpstreamKeepAlive{
+		Connections: keepAlive.Connections,
+	}
+
+	if keepAlive.Requests != nil {
+		result.Requests = *keepAlive.Requests
+	}
+	if keepAlive.Time != nil {
+		result.Time = string(*keepAlive.Time)
+	}
+	if keepAlive.Timeout != nil {
+		result.Timeout = string(*keepAlive.Timeout)
+	}
+
+	return result
+}
+
+func convertLoadBalancingMethod(method nkgAPI.LoadBalancingMethod) LoadBalancingMethod {
+	switch method {
+	case nkgAPI.LoadBalancingMethodRandomTwoLeastConn:
+		return LoadBalancingMethodRandomTwoLeastConn
+	case nkgAPI.LoadBalancingMethodRoundRobin:
+		return LoadBalancingMethodRoundRobin
+	case nkgAPI.LoadBalancingMethodLeastConn:
+		return LoadBalancingMethodLeastConn
+	case nkgAPI.LoadBalancingMethodIPHash:
+		return LoadBalancingMethodIPHash
+	case nkgAPI.LoadBalancingMethodHash:
+		return LoadBalancingMethodHash
+	case nkgAPI.LoadBalancingMethodHashConsistent:
+		return LoadBalancingMethodHashConsistent
+	default:
+		panic(fmt.Sprintf("unsupported load-balancing method: %s", method))
+	}
+}
+
 // listenerHostnameMoreSpecific returns true if host1 is more specific than host2.
 func listenerHostnameMoreSpecific(host1, host2 *v1beta1.Hostname) bool {
 	var host1Str, host2Str string
@@ -624,9 +1404,604 @@ func listenerHostnameMoreSpecific(host1, host2 *v1beta1.Hostname) bool {
 	return graph.GetMoreSpecificHostname(host1Str, host2Str) == host1Str
 }
 
+// generateExternalAuthUpstreamName generates the name of the Upstream of the external authorization service of
+// the AuthenticationFilter based on its namespaced name.
+func generateExternalAuthUpstreamName(filter types.NamespacedName) string {
+	return fmt.Sprintf("ext_auth_%s_%s", filter.Namespace, filter.Name)
+}
+
+// generateAuthFileID generates an ID for the file of the AuthenticationFilter based on its namespaced name.
+func generateAuthFileID(filter types.NamespacedName) AuthFileID {
+	return AuthFileID(fmt.Sprintf("auth_%s_%s", filter.Namespace, filter.Name))
+}
+
+// generateErrorPageFileID generates an ID for the file with the body of the response of the error page based on
+// the namespaced name of the ErrorPagePolicy and the index of the page.
+func generateErrorPageFileID(policy types.NamespacedName, index int) ErrorPageFileID {
+	return ErrorPageFileID(fmt.Sprintf("error_page_%s_%s_%d", policy.Namespace, policy.Name, index))
+}
+
 // generateSSLKeyPairID generates an ID for the SSL key pair based on the Secret namespaced name.
 // It is guaranteed to be unique per unique namespaced name.
 // The ID is safe to use as a file name.
 func generateSSLKeyPairID(secret types.NamespacedName) SSLKeyPairID {
 	return SSLKeyPairID(fmt.Sprintf("ssl_keypair_%s_%s", secret.Namespace, secret.Name))
 }
+
+// buildRateLimitZones builds a RateLimitZone for every valid RateLimitPolicy.
+func buildRateLimitZones(policies map[types.NamespacedName]*graph.RateLimitPolicy) []RateLimitZone {
+	var zones []RateLimitZone
+
+	for _, p := range policies {
+		if !p.Valid {
+			continue
+		}
+
+		zones = append(zones, RateLimitZone{
+			Name: getRateLimitZoneName(p.Source),
+			Rate: p.Source.Spec.Rate,
+			Key:  convertRateLimitKey(p.Source.Spec.Key),
+		})
+	}
+
+	// We sort the zones so the order is preserved after reconfiguration.
+	sort.Slice(zones, func(i, j int) bool {
+		return zones[i].Name < zones[j].Name
+	})
+
+	return zones
+}
+
+func convertRateLimit(policy *graph.RateLimitPolicy) *RateLimit {
+	if policy == nil {
+		return nil
+	}
+
+	spec := policy.Source.Spec
+
+	result := &RateLimit{
+		Zone: getRateLimitZoneName(policy.Source),
+		Key:  convertRateLimitKey(spec.Key),
+	}
+
+	if spec.Burst != nil {
+		result.Burst = *spec.Burst
+	}
+	if spec.DryRun != nil {
+		result.DryRun = *spec.DryRun
+	}
+
+	return result
+}
+
+// buildCacheZones builds a CacheZone for every zone of the valid CachePolicy for the Gateway.
+func buildCacheZones(policies map[types.NamespacedName]*graph.CachePolicy) []CacheZone {
+	var zones []CacheZone
+
+	for _, p := range policies {
+		// Only the policies for the Gateway have zones, which the graph package ensures.
+		if !p.Valid {
+			continue
+		}
+
+		for _, z := range p.Source.Spec.Zones {
+			zone := CacheZone{
+				Name:     getCacheZoneName(z.Name),
+				KeysSize: string(z.KeysSize),
+			}
+
+			if z.MaxSize != nil {
+				zone.MaxSize = string(*z.MaxSize)
+			}
+			if z.Inactive != nil {
+				zone.Inactive = string(*z.Inactive)
+			}
+
+			zones = append(zones, zone)
+		}
+	}
+
+	// We sort the zones so the order is preserved after reconfiguration.
+	sort.Slice(zones, func(i, j int) bool {
+		return zones[i].Name < zones[j].Name
+	})
+
+	return zones
+}
+
+func convertCache(cache *nkgAPI.CacheSettings) *Cache {
+	if cache == nil {
+		return nil
+	}
+
+	result := &Cache{
+		Zone: getCacheZoneName(cache.Zone),
+	}
+
+	if cache.Key != nil {
+		result.Key = *cache.Key
+	}
+	if cache.StaleWhileRevalidate != nil {
+		result.StaleWhileRevalidate = *cache.StaleWhileRevalidate
+	}
+	if cache.StaleIfError != nil {
+		result.StaleIfError = *cache.StaleIfError
+	}
+
+	if len(cache.Valid) > 0 {
+		result.Valid = make([]CacheValid, 0, len(cache.Valid))
+
+		for _, v := range cache.Valid {
+			valid := CacheValid{Duration: string(v.Duration)}
+
+			for _, code := range v.Codes {
+				valid.Codes = append(valid.Codes, int32(code))
+			}
+
+			result.Valid = append(result.Valid, valid)
+		}
+	}
+
+	if len(cache.Bypass) > 0 {
+		result.Bypass = make([]CacheBypass, 0, len(cache.Bypass))
+
+		for _, b := range cache.Bypass {
+			result.Bypass = append(result.Bypass, CacheBypass{
+				Type: convertCacheBypassType(b.Type),
+				Name: b.Name,
+			})
+		}
+	}
+
+	return result
+}
+
+func convertCacheBypassType(t nkgAPI.CacheBypassConditionType) CacheBypassType {
+	switch t {
+	case nkgAPI.CacheBypassConditionTypeHeader:
+		return CacheBypassTypeHeader
+	case nkgAPI.CacheBypassConditionTypeCookie:
+		return CacheBypassTypeCookie
+	case nkgAPI.CacheBypassConditionTypeQueryParam:
+		return CacheBypassTypeQueryParam
+	default:
+		panic(fmt.Sprintf("unsupported cache bypass condition type: %s", t))
+	}
+}
+
+// getCacheZoneName returns the name of the CacheZone for the zone of the CachePolicy for the Gateway.
+// Only one CachePolicy for the Gateway is valid, so the name of the zone is unique.
+func getCacheZoneName(zone string) string {
+	return "cache_" + zone
+}
+
+func convertAccessControl(policy *graph.AccessControlPolicy) *AccessControl {
+	if policy == nil {
+		return nil
+	}
+
+	return &AccessControl{
+		Allow: policy.Source.Spec.Allow,
+		Deny:  policy.Source.Spec.Deny,
+	}
+}
+
+func getRateLimitZoneName(policy *nkgAPI.RateLimitPolicy) string {
+	return fmt.Sprintf("ratelimit_%s_%s", policy.Namespace, policy.Name)
+}
+
+func convertRateLimitKey(key nkgAPI.RateLimitKey) RateLimitKey {
+	var result RateLimitKey
+
+	switch key.Type {
+	case nkgAPI.RateLimitKeyTypeClientIP:
+		result.Type = RateLimitKeyTypeClientIP
+	case nkgAPI.RateLimitKeyTypeHeader:
+		result.Type = RateLimitKeyTypeHeader
+	default:
+		panic(fmt.Sprintf("unsupported rate limit key type: %s", key.Type))
+	}
+
+	if key.Name != nil {
+		result.Name = *key.Name
+	}
+
+	return result
+}
+
+// addClientSettingsToServers adds the client settings of the Gateway to all servers, including the default ones,
+// because NGINX reads the request header in the default server of the port before it picks the server
+// by the Host header.
+func addClientSettingsToServers(servers []VirtualServer, spec *nkgAPI.ClientSettingsPolicySpec) {
+	settings := convertClientSettings(spec)
+	if settings == nil {
+		return
+	}
+
+	for i := range servers {
+		servers[i].ClientSettings = settings
+	}
+}
+
+func convertClientSettings(spec *nkgAPI.ClientSettingsPolicySpec) *ClientSettings {
+	if spec == nil {
+		return nil
+	}
+
+	var result ClientSettings
+
+	if spec.Body != nil {
+		if spec.Body.MaxSize != nil {
+			result.BodyMaxSize = string(*spec.Body.MaxSize)
+		}
+		if spec.Body.Timeout != nil {
+			result.BodyTimeout = string(*spec.Body.Timeout)
+		}
+	}
+
+	if spec.Header != nil && spec.Header.Timeout != nil {
+		result.HeaderTimeout = string(*spec.Header.Timeout)
+	}
+
+	if spec.KeepAlive != nil {
+		if spec.KeepAlive.Requests != nil {
+			result.KeepAliveRequests = *spec.KeepAlive.Requests
+		}
+		if spec.KeepAlive.Time != nil {
+			result.KeepAliveTime = string(*spec.KeepAlive.Time)
+		}
+		if spec.KeepAlive.Timeout != nil {
+			result.KeepAliveTimeout = string(spec.KeepAlive.Timeout.Server)
+			if spec.KeepAlive.Timeout.Header != nil {
+				result.KeepAliveHeaderTimeout = string(*spec.KeepAlive.Timeout.Header)
+			}
+		}
+	}
+
+	return &result
+}
+
+// addCompressionToServers adds the compression settings of the Gateway to the servers, except for the default ones,
+// which don't proxy the requests.
+func addCompressionToServers(servers []VirtualServer, gzip *nkgAPI.Gzip) {
+	compression := convertCompression(gzip)
+	if compression == nil {
+		return
+	}
+
+	for i := range servers {
+		if !servers[i].IsDefault {
+			servers[i].Compression = compression
+		}
+	}
+}
+
+func convertCompression(gzip *nkgAPI.Gzip) *Compression {
+	if gzip == nil {
+		return nil
+	}
+
+	result := &Compression{
+		Enable:    gzip.Enable,
+		MinLength: gzip.MinLength,
+		Vary:      gzip.Vary,
+	}
+
+	if gzip.Level != nil {
+		result.Level = *gzip.Level
+	}
+
+	if len(gzip.Types) > 0 {
+		result.Types = make([]string, 0, len(gzip.Types))
+		for _, t := range gzip.Types {
+			result.Types = append(result.Types, string(t))
+		}
+	}
+
+	return result
+}
+
+// addErrorPagesToServers adds the error pages of the Gateway to all servers, including the default ones,
+// so that the error pages also replace the responses to the requests that don't match any route.
+func addErrorPagesToServers(servers []VirtualServer, pages *graph.ErrorPages) {
+	errorPages := convertErrorPages(pages)
+	if errorPages == nil {
+		return
+	}
+
+	for i := range servers {
+		servers[i].ErrorPages = errorPages
+	}
+}
+
+func convertErrorPages(pages *graph.ErrorPages) []ErrorPage {
+	if pages == nil {
+		return nil
+	}
+
+	result := make([]ErrorPage, 0, len(pages.Pages))
+
+	for i, page := range pages.Pages {
+		errorPage := ErrorPage{
+			Codes: make([]int32, 0, len(page.Codes)),
+		}
+
+		for _, code := range page.Codes {
+			errorPage.Codes = append(errorPage.Codes, int32(code))
+		}
+
+		switch {
+		case page.Response != nil:
+			errorPage.Response = &ErrorPageResponse{
+				FileID: generateErrorPageFileID(pages.Policy, i),
+			}
+			if page.Response.ContentType != nil {
+				errorPage.Response.ContentType = *page.Response.ContentType
+			}
+			if page.Response.StatusCode != nil {
+				errorPage.Response.StatusCode = *page.Response.StatusCode
+			}
+		case page.Redirect != nil:
+			errorPage.Redirect = &ErrorPageRedirect{
+				URL: page.Redirect.URL,
+			}
+			if page.Redirect.StatusCode != nil {
+				errorPage.Redirect.StatusCode = *page.Redirect.StatusCode
+			}
+		}
+
+		result = append(result, errorPage)
+	}
+
+	return result
+}
+
+func convertTracing(tracing *nkgAPI.Tracing) *Tracing {
+	if tracing == nil || tracing.Exporter == nil {
+		return nil
+	}
+
+	result := &Tracing{
+		Endpoint: tracing.Exporter.Endpoint,
+		Context:  TraceContextPropagate,
+		Ratio:    100,
+	}
+
+	if tracing.Exporter.Interval != nil {
+		result.Interval = string(*tracing.Exporter.Interval)
+	}
+	if tracing.Exporter.BatchSize != nil {
+		result.BatchSize = *tracing.Exporter.BatchSize
+	}
+	if tracing.Exporter.BatchCount != nil {
+		result.BatchCount = *tracing.Exporter.BatchCount
+	}
+	if tracing.ServiceName != nil {
+		result.ServiceName = *tracing.ServiceName
+	}
+	if tracing.Context != nil {
+		result.Context = convertTraceContext(*tracing.Context)
+	}
+	if tracing.Ratio != nil {
+		result.Ratio = *tracing.Ratio
+	}
+
+	return result
+}
+
+func convertTraceContext(context nkgAPI.TraceContext) TraceContext {
+	switch context {
+	case nkgAPI.TraceContextExtract:
+		return TraceContextExtract
+	case nkgAPI.TraceContextInject:
+		return TraceContextInject
+	case nkgAPI.TraceContextPropagate:
+		return TraceContextPropagate
+	case nkgAPI.TraceContextIgnore:
+		return TraceContextIgnore
+	default:
+		panic(fmt.Sprintf("unsupported trace context: %s", context))
+	}
+}
+
+func getTracingRatio(tracing *nkgAPI.Tracing) *int32 {
+	if tracing == nil {
+		return nil
+	}
+
+	return tracing.Ratio
+}
+
+func convertAccessLog(accessLog *nkgAPI.AccessLog) *AccessLog {
+	if accessLog == nil {
+		return nil
+	}
+
+	result := &AccessLog{
+		Format:   AccessLogFormatJSON,
+		Disabled: accessLog.Enabled != nil && !*accessLog.Enabled,
+	}
+
+	if accessLog.Format != nil {
+		result.Format = convertAccessLogFormat(*accessLog.Format)
+	}
+
+	if accessLog.Destination != nil && accessLog.Destination.Syslog != nil {
+		syslog := accessLog.Destination.Syslog
+
+		result.Syslog = &SyslogDestination{
+			Server: syslog.Server,
+		}
+
+		if syslog.Tag != nil {
+			result.Syslog.Tag = *syslog.Tag
+		}
+	}
+
+	return result
+}
+
+func convertAccessLogFormat(format nkgAPI.AccessLogFormat) AccessLogFormat {
+	switch format {
+	case nkgAPI.AccessLogFormatJSON:
+		return AccessLogFormatJSON
+	case nkgAPI.AccessLogFormatText:
+		return AccessLogFormatText
+	default:
+		panic(fmt.Sprintf("unsupported access log format: %s", format))
+	}
+}
+
+// defaultAuthenticationRealm is the realm of an authentication that doesn't configure one.
+const defaultAuthenticationRealm = "Restricted"
+
+func convertAuthentication(filter *graph.AuthenticationFilter) *Authentication {
+	if filter == nil {
+		return nil
+	}
+
+	spec := filter.Source.Spec
+	nsname := client.ObjectKeyFromObject(filter.Source)
+
+	if spec.Type == nkgAPI.AuthenticationTypeExternal {
+		return &Authentication{
+			External: convertExternalAuthentication(nsname, *spec.External),
+		}
+	}
+
+	result := &Authentication{
+		Realm:  defaultAuthenticationRealm,
+		FileID: generateAuthFileID(nsname),
+	}
+
+	var realm *string
+
+	switch spec.Type {
+	case nkgAPI.AuthenticationTypeBasic:
+		realm = spec.Basic.Realm
+	case nkgAPI.AuthenticationTypeJWT:
+		realm = spec.JWT.Realm
+		result.JWT = &JWTAuthentication{
+			RequiredClaims: spec.JWT.RequiredClaims,
+		}
+	default:
+		panic(fmt.Sprintf("unsupported authentication type: %s", spec.Type))
+	}
+
+	if realm != nil {
+		result.Realm = *realm
+	}
+
+	return result
+}
+
+// defaultDirectResponseContentType is the content type of a direct response that doesn't configure one.
+const defaultDirectResponseContentType = "text/plain"
+
+func convertDirectResponse(filter *graph.DirectResponseFilter) *DirectResponse {
+	if filter == nil {
+		return nil
+	}
+
+	spec := filter.Source.Spec
+
+	result := &DirectResponse{
+		Body:        string(filter.Body),
+		ContentType: defaultDirectResponseContentType,
+		StatusCode:  spec.StatusCode,
+	}
+
+	if spec.ContentType != nil {
+		result.ContentType = *spec.ContentType
+	}
+
+	if len(spec.Headers) > 0 {
+		result.Headers = make([]HTTPHeader, 0, len(spec.Headers))
+		for _, h := range spec.Headers {
+			result.Headers = append(result.Headers, HTTPHeader{Name: h.Name, Value: h.Value})
+		}
+	}
+
+	return result
+}
+
+// buildExternalAuthUpstream builds the Upstream of the external authorization service of the filter.
+// The Upstream uses the default settings, because the policies for the Service only apply to the backendRefs.
+func buildExternalAuthUpstream(
+	ctx context.Context,
+	name string,
+	filter *graph.AuthenticationFilter,
+	resolver resolver.ServiceResolver,
+) Upstream {
+	var errMsg string
+
+	eps, err := resolver.Resolve(ctx, filter.Service, filter.Source.Spec.External.BackendRef.Port)
+	if err != nil {
+		errMsg = err.Error()
+	}
+
+	return Upstream{
+		Name:      name,
+		Endpoints: eps,
+		ErrorMsg:  errMsg,
+	}
+}
+
+// defaultExternalAuthPath is the path of the subrequests to an external authorization service that doesn't
+// configure one.
+const defaultExternalAuthPath = "/"
+
+func convertExternalAuthentication(
+	filter types.NamespacedName,
+	external nkgAPI.ExternalAuthentication,
+) *ExternalAuthentication {
+	path := defaultExternalAuthPath
+	if external.Path != nil {
+		path = *external.Path
+	}
+
+	return &ExternalAuthentication{
+		UpstreamName:    generateExternalAuthUpstreamName(filter),
+		Path:            path,
+		RequestHeaders:  external.RequestHeaders,
+		ResponseHeaders: external.ResponseHeaders,
+	}
+}
+
+// addSnippetToServers adds the server snippet of the Gateway to the servers, except for the default ones,
+// which don't proxy the requests.
+func addSnippetToServers(servers []VirtualServer, snippet string) {
+	if snippet == "" {
+		return
+	}
+
+	for i := range servers {
+		if !servers[i].IsDefault {
+			servers[i].Snippet = snippet
+		}
+	}
+}
+
+// getSnippet returns the snippet for the context or an empty string if there is none or if the SnippetsPolicy of
+// the snippets is excluded.
+func getSnippet(
+	snippets *graph.Snippets,
+	context nkgAPI.SnippetContext,
+	excludedSnippets map[types.NamespacedName]struct{},
+) string {
+	if snippets == nil {
+		return ""
+	}
+
+	if _, excluded := excludedSnippets[snippets.Policy]; excluded {
+		return ""
+	}
+
+	for _, s := range snippets.Snippets {
+		if s.Context == context {
+			return s.Value
+		}
+	}
+
+	return ""
+}
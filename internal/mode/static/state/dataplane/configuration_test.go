@@ -14,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/graph"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/resolver"
@@ -246,6 +247,132 @@ func TestBuildConfiguration(t *testing.T) {
 		pathAndType{path: "/third", pathType: prefix},
 	)
 
+	hr9, expHR9Groups, routeHR9 := createTestResources(
+		"hr-9",
+		"foo.example.com",
+		"listener-80-1",
+		pathAndType{path: "/", pathType: prefix},
+	)
+
+	hr10, expHR10Groups, routeHR10 := createTestResources(
+		"hr-10",
+		"bar.example.com",
+		"listener-80-1",
+		pathAndType{path: "/", pathType: prefix},
+	)
+
+	createRateLimitPolicy := func(name string, key nkgAPI.RateLimitKey) *graph.RateLimitPolicy {
+		return &graph.RateLimitPolicy{
+			Source: &nkgAPI.RateLimitPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      name,
+				},
+				Spec: nkgAPI.RateLimitPolicySpec{
+					Key:   key,
+					Rate:  "10r/s",
+					Burst: helpers.GetPointer[int32](5),
+				},
+			},
+			Valid: true,
+		}
+	}
+
+	gatewayRateLimitPolicy := createRateLimitPolicy("gateway", nkgAPI.RateLimitKey{Type: nkgAPI.RateLimitKeyTypeClientIP})
+	routeRateLimitPolicy := createRateLimitPolicy(
+		"route",
+		nkgAPI.RateLimitKey{Type: nkgAPI.RateLimitKeyTypeHeader, Name: helpers.GetStringPointer("X-User")},
+	)
+	invalidRateLimitPolicy := createRateLimitPolicy("invalid", nkgAPI.RateLimitKey{Type: nkgAPI.RateLimitKeyTypeClientIP})
+	invalidRateLimitPolicy.Valid = false
+
+	routeHR10.RateLimit = routeRateLimitPolicy
+
+	hr11, expHR11Groups, routeHR11 := createTestResources(
+		"hr-11",
+		"foo.example.com",
+		"listener-80-1",
+		pathAndType{path: "/", pathType: prefix},
+	)
+
+	routeHR11.ClientSettings = &nkgAPI.ClientSettingsPolicySpec{
+		Body: &nkgAPI.ClientBody{
+			MaxSize: helpers.GetPointer[nkgAPI.Size]("100m"),
+		},
+	}
+
+	gatewayClientSettings := &nkgAPI.ClientSettingsPolicySpec{
+		Body: &nkgAPI.ClientBody{
+			MaxSize: helpers.GetPointer[nkgAPI.Size]("10m"),
+		},
+		Header: &nkgAPI.ClientHeader{
+			Timeout: helpers.GetPointer[nkgAPI.Duration]("30s"),
+		},
+	}
+
+	expServerClientSettings := &ClientSettings{
+		BodyMaxSize:   "10m",
+		HeaderTimeout: "30s",
+	}
+
+	hr12, expHR12Groups, routeHR12 := createTestResources(
+		"hr-12",
+		"foo.example.com",
+		"listener-80-1",
+		pathAndType{path: "/", pathType: prefix},
+	)
+
+	routeHR12.Tracing = &nkgAPI.Tracing{
+		Ratio: helpers.GetPointer[int32](50),
+	}
+
+	gatewayAccessLog := &nkgAPI.AccessLog{
+		Format: helpers.GetPointer(nkgAPI.AccessLogFormatText),
+		Destination: &nkgAPI.AccessLogDestination{
+			Type: nkgAPI.AccessLogDestinationSyslog,
+			Syslog: &nkgAPI.SyslogDestination{
+				Server: "syslog:514",
+			},
+		},
+	}
+
+	routeHR12.AccessLog = &nkgAPI.AccessLog{
+		Enabled:     helpers.GetPointer(false),
+		Format:      gatewayAccessLog.Format,
+		Destination: gatewayAccessLog.Destination,
+	}
+
+	gatewayTracing := &nkgAPI.Tracing{
+		Exporter: &nkgAPI.TracingExporter{
+			Endpoint: "otel-collector:4317",
+		},
+		Ratio: helpers.GetPointer[int32](10),
+	}
+
+	hr13, expHR13Groups, routeHR13 := createTestResources(
+		"hr-13",
+		"foo.example.com",
+		"listener-80-1",
+		pathAndType{path: "/", pathType: prefix},
+	)
+
+	routeHR13.Rules[0].Authentication = &graph.AuthenticationFilter{
+		Source: &nkgAPI.AuthenticationFilter{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "jwt",
+			},
+			Spec: nkgAPI.AuthenticationFilterSpec{
+				Type: nkgAPI.AuthenticationTypeJWT,
+				JWT: &nkgAPI.JWTAuthentication{
+					RequiredClaims: map[string]string{"iss": "issuer"},
+				},
+			},
+		},
+		Data:  []byte("jwks"),
+		Valid: true,
+	}
+
 	httpsHR1, expHTTPSHR1Groups, httpsRouteHR1 := createTestResources(
 		"https-hr-1",
 		"foo.example.com",
@@ -596,6 +723,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHR2Groups[0],
 										Source:       hr2,
+										Listener:     "listener-80-1",
 									},
 								},
 							},
@@ -614,6 +742,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHR1Groups[0],
 										Source:       hr1,
+										Listener:     "listener-80-1",
 									},
 								},
 							},
@@ -685,6 +814,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHTTPSHR2Groups[0],
 										Source:       httpsHR2,
+										Listener:     "listener-443-1",
 									},
 								},
 							},
@@ -704,6 +834,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHTTPSHR5Groups[0],
 										Source:       httpsHR5,
+										Listener:     "listener-443-with-hostname",
 									},
 								},
 							},
@@ -723,6 +854,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHTTPSHR1Groups[0],
 										Source:       httpsHR1,
+										Listener:     "listener-443-1",
 									},
 								},
 							},
@@ -807,12 +939,14 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHR3Groups[0],
 										Source:       hr3,
+										Listener:     "listener-80-1",
 									},
 									{
 										MatchIdx:     0,
 										RuleIdx:      1,
 										BackendGroup: expHR4Groups[1],
 										Source:       hr4,
+										Listener:     "listener-80-1",
 									},
 								},
 							},
@@ -825,6 +959,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHR4Groups[0],
 										Source:       hr4,
+										Listener:     "listener-80-1",
 									},
 								},
 							},
@@ -837,6 +972,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      1,
 										BackendGroup: expHR3Groups[1],
 										Source:       hr3,
+										Listener:     "listener-80-1",
 									},
 								},
 							},
@@ -862,12 +998,14 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHTTPSHR3Groups[0],
 										Source:       httpsHR3,
+										Listener:     "listener-443-1",
 									},
 									{
 										MatchIdx:     0,
 										RuleIdx:      1,
 										BackendGroup: expHTTPSHR4Groups[1],
 										Source:       httpsHR4,
+										Listener:     "listener-443-1",
 									},
 								},
 							},
@@ -880,6 +1018,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHTTPSHR4Groups[0],
 										Source:       httpsHR4,
+										Listener:     "listener-443-1",
 									},
 								},
 							},
@@ -892,6 +1031,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      1,
 										BackendGroup: expHTTPSHR3Groups[1],
 										Source:       httpsHR3,
+										Listener:     "listener-443-1",
 									},
 								},
 							},
@@ -993,6 +1133,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHR3Groups[0],
 										Source:       hr3,
+										Listener:     "listener-80-1",
 									},
 								},
 							},
@@ -1005,6 +1146,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      1,
 										BackendGroup: expHR3Groups[1],
 										Source:       hr3,
+										Listener:     "listener-80-1",
 									},
 								},
 							},
@@ -1027,6 +1169,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHR8Groups[0],
 										Source:       hr8,
+										Listener:     "listener-8080",
 									},
 								},
 							},
@@ -1039,6 +1182,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      1,
 										BackendGroup: expHR8Groups[1],
 										Source:       hr8,
+										Listener:     "listener-8080",
 									},
 								},
 							},
@@ -1064,6 +1208,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHTTPSHR3Groups[0],
 										Source:       httpsHR3,
+										Listener:     "listener-443-1",
 									},
 								},
 							},
@@ -1076,6 +1221,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      1,
 										BackendGroup: expHTTPSHR3Groups[1],
 										Source:       httpsHR3,
+										Listener:     "listener-443-1",
 									},
 								},
 							},
@@ -1104,6 +1250,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHTTPSHR7Groups[0],
 										Source:       httpsHR7,
+										Listener:     "listener-8443",
 									},
 								},
 							},
@@ -1116,6 +1263,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      1,
 										BackendGroup: expHTTPSHR7Groups[1],
 										Source:       httpsHR7,
+										Listener:     "listener-8443",
 									},
 								},
 							},
@@ -1247,6 +1395,7 @@ func TestBuildConfiguration(t *testing.T) {
 										MatchIdx:     0,
 										RuleIdx:      0,
 										Source:       hr5,
+										Listener:     "listener-80-1",
 										BackendGroup: expHR5Groups[0],
 										Filters: Filters{
 											RequestRedirect: redirect.RequestRedirect,
@@ -1262,6 +1411,7 @@ func TestBuildConfiguration(t *testing.T) {
 										MatchIdx:     0,
 										RuleIdx:      1,
 										Source:       hr5,
+										Listener:     "listener-80-1",
 										BackendGroup: expHR5Groups[1],
 										Filters: Filters{
 											InvalidFilter: &InvalidFilter{},
@@ -1332,6 +1482,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHR6Groups[0],
 										Source:       hr6,
+										Listener:     "listener-80-1",
 									},
 								},
 							},
@@ -1357,6 +1508,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHTTPSHR6Groups[0],
 										Source:       httpsHR6,
+										Listener:     "listener-443-1",
 									},
 								},
 							},
@@ -1423,6 +1575,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      1,
 										BackendGroup: expHR7Groups[1],
 										Source:       hr7,
+										Listener:     "listener-80-1",
 									},
 								},
 							},
@@ -1435,6 +1588,7 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHR7Groups[0],
 										Source:       hr7,
+										Listener:     "listener-80-1",
 									},
 								},
 							},
@@ -1504,12 +1658,14 @@ func TestBuildConfiguration(t *testing.T) {
 										RuleIdx:      0,
 										BackendGroup: expHTTPSHR5Groups[0],
 										Source:       httpsHR5,
+										Listener:     "listener-443-1",
 									},
 									{
 										MatchIdx:     0,
 										RuleIdx:      0,
 										BackendGroup: expHTTPSHR5Groups[0],
 										Source:       httpsHR5,
+										Listener:     "listener-443-with-hostname",
 									},
 								},
 							},
@@ -1538,455 +1694,1661 @@ func TestBuildConfiguration(t *testing.T) {
 			},
 			msg: "two https listeners with different hostnames but same route; chooses listener with more specific hostname",
 		},
-	}
-
-	for _, test := range tests {
-		t.Run(test.msg, func(t *testing.T) {
-			g := NewGomegaWithT(t)
-
-			result := BuildConfiguration(context.TODO(), test.graph, fakeResolver)
-
-			g.Expect(result.BackendGroups).To(ConsistOf(test.expConf.BackendGroups))
-			g.Expect(result.Upstreams).To(ConsistOf(test.expConf.Upstreams))
-			g.Expect(result.HTTPServers).To(ConsistOf(test.expConf.HTTPServers))
-			g.Expect(result.SSLServers).To(ConsistOf(test.expConf.SSLServers))
-			g.Expect(result.SSLKeyPairs).To(Equal(test.expConf.SSLKeyPairs))
-		})
-	}
-}
-
-func TestGetPath(t *testing.T) {
-	tests := []struct {
-		path     *v1beta1.HTTPPathMatch
-		expected string
-		msg      string
-	}{
-		{
-			path:     &v1beta1.HTTPPathMatch{Value: helpers.GetStringPointer("/abc")},
-			expected: "/abc",
-			msg:      "normal case",
-		},
-		{
-			path:     nil,
-			expected: "/",
-			msg:      "nil path",
-		},
-		{
-			path:     &v1beta1.HTTPPathMatch{Value: nil},
-			expected: "/",
-			msg:      "nil value",
-		},
 		{
-			path:     &v1beta1.HTTPPathMatch{Value: helpers.GetStringPointer("")},
-			expected: "/",
-			msg:      "empty value",
-		},
-	}
-
-	for _, test := range tests {
-		result := getPath(test.path)
-		if result != test.expected {
-			t.Errorf("getPath() returned %q but expected %q for the case of %q", result, test.expected, test.msg)
-		}
-	}
-}
-
-func TestCreateFilters(t *testing.T) {
-	redirect1 := v1beta1.HTTPRouteFilter{
-		Type: v1beta1.HTTPRouteFilterRequestRedirect,
-		RequestRedirect: &v1beta1.HTTPRequestRedirectFilter{
-			Hostname: (*v1beta1.PreciseHostname)(helpers.GetStringPointer("foo.example.com")),
-		},
-	}
-	redirect2 := v1beta1.HTTPRouteFilter{
-		Type: v1beta1.HTTPRouteFilterRequestRedirect,
-		RequestRedirect: &v1beta1.HTTPRequestRedirectFilter{
-			Hostname: (*v1beta1.PreciseHostname)(helpers.GetStringPointer("bar.example.com")),
-		},
-	}
-	requestHeaderModifiers1 := v1beta1.HTTPRouteFilter{
-		Type: v1beta1.HTTPRouteFilterRequestHeaderModifier,
-		RequestHeaderModifier: &v1beta1.HTTPHeaderFilter{
-			Set: []v1beta1.HTTPHeader{
-				{
-					Name:  "Connection",
-					Value: "close",
-				},
-			},
-		},
-	}
-	requestHeaderModifiers2 := v1beta1.HTTPRouteFilter{
-		Type: v1beta1.HTTPRouteFilterRequestHeaderModifier,
-		RequestHeaderModifier: &v1beta1.HTTPHeaderFilter{
-			Add: []v1beta1.HTTPHeader{
-				{
-					Name:  "Content-Accepted",
-					Value: "gzip",
+			graph: &graph.Graph{
+				GatewayClass: &graph.GatewayClass{
+					Source: &v1beta1.GatewayClass{},
+					Valid:  true,
 				},
-			},
-		},
-	}
-
-	tests := []struct {
-		expected Filters
-		msg      string
-		filters  []v1beta1.HTTPRouteFilter
-	}{
-		{
-			filters:  []v1beta1.HTTPRouteFilter{},
-			expected: Filters{},
-			msg:      "no filters",
-		},
-		{
-			filters: []v1beta1.HTTPRouteFilter{
-				redirect1,
-			},
-			expected: Filters{
-				RequestRedirect: redirect1.RequestRedirect,
-			},
-			msg: "one filter",
-		},
-		{
-			filters: []v1beta1.HTTPRouteFilter{
-				redirect1,
-				redirect2,
-			},
-			expected: Filters{
-				RequestRedirect: redirect1.RequestRedirect,
-			},
-			msg: "two filters, first wins",
-		},
-		{
-			filters: []v1beta1.HTTPRouteFilter{
-				redirect1,
-				redirect2,
-				requestHeaderModifiers1,
-			},
-			expected: Filters{
-				RequestRedirect:        redirect1.RequestRedirect,
-				RequestHeaderModifiers: convertHTTPFilter(requestHeaderModifiers1.RequestHeaderModifier),
-			},
-			msg: "two redirect filters, one request header modifier, first redirect wins",
-		},
-		{
-			filters: []v1beta1.HTTPRouteFilter{
-				redirect1,
-				redirect2,
-				requestHeaderModifiers1,
-				requestHeaderModifiers2,
-			},
-			expected: Filters{
-				RequestRedirect:        redirect1.RequestRedirect,
-				RequestHeaderModifiers: convertHTTPFilter(requestHeaderModifiers1.RequestHeaderModifier),
-			},
-			msg: "two redirect filters, two request header modifier, first value for each wins",
-		},
-	}
-
-	for _, test := range tests {
-		result := createFilters(test.filters)
-		if diff := cmp.Diff(test.expected, result); diff != "" {
-			t.Errorf("createFilters() %q mismatch (-want +got):\n%s", test.msg, diff)
-		}
-	}
-}
-
-func TestMatchRuleGetMatch(t *testing.T) {
-	hr := &v1beta1.HTTPRoute{
-		Spec: v1beta1.HTTPRouteSpec{
-			Rules: []v1beta1.HTTPRouteRule{
-				{
-					Matches: []v1beta1.HTTPRouteMatch{
-						{
-							Path: &v1beta1.HTTPPathMatch{
-								Value: helpers.GetStringPointer("/path-1"),
-							},
-						},
-						{
-							Path: &v1beta1.HTTPPathMatch{
-								Value: helpers.GetStringPointer("/path-2"),
+				Gateway: &graph.Gateway{
+					Source: &v1beta1.Gateway{},
+					Listeners: map[string]*graph.Listener{
+						"listener-80-1": {
+							Source: listener80,
+							Valid:  true,
+							Routes: map[types.NamespacedName]*graph.Route{
+								{Namespace: "test", Name: "hr-9"}:  routeHR9,
+								{Namespace: "test", Name: "hr-10"}: routeHR10,
 							},
+							RateLimit: gatewayRateLimitPolicy,
 						},
 					},
 				},
-				{
-					Matches: []v1beta1.HTTPRouteMatch{
-						{
-							Path: &v1beta1.HTTPPathMatch{
-								Value: helpers.GetStringPointer("/path-3"),
-							},
-						},
-						{
-							Path: &v1beta1.HTTPPathMatch{
-								Value: helpers.GetStringPointer("/path-4"),
-							},
-						},
-					},
+				Routes: map[types.NamespacedName]*graph.Route{
+					{Namespace: "test", Name: "hr-9"}:  routeHR9,
+					{Namespace: "test", Name: "hr-10"}: routeHR10,
+				},
+				RateLimitPolicies: map[types.NamespacedName]*graph.RateLimitPolicy{
+					{Namespace: "test", Name: "gateway"}: gatewayRateLimitPolicy,
+					{Namespace: "test", Name: "route"}:   routeRateLimitPolicy,
+					{Namespace: "test", Name: "invalid"}: invalidRateLimitPolicy,
 				},
 			},
-		},
-	}
-
-	tests := []struct {
+			expConf: Configuration{
+				HTTPServers: []VirtualServer{
+					{
+						IsDefault: true,
+						Port:      80,
+					},
+					{
+						Hostname: "bar.example.com",
+						PathRules: []PathRule{
+							{
+								Path:     "/",
+								PathType: PathTypePrefix,
+								MatchRules: []MatchRule{
+									{
+										MatchIdx:     0,
+										RuleIdx:      0,
+										Source:       hr10,
+										Listener:     "listener-80-1",
+										BackendGroup: expHR10Groups[0],
+										RateLimit: &RateLimit{
+											Zone: "ratelimit_test_route",
+											Key: RateLimitKey{
+												Type: RateLimitKeyTypeHeader,
+												Name: "X-User",
+											},
+											Burst: 5,
+										},
+									},
+								},
+							},
+						},
+						Port: 80,
+					},
+					{
+						Hostname: "foo.example.com",
+						PathRules: []PathRule{
+							{
+								Path:     "/",
+								PathType: PathTypePrefix,
+								MatchRules: []MatchRule{
+									{
+										MatchIdx:     0,
+										RuleIdx:      0,
+										Source:       hr9,
+										Listener:     "listener-80-1",
+										BackendGroup: expHR9Groups[0],
+										RateLimit: &RateLimit{
+											Zone:  "ratelimit_test_gateway",
+											Key:   RateLimitKey{Type: RateLimitKeyTypeClientIP},
+											Burst: 5,
+										},
+									},
+								},
+							},
+						},
+						Port: 80,
+					},
+				},
+				SSLServers:    []VirtualServer{},
+				Upstreams:     []Upstream{fooUpstream},
+				BackendGroups: []BackendGroup{expHR9Groups[0], expHR10Groups[0]},
+				SSLKeyPairs:   map[SSLKeyPairID]SSLKeyPair{},
+				RateLimitZones: []RateLimitZone{
+					{
+						Name: "ratelimit_test_gateway",
+						Rate: "10r/s",
+						Key:  RateLimitKey{Type: RateLimitKeyTypeClientIP},
+					},
+					{
+						Name: "ratelimit_test_route",
+						Rate: "10r/s",
+						Key: RateLimitKey{
+							Type: RateLimitKeyTypeHeader,
+							Name: "X-User",
+						},
+					},
+				},
+			},
+			msg: "one http listener with rate limits for the listener and a route",
+		},
+		{
+			graph: &graph.Graph{
+				GatewayClass: &graph.GatewayClass{
+					Source: &v1beta1.GatewayClass{},
+					Valid:  true,
+				},
+				Gateway: &graph.Gateway{
+					Source: &v1beta1.Gateway{},
+					Listeners: map[string]*graph.Listener{
+						"listener-80-1": {
+							Source: listener80,
+							Valid:  true,
+							Routes: map[types.NamespacedName]*graph.Route{
+								{Namespace: "test", Name: "hr-11"}: routeHR11,
+							},
+						},
+					},
+					ClientSettings: gatewayClientSettings,
+				},
+				Routes: map[types.NamespacedName]*graph.Route{
+					{Namespace: "test", Name: "hr-11"}: routeHR11,
+				},
+			},
+			expConf: Configuration{
+				HTTPServers: []VirtualServer{
+					{
+						IsDefault:      true,
+						Port:           80,
+						ClientSettings: expServerClientSettings,
+					},
+					{
+						Hostname: "foo.example.com",
+						PathRules: []PathRule{
+							{
+								Path:     "/",
+								PathType: PathTypePrefix,
+								MatchRules: []MatchRule{
+									{
+										MatchIdx:     0,
+										RuleIdx:      0,
+										Source:       hr11,
+										Listener:     "listener-80-1",
+										BackendGroup: expHR11Groups[0],
+										ClientSettings: &ClientSettings{
+											BodyMaxSize: "100m",
+										},
+									},
+								},
+							},
+						},
+						Port:           80,
+						ClientSettings: expServerClientSettings,
+					},
+				},
+				SSLServers:    []VirtualServer{},
+				Upstreams:     []Upstream{fooUpstream},
+				BackendGroups: []BackendGroup{expHR11Groups[0]},
+				SSLKeyPairs:   map[SSLKeyPairID]SSLKeyPair{},
+			},
+			msg: "one http listener with client settings for the gateway and a route",
+		},
+		{
+			graph: &graph.Graph{
+				GatewayClass: &graph.GatewayClass{
+					Source: &v1beta1.GatewayClass{},
+					Valid:  true,
+				},
+				Gateway: &graph.Gateway{
+					Source: &v1beta1.Gateway{},
+					Listeners: map[string]*graph.Listener{
+						"listener-80-1": {
+							Source: listener80,
+							Valid:  true,
+							Routes: map[types.NamespacedName]*graph.Route{
+								{Namespace: "test", Name: "hr-12"}: routeHR12,
+							},
+						},
+					},
+					Tracing:   gatewayTracing,
+					AccessLog: gatewayAccessLog,
+				},
+				Routes: map[types.NamespacedName]*graph.Route{
+					{Namespace: "test", Name: "hr-12"}: routeHR12,
+				},
+			},
+			expConf: Configuration{
+				HTTPServers: []VirtualServer{
+					{
+						IsDefault: true,
+						Port:      80,
+					},
+					{
+						Hostname: "foo.example.com",
+						PathRules: []PathRule{
+							{
+								Path:     "/",
+								PathType: PathTypePrefix,
+								MatchRules: []MatchRule{
+									{
+										MatchIdx:     0,
+										RuleIdx:      0,
+										Source:       hr12,
+										Listener:     "listener-80-1",
+										BackendGroup: expHR12Groups[0],
+										TracingRatio: helpers.GetPointer[int32](50),
+										AccessLog: &AccessLog{
+											Syslog:   &SyslogDestination{Server: "syslog:514"},
+											Format:   AccessLogFormatText,
+											Disabled: true,
+										},
+									},
+								},
+							},
+						},
+						Port: 80,
+					},
+				},
+				SSLServers:    []VirtualServer{},
+				Upstreams:     []Upstream{fooUpstream},
+				BackendGroups: []BackendGroup{expHR12Groups[0]},
+				SSLKeyPairs:   map[SSLKeyPairID]SSLKeyPair{},
+				Tracing: &Tracing{
+					Endpoint: "otel-collector:4317",
+					Context:  TraceContextPropagate,
+					Ratio:    10,
+				},
+				AccessLog: &AccessLog{
+					Syslog: &SyslogDestination{Server: "syslog:514"},
+					Format: AccessLogFormatText,
+				},
+			},
+			msg: "one http listener with tracing and access logs for the gateway and a route",
+		},
+		{
+			graph: &graph.Graph{
+				GatewayClass: &graph.GatewayClass{
+					Source: &v1beta1.GatewayClass{},
+					Valid:  true,
+				},
+				Gateway: &graph.Gateway{
+					Source: &v1beta1.Gateway{},
+					Listeners: map[string]*graph.Listener{
+						"listener-80-1": {
+							Source: listener80,
+							Valid:  true,
+							Routes: map[types.NamespacedName]*graph.Route{
+								{Namespace: "test", Name: "hr-13"}: routeHR13,
+							},
+						},
+					},
+				},
+				Routes: map[types.NamespacedName]*graph.Route{
+					{Namespace: "test", Name: "hr-13"}: routeHR13,
+				},
+			},
+			expConf: Configuration{
+				HTTPServers: []VirtualServer{
+					{
+						IsDefault: true,
+						Port:      80,
+					},
+					{
+						Hostname: "foo.example.com",
+						PathRules: []PathRule{
+							{
+								Path:     "/",
+								PathType: PathTypePrefix,
+								MatchRules: []MatchRule{
+									{
+										MatchIdx:     0,
+										RuleIdx:      0,
+										Source:       hr13,
+										Listener:     "listener-80-1",
+										BackendGroup: expHR13Groups[0],
+										Filters: Filters{
+											Authentication: &Authentication{
+												JWT: &JWTAuthentication{
+													RequiredClaims: map[string]string{"iss": "issuer"},
+												},
+												Realm:  "Restricted",
+												FileID: "auth_test_jwt",
+											},
+										},
+									},
+								},
+							},
+						},
+						Port: 80,
+					},
+				},
+				SSLServers:    []VirtualServer{},
+				Upstreams:     []Upstream{fooUpstream},
+				BackendGroups: []BackendGroup{expHR13Groups[0]},
+				SSLKeyPairs:   map[SSLKeyPairID]SSLKeyPair{},
+				AuthFiles: map[AuthFileID][]byte{
+					"auth_test_jwt": []byte("jwks"),
+				},
+			},
+			msg: "one http listener with an authentication filter",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			result := BuildConfiguration(context.TODO(), test.graph, fakeResolver, nil)
+
+			g.Expect(result.BackendGroups).To(ConsistOf(test.expConf.BackendGroups))
+			g.Expect(result.Upstreams).To(ConsistOf(test.expConf.Upstreams))
+			g.Expect(result.HTTPServers).To(ConsistOf(test.expConf.HTTPServers))
+			g.Expect(result.SSLServers).To(ConsistOf(test.expConf.SSLServers))
+			g.Expect(result.SSLKeyPairs).To(Equal(test.expConf.SSLKeyPairs))
+			g.Expect(result.AuthFiles).To(Equal(test.expConf.AuthFiles))
+			g.Expect(result.RateLimitZones).To(Equal(test.expConf.RateLimitZones))
+			g.Expect(result.Tracing).To(Equal(test.expConf.Tracing))
+			g.Expect(result.AccessLog).To(Equal(test.expConf.AccessLog))
+		})
+	}
+}
+
+func TestGetPath(t *testing.T) {
+	tests := []struct {
+		path     *v1beta1.HTTPPathMatch
+		expected string
+		msg      string
+	}{
+		{
+			path:     &v1beta1.HTTPPathMatch{Value: helpers.GetStringPointer("/abc")},
+			expected: "/abc",
+			msg:      "normal case",
+		},
+		{
+			path:     nil,
+			expected: "/",
+			msg:      "nil path",
+		},
+		{
+			path:     &v1beta1.HTTPPathMatch{Value: nil},
+			expected: "/",
+			msg:      "nil value",
+		},
+		{
+			path:     &v1beta1.HTTPPathMatch{Value: helpers.GetStringPointer("")},
+			expected: "/",
+			msg:      "empty value",
+		},
+	}
+
+	for _, test := range tests {
+		result := getPath(test.path)
+		if result != test.expected {
+			t.Errorf("getPath() returned %q but expected %q for the case of %q", result, test.expected, test.msg)
+		}
+	}
+}
+
+func TestCreateFilters(t *testing.T) {
+	redirect1 := v1beta1.HTTPRouteFilter{
+		Type: v1beta1.HTTPRouteFilterRequestRedirect,
+		RequestRedirect: &v1beta1.HTTPRequestRedirectFilter{
+			Hostname: (*v1beta1.PreciseHostname)(helpers.GetStringPointer("foo.example.com")),
+		},
+	}
+	redirect2 := v1beta1.HTTPRouteFilter{
+		Type: v1beta1.HTTPRouteFilterRequestRedirect,
+		RequestRedirect: &v1beta1.HTTPRequestRedirectFilter{
+			Hostname: (*v1beta1.PreciseHostname)(helpers.GetStringPointer("bar.example.com")),
+		},
+	}
+	requestHeaderModifiers1 := v1beta1.HTTPRouteFilter{
+		Type: v1beta1.HTTPRouteFilterRequestHeaderModifier,
+		RequestHeaderModifier: &v1beta1.HTTPHeaderFilter{
+			Set: []v1beta1.HTTPHeader{
+				{
+					Name:  "Connection",
+					Value: "close",
+				},
+			},
+		},
+	}
+	requestHeaderModifiers2 := v1beta1.HTTPRouteFilter{
+		Type: v1beta1.HTTPRouteFilterRequestHeaderModifier,
+		RequestHeaderModifier: &v1beta1.HTTPHeaderFilter{
+			Add: []v1beta1.HTTPHeader{
+				{
+					Name:  "Content-Accepted",
+					Value: "gzip",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		expected Filters
+		msg      string
+		filters  []v1beta1.HTTPRouteFilter
+	}{
+		{
+			filters:  []v1beta1.HTTPRouteFilter{},
+			expected: Filters{},
+			msg:      "no filters",
+		},
+		{
+			filters: []v1beta1.HTTPRouteFilter{
+				redirect1,
+			},
+			expected: Filters{
+				RequestRedirect: redirect1.RequestRedirect,
+			},
+			msg: "one filter",
+		},
+		{
+			filters: []v1beta1.HTTPRouteFilter{
+				redirect1,
+				redirect2,
+			},
+			expected: Filters{
+				RequestRedirect: redirect1.RequestRedirect,
+			},
+			msg: "two filters, first wins",
+		},
+		{
+			filters: []v1beta1.HTTPRouteFilter{
+				redirect1,
+				redirect2,
+				requestHeaderModifiers1,
+			},
+			expected: Filters{
+				RequestRedirect:        redirect1.RequestRedirect,
+				RequestHeaderModifiers: convertHTTPFilter(requestHeaderModifiers1.RequestHeaderModifier),
+			},
+			msg: "two redirect filters, one request header modifier, first redirect wins",
+		},
+		{
+			filters: []v1beta1.HTTPRouteFilter{
+				redirect1,
+				redirect2,
+				requestHeaderModifiers1,
+				requestHeaderModifiers2,
+			},
+			expected: Filters{
+				RequestRedirect:        redirect1.RequestRedirect,
+				RequestHeaderModifiers: convertHTTPFilter(requestHeaderModifiers1.RequestHeaderModifier),
+			},
+			msg: "two redirect filters, two request header modifier, first value for each wins",
+		},
+	}
+
+	for _, test := range tests {
+		result := createFilters(test.filters)
+		if diff := cmp.Diff(test.expected, result); diff != "" {
+			t.Errorf("createFilters() %q mismatch (-want +got):\n%s", test.msg, diff)
+		}
+	}
+}
+
+func TestMatchRuleGetMatch(t *testing.T) {
+	hr := &v1beta1.HTTPRoute{
+		Spec: v1beta1.HTTPRouteSpec{
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/path-1"),
+							},
+						},
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/path-2"),
+							},
+						},
+					},
+				},
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/path-3"),
+							},
+						},
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Value: helpers.GetStringPointer("/path-4"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
 		name    string
 		expPath string
 		rule    MatchRule
 	}{
 		{
-			name:    "first match in first rule",
-			expPath: "/path-1",
-			rule:    MatchRule{MatchIdx: 0, RuleIdx: 0, Source: hr},
+			name:    "first match in first rule",
+			expPath: "/path-1",
+			rule:    MatchRule{MatchIdx: 0, RuleIdx: 0, Source: hr},
+		},
+		{
+			name:    "second match in first rule",
+			expPath: "/path-2",
+			rule:    MatchRule{MatchIdx: 1, RuleIdx: 0, Source: hr},
+		},
+		{
+			name:    "second match in second rule",
+			expPath: "/path-4",
+			rule:    MatchRule{MatchIdx: 1, RuleIdx: 1, Source: hr},
+		},
+	}
+
+	for _, tc := range tests {
+		actual := tc.rule.GetMatch()
+		if *actual.Path.Value != tc.expPath {
+			t.Errorf(
+				"MatchRule.GetMatch() returned incorrect match with path: %s, expected path: %s for test case: %q",
+				*actual.Path.Value,
+				tc.expPath,
+				tc.name,
+			)
+		}
+	}
+}
+
+func TestGetListenerHostname(t *testing.T) {
+	var emptyHostname v1beta1.Hostname
+	var hostname v1beta1.Hostname = "example.com"
+
+	tests := []struct {
+		hostname *v1beta1.Hostname
+		expected string
+		msg      string
+	}{
+		{
+			hostname: nil,
+			expected: wildcardHostname,
+			msg:      "nil hostname",
+		},
+		{
+			hostname: &emptyHostname,
+			expected: wildcardHostname,
+			msg:      "empty hostname",
+		},
+		{
+			hostname: &hostname,
+			expected: string(hostname),
+			msg:      "normal hostname",
+		},
+	}
+
+	for _, test := range tests {
+		result := getListenerHostname(test.hostname)
+		if result != test.expected {
+			t.Errorf(
+				"getListenerHostname() returned %q but expected %q for the case of %q",
+				result,
+				test.expected,
+				test.msg,
+			)
+		}
+	}
+}
+
+func refsToValidRules(refs ...[]graph.BackendRef) []graph.Rule {
+	rules := make([]graph.Rule, 0, len(refs))
+
+	for _, ref := range refs {
+		rules = append(rules, graph.Rule{
+			ValidMatches: true,
+			ValidFilters: true,
+			BackendRefs:  ref,
+		})
+	}
+
+	return rules
+}
+
+func TestBuildUpstreams(t *testing.T) {
+	fooEndpoints := []resolver.Endpoint{
+		{
+			Address: "10.0.0.0",
+			Port:    8080,
+		},
+		{
+			Address: "10.0.0.1",
+			Port:    8080,
+		},
+		{
+			Address: "10.0.0.2",
+			Port:    8080,
+		},
+	}
+
+	barEndpoints := []resolver.Endpoint{
+		{
+			Address: "11.0.0.0",
+			Port:    80,
+		},
+		{
+			Address: "11.0.0.1",
+			Port:    80,
+		},
+		{
+			Address: "11.0.0.2",
+			Port:    80,
+		},
+		{
+			Address: "11.0.0.3",
+			Port:    80,
+		},
+	}
+
+	bazEndpoints := []resolver.Endpoint{
+		{
+			Address: "12.0.0.0",
+			Port:    80,
+		},
+	}
+
+	baz2Endpoints := []resolver.Endpoint{
+		{
+			Address: "13.0.0.0",
+			Port:    80,
+		},
+	}
+
+	authEndpoints := []resolver.Endpoint{
+		{
+			Address: "14.0.0.0",
+			Port:    9000,
+		},
+	}
+
+	createBackendRefs := func(serviceNames ...string) []graph.BackendRef {
+		var backends []graph.BackendRef
+		for _, name := range serviceNames {
+			backends = append(backends, graph.BackendRef{
+				Svc:   &apiv1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name}},
+				Port:  80,
+				Valid: name != "",
+			})
+		}
+		return backends
+	}
+
+	hr1Refs0 := createBackendRefs("foo", "bar")
+
+	hr1Refs1 := createBackendRefs("baz", "", "") // empty service names should be ignored
+
+	hr2Refs0 := createBackendRefs("foo", "baz") // shouldn't duplicate foo and baz upstream
+
+	hr2Refs1 := createBackendRefs("nil-endpoints")
+
+	hr3Refs0 := createBackendRefs("baz") // shouldn't duplicate baz upstream
+
+	hr4Refs0 := createBackendRefs("empty-endpoints", "")
+
+	hr4Refs1 := createBackendRefs("baz2")
+	hr4Refs1[0].UpstreamHealth = &nkgAPI.UpstreamHealthPolicySpec{
+		MaxFails:    helpers.GetPointer[int32](3),
+		FailTimeout: helpers.GetPointer[nkgAPI.Duration]("30s"),
+		NextUpstream: &nkgAPI.NextUpstream{
+			Conditions: []nkgAPI.NextUpstreamCondition{
+				nkgAPI.NextUpstreamConditionError,
+				nkgAPI.NextUpstreamConditionHTTP503,
+			},
+			Tries:   helpers.GetPointer[int32](2),
+			Timeout: helpers.GetPointer[nkgAPI.Duration]("10s"),
+		},
+	}
+
+	// the route-specific settings make a separate upstream for foo
+	hr5Refs0 := createBackendRefs("foo")
+	hr5Refs0[0].UpstreamSettings = &graph.UpstreamSettings{
+		LoadBalancingMethod: helpers.GetPointer(nkgAPI.LoadBalancingMethodHash),
+		HashMethodKey:       "$request_uri",
+		RouteNsName:         &types.NamespacedName{Namespace: "test", Name: "hr5"},
+		KeepAlive: &nkgAPI.UpstreamKeepAlive{
+			Connections: 16,
+			Requests:    helpers.GetPointer[int32](100),
+			Timeout:     helpers.GetPointer[nkgAPI.Duration]("30s"),
+		},
+		MaxConnections: helpers.GetPointer[int32](10),
+	}
+
+	invalidRefs := createBackendRefs("invalid")
+
+	// the external authorization service of the filter gets a separate upstream
+	hr3Rules := refsToValidRules(hr3Refs0)
+	hr3Rules[0].Authentication = &graph.AuthenticationFilter{
+		Source: &nkgAPI.AuthenticationFilter{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "ext-auth"},
+			Spec: nkgAPI.AuthenticationFilterSpec{
+				Type: nkgAPI.AuthenticationTypeExternal,
+				External: &nkgAPI.ExternalAuthentication{
+					BackendRef: nkgAPI.ServiceReference{Name: "auth", Port: 9000},
+				},
+			},
+		},
+		Service: &apiv1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "auth"}},
+		Valid:   true,
+	}
+
+	routes := map[types.NamespacedName]*graph.Route{
+		{Name: "hr1", Namespace: "test"}: {
+			Rules: refsToValidRules(hr1Refs0, hr1Refs1),
+		},
+		{Name: "hr2", Namespace: "test"}: {
+			Rules: refsToValidRules(hr2Refs0, hr2Refs1),
+		},
+		{Name: "hr3", Namespace: "test"}: {
+			Rules: hr3Rules,
+		},
+	}
+
+	routes2 := map[types.NamespacedName]*graph.Route{
+		{Name: "hr4", Namespace: "test"}: {
+			Rules: refsToValidRules(hr4Refs0, hr4Refs1),
+		},
+		{Name: "hr5", Namespace: "test"}: {
+			Rules: refsToValidRules(hr5Refs0),
+		},
+	}
+
+	invalidRoutes := map[types.NamespacedName]*graph.Route{
+		{Name: "invalid", Namespace: "test"}: {
+			Rules: refsToValidRules(invalidRefs),
+		},
+	}
+
+	listeners := map[string]*graph.Listener{
+		"invalid-listener": {
+			Valid:  false,
+			Routes: invalidRoutes, // shouldn't be included since listener is invalid
+		},
+		"listener-1": {
+			Valid:  true,
+			Routes: routes,
+		},
+		"listener-2": {
+			Valid:  true,
+			Routes: routes2,
+		},
+	}
+
+	emptyEndpointsErrMsg := "empty endpoints error"
+	nilEndpointsErrMsg := "nil endpoints error"
+
+	expUpstreams := []Upstream{
+		{
+			Name:      "ext_auth_test_ext-auth",
+			Endpoints: authEndpoints,
+		},
+		{
+			Name:      "test_bar_80",
+			Endpoints: barEndpoints,
+		},
+		{
+			Name:        "test_baz2_80",
+			Endpoints:   baz2Endpoints,
+			MaxFails:    helpers.GetPointer[int32](3),
+			FailTimeout: "30s",
+			NextUpstream: &NextUpstream{
+				Conditions: []NextUpstreamCondition{
+					NextUpstreamConditionError,
+					NextUpstreamConditionHTTP503,
+				},
+				Tries:   2,
+				Timeout: "10s",
+			},
+		},
+		{
+			Name:      "test_baz_80",
+			Endpoints: bazEndpoints,
+		},
+		{
+			Name:      "test_empty-endpoints_80",
+			Endpoints: []resolver.Endpoint{},
+			ErrorMsg:  emptyEndpointsErrMsg,
+		},
+		{
+			Name:      "test_foo_80",
+			Endpoints: fooEndpoints,
+		},
+		{
+			Name:                "test_foo_80_test_hr5",
+			Endpoints:           fooEndpoints,
+			LoadBalancingMethod: LoadBalancingMethodHash,
+			HashMethodKey:       "$request_uri",
+			KeepAlive: &UpstreamKeepAlive{
+				Connections: 16,
+				Requests:    100,
+				Timeout:     "30s",
+			},
+			MaxConnections: 10,
+		},
+		{
+			Name:      "test_nil-endpoints_80",
+			Endpoints: nil,
+			ErrorMsg:  nilEndpointsErrMsg,
+		},
+	}
+
+	fakeResolver := &resolverfakes.FakeServiceResolver{}
+	fakeResolver.ResolveCalls(func(ctx context.Context, svc *apiv1.Service, port int32) ([]resolver.Endpoint, error) {
+		switch svc.Name {
+		case "auth":
+			if port != 9000 {
+				return nil, fmt.Errorf("unexpected port %d", port)
+			}
+			return authEndpoints, nil
+		case "bar":
+			return barEndpoints, nil
+		case "baz":
+			return bazEndpoints, nil
+		case "baz2":
+			return baz2Endpoints, nil
+		case "empty-endpoints":
+			return []resolver.Endpoint{}, errors.New(emptyEndpointsErrMsg)
+		case "foo":
+			return fooEndpoints, nil
+		case "nil-endpoints":
+			return nil, errors.New(nilEndpointsErrMsg)
+		default:
+			return nil, fmt.Errorf("unexpected service %s", svc.Name)
+		}
+	})
+
+	g := NewGomegaWithT(t)
+
+	upstreams := buildUpstreams(context.TODO(), listeners, fakeResolver)
+	g.Expect(upstreams).To(ConsistOf(expUpstreams))
+}
+
+func TestConvertLoadBalancingMethod(t *testing.T) {
+	tests := []struct {
+		method   nkgAPI.LoadBalancingMethod
+		expected LoadBalancingMethod
+	}{
+		{
+			method:   nkgAPI.LoadBalancingMethodRandomTwoLeastConn,
+			expected: LoadBalancingMethodRandomTwoLeastConn,
+		},
+		{
+			method:   nkgAPI.LoadBalancingMethodRoundRobin,
+			expected: LoadBalancingMethodRoundRobin,
+		},
+		{
+			method:   nkgAPI.LoadBalancingMethodLeastConn,
+			expected: LoadBalancingMethodLeastConn,
+		},
+		{
+			method:   nkgAPI.LoadBalancingMethodIPHash,
+			expected: LoadBalancingMethodIPHash,
+		},
+		{
+			method:   nkgAPI.LoadBalancingMethodHash,
+			expected: LoadBalancingMethodHash,
+		},
+		{
+			method:   nkgAPI.LoadBalancingMethodHashConsistent,
+			expected: LoadBalancingMethodHashConsistent,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(string(tc.method), func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(convertLoadBalancingMethod(tc.method)).To(Equal(tc.expected))
+		})
+	}
+
+	g := NewGomegaWithT(t)
+	g.Expect(func() { convertLoadBalancingMethod("unknown") }).To(Panic())
+}
+
+func TestConvertRetry(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(convertRetry(nil)).To(BeNil())
+
+	retry := &nkgAPI.RetryPolicySpec{
+		Conditions: []nkgAPI.NextUpstreamCondition{
+			nkgAPI.NextUpstreamConditionTimeout,
+			nkgAPI.NextUpstreamConditionHTTP504,
+		},
+		Attempts: helpers.GetPointer[int32](3),
+		Timeout:  helpers.GetPointer[nkgAPI.Duration]("10s"),
+	}
+
+	expected := &NextUpstream{
+		Conditions: []NextUpstreamCondition{
+			NextUpstreamConditionTimeout,
+			NextUpstreamConditionHTTP504,
+		},
+		Tries:   3,
+		Timeout: "10s",
+	}
+
+	g.Expect(convertRetry(retry)).To(Equal(expected))
+}
+
+func TestConvertTimeouts(t *testing.T) {
+	tests := []struct {
+		timeouts *nkgAPI.TimeoutPolicySpec
+		expected *Timeouts
+		msg      string
+	}{
+		{
+			timeouts: nil,
+			expected: nil,
+			msg:      "no timeouts",
 		},
 		{
-			name:    "second match in first rule",
-			expPath: "/path-2",
-			rule:    MatchRule{MatchIdx: 1, RuleIdx: 0, Source: hr},
+			timeouts: &nkgAPI.TimeoutPolicySpec{
+				BackendRequest: "10s",
+			},
+			expected: &Timeouts{BackendRequest: "10s"},
+			msg:      "backend request",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(convertTimeouts(test.timeouts)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertCORS(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(convertCORS(nil)).To(BeNil())
+
+	cors := &nkgAPI.CORSPolicySpec{
+		AllowOrigins:     []string{"https://example.com", "https://*.example.com"},
+		AllowMethods:     []nkgAPI.CORSMethod{"GET", "PUT"},
+		AllowHeaders:     []string{"Content-Type"},
+		ExposeHeaders:    []string{"X-Request-ID"},
+		AllowCredentials: helpers.GetPointer(true),
+		MaxAge:           helpers.GetPointer[int32](600),
+	}
+
+	expected := &CORS{
+		AllowOrigins:     []string{"https://example.com", "https://*.example.com"},
+		AllowMethods:     []string{"GET", "PUT"},
+		AllowHeaders:     []string{"Content-Type"},
+		ExposeHeaders:    []string{"X-Request-ID"},
+		AllowCredentials: true,
+		MaxAge:           helpers.GetPointer[int32](600),
+	}
+
+	g.Expect(convertCORS(cors)).To(Equal(expected))
+
+	g.Expect(convertCORS(&nkgAPI.CORSPolicySpec{AllowOrigins: []string{"*"}})).To(Equal(&CORS{
+		AllowOrigins: []string{"*"},
+	}))
+}
+
+func TestConvertRateLimit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(convertRateLimit(nil)).To(BeNil())
+
+	policy := &graph.RateLimitPolicy{
+		Source: &nkgAPI.RateLimitPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "policy",
+			},
+			Spec: nkgAPI.RateLimitPolicySpec{
+				Key: nkgAPI.RateLimitKey{
+					Type: nkgAPI.RateLimitKeyTypeHeader,
+					Name: helpers.GetStringPointer("X-User-ID"),
+				},
+				Rate:   "100r/m",
+				Burst:  helpers.GetPointer[int32](10),
+				DryRun: helpers.GetPointer(true),
+			},
+		},
+		Valid: true,
+	}
+
+	expected := &RateLimit{
+		Zone: "ratelimit_test_policy",
+		Key: RateLimitKey{
+			Type: RateLimitKeyTypeHeader,
+			Name: "X-User-ID",
 		},
+		Burst:  10,
+		DryRun: true,
+	}
+
+	g.Expect(convertRateLimit(policy)).To(Equal(expected))
+}
+
+func TestConvertRateLimitKey(t *testing.T) {
+	tests := []struct {
+		msg      string
+		key      nkgAPI.RateLimitKey
+		expected RateLimitKey
+	}{
 		{
-			name:    "second match in second rule",
-			expPath: "/path-4",
-			rule:    MatchRule{MatchIdx: 1, RuleIdx: 1, Source: hr},
+			msg:      "client IP",
+			key:      nkgAPI.RateLimitKey{Type: nkgAPI.RateLimitKeyTypeClientIP},
+			expected: RateLimitKey{Type: RateLimitKeyTypeClientIP},
+		},
+		{
+			msg: "header",
+			key: nkgAPI.RateLimitKey{
+				Type: nkgAPI.RateLimitKeyTypeHeader,
+				Name: helpers.GetStringPointer("X-User"),
+			},
+			expected: RateLimitKey{Type: RateLimitKeyTypeHeader, Name: "X-User"},
 		},
 	}
 
-	for _, tc := range tests {
-		actual := tc.rule.GetMatch()
-		if *actual.Path.Value != tc.expPath {
-			t.Errorf(
-				"MatchRule.GetMatch() returned incorrect match with path: %s, expected path: %s for test case: %q",
-				*actual.Path.Value,
-				tc.expPath,
-				tc.name,
-			)
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(convertRateLimitKey(test.key)).To(Equal(test.expected))
+		})
+	}
+
+	g := NewGomegaWithT(t)
+	g.Expect(func() {
+		convertRateLimitKey(nkgAPI.RateLimitKey{Type: "unsupported"})
+	}).To(Panic())
+}
+
+func TestConvertAccessControl(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(convertAccessControl(nil)).To(BeNil())
+
+	policy := &graph.AccessControlPolicy{
+		Source: &nkgAPI.AccessControlPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "policy",
+			},
+			Spec: nkgAPI.AccessControlPolicySpec{
+				Allow: []string{"10.0.0.0/8"},
+				Deny:  []string{"10.0.1.0/24", "10.0.2.1"},
+			},
+		},
+		Valid: true,
+	}
+
+	expected := &AccessControl{
+		Allow: []string{"10.0.0.0/8"},
+		Deny:  []string{"10.0.1.0/24", "10.0.2.1"},
+	}
+
+	g.Expect(convertAccessControl(policy)).To(Equal(expected))
+}
+
+func TestBuildCacheZones(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	createPolicy := func(valid bool, zones ...nkgAPI.CacheZone) *graph.CachePolicy {
+		return &graph.CachePolicy{
+			Source: &nkgAPI.CachePolicy{
+				Spec: nkgAPI.CachePolicySpec{
+					Zones: zones,
+				},
+			},
+			Valid: valid,
 		}
 	}
+
+	policies := map[types.NamespacedName]*graph.CachePolicy{
+		{Namespace: "test", Name: "gateway"}: createPolicy(
+			true,
+			nkgAPI.CacheZone{Name: "static", KeysSize: "1m"},
+			nkgAPI.CacheZone{
+				Name:     "api",
+				KeysSize: "10m",
+				MaxSize:  helpers.GetPointer[nkgAPI.Size]("1g"),
+				Inactive: helpers.GetPointer[nkgAPI.Duration]("1h"),
+			},
+		),
+		{Namespace: "test", Name: "invalid"}: createPolicy(false, nkgAPI.CacheZone{Name: "invalid", KeysSize: "1m"}),
+		{Namespace: "test", Name: "route"}:   createPolicy(true),
+	}
+
+	expected := []CacheZone{
+		{
+			Name:     "cache_api",
+			KeysSize: "10m",
+			MaxSize:  "1g",
+			Inactive: "1h",
+		},
+		{
+			Name:     "cache_static",
+			KeysSize: "1m",
+		},
+	}
+
+	g.Expect(buildCacheZones(policies)).To(Equal(expected))
+	g.Expect(buildCacheZones(nil)).To(BeNil())
 }
 
-func TestGetListenerHostname(t *testing.T) {
-	var emptyHostname v1beta1.Hostname
-	var hostname v1beta1.Hostname = "example.com"
+func TestConvertCache(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(convertCache(nil)).To(BeNil())
+
+	cache := &nkgAPI.CacheSettings{
+		Zone: "api",
+		Key:  helpers.GetStringPointer("$host$request_uri"),
+		Valid: []nkgAPI.CacheValid{
+			{Codes: []nkgAPI.CacheStatusCode{200, 301}, Duration: "10m"},
+			{Duration: "1m"},
+		},
+		Bypass: []nkgAPI.CacheBypassCondition{
+			{Type: nkgAPI.CacheBypassConditionTypeHeader, Name: "X-No-Cache"},
+			{Type: nkgAPI.CacheBypassConditionTypeCookie, Name: "nocache"},
+			{Type: nkgAPI.CacheBypassConditionTypeQueryParam, Name: "nocache"},
+		},
+		StaleWhileRevalidate: helpers.GetPointer(true),
+		StaleIfError:         helpers.GetPointer(true),
+	}
+
+	expected := &Cache{
+		Zone: "cache_api",
+		Key:  "$host$request_uri",
+		Valid: []CacheValid{
+			{Codes: []int32{200, 301}, Duration: "10m"},
+			{Duration: "1m"},
+		},
+		Bypass: []CacheBypass{
+			{Type: CacheBypassTypeHeader, Name: "X-No-Cache"},
+			{Type: CacheBypassTypeCookie, Name: "nocache"},
+			{Type: CacheBypassTypeQueryParam, Name: "nocache"},
+		},
+		StaleWhileRevalidate: true,
+		StaleIfError:         true,
+	}
+
+	g.Expect(convertCache(cache)).To(Equal(expected))
+
+	g.Expect(convertCache(&nkgAPI.CacheSettings{Zone: "api"})).To(Equal(&Cache{Zone: "cache_api"}))
+}
 
+func TestConvertClientSettings(t *testing.T) {
 	tests := []struct {
-		hostname *v1beta1.Hostname
-		expected string
+		spec     *nkgAPI.ClientSettingsPolicySpec
+		expected *ClientSettings
 		msg      string
 	}{
 		{
-			hostname: nil,
-			expected: wildcardHostname,
-			msg:      "nil hostname",
+			spec:     nil,
+			expected: nil,
+			msg:      "no spec",
 		},
 		{
-			hostname: &emptyHostname,
-			expected: wildcardHostname,
-			msg:      "empty hostname",
+			spec:     &nkgAPI.ClientSettingsPolicySpec{},
+			expected: &ClientSettings{},
+			msg:      "empty spec",
 		},
 		{
-			hostname: &hostname,
-			expected: string(hostname),
-			msg:      "normal hostname",
+			spec: &nkgAPI.ClientSettingsPolicySpec{
+				Body: &nkgAPI.ClientBody{
+					MaxSize: helpers.GetPointer[nkgAPI.Size]("10m"),
+					Timeout: helpers.GetPointer[nkgAPI.Duration]("30s"),
+				},
+				Header: &nkgAPI.ClientHeader{
+					Timeout: helpers.GetPointer[nkgAPI.Duration]("10s"),
+				},
+				KeepAlive: &nkgAPI.ClientKeepAlive{
+					Requests: helpers.GetPointer[int32](100),
+					Time:     helpers.GetPointer[nkgAPI.Duration]("1h"),
+					Timeout: &nkgAPI.ClientKeepAliveTimeout{
+						Server: "75s",
+						Header: helpers.GetPointer[nkgAPI.Duration]("60s"),
+					},
+				},
+			},
+			expected: &ClientSettings{
+				BodyMaxSize:            "10m",
+				BodyTimeout:            "30s",
+				HeaderTimeout:          "10s",
+				KeepAliveTime:          "1h",
+				KeepAliveTimeout:       "75s",
+				KeepAliveHeaderTimeout: "60s",
+				KeepAliveRequests:      100,
+			},
+			msg: "all fields",
+		},
+		{
+			spec: &nkgAPI.ClientSettingsPolicySpec{
+				KeepAlive: &nkgAPI.ClientKeepAlive{
+					Timeout: &nkgAPI.ClientKeepAliveTimeout{
+						Server: "0",
+					},
+				},
+			},
+			expected: &ClientSettings{
+				KeepAliveTimeout: "0",
+			},
+			msg: "keep-alive disabled",
 		},
 	}
 
 	for _, test := range tests {
-		result := getListenerHostname(test.hostname)
-		if result != test.expected {
-			t.Errorf(
-				"getListenerHostname() returned %q but expected %q for the case of %q",
-				result,
-				test.expected,
-				test.msg,
-			)
-		}
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(convertClientSettings(test.spec)).To(Equal(test.expected))
+		})
 	}
 }
 
-func refsToValidRules(refs ...[]graph.BackendRef) []graph.Rule {
-	rules := make([]graph.Rule, 0, len(refs))
+func TestConvertCompression(t *testing.T) {
+	tests := []struct {
+		gzip     *nkgAPI.Gzip
+		expected *Compression
+		msg      string
+	}{
+		{
+			gzip:     nil,
+			expected: nil,
+			msg:      "no gzip",
+		},
+		{
+			gzip:     &nkgAPI.Gzip{},
+			expected: &Compression{},
+			msg:      "disabled",
+		},
+		{
+			gzip: &nkgAPI.Gzip{
+				Enable:    true,
+				Types:     []nkgAPI.MIMEType{"application/json", "text/css"},
+				MinLength: helpers.GetPointer[int32](0),
+				Level:     helpers.GetPointer[int32](5),
+				Vary:      helpers.GetPointer(true),
+			},
+			expected: &Compression{
+				Enable:    true,
+				Types:     []string{"application/json", "text/css"},
+				MinLength: helpers.GetPointer[int32](0),
+				Level:     5,
+				Vary:      helpers.GetPointer(true),
+			},
+			msg: "all fields",
+		},
+	}
 
-	for _, ref := range refs {
-		rules = append(rules, graph.Rule{
-			ValidMatches: true,
-			ValidFilters: true,
-			BackendRefs:  ref,
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(convertCompression(test.gzip)).To(Equal(test.expected))
 		})
 	}
+}
+
+func TestAddCompressionToServers(t *testing.T) {
+	servers := []VirtualServer{
+		{IsDefault: true, Port: 80},
+		{Hostname: "foo.example.com", Port: 80},
+	}
+
+	g := NewGomegaWithT(t)
+
+	addCompressionToServers(servers, nil)
+	g.Expect(servers[0].Compression).To(BeNil())
+	g.Expect(servers[1].Compression).To(BeNil())
+
+	addCompressionToServers(servers, &nkgAPI.Gzip{Enable: true})
+	g.Expect(servers[0].Compression).To(BeNil())
+	g.Expect(servers[1].Compression).To(Equal(&Compression{Enable: true}))
+}
+
+func TestConvertErrorPages(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	g.Expect(convertErrorPages(nil)).To(BeNil())
+
+	pages := &graph.ErrorPages{
+		Policy: types.NamespacedName{Namespace: "test", Name: "pages"},
+		Pages: []nkgAPI.ErrorPage{
+			{
+				Codes: []nkgAPI.ErrorStatusCode{404},
+				Response: &nkgAPI.ErrorPageResponse{
+					ContentType: helpers.GetStringPointer("application/json"),
+					StatusCode:  helpers.GetPointer[int32](200),
+				},
+			},
+			{
+				Codes:    []nkgAPI.ErrorStatusCode{500, 502},
+				Response: &nkgAPI.ErrorPageResponse{},
+			},
+			{
+				Codes: []nkgAPI.ErrorStatusCode{503},
+				Redirect: &nkgAPI.ErrorPageRedirect{
+					URL:        "https://status.example.com",
+					StatusCode: helpers.GetPointer[int32](307),
+				},
+			},
+		},
+	}
+
+	expected := []ErrorPage{
+		{
+			Codes: []int32{404},
+			Response: &ErrorPageResponse{
+				FileID:      "error_page_test_pages_0",
+				ContentType: "application/json",
+				StatusCode:  200,
+			},
+		},
+		{
+			Codes: []int32{500, 502},
+			Response: &ErrorPageResponse{
+				FileID: "error_page_test_pages_1",
+			},
+		},
+		{
+			Codes: []int32{503},
+			Redirect: &ErrorPageRedirect{
+				URL:        "https://status.example.com",
+				StatusCode: 307,
+			},
+		},
+	}
+
+	g.Expect(convertErrorPages(pages)).To(Equal(expected))
+}
+
+func TestAddErrorPagesToServers(t *testing.T) {
+	servers := []VirtualServer{
+		{IsDefault: true, Port: 80},
+		{Hostname: "foo.example.com", Port: 80},
+	}
+
+	pages := &graph.ErrorPages{
+		Policy: types.NamespacedName{Namespace: "test", Name: "pages"},
+		Pages: []nkgAPI.ErrorPage{
+			{
+				Codes:    []nkgAPI.ErrorStatusCode{404},
+				Redirect: &nkgAPI.ErrorPageRedirect{URL: "https://example.com"},
+			},
+		},
+	}
+
+	expected := []ErrorPage{
+		{
+			Codes:    []int32{404},
+			Redirect: &ErrorPageRedirect{URL: "https://example.com"},
+		},
+	}
+
+	g := NewGomegaWithT(t)
+
+	addErrorPagesToServers(servers, nil)
+	g.Expect(servers[0].ErrorPages).To(BeNil())
+	g.Expect(servers[1].ErrorPages).To(BeNil())
+
+	addErrorPagesToServers(servers, pages)
+	g.Expect(servers[0].ErrorPages).To(Equal(expected))
+	g.Expect(servers[1].ErrorPages).To(Equal(expected))
+}
+
+func TestBuildErrorPageFiles(t *testing.T) {
+	newPages := func(name string, bodies map[int][]byte) *graph.ErrorPages {
+		return &graph.ErrorPages{
+			Policy: types.NamespacedName{Namespace: "test", Name: name},
+			Bodies: bodies,
+		}
+	}
+
+	gw := &graph.Gateway{
+		ErrorPages: newPages("gateway", map[int][]byte{0: []byte("gateway-0"), 2: []byte("gateway-2")}),
+		Listeners: map[string]*graph.Listener{
+			"valid": {
+				Valid: true,
+				Routes: map[types.NamespacedName]*graph.Route{
+					{Namespace: "test", Name: "hr1"}: {
+						ErrorPages: newPages("route", map[int][]byte{0: []byte("route-0")}),
+					},
+					{Namespace: "test", Name: "hr2"}: {},
+				},
+			},
+			"invalid": {
+				Routes: map[types.NamespacedName]*graph.Route{
+					{Namespace: "test", Name: "hr3"}: {
+						ErrorPages: newPages("invalid", map[int][]byte{0: []byte("invalid-0")}),
+					},
+				},
+			},
+		},
+	}
+
+	expected := map[ErrorPageFileID][]byte{
+		"error_page_test_gateway_0": []byte("gateway-0"),
+		"error_page_test_gateway_2": []byte("gateway-2"),
+		"error_page_test_route_0":   []byte("route-0"),
+	}
+
+	g := NewGomegaWithT(t)
+
+	g.Expect(buildErrorPageFiles(gw)).To(Equal(expected))
+	g.Expect(buildErrorPageFiles(&graph.Gateway{})).To(BeNil())
+}
+
+func TestConvertTracing(t *testing.T) {
+	tests := []struct {
+		tracing  *nkgAPI.Tracing
+		expected *Tracing
+		msg      string
+	}{
+		{
+			tracing:  nil,
+			expected: nil,
+			msg:      "no tracing",
+		},
+		{
+			tracing: &nkgAPI.Tracing{
+				Ratio: helpers.GetPointer[int32](50),
+			},
+			expected: nil,
+			msg:      "no exporter",
+		},
+		{
+			tracing: &nkgAPI.Tracing{
+				Exporter: &nkgAPI.TracingExporter{
+					Endpoint: "otel-collector:4317",
+				},
+			},
+			expected: &Tracing{
+				Endpoint: "otel-collector:4317",
+				Context:  TraceContextPropagate,
+				Ratio:    100,
+			},
+			msg: "defaults",
+		},
+		{
+			tracing: &nkgAPI.Tracing{
+				Exporter: &nkgAPI.TracingExporter{
+					Endpoint:   "otel-collector:4317",
+					Interval:   helpers.GetPointer[nkgAPI.Duration]("10s"),
+					BatchSize:  helpers.GetPointer[int32](256),
+					BatchCount: helpers.GetPointer[int32](2),
+				},
+				ServiceName: helpers.GetStringPointer("my-gateway"),
+				Ratio:       helpers.GetPointer[int32](0),
+				Context:     helpers.GetPointer(nkgAPI.TraceContextIgnore),
+			},
+			expected: &Tracing{
+				Endpoint:    "otel-collector:4317",
+				Interval:    "10s",
+				ServiceName: "my-gateway",
+				Context:     TraceContextIgnore,
+				BatchSize:   256,
+				BatchCount:  2,
+				Ratio:       0,
+			},
+			msg: "all fields",
+		},
+	}
 
-	return rules
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(convertTracing(test.tracing)).To(Equal(test.expected))
+		})
+	}
 }
 
-func TestBuildUpstreams(t *testing.T) {
-	fooEndpoints := []resolver.Endpoint{
+func TestConvertTraceContext(t *testing.T) {
+	tests := []struct {
+		context  nkgAPI.TraceContext
+		expected TraceContext
+	}{
 		{
-			Address: "10.0.0.0",
-			Port:    8080,
+			context:  nkgAPI.TraceContextExtract,
+			expected: TraceContextExtract,
 		},
 		{
-			Address: "10.0.0.1",
-			Port:    8080,
+			context:  nkgAPI.TraceContextInject,
+			expected: TraceContextInject,
 		},
 		{
-			Address: "10.0.0.2",
-			Port:    8080,
+			context:  nkgAPI.TraceContextPropagate,
+			expected: TraceContextPropagate,
 		},
-	}
-
-	barEndpoints := []resolver.Endpoint{
 		{
-			Address: "11.0.0.0",
-			Port:    80,
+			context:  nkgAPI.TraceContextIgnore,
+			expected: TraceContextIgnore,
 		},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.context), func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(convertTraceContext(test.context)).To(Equal(test.expected))
+		})
+	}
+
+	g := NewGomegaWithT(t)
+	g.Expect(func() { convertTraceContext("unsupported") }).To(Panic())
+}
+
+func TestConvertAccessLog(t *testing.T) {
+	tests := []struct {
+		accessLog *nkgAPI.AccessLog
+		expected  *AccessLog
+		msg       string
+	}{
 		{
-			Address: "11.0.0.1",
-			Port:    80,
+			accessLog: nil,
+			expected:  nil,
+			msg:       "no access log",
 		},
 		{
-			Address: "11.0.0.2",
-			Port:    80,
+			accessLog: &nkgAPI.AccessLog{},
+			expected: &AccessLog{
+				Format: AccessLogFormatJSON,
+			},
+			msg: "defaults",
 		},
 		{
-			Address: "11.0.0.3",
-			Port:    80,
+			accessLog: &nkgAPI.AccessLog{
+				Enabled: helpers.GetPointer(false),
+			},
+			expected: &AccessLog{
+				Format:   AccessLogFormatJSON,
+				Disabled: true,
+			},
+			msg: "disabled",
 		},
-	}
-
-	bazEndpoints := []resolver.Endpoint{
 		{
-			Address: "12.0.0.0",
-			Port:    80,
+			accessLog: &nkgAPI.AccessLog{
+				Enabled: helpers.GetPointer(true),
+				Format:  helpers.GetPointer(nkgAPI.AccessLogFormatText),
+				Destination: &nkgAPI.AccessLogDestination{
+					Type: nkgAPI.AccessLogDestinationStdout,
+				},
+			},
+			expected: &AccessLog{
+				Format: AccessLogFormatText,
+			},
+			msg: "stdout",
 		},
-	}
-
-	baz2Endpoints := []resolver.Endpoint{
 		{
-			Address: "13.0.0.0",
-			Port:    80,
+			accessLog: &nkgAPI.AccessLog{
+				Destination: &nkgAPI.AccessLogDestination{
+					Type: nkgAPI.AccessLogDestinationSyslog,
+					Syslog: &nkgAPI.SyslogDestination{
+						Server: "syslog.logging.svc:514",
+						Tag:    helpers.GetPointer("gateway"),
+					},
+				},
+			},
+			expected: &AccessLog{
+				Syslog: &SyslogDestination{
+					Server: "syslog.logging.svc:514",
+					Tag:    "gateway",
+				},
+				Format: AccessLogFormatJSON,
+			},
+			msg: "syslog",
 		},
 	}
 
-	createBackendRefs := func(serviceNames ...string) []graph.BackendRef {
-		var backends []graph.BackendRef
-		for _, name := range serviceNames {
-			backends = append(backends, graph.BackendRef{
-				Svc:   &apiv1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name}},
-				Port:  80,
-				Valid: name != "",
-			})
-		}
-		return backends
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(convertAccessLog(test.accessLog)).To(Equal(test.expected))
+		})
 	}
 
-	hr1Refs0 := createBackendRefs("foo", "bar")
-
-	hr1Refs1 := createBackendRefs("baz", "", "") // empty service names should be ignored
-
-	hr2Refs0 := createBackendRefs("foo", "baz") // shouldn't duplicate foo and baz upstream
-
-	hr2Refs1 := createBackendRefs("nil-endpoints")
-
-	hr3Refs0 := createBackendRefs("baz") // shouldn't duplicate baz upstream
-
-	hr4Refs0 := createBackendRefs("empty-endpoints", "")
-
-	hr4Refs1 := createBackendRefs("baz2")
-
-	invalidRefs := createBackendRefs("invalid")
+	g := NewGomegaWithT(t)
+	g.Expect(func() { convertAccessLogFormat("unsupported") }).To(Panic())
+}
 
-	routes := map[types.NamespacedName]*graph.Route{
-		{Name: "hr1", Namespace: "test"}: {
-			Rules: refsToValidRules(hr1Refs0, hr1Refs1),
-		},
-		{Name: "hr2", Namespace: "test"}: {
-			Rules: refsToValidRules(hr2Refs0, hr2Refs1),
-		},
-		{Name: "hr3", Namespace: "test"}: {
-			Rules: refsToValidRules(hr3Refs0),
+func TestConvertNextUpstreamCondition(t *testing.T) {
+	tests := []struct {
+		cond     nkgAPI.NextUpstreamCondition
+		expected NextUpstreamCondition
+	}{
+		{
+			cond:     nkgAPI.NextUpstreamConditionError,
+			expected: NextUpstreamConditionError,
 		},
-	}
-
-	routes2 := map[types.NamespacedName]*graph.Route{
-		{Name: "hr4", Namespace: "test"}: {
-			Rules: refsToValidRules(hr4Refs0, hr4Refs1),
+		{
+			cond:     nkgAPI.NextUpstreamConditionTimeout,
+			expected: NextUpstreamConditionTimeout,
 		},
-	}
-
-	invalidRoutes := map[types.NamespacedName]*graph.Route{
-		{Name: "invalid", Namespace: "test"}: {
-			Rules: refsToValidRules(invalidRefs),
+		{
+			cond:     nkgAPI.NextUpstreamConditionInvalidHeader,
+			expected: NextUpstreamConditionInvalidHeader,
 		},
-	}
-
-	listeners := map[string]*graph.Listener{
-		"invalid-listener": {
-			Valid:  false,
-			Routes: invalidRoutes, // shouldn't be included since listener is invalid
+		{
+			cond:     nkgAPI.NextUpstreamConditionHTTP500,
+			expected: NextUpstreamConditionHTTP500,
 		},
-		"listener-1": {
-			Valid:  true,
-			Routes: routes,
+		{
+			cond:     nkgAPI.NextUpstreamConditionHTTP502,
+			expected: NextUpstreamConditionHTTP502,
 		},
-		"listener-2": {
-			Valid:  true,
-			Routes: routes2,
+		{
+			cond:     nkgAPI.NextUpstreamConditionHTTP503,
+			expected: NextUpstreamConditionHTTP503,
 		},
-	}
-
-	emptyEndpointsErrMsg := "empty endpoints error"
-	nilEndpointsErrMsg := "nil endpoints error"
-
-	expUpstreams := []Upstream{
 		{
-			Name:      "test_bar_80",
-			Endpoints: barEndpoints,
+			cond:     nkgAPI.NextUpstreamConditionHTTP504,
+			expected: NextUpstreamConditionHTTP504,
 		},
 		{
-			Name:      "test_baz2_80",
-			Endpoints: baz2Endpoints,
+			cond:     nkgAPI.NextUpstreamConditionHTTP403,
+			expected: NextUpstreamConditionHTTP403,
 		},
 		{
-			Name:      "test_baz_80",
-			Endpoints: bazEndpoints,
+			cond:     nkgAPI.NextUpstreamConditionHTTP404,
+			expected: NextUpstreamConditionHTTP404,
 		},
 		{
-			Name:      "test_empty-endpoints_80",
-			Endpoints: []resolver.Endpoint{},
-			ErrorMsg:  emptyEndpointsErrMsg,
+			cond:     nkgAPI.NextUpstreamConditionHTTP429,
+			expected: NextUpstreamConditionHTTP429,
 		},
 		{
-			Name:      "test_foo_80",
-			Endpoints: fooEndpoints,
+			cond:     nkgAPI.NextUpstreamConditionNonIdempotent,
+			expected: NextUpstreamConditionNonIdempotent,
 		},
 		{
-			Name:      "test_nil-endpoints_80",
-			Endpoints: nil,
-			ErrorMsg:  nilEndpointsErrMsg,
+			cond:     nkgAPI.NextUpstreamConditionOff,
+			expected: NextUpstreamConditionOff,
 		},
 	}
 
-	fakeResolver := &resolverfakes.FakeServiceResolver{}
-	fakeResolver.ResolveCalls(func(ctx context.Context, svc *apiv1.Service, port int32) ([]resolver.Endpoint, error) {
-		switch svc.Name {
-		case "bar":
-			return barEndpoints, nil
-		case "baz":
-			return bazEndpoints, nil
-		case "baz2":
-			return baz2Endpoints, nil
-		case "empty-endpoints":
-			return []resolver.Endpoint{}, errors.New(emptyEndpointsErrMsg)
-		case "foo":
-			return fooEndpoints, nil
-		case "nil-endpoints":
-			return nil, errors.New(nilEndpointsErrMsg)
-		default:
-			return nil, fmt.Errorf("unexpected service %s", svc.Name)
-		}
-	})
+	for _, tc := range tests {
+		t.Run(string(tc.cond), func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			g.Expect(convertNextUpstreamCondition(tc.cond)).To(Equal(tc.expected))
+		})
+	}
 
 	g := NewGomegaWithT(t)
-
-	upstreams := buildUpstreams(context.TODO(), listeners, fakeResolver)
-	g.Expect(upstreams).To(ConsistOf(expUpstreams))
+	g.Expect(func() { convertNextUpstreamCondition("unknown") }).To(Panic())
 }
 
 func TestBuildBackendGroups(t *testing.T) {
@@ -2183,3 +3545,199 @@ func TestConvertHTTPFilter(t *testing.T) {
 	result := convertHTTPFilter(httpFilter)
 	g.Expect(*result).To(Equal(expected))
 }
+
+func TestConvertAuthentication(t *testing.T) {
+	createFilter := func(spec nkgAPI.AuthenticationFilterSpec) *graph.AuthenticationFilter {
+		return &graph.AuthenticationFilter{
+			Source: &nkgAPI.AuthenticationFilter{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "auth",
+				},
+				Spec: spec,
+			},
+			Valid: true,
+		}
+	}
+
+	tests := []struct {
+		filter   *graph.AuthenticationFilter
+		expected *Authentication
+		msg      string
+	}{
+		{
+			filter:   nil,
+			expected: nil,
+			msg:      "nil filter",
+		},
+		{
+			filter: createFilter(nkgAPI.AuthenticationFilterSpec{
+				Type: nkgAPI.AuthenticationTypeBasic,
+				Basic: &nkgAPI.BasicAuthentication{
+					Realm: helpers.GetPointer("Dashboards"),
+				},
+			}),
+			expected: &Authentication{
+				Realm:  "Dashboards",
+				FileID: "auth_test_auth",
+			},
+			msg: "basic authentication",
+		},
+		{
+			filter: createFilter(nkgAPI.AuthenticationFilterSpec{
+				Type: nkgAPI.AuthenticationTypeJWT,
+				JWT:  &nkgAPI.JWTAuthentication{},
+			}),
+			expected: &Authentication{
+				JWT:    &JWTAuthentication{},
+				Realm:  "Restricted",
+				FileID: "auth_test_auth",
+			},
+			msg: "JWT with the default realm",
+		},
+		{
+			filter: createFilter(nkgAPI.AuthenticationFilterSpec{
+				Type: nkgAPI.AuthenticationTypeExternal,
+				External: &nkgAPI.ExternalAuthentication{
+					Path:            helpers.GetPointer("/auth"),
+					BackendRef:      nkgAPI.ServiceReference{Name: "auth", Port: 9000},
+					RequestHeaders:  []string{"Authorization"},
+					ResponseHeaders: []string{"X-User-Id"},
+				},
+			}),
+			expected: &Authentication{
+				External: &ExternalAuthentication{
+					UpstreamName:    "ext_auth_test_auth",
+					Path:            "/auth",
+					RequestHeaders:  []string{"Authorization"},
+					ResponseHeaders: []string{"X-User-Id"},
+				},
+			},
+			msg: "external authorization",
+		},
+		{
+			filter: createFilter(nkgAPI.AuthenticationFilterSpec{
+				Type: nkgAPI.AuthenticationTypeExternal,
+				External: &nkgAPI.ExternalAuthentication{
+					BackendRef: nkgAPI.ServiceReference{Name: "auth", Port: 9000},
+				},
+			}),
+			expected: &Authentication{
+				External: &ExternalAuthentication{
+					UpstreamName: "ext_auth_test_auth",
+					Path:         "/",
+				},
+			},
+			msg: "external authorization with the default path",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(convertAuthentication(test.filter)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertDirectResponse(t *testing.T) {
+	tests := []struct {
+		filter   *graph.DirectResponseFilter
+		expected *DirectResponse
+		msg      string
+	}{
+		{
+			filter:   nil,
+			expected: nil,
+			msg:      "no filter",
+		},
+		{
+			filter: &graph.DirectResponseFilter{
+				Source: &nkgAPI.DirectResponseFilter{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "maintenance"},
+					Spec: nkgAPI.DirectResponseFilterSpec{
+						StatusCode:  503,
+						ContentType: helpers.GetPointer("text/html"),
+						Headers: []nkgAPI.ResponseHeader{
+							{Name: "Retry-After", Value: "120"},
+						},
+					},
+				},
+				Body:  []byte("<p>Under maintenance</p>"),
+				Valid: true,
+			},
+			expected: &DirectResponse{
+				Body:        "<p>Under maintenance</p>",
+				ContentType: "text/html",
+				Headers: []HTTPHeader{
+					{Name: "Retry-After", Value: "120"},
+				},
+				StatusCode: 503,
+			},
+			msg: "all fields",
+		},
+		{
+			filter: &graph.DirectResponseFilter{
+				Source: &nkgAPI.DirectResponseFilter{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "health"},
+					Spec: nkgAPI.DirectResponseFilterSpec{
+						StatusCode: 200,
+					},
+				},
+				Valid: true,
+			},
+			expected: &DirectResponse{
+				ContentType: "text/plain",
+				StatusCode:  200,
+			},
+			msg: "default content type without body",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			g.Expect(convertDirectResponse(test.filter)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestGetSnippet(t *testing.T) {
+	policy := types.NamespacedName{Namespace: "test", Name: "snippets"}
+	snippets := &graph.Snippets{
+		Policy: policy,
+		Snippets: []nkgAPI.Snippet{
+			{Context: nkgAPI.SnippetContextHTTP, Value: "map_hash_bucket_size 128;"},
+			{Context: nkgAPI.SnippetContextServer, Value: "large_client_header_buffers 4 16k;"},
+		},
+	}
+
+	g := NewGomegaWithT(t)
+
+	g.Expect(getSnippet(snippets, nkgAPI.SnippetContextHTTP, nil)).To(Equal("map_hash_bucket_size 128;"))
+	g.Expect(getSnippet(snippets, nkgAPI.SnippetContextServer, nil)).To(Equal("large_client_header_buffers 4 16k;"))
+	g.Expect(getSnippet(snippets, nkgAPI.SnippetContextLocation, nil)).To(BeEmpty())
+	g.Expect(getSnippet(nil, nkgAPI.SnippetContextHTTP, nil)).To(BeEmpty())
+
+	excluded := map[types.NamespacedName]struct{}{policy: {}}
+	g.Expect(getSnippet(snippets, nkgAPI.SnippetContextHTTP, excluded)).To(BeEmpty())
+}
+
+func TestAddSnippetToServers(t *testing.T) {
+	servers := []VirtualServer{
+		{IsDefault: true, Port: 80},
+		{Hostname: "foo.example.com", Port: 80},
+	}
+
+	g := NewGomegaWithT(t)
+
+	addSnippetToServers(servers, "")
+	g.Expect(servers[0].Snippet).To(BeEmpty())
+	g.Expect(servers[1].Snippet).To(BeEmpty())
+
+	addSnippetToServers(servers, "large_client_header_buffers 4 16k;")
+	g.Expect(servers[0].Snippet).To(BeEmpty())
+	g.Expect(servers[1].Snippet).To(Equal("large_client_header_buffers 4 16k;"))
+}
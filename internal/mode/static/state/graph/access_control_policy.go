@@ -0,0 +1,196 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// AccessControlPolicy represents an AccessControlPolicy resource.
+type AccessControlPolicy = Policy[*nkgAPI.AccessControlPolicy]
+
+func processAccessControlPolicies(
+	policies map[types.NamespacedName]*nkgAPI.AccessControlPolicy,
+	validator validation.PolicyValidator,
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+) map[types.NamespacedName]*AccessControlPolicy {
+	targets := policyTargets{gw: gw, routes: routes}
+
+	return processPolicies(policies, policyKind[*nkgAPI.AccessControlPolicy, policyTarget]{
+		name:       nkgAPI.AccessControlPolicyKind,
+		sameTarget: "resource",
+		validate: func(policy *nkgAPI.AccessControlPolicy) error {
+			return validateAccessControlPolicy(validator, policy)
+		},
+		getTarget:  getAccessControlPolicyTarget,
+		findTarget: targets.find,
+	})
+}
+
+func getAccessControlPolicyTarget(policy *nkgAPI.AccessControlPolicy) policyTarget {
+	return getPolicyTarget(policy, policy.Spec.SectionName)
+}
+
+func validateAccessControlPolicy(
+	validator validation.PolicyValidator,
+	policy *nkgAPI.AccessControlPolicy,
+) error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicyTargetRef(policy, specPath.Child("targetRef"), gatewayGroupKind, httpRouteGroupKind)
+
+	if policy.Spec.SectionName != nil && policy.Spec.TargetRef.Kind != kindGateway {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("sectionName"), "can only be set for a Gateway"))
+	}
+
+	if len(policy.Spec.Allow) == 0 && len(policy.Spec.Deny) == 0 {
+		allErrs = append(allErrs, field.Required(specPath, "at least one of allow and deny must be set"))
+	}
+
+	allErrs = append(allErrs, validateAddresses(validator, policy.Spec.Allow, specPath.Child("allow"))...)
+	allErrs = append(allErrs, validateAddresses(validator, policy.Spec.Deny, specPath.Child("deny"))...)
+
+	return allErrs.ToAggregate()
+}
+
+func validateAddresses(
+	validator validation.PolicyValidator,
+	addresses []string,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, address := range addresses {
+		if err := validator.ValidateIPAddressOrCIDR(address); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Index(i), address, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// addAccessControlToListenersAndRoutes adds the AccessControlPolicies to the listeners of the Gateway and the routes.
+// A policy that targets a listener overrides a policy that targets the whole Gateway.
+// The policies that apply to the rules that respond before NGINX checks the access get the PartiallyApplied
+// condition.
+// The Gateway and the routes are modified in place.
+func addAccessControlToListenersAndRoutes(
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+	policies map[types.NamespacedName]*AccessControlPolicy,
+) {
+	attached := newAttachedPolicies(policies, getAccessControlPolicyTarget)
+	if len(attached) == 0 {
+		return
+	}
+
+	if gw != nil {
+		for name, l := range gw.Listeners {
+			l.AccessControl = attached.forListener(gw, name)
+		}
+	}
+
+	for routeNsName, r := range routes {
+		r.AccessControl = attached.forRoute(routeNsName)
+	}
+
+	reportRulesWithoutAccessControl(gw, routes)
+}
+
+// reportRulesWithoutAccessControl adds the PartiallyApplied condition to the AccessControlPolicies that apply to
+// the rules with a DirectResponseFilter or a RequestRedirect filter. NGINX responds to the requests of such rules in
+// the rewrite phase, before it checks the access in the access phase, so the policies have no effect on them.
+func reportRulesWithoutAccessControl(gw *Gateway, routes map[types.NamespacedName]*Route) {
+	rulesPerPolicy := make(map[*AccessControlPolicy][]string)
+
+	for routeNsName, r := range routes {
+		if !r.Valid {
+			continue
+		}
+
+		for ruleIdx := range r.Rules {
+			if !respondsBeforeAccessCheck(r, ruleIdx) {
+				continue
+			}
+
+			rule := fmt.Sprintf("rule %d of HTTPRoute %s", ruleIdx, routeNsName)
+
+			for _, p := range getAccessControlPoliciesForRoute(gw, r) {
+				rulesPerPolicy[p] = append(rulesPerPolicy[p], rule)
+			}
+		}
+	}
+
+	for p, rules := range rulesPerPolicy {
+		sort.Strings(rules)
+
+		msg := "The policy has no effect on the rules that respond directly or redirect, because NGINX responds " +
+			"before it checks the access: " + strings.Join(rules, ", ")
+		p.Conditions = append(p.Conditions, staticConds.NewPolicyPartiallyApplied(msg))
+	}
+}
+
+// respondsBeforeAccessCheck returns true if NGINX responds to the requests of the valid rule of the route with
+// a direct response or a redirect.
+func respondsBeforeAccessCheck(r *Route, ruleIdx int) bool {
+	rule := r.Rules[ruleIdx]
+	if !rule.ValidMatches || !rule.ValidFilters {
+		return false
+	}
+
+	if rule.DirectResponse != nil {
+		return true
+	}
+
+	for _, f := range r.Source.Spec.Rules[ruleIdx].Filters {
+		if f.Type == v1beta1.HTTPRouteFilterRequestRedirect {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getAccessControlPoliciesForRoute returns the AccessControlPolicies that apply to the route: either the policy
+// that targets the route or the policies of the listeners that the route is attached to.
+func getAccessControlPoliciesForRoute(gw *Gateway, r *Route) []*AccessControlPolicy {
+	if r.AccessControl != nil {
+		return []*AccessControlPolicy{r.AccessControl}
+	}
+
+	if gw == nil {
+		return nil
+	}
+
+	gwNsName := client.ObjectKeyFromObject(gw.Source)
+	policies := make(map[*AccessControlPolicy]struct{})
+
+	for _, ref := range r.ParentRefs {
+		if ref.Gateway != gwNsName || ref.Attachment == nil || !ref.Attachment.Attached {
+			continue
+		}
+
+		for listenerName := range ref.Attachment.AcceptedHostnames {
+			if l, exists := gw.Listeners[listenerName]; exists && l.AccessControl != nil {
+				policies[l.AccessControl] = struct{}{}
+			}
+		}
+	}
+
+	result := make([]*AccessControlPolicy, 0, len(policies))
+	for p := range policies {
+		result = append(result, p)
+	}
+
+	return result
+}
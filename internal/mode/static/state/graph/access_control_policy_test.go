@@ -0,0 +1,267 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+func createAccessControlPolicy(
+	name string,
+	kind v1alpha2.Kind,
+	targetName string,
+	sectionName *v1beta1.SectionName,
+) *nkgAPI.AccessControlPolicy {
+	return &nkgAPI.AccessControlPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: nkgAPI.AccessControlPolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kind,
+				Name:  v1alpha2.ObjectName(targetName),
+			},
+			SectionName: sectionName,
+			Allow:       []string{"10.0.0.0/8", "192.168.1.1"},
+			Deny:        []string{"10.0.1.0/24"},
+		},
+	}
+}
+
+func TestValidateAccessControlPolicy(t *testing.T) {
+	createPolicy := func(mutate func(spec *nkgAPI.AccessControlPolicySpec)) *nkgAPI.AccessControlPolicy {
+		p := createAccessControlPolicy("policy", kindHTTPRoute, "hr", nil)
+
+		if mutate != nil {
+			mutate(&p.Spec)
+		}
+
+		return p
+	}
+
+	tests := []struct {
+		policy         *nkgAPI.AccessControlPolicy
+		name           string
+		expectErrCount int
+		invalidAddress bool
+	}{
+		{
+			policy: createPolicy(nil),
+			name:   "valid",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.AccessControlPolicySpec) {
+				spec.TargetRef.Kind = kindGateway
+				spec.SectionName = helpers.GetPointer[v1beta1.SectionName]("listener")
+				spec.Allow = nil
+			}),
+			name: "valid listener policy with deny only",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.AccessControlPolicySpec) {
+				spec.TargetRef.Namespace = helpers.GetPointer[v1alpha2.Namespace]("other")
+			}),
+			expectErrCount: 1,
+			name:           "target in another namespace",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.AccessControlPolicySpec) {
+				spec.SectionName = helpers.GetPointer[v1beta1.SectionName]("listener")
+			}),
+			expectErrCount: 1,
+			name:           "section name for HTTPRoute",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.AccessControlPolicySpec) {
+				spec.Allow = nil
+				spec.Deny = nil
+			}),
+			expectErrCount: 1,
+			name:           "no addresses",
+		},
+		{
+			policy:         createPolicy(nil),
+			invalidAddress: true,
+			expectErrCount: 3,
+			name:           "invalid addresses",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{}
+			if test.invalidAddress {
+				validator.ValidateIPAddressOrCIDRReturns(errors.New("invalid address"))
+			}
+
+			err := validateAccessControlPolicy(validator, test.policy)
+
+			if test.expectErrCount == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.(interface{ Errors() []error }).Errors()).To(HaveLen(test.expectErrCount))
+			}
+		})
+	}
+}
+
+func TestAddAccessControlToListenersAndRoutes(t *testing.T) {
+	listenerName := helpers.GetPointer[v1beta1.SectionName]("listener-443")
+
+	gatewayPolicy := &AccessControlPolicy{
+		Source: createAccessControlPolicy("gateway", kindGateway, "gateway", nil),
+		Valid:  true,
+	}
+	listenerPolicy := &AccessControlPolicy{
+		Source: createAccessControlPolicy("listener", kindGateway, "gateway", listenerName),
+		Valid:  true,
+	}
+	routePolicy := &AccessControlPolicy{
+		Source: createAccessControlPolicy("route", kindHTTPRoute, "hr", nil),
+		Valid:  true,
+	}
+	invalidPolicy := &AccessControlPolicy{
+		Source: createAccessControlPolicy("invalid", kindHTTPRoute, "hr2", nil),
+	}
+
+	policies := map[types.NamespacedName]*AccessControlPolicy{
+		{Namespace: "test", Name: "gateway"}:  gatewayPolicy,
+		{Namespace: "test", Name: "listener"}: listenerPolicy,
+		{Namespace: "test", Name: "route"}:    routePolicy,
+		{Namespace: "test", Name: "invalid"}:  invalidPolicy,
+	}
+
+	gw := &Gateway{
+		Source: &v1beta1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+		},
+		Listeners: map[string]*Listener{
+			"listener-80":  {},
+			"listener-443": {},
+		},
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}:  {},
+		{Namespace: "test", Name: "hr2"}: {},
+	}
+
+	addAccessControlToListenersAndRoutes(gw, routes, policies)
+
+	g := NewWithT(t)
+
+	g.Expect(gw.Listeners["listener-80"].AccessControl).To(Equal(gatewayPolicy))
+	g.Expect(gw.Listeners["listener-443"].AccessControl).To(Equal(listenerPolicy))
+
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr"}].AccessControl).To(Equal(routePolicy))
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr2"}].AccessControl).To(BeNil())
+}
+
+func TestAddAccessControlReportsRulesWithoutAccessControl(t *testing.T) {
+	gatewayPolicy := &AccessControlPolicy{
+		Source: createAccessControlPolicy("gateway", kindGateway, "gateway", nil),
+		Valid:  true,
+	}
+	routePolicy := &AccessControlPolicy{
+		Source: createAccessControlPolicy("route", kindHTTPRoute, "hr", nil),
+		Valid:  true,
+	}
+
+	policies := map[types.NamespacedName]*AccessControlPolicy{
+		{Namespace: "test", Name: "gateway"}: gatewayPolicy,
+		{Namespace: "test", Name: "route"}:   routePolicy,
+	}
+
+	gw := &Gateway{
+		Source: &v1beta1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+		},
+		Listeners: map[string]*Listener{
+			"listener-80": {},
+		},
+	}
+
+	redirectFilter := v1beta1.HTTPRouteFilter{
+		Type:            v1beta1.HTTPRouteFilterRequestRedirect,
+		RequestRedirect: &v1beta1.HTTPRequestRedirectFilter{},
+	}
+
+	// The rule 0 of hr redirects, the rule 1 of hr proxies, the rule 0 of hr2 responds directly,
+	// and the rule 1 of hr2 responds directly but has invalid filters, so NGINX responds with 500 before anything.
+	hr := createHTTPRoute("hr", "gateway", "example.com", "/redirect", "/proxy")
+	addFilterToPath(hr, "/redirect", redirectFilter)
+	hr2 := createHTTPRoute("hr2", "gateway", "example.com", "/direct", "/invalid")
+
+	parentRefs := []ParentRef{
+		{
+			Gateway: types.NamespacedName{Namespace: "test", Name: "gateway"},
+			Attachment: &ParentRefAttachmentStatus{
+				AcceptedHostnames: map[string][]string{"listener-80": {"example.com"}},
+				Attached:          true,
+			},
+		},
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}: {
+			Source:     hr,
+			ParentRefs: parentRefs,
+			Rules: []Rule{
+				{ValidMatches: true, ValidFilters: true},
+				{ValidMatches: true, ValidFilters: true},
+			},
+			Valid: true,
+		},
+		{Namespace: "test", Name: "hr2"}: {
+			Source:     hr2,
+			ParentRefs: parentRefs,
+			Rules: []Rule{
+				{ValidMatches: true, ValidFilters: true, DirectResponse: &DirectResponseFilter{}},
+				{ValidMatches: true, ValidFilters: false, DirectResponse: &DirectResponseFilter{}},
+			},
+			Valid: true,
+		},
+	}
+
+	addAccessControlToListenersAndRoutes(gw, routes, policies)
+
+	g := NewWithT(t)
+
+	g.Expect(routePolicy.Valid).To(BeTrue())
+	g.Expect(routePolicy.Conditions).To(Equal([]conditions.Condition{
+		staticConds.NewPolicyPartiallyApplied(
+			"The policy has no effect on the rules that respond directly or redirect, because NGINX responds " +
+				"before it checks the access: rule 0 of HTTPRoute test/hr",
+		),
+	}))
+
+	g.Expect(gatewayPolicy.Valid).To(BeTrue())
+	g.Expect(gatewayPolicy.Conditions).To(Equal([]conditions.Condition{
+		staticConds.NewPolicyPartiallyApplied(
+			"The policy has no effect on the rules that respond directly or redirect, because NGINX responds " +
+				"before it checks the access: rule 0 of HTTPRoute test/hr2",
+		),
+	}))
+}
@@ -0,0 +1,311 @@
+package graph
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+const (
+	kindSecret    = "Secret"
+	kindConfigMap = "ConfigMap"
+)
+
+// AuthenticationFilter represents an AuthenticationFilter resource that the rules of HTTPRoutes reference.
+type AuthenticationFilter struct {
+	// Source is the source resource of the filter.
+	Source *nkgAPI.AuthenticationFilter
+	// Data is the htpasswd file for basic authentication or the JSON Web Key Set for JWT validation.
+	// It is nil if the filter is invalid or its type is External.
+	Data []byte
+	// Service is the Service of the external authorization service.
+	// It is nil if the filter is invalid or its type is not External.
+	Service *v1.Service
+	// Valid indicates whether the filter is valid and its Secret or ConfigMap is resolved.
+	Valid bool
+}
+
+// authenticationDataRef identifies the Secret or the ConfigMap with the data of an AuthenticationFilter or
+// the Service of its external authorization service.
+type authenticationDataRef struct {
+	nsname types.NamespacedName
+	kind   string
+}
+
+// getAuthenticationDataRef returns the reference to the Secret or the ConfigMap with the data of the filter or
+// to the Service of its external authorization service.
+func getAuthenticationDataRef(filter *nkgAPI.AuthenticationFilter) authenticationDataRef {
+	ref := authenticationDataRef{
+		nsname: types.NamespacedName{Namespace: filter.Namespace},
+		kind:   kindSecret,
+	}
+
+	// The CRD ensures that the field that corresponds to the type is set, as well as exactly one of the
+	// references of the JWKS.
+	switch {
+	case filter.Spec.Basic != nil:
+		ref.nsname.Name = filter.Spec.Basic.SecretRef.Name
+	case filter.Spec.JWT != nil && filter.Spec.JWT.JWKS.SecretRef != nil:
+		ref.nsname.Name = filter.Spec.JWT.JWKS.SecretRef.Name
+	case filter.Spec.JWT != nil && filter.Spec.JWT.JWKS.ConfigMapRef != nil:
+		ref.nsname.Name = filter.Spec.JWT.JWKS.ConfigMapRef.Name
+		ref.kind = kindConfigMap
+	case filter.Spec.External != nil:
+		ref.nsname.Name = filter.Spec.External.BackendRef.Name
+		ref.kind = kindService
+	}
+
+	return ref
+}
+
+// authenticationFilterResolver resolves the AuthenticationFilters, including their Secrets, ConfigMaps and Services.
+// All resolved filters are saved to be used later.
+type authenticationFilterResolver struct {
+	validator       validation.HTTPFieldsValidator
+	clusterFilters  map[types.NamespacedName]*nkgAPI.AuthenticationFilter
+	secrets         map[types.NamespacedName]*v1.Secret
+	configMaps      map[types.NamespacedName]*v1.ConfigMap
+	services        map[types.NamespacedName]*v1.Service
+	resolvedFilters map[types.NamespacedName]*AuthenticationFilter
+	errs            map[types.NamespacedName]error
+}
+
+func newAuthenticationFilterResolver(
+	filters map[types.NamespacedName]*nkgAPI.AuthenticationFilter,
+	secrets map[types.NamespacedName]*v1.Secret,
+	configMaps map[types.NamespacedName]*v1.ConfigMap,
+	services map[types.NamespacedName]*v1.Service,
+	validator validation.HTTPFieldsValidator,
+) *authenticationFilterResolver {
+	return &authenticationFilterResolver{
+		validator:       validator,
+		clusterFilters:  filters,
+		secrets:         secrets,
+		configMaps:      configMaps,
+		services:        services,
+		resolvedFilters: make(map[types.NamespacedName]*AuthenticationFilter),
+		errs:            make(map[types.NamespacedName]error),
+	}
+}
+
+// resolve returns the filter or an error if the filter doesn't exist or is invalid.
+func (r *authenticationFilterResolver) resolve(nsname types.NamespacedName) (*AuthenticationFilter, error) {
+	if err, resolved := r.errs[nsname]; resolved {
+		return r.resolvedFilters[nsname], err
+	}
+
+	source, exists := r.clusterFilters[nsname]
+	if !exists {
+		err := errors.New("AuthenticationFilter does not exist")
+		r.errs[nsname] = err
+		return nil, err
+	}
+
+	filter := &AuthenticationFilter{
+		Source: source,
+	}
+	r.resolvedFilters[nsname] = filter
+
+	err := r.validate(source)
+	if err == nil {
+		if source.Spec.Type == nkgAPI.AuthenticationTypeExternal {
+			filter.Service, err = r.getService(source)
+		} else {
+			filter.Data, err = r.getData(source)
+		}
+	}
+
+	r.errs[nsname] = err
+	filter.Valid = err == nil
+
+	if !filter.Valid {
+		filter.Data = nil
+		filter.Service = nil
+	}
+
+	return filter, err
+}
+
+func (r *authenticationFilterResolver) validate(filter *nkgAPI.AuthenticationFilter) error {
+	var realm *string
+
+	switch filter.Spec.Type {
+	case nkgAPI.AuthenticationTypeBasic:
+		realm = filter.Spec.Basic.Realm
+	case nkgAPI.AuthenticationTypeJWT:
+		realm = filter.Spec.JWT.Realm
+
+		for name := range filter.Spec.JWT.RequiredClaims {
+			if name == "" {
+				return errors.New("the name of a required claim must not be empty")
+			}
+		}
+	case nkgAPI.AuthenticationTypeExternal:
+		return r.validateExternal(filter.Spec.External)
+	default:
+		return fmt.Errorf("unsupported authentication type %q", filter.Spec.Type)
+	}
+
+	if realm != nil {
+		if err := r.validator.ValidateAuthenticationRealm(*realm); err != nil {
+			return fmt.Errorf("realm is invalid: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *authenticationFilterResolver) getData(filter *nkgAPI.AuthenticationFilter) ([]byte, error) {
+	ref := getAuthenticationDataRef(filter)
+
+	key := nkgAPI.JWKSKey
+	if filter.Spec.Type == nkgAPI.AuthenticationTypeBasic {
+		key = nkgAPI.HtpasswdSecretKey
+	}
+
+	var data []byte
+
+	switch ref.kind {
+	case kindSecret:
+		secret, exists := r.secrets[ref.nsname]
+		if !exists {
+			return nil, fmt.Errorf("secret %s does not exist", ref.nsname)
+		}
+		data = secret.Data[key]
+	case kindConfigMap:
+		configMap, exists := r.configMaps[ref.nsname]
+		if !exists {
+			return nil, fmt.Errorf("configmap %s does not exist", ref.nsname)
+		}
+		data = []byte(configMap.Data[key])
+	}
+
+	if len(data) == 0 {
+		return nil, fmt.Errorf("%s %s does not have the %s key", ref.kind, ref.nsname, key)
+	}
+
+	if filter.Spec.Type == nkgAPI.AuthenticationTypeJWT {
+		if err := validateJWKS(data); err != nil {
+			return nil, fmt.Errorf("the JSON Web Key Set in %s %s is invalid: %w", ref.kind, ref.nsname, err)
+		}
+	}
+
+	return data, nil
+}
+
+func (r *authenticationFilterResolver) validateExternal(external *nkgAPI.ExternalAuthentication) error {
+	if external.Path != nil {
+		if err := r.validator.ValidateExternalAuthPath(*external.Path); err != nil {
+			return fmt.Errorf("path is invalid: %w", err)
+		}
+	}
+
+	for _, name := range external.RequestHeaders {
+		if err := r.validator.ValidateExternalAuthHeaderName(name); err != nil {
+			return fmt.Errorf("request header %q is invalid: %w", name, err)
+		}
+	}
+
+	for _, name := range external.ResponseHeaders {
+		if err := r.validator.ValidateExternalAuthHeaderName(name); err != nil {
+			return fmt.Errorf("response header %q is invalid: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *authenticationFilterResolver) getService(filter *nkgAPI.AuthenticationFilter) (*v1.Service, error) {
+	ref := getAuthenticationDataRef(filter)
+
+	svc, exists := r.services[ref.nsname]
+	if !exists {
+		return nil, fmt.Errorf("service %s does not exist", ref.nsname)
+	}
+
+	return svc, nil
+}
+
+func validateJWKS(data []byte) error {
+	var jwks struct {
+		Keys []map[string]interface{} `json:"keys"`
+	}
+
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return err
+	}
+
+	if len(jwks.Keys) == 0 {
+		return errors.New("it must have at least one key")
+	}
+
+	return nil
+}
+
+func (r *authenticationFilterResolver) getResolvedFilters() map[types.NamespacedName]*AuthenticationFilter {
+	if len(r.resolvedFilters) == 0 {
+		return nil
+	}
+
+	return r.resolvedFilters
+}
+
+// addAuthenticationFiltersToRoutes resolves the AuthenticationFilters that the rules of the routes reference in
+// ExtensionRef filters. If a filter doesn't exist or is invalid, the filters of the rule become invalid, so that
+// the data plane responds with 500, and the route gets a condition.
+// The routes are modified in place.
+func addAuthenticationFiltersToRoutes(
+	routes map[types.NamespacedName]*Route,
+	resolver *authenticationFilterResolver,
+) {
+	for _, r := range routes {
+		if !r.Valid {
+			continue
+		}
+
+		for ruleIdx, rule := range r.Source.Spec.Rules {
+			if !r.Rules[ruleIdx].ValidMatches || !r.Rules[ruleIdx].ValidFilters {
+				continue
+			}
+
+			for filterIdx, f := range rule.Filters {
+				if !isExtensionRefFilterOfKind(f, nkgAPI.AuthenticationFilterKind) {
+					continue
+				}
+
+				nsname := types.NamespacedName{Namespace: r.Source.Namespace, Name: string(f.ExtensionRef.Name)}
+				path := field.NewPath("spec").Child("rules").Index(ruleIdx).
+					Child("filters").Index(filterIdx).Child("extensionRef")
+
+				filter, err := resolver.resolve(nsname)
+				if err != nil {
+					var valErr *field.Error
+					if filter == nil {
+						valErr = field.NotFound(path, nsname.Name)
+					} else {
+						valErr = field.Invalid(path, nsname.Name, err.Error())
+					}
+
+					r.Rules[ruleIdx].ValidFilters = false
+					r.Rules[ruleIdx].Authentication = nil
+					r.Conditions = append(r.Conditions, staticConds.NewRouteInvalidFilter(valErr.Error()))
+
+					break
+				}
+
+				// using the first filter
+				if r.Rules[ruleIdx].Authentication == nil {
+					r.Rules[ruleIdx].Authentication = filter
+				}
+			}
+		}
+	}
+}
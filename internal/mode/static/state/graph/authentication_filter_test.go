@@ -0,0 +1,374 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+const testJWKS = `{"keys":[{"kty":"oct","kid":"key","alg":"HS256","k":"c2VjcmV0"}]}`
+
+func createBasicAuthenticationFilter(name string, secretName string) *nkgAPI.AuthenticationFilter {
+	return &nkgAPI.AuthenticationFilter{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: nkgAPI.AuthenticationFilterSpec{
+			Type: nkgAPI.AuthenticationTypeBasic,
+			Basic: &nkgAPI.BasicAuthentication{
+				Realm:     helpers.GetPointer("Dashboards"),
+				SecretRef: nkgAPI.LocalObjectReference{Name: secretName},
+			},
+		},
+	}
+}
+
+func createJWTAuthenticationFilter(name string, jwks nkgAPI.JWKSSource) *nkgAPI.AuthenticationFilter {
+	return &nkgAPI.AuthenticationFilter{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: nkgAPI.AuthenticationFilterSpec{
+			Type: nkgAPI.AuthenticationTypeJWT,
+			JWT: &nkgAPI.JWTAuthentication{
+				RequiredClaims: map[string]string{"iss": "https://issuer.example.com"},
+				JWKS:           jwks,
+			},
+		},
+	}
+}
+
+func createExternalAuthenticationFilter(name string, svcName string) *nkgAPI.AuthenticationFilter {
+	return &nkgAPI.AuthenticationFilter{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: nkgAPI.AuthenticationFilterSpec{
+			Type: nkgAPI.AuthenticationTypeExternal,
+			External: &nkgAPI.ExternalAuthentication{
+				Path:            helpers.GetPointer("/auth"),
+				BackendRef:      nkgAPI.ServiceReference{Name: svcName, Port: 8080},
+				RequestHeaders:  []string{"Authorization"},
+				ResponseHeaders: []string{"X-User-Id"},
+			},
+		},
+	}
+}
+
+func createRouteWithAuthenticationFilter(filterName string) *Route {
+	hr := createHTTPRoute("hr", "gateway", "example.com", "/")
+	addFilterToPath(hr, "/", v1beta1.HTTPRouteFilter{
+		Type: v1beta1.HTTPRouteFilterExtensionRef,
+		ExtensionRef: &v1beta1.LocalObjectReference{
+			Group: nkgAPI.GroupName,
+			Kind:  nkgAPI.AuthenticationFilterKind,
+			Name:  v1beta1.ObjectName(filterName),
+		},
+	})
+
+	return &Route{
+		Source: hr,
+		Valid:  true,
+		Rules: []Rule{
+			{
+				ValidMatches: true,
+				ValidFilters: true,
+			},
+		},
+	}
+}
+
+func TestAddAuthenticationFiltersToRoutes(t *testing.T) {
+	htpasswdSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "htpasswd"},
+		Data: map[string][]byte{
+			nkgAPI.HtpasswdSecretKey: []byte("user:$apr1$salt$hash"),
+		},
+	}
+	jwksSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "jwks"},
+		Data: map[string][]byte{
+			nkgAPI.JWKSKey: []byte(testJWKS),
+		},
+	}
+	invalidJWKSSecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "invalid-jwks"},
+		Data: map[string][]byte{
+			nkgAPI.JWKSKey: []byte(`{"keys":[]}`),
+		},
+	}
+	emptySecret := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "empty"},
+	}
+	jwksConfigMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "jwks"},
+		Data: map[string]string{
+			nkgAPI.JWKSKey: testJWKS,
+		},
+	}
+
+	secrets := map[types.NamespacedName]*v1.Secret{
+		{Namespace: "test", Name: "htpasswd"}:     htpasswdSecret,
+		{Namespace: "test", Name: "jwks"}:         jwksSecret,
+		{Namespace: "test", Name: "invalid-jwks"}: invalidJWKSSecret,
+		{Namespace: "test", Name: "empty"}:        emptySecret,
+	}
+	configMaps := map[types.NamespacedName]*v1.ConfigMap{
+		{Namespace: "test", Name: "jwks"}: jwksConfigMap,
+	}
+
+	authSvc := &v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "auth"},
+	}
+	services := map[types.NamespacedName]*v1.Service{
+		{Namespace: "test", Name: "auth"}: authSvc,
+	}
+
+	basic := createBasicAuthenticationFilter("basic", "htpasswd")
+	jwtSecret := createJWTAuthenticationFilter("jwt-secret", nkgAPI.JWKSSource{
+		SecretRef: &nkgAPI.LocalObjectReference{Name: "jwks"},
+	})
+	jwtConfigMap := createJWTAuthenticationFilter("jwt-configmap", nkgAPI.JWKSSource{
+		ConfigMapRef: &nkgAPI.LocalObjectReference{Name: "jwks"},
+	})
+	missingSecret := createBasicAuthenticationFilter("missing-secret", "missing")
+	missingKey := createBasicAuthenticationFilter("missing-key", "empty")
+	invalidJWKS := createJWTAuthenticationFilter("invalid-jwks", nkgAPI.JWKSSource{
+		SecretRef: &nkgAPI.LocalObjectReference{Name: "invalid-jwks"},
+	})
+	external := createExternalAuthenticationFilter("external", "auth")
+	missingService := createExternalAuthenticationFilter("missing-service", "missing")
+
+	filters := map[types.NamespacedName]*nkgAPI.AuthenticationFilter{
+		{Namespace: "test", Name: "basic"}:          basic,
+		{Namespace: "test", Name: "jwt-secret"}:     jwtSecret,
+		{Namespace: "test", Name: "jwt-configmap"}:  jwtConfigMap,
+		{Namespace: "test", Name: "missing-secret"}: missingSecret,
+		{Namespace: "test", Name: "missing-key"}:    missingKey,
+		{Namespace: "test", Name: "invalid-jwks"}:   invalidJWKS,
+
+		{Namespace: "test", Name: "external"}:        external,
+		{Namespace: "test", Name: "missing-service"}: missingService,
+	}
+
+	tests := []struct {
+		validator       *validationfakes.FakeHTTPFieldsValidator
+		route           *Route
+		expectedFilter  *AuthenticationFilter
+		name            string
+		expectedConds   []conditions.Condition
+		expectedValid   bool
+		expectedFilters int
+	}{
+		{
+			route: createRouteWithAuthenticationFilter("basic"),
+			expectedFilter: &AuthenticationFilter{
+				Source: basic,
+				Data:   []byte("user:$apr1$salt$hash"),
+				Valid:  true,
+			},
+			expectedValid:   true,
+			expectedFilters: 1,
+			name:            "basic authentication",
+		},
+		{
+			route: createRouteWithAuthenticationFilter("jwt-secret"),
+			expectedFilter: &AuthenticationFilter{
+				Source: jwtSecret,
+				Data:   []byte(testJWKS),
+				Valid:  true,
+			},
+			expectedValid:   true,
+			expectedFilters: 1,
+			name:            "JWT with a JWKS in a Secret",
+		},
+		{
+			route: createRouteWithAuthenticationFilter("jwt-configmap"),
+			expectedFilter: &AuthenticationFilter{
+				Source: jwtConfigMap,
+				Data:   []byte(testJWKS),
+				Valid:  true,
+			},
+			expectedValid:   true,
+			expectedFilters: 1,
+			name:            "JWT with a JWKS in a ConfigMap",
+		},
+		{
+			route: createRouteWithAuthenticationFilter("external"),
+			expectedFilter: &AuthenticationFilter{
+				Source:  external,
+				Service: authSvc,
+				Valid:   true,
+			},
+			expectedValid:   true,
+			expectedFilters: 1,
+			name:            "external authorization",
+		},
+		{
+			route: createRouteWithAuthenticationFilter("not-found"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Not found: "not-found"`,
+				),
+			},
+			name: "filter not found",
+		},
+		{
+			route: createRouteWithAuthenticationFilter("missing-secret"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Invalid value: "missing-secret": ` +
+						`secret test/missing does not exist`,
+				),
+			},
+			expectedFilters: 1,
+			name:            "secret not found",
+		},
+		{
+			route: createRouteWithAuthenticationFilter("missing-key"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Invalid value: "missing-key": ` +
+						`Secret test/empty does not have the auth key`,
+				),
+			},
+			expectedFilters: 1,
+			name:            "secret without the key",
+		},
+		{
+			route: createRouteWithAuthenticationFilter("invalid-jwks"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Invalid value: "invalid-jwks": ` +
+						`the JSON Web Key Set in Secret test/invalid-jwks is invalid: it must have at least one key`,
+				),
+			},
+			expectedFilters: 1,
+			name:            "invalid JWKS",
+		},
+		{
+			route: createRouteWithAuthenticationFilter("missing-service"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Invalid value: "missing-service": ` +
+						`service test/missing does not exist`,
+				),
+			},
+			expectedFilters: 1,
+			name:            "service not found",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := &validationfakes.FakeHTTPFieldsValidator{}
+				v.ValidateExternalAuthPathReturns(errors.New("invalid path"))
+				return v
+			}(),
+			route: createRouteWithAuthenticationFilter("external"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Invalid value: "external": path is invalid: invalid path`,
+				),
+			},
+			expectedFilters: 1,
+			name:            "invalid external authorization path",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := &validationfakes.FakeHTTPFieldsValidator{}
+				v.ValidateExternalAuthHeaderNameReturns(errors.New("invalid header"))
+				return v
+			}(),
+			route: createRouteWithAuthenticationFilter("external"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Invalid value: "external": ` +
+						`request header "Authorization" is invalid: invalid header`,
+				),
+			},
+			expectedFilters: 1,
+			name:            "invalid external authorization header",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := &validationfakes.FakeHTTPFieldsValidator{}
+				v.ValidateAuthenticationRealmReturns(errors.New("invalid realm"))
+				return v
+			}(),
+			route: createRouteWithAuthenticationFilter("basic"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Invalid value: "basic": realm is invalid: invalid realm`,
+				),
+			},
+			expectedFilters: 1,
+			name:            "invalid realm",
+		},
+		{
+			route: func() *Route {
+				r := createRouteWithAuthenticationFilter("basic")
+				r.Rules[0].ValidFilters = false
+				return r
+			}(),
+			name: "rule with invalid filters is skipped",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := test.validator
+			if validator == nil {
+				validator = &validationfakes.FakeHTTPFieldsValidator{}
+			}
+
+			routes := map[types.NamespacedName]*Route{
+				{Namespace: "test", Name: "hr"}: test.route,
+			}
+
+			resolver := newAuthenticationFilterResolver(filters, secrets, configMaps, services, validator)
+			addAuthenticationFiltersToRoutes(routes, resolver)
+
+			g.Expect(test.route.Rules[0].Authentication).To(Equal(test.expectedFilter))
+			g.Expect(test.route.Rules[0].ValidFilters).To(Equal(test.expectedValid))
+			g.Expect(test.route.Conditions).To(Equal(test.expectedConds))
+			g.Expect(resolver.getResolvedFilters()).To(HaveLen(test.expectedFilters))
+		})
+	}
+}
+
+func TestIsReferencedByAuthenticationFilters(t *testing.T) {
+	graph := &Graph{
+		AuthenticationFilters: map[types.NamespacedName]*AuthenticationFilter{
+			{Namespace: "test", Name: "basic"}: {
+				Source: createBasicAuthenticationFilter("basic", "htpasswd"),
+			},
+			{Namespace: "test", Name: "jwt"}: {
+				Source: createJWTAuthenticationFilter("jwt", nkgAPI.JWKSSource{
+					ConfigMapRef: &nkgAPI.LocalObjectReference{Name: "jwks"},
+				}),
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(graph.IsReferenced(&v1.Secret{}, types.NamespacedName{Namespace: "test", Name: "htpasswd"})).To(BeTrue())
+	g.Expect(graph.IsReferenced(&v1.Secret{}, types.NamespacedName{Namespace: "test", Name: "jwks"})).To(BeFalse())
+	g.Expect(graph.IsReferenced(&v1.ConfigMap{}, types.NamespacedName{Namespace: "test", Name: "jwks"})).To(BeTrue())
+	g.Expect(graph.IsReferenced(&v1.ConfigMap{}, types.NamespacedName{Namespace: "other", Name: "jwks"})).To(BeFalse())
+}
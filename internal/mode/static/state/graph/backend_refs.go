@@ -8,6 +8,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
 	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
 )
@@ -18,6 +19,11 @@ type BackendRef struct {
 	Svc *v1.Service
 	// Port is the port of the backendRef.
 	Port int32
+	// UpstreamSettings holds the settings of the upstream of the backendRef. If nil, the default settings are used.
+	UpstreamSettings *UpstreamSettings
+	// UpstreamHealth holds the passive health check settings of the Service of the backendRef from its
+	// UpstreamHealthPolicy. If nil, the default settings are used.
+	UpstreamHealth *nkgAPI.UpstreamHealthPolicySpec
 	// Weight is the weight of the backendRef.
 	Weight int32
 	// Valid indicates whether the backendRef is valid.
@@ -32,6 +38,18 @@ func (b BackendRef) ServicePortReference() string {
 	return fmt.Sprintf("%s_%s_%d", b.Svc.Namespace, b.Svc.Name, b.Port)
 }
 
+// UpstreamName returns the name of the upstream for the BackendRef.
+// BackendRefs that reference the same service and port share the upstream, unless the upstream settings of
+// the BackendRef are specific to its HTTPRoute. In that case, the name also includes the HTTPRoute.
+func (b BackendRef) UpstreamName() string {
+	ref := b.ServicePortReference()
+	if ref == "" || b.UpstreamSettings == nil || b.UpstreamSettings.RouteNsName == nil {
+		return ref
+	}
+
+	return fmt.Sprintf("%s_%s_%s", ref, b.UpstreamSettings.RouteNsName.Namespace, b.UpstreamSettings.RouteNsName.Name)
+}
+
 func addBackendRefsToRouteRules(
 	routes map[types.NamespacedName]*Route,
 	refGrantResolver *referenceGrantResolver,
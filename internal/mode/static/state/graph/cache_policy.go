@@ -0,0 +1,241 @@
+package graph
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// CachePolicy represents a CachePolicy resource.
+type CachePolicy = Policy[*nkgAPI.CachePolicy]
+
+// cachePolicyTarget identifies the target of a CachePolicy: a Gateway or the rules of an HTTPRoute.
+type cachePolicyTarget struct {
+	kind string
+	// rules holds the Gateway in the route field with allRules if the kind is Gateway.
+	rules ruleTarget
+}
+
+func processCachePolicies(
+	policies map[types.NamespacedName]*nkgAPI.CachePolicy,
+	validator validation.PolicyValidator,
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+) map[types.NamespacedName]*CachePolicy {
+	targets := policyTargets{gw: gw, routes: routes}
+
+	processed := processPolicies(policies, policyKind[*nkgAPI.CachePolicy, cachePolicyTarget]{
+		name:       nkgAPI.CachePolicyKind,
+		sameTarget: "resource",
+		validate: func(policy *nkgAPI.CachePolicy) error {
+			return validateCachePolicy(validator, policy)
+		},
+		getTarget: getCachePolicyTarget,
+		findTarget: func(target cachePolicyTarget) string {
+			if target.kind == kindGateway {
+				return targets.find(policyTarget{kind: kindGateway, nsname: target.rules.route})
+			}
+
+			return targets.findRule(target.rules)
+		},
+	})
+
+	validateCacheZoneReferences(processed)
+
+	return processed
+}
+
+func getCachePolicyTarget(policy *nkgAPI.CachePolicy) cachePolicyTarget {
+	return cachePolicyTarget{
+		kind:  string(policy.Spec.TargetRef.Kind),
+		rules: getRuleTarget(policy, policy.Spec.RuleIndex),
+	}
+}
+
+func validateCachePolicy(
+	validator validation.PolicyValidator,
+	policy *nkgAPI.CachePolicy,
+) error {
+	specPath := field.NewPath("spec")
+	spec := policy.Spec
+
+	allErrs := validatePolicyTargetRef(policy, specPath.Child("targetRef"), gatewayGroupKind, httpRouteGroupKind)
+
+	switch spec.TargetRef.Kind {
+	case kindGateway:
+		if len(spec.Zones) == 0 {
+			allErrs = append(allErrs, field.Required(specPath.Child("zones"), "must be set for a Gateway"))
+		}
+		if spec.RuleIndex != nil {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("ruleIndex"), "can only be set for an HTTPRoute"))
+		}
+		if spec.Cache != nil {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("cache"), "can only be set for an HTTPRoute"))
+		}
+	case kindHTTPRoute:
+		if spec.Cache == nil {
+			allErrs = append(allErrs, field.Required(specPath.Child("cache"), "must be set for an HTTPRoute"))
+		}
+		if len(spec.Zones) > 0 {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("zones"), "can only be set for a Gateway"))
+		}
+		if spec.RuleIndex != nil && *spec.RuleIndex < 0 {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("ruleIndex"), *spec.RuleIndex, "must be non-negative"))
+		}
+	}
+
+	for i, zone := range spec.Zones {
+		allErrs = append(allErrs, validateCacheZone(validator, zone, specPath.Child("zones").Index(i))...)
+	}
+
+	if spec.Cache != nil {
+		allErrs = append(allErrs, validateCacheSettings(validator, *spec.Cache, specPath.Child("cache"))...)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateCacheZone(
+	validator validation.PolicyValidator,
+	zone nkgAPI.CacheZone,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if err := validator.ValidateCacheZoneName(zone.Name); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("name"), zone.Name, err.Error()))
+	}
+
+	if err := validator.ValidateSize(string(zone.KeysSize)); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("keysSize"), zone.KeysSize, err.Error()))
+	}
+
+	if zone.MaxSize != nil {
+		if err := validator.ValidateSize(string(*zone.MaxSize)); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("maxSize"), *zone.MaxSize, err.Error()))
+		}
+	}
+
+	if zone.Inactive != nil {
+		if err := validator.ValidateDuration(string(*zone.Inactive)); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("inactive"), *zone.Inactive, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateCacheSettings(
+	validator validation.PolicyValidator,
+	cache nkgAPI.CacheSettings,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if err := validator.ValidateCacheZoneName(cache.Zone); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("zone"), cache.Zone, err.Error()))
+	}
+
+	if cache.Key != nil {
+		if err := validator.ValidateCacheKey(*cache.Key); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("key"), *cache.Key, err.Error()))
+		}
+	}
+
+	for i, valid := range cache.Valid {
+		if err := validator.ValidateDuration(string(valid.Duration)); err != nil {
+			validPath := path.Child("valid").Index(i).Child("duration")
+			allErrs = append(allErrs, field.Invalid(validPath, valid.Duration, err.Error()))
+		}
+	}
+
+	for i, cond := range cache.Bypass {
+		condPath := path.Child("bypass").Index(i)
+
+		switch cond.Type {
+		case nkgAPI.CacheBypassConditionTypeHeader:
+		case nkgAPI.CacheBypassConditionTypeCookie, nkgAPI.CacheBypassConditionTypeQueryParam:
+			// NGINX variable names can't include '-', so the $cookie_ and $arg_ variables can't match such names.
+			if strings.Contains(cond.Name, "-") {
+				allErrs = append(
+					allErrs,
+					field.Invalid(condPath.Child("name"), cond.Name, "cannot contain '-' for a Cookie or a QueryParam"),
+				)
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(
+				condPath.Child("type"),
+				cond.Type,
+				[]string{
+					string(nkgAPI.CacheBypassConditionTypeHeader),
+					string(nkgAPI.CacheBypassConditionTypeCookie),
+					string(nkgAPI.CacheBypassConditionTypeQueryParam),
+				},
+			))
+		}
+
+		if err := validator.ValidateCacheBypassName(cond.Name); err != nil {
+			allErrs = append(allErrs, field.Invalid(condPath.Child("name"), cond.Name, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateCacheZoneReferences invalidates the valid policies for the HTTPRoutes that reference a zone that
+// the valid policy for the Gateway doesn't declare.
+func validateCacheZoneReferences(policies map[types.NamespacedName]*CachePolicy) {
+	zones := make(map[string]struct{})
+
+	for _, p := range policies {
+		if p.Valid && p.Source.Spec.TargetRef.Kind == kindGateway {
+			for _, z := range p.Source.Spec.Zones {
+				zones[z.Name] = struct{}{}
+			}
+		}
+	}
+
+	for _, p := range policies {
+		if !p.Valid || p.Source.Spec.TargetRef.Kind != kindHTTPRoute {
+			continue
+		}
+
+		zone := p.Source.Spec.Cache.Zone
+		if _, exists := zones[zone]; !exists {
+			path := field.NewPath("spec", "cache", "zone")
+			err := field.Invalid(path, zone, "must be a zone of the CachePolicy for the Gateway")
+
+			p.Valid = false
+			p.Conditions = append(p.Conditions, staticConds.NewPolicyInvalid(err.Error()))
+		}
+	}
+}
+
+// addCacheToRules adds the cache settings of the CachePolicies for the HTTPRoutes to the rules of the routes.
+// A policy that targets a rule overrides a policy that targets all rules of the route.
+// The routes are modified in place.
+func addCacheToRules(
+	routes map[types.NamespacedName]*Route,
+	policies map[types.NamespacedName]*CachePolicy,
+) {
+	routePolicies := make(map[types.NamespacedName]*CachePolicy)
+
+	for nsname, p := range policies {
+		if p.Source.Spec.TargetRef.Kind == kindHTTPRoute {
+			routePolicies[nsname] = p
+		}
+	}
+
+	getTarget := func(policy *nkgAPI.CachePolicy) ruleTarget {
+		return getRuleTarget(policy, policy.Spec.RuleIndex)
+	}
+
+	addPoliciesToRules(routes, routePolicies, getTarget, func(rule *Rule, p *CachePolicy) {
+		rule.Cache = p.Source.Spec.Cache
+	})
+}
@@ -0,0 +1,309 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+func createGatewayCachePolicy(name string, created metav1.Time, gatewayName string) *nkgAPI.CachePolicy {
+	return &nkgAPI.CachePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "test",
+			Name:              name,
+			CreationTimestamp: created,
+		},
+		Spec: nkgAPI.CachePolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kindGateway,
+				Name:  v1alpha2.ObjectName(gatewayName),
+			},
+			Zones: []nkgAPI.CacheZone{
+				{
+					Name:     "api",
+					KeysSize: "10m",
+					MaxSize:  helpers.GetPointer[nkgAPI.Size]("1g"),
+					Inactive: helpers.GetPointer[nkgAPI.Duration]("1h"),
+				},
+			},
+		},
+	}
+}
+
+func createRouteCachePolicy(
+	name string,
+	created metav1.Time,
+	routeName string,
+	ruleIdx *int32,
+) *nkgAPI.CachePolicy {
+	return &nkgAPI.CachePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "test",
+			Name:              name,
+			CreationTimestamp: created,
+		},
+		Spec: nkgAPI.CachePolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kindHTTPRoute,
+				Name:  v1alpha2.ObjectName(routeName),
+			},
+			RuleIndex: ruleIdx,
+			Cache: &nkgAPI.CacheSettings{
+				Zone: "api",
+				Key:  helpers.GetStringPointer("$host$request_uri"),
+				Valid: []nkgAPI.CacheValid{
+					{Codes: []nkgAPI.CacheStatusCode{200}, Duration: "10m"},
+				},
+				Bypass: []nkgAPI.CacheBypassCondition{
+					{Type: nkgAPI.CacheBypassConditionTypeHeader, Name: "X-No-Cache"},
+					{Type: nkgAPI.CacheBypassConditionTypeCookie, Name: "nocache"},
+				},
+				StaleWhileRevalidate: helpers.GetPointer(true),
+			},
+		},
+	}
+}
+
+func TestProcessCachePolicies(t *testing.T) {
+	older := metav1.Now()
+	newer := metav1.NewTime(older.Add(1))
+
+	gatewayPolicy := createGatewayCachePolicy("gateway", older, "gateway")
+	conflictedGatewayPolicy := createGatewayCachePolicy("conflicted-gateway", newer, "gateway")
+	conflictedGatewayPolicy.Spec.Zones[0].Name = "other"
+
+	routePolicy := createRouteCachePolicy("route", older, "hr", nil)
+	rulePolicy := createRouteCachePolicy("rule", older, "hr", helpers.GetPointer[int32](0))
+
+	// The zone is declared only by the conflicted policy for the Gateway.
+	zoneNotFoundPolicy := createRouteCachePolicy("zone-not-found", older, "hr2", nil)
+	zoneNotFoundPolicy.Spec.Cache.Zone = "other"
+
+	gw := &Gateway{
+		Source: &v1beta1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+		},
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}: {
+			Rules: []Rule{{}},
+		},
+		{Namespace: "test", Name: "hr2"}: {
+			Rules: []Rule{{}},
+		},
+	}
+
+	policies := map[types.NamespacedName]*nkgAPI.CachePolicy{
+		{Namespace: "test", Name: "gateway"}:            gatewayPolicy,
+		{Namespace: "test", Name: "conflicted-gateway"}: conflictedGatewayPolicy,
+		{Namespace: "test", Name: "route"}:              routePolicy,
+		{Namespace: "test", Name: "rule"}:               rulePolicy,
+		{Namespace: "test", Name: "zone-not-found"}:     zoneNotFoundPolicy,
+	}
+
+	expected := map[types.NamespacedName]*CachePolicy{
+		{Namespace: "test", Name: "gateway"}: {
+			Source: gatewayPolicy,
+			Valid:  true,
+		},
+		{Namespace: "test", Name: "conflicted-gateway"}: {
+			Source: conflictedGatewayPolicy,
+			Conditions: []conditions.Condition{
+				staticConds.NewPolicyConflicted(
+					"Conflicts with CachePolicy test/gateway, which targets the same resource",
+				),
+			},
+		},
+		{Namespace: "test", Name: "route"}: {
+			Source: routePolicy,
+			Valid:  true,
+		},
+		{Namespace: "test", Name: "rule"}: {
+			Source: rulePolicy,
+			Valid:  true,
+		},
+		{Namespace: "test", Name: "zone-not-found"}: {
+			Source: zoneNotFoundPolicy,
+			Conditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					`spec.cache.zone: Invalid value: "other": must be a zone of the CachePolicy for the Gateway`,
+				),
+			},
+		},
+	}
+
+	validator := &validationfakes.FakePolicyValidator{}
+
+	g := NewWithT(t)
+
+	result := processCachePolicies(policies, validator, gw, routes)
+	g.Expect(helpers.Diff(expected, result)).To(BeEmpty())
+}
+
+func TestValidateCachePolicy(t *testing.T) {
+	createPolicy := func(
+		gateway bool,
+		mutate func(spec *nkgAPI.CachePolicySpec),
+	) *nkgAPI.CachePolicy {
+		p := createRouteCachePolicy("policy", metav1.Now(), "hr", nil)
+		if gateway {
+			p = createGatewayCachePolicy("policy", metav1.Now(), "gateway")
+		}
+
+		if mutate != nil {
+			mutate(&p.Spec)
+		}
+
+		return p
+	}
+
+	tests := []struct {
+		policy         *nkgAPI.CachePolicy
+		validator      *validationfakes.FakePolicyValidator
+		name           string
+		expectErrCount int
+	}{
+		{
+			policy: createPolicy(true, nil),
+			name:   "valid Gateway policy",
+		},
+		{
+			policy: createPolicy(false, nil),
+			name:   "valid HTTPRoute policy",
+		},
+		{
+			policy: createPolicy(true, func(spec *nkgAPI.CachePolicySpec) {
+				spec.Zones = nil
+				spec.RuleIndex = helpers.GetPointer[int32](0)
+				spec.Cache = &nkgAPI.CacheSettings{Zone: "api"}
+			}),
+			expectErrCount: 3,
+			name:           "Gateway policy without zones and with HTTPRoute fields",
+		},
+		{
+			policy: createPolicy(false, func(spec *nkgAPI.CachePolicySpec) {
+				spec.Cache = nil
+				spec.RuleIndex = helpers.GetPointer[int32](-1)
+			}),
+			expectErrCount: 2,
+			name:           "HTTPRoute policy without cache and with negative rule index",
+		},
+		{
+			policy: createPolicy(false, func(spec *nkgAPI.CachePolicySpec) {
+				spec.TargetRef.Kind = "Service"
+				spec.TargetRef.Group = ""
+				spec.Cache = nil
+			}),
+			expectErrCount: 1,
+			name:           "unsupported target",
+		},
+		{
+			policy: createPolicy(false, func(spec *nkgAPI.CachePolicySpec) {
+				spec.Cache.Bypass = []nkgAPI.CacheBypassCondition{
+					{Type: nkgAPI.CacheBypassConditionTypeCookie, Name: "no-cache"},
+					{Type: nkgAPI.CacheBypassConditionTypeQueryParam, Name: "no-cache"},
+					{Type: "Method", Name: "POST"},
+				}
+			}),
+			expectErrCount: 3,
+			name:           "invalid bypass conditions",
+		},
+		{
+			policy: createPolicy(true, nil),
+			validator: func() *validationfakes.FakePolicyValidator {
+				v := &validationfakes.FakePolicyValidator{}
+				v.ValidateCacheZoneNameReturns(errors.New("invalid name"))
+				v.ValidateSizeReturns(errors.New("invalid size"))
+				v.ValidateDurationReturns(errors.New("invalid duration"))
+				return v
+			}(),
+			expectErrCount: 4,
+			name:           "invalid zone",
+		},
+		{
+			policy: createPolicy(false, nil),
+			validator: func() *validationfakes.FakePolicyValidator {
+				v := &validationfakes.FakePolicyValidator{}
+				v.ValidateCacheZoneNameReturns(errors.New("invalid name"))
+				v.ValidateCacheKeyReturns(errors.New("invalid key"))
+				v.ValidateDurationReturns(errors.New("invalid duration"))
+				v.ValidateCacheBypassNameReturns(errors.New("invalid bypass name"))
+				return v
+			}(),
+			expectErrCount: 5,
+			name:           "invalid cache settings",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := test.validator
+			if validator == nil {
+				validator = &validationfakes.FakePolicyValidator{}
+			}
+
+			err := validateCachePolicy(validator, test.policy)
+
+			if test.expectErrCount == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.(interface{ Errors() []error }).Errors()).To(HaveLen(test.expectErrCount))
+			}
+		})
+	}
+}
+
+func TestAddCacheToRules(t *testing.T) {
+	// The Gateway has the same name as the HTTPRoute, so that the test ensures that the policy for the Gateway
+	// doesn't apply to the rules of the HTTPRoute.
+	gatewayPolicy := createGatewayCachePolicy("gateway", metav1.Now(), "hr")
+	routePolicy := createRouteCachePolicy("route", metav1.Now(), "hr", nil)
+	rulePolicy := createRouteCachePolicy("rule", metav1.Now(), "hr", helpers.GetPointer[int32](1))
+	invalidPolicy := createRouteCachePolicy("invalid", metav1.Now(), "hr2", nil)
+
+	policies := map[types.NamespacedName]*CachePolicy{
+		{Namespace: "test", Name: "gateway"}: {Source: gatewayPolicy, Valid: true},
+		{Namespace: "test", Name: "route"}:   {Source: routePolicy, Valid: true},
+		{Namespace: "test", Name: "rule"}:    {Source: rulePolicy, Valid: true},
+		{Namespace: "test", Name: "invalid"}: {Source: invalidPolicy},
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}: {
+			Rules: []Rule{{}, {}},
+		},
+		{Namespace: "test", Name: "hr2"}: {
+			Rules: []Rule{{}},
+		},
+	}
+
+	addCacheToRules(routes, policies)
+
+	g := NewWithT(t)
+
+	rules := routes[types.NamespacedName{Namespace: "test", Name: "hr"}].Rules
+	g.Expect(rules[0].Cache).To(Equal(routePolicy.Spec.Cache))
+	g.Expect(rules[1].Cache).To(Equal(rulePolicy.Spec.Cache))
+
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr2"}].Rules[0].Cache).To(BeNil())
+}
@@ -0,0 +1,137 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// ClientSettingsPolicy represents a ClientSettingsPolicy resource.
+type ClientSettingsPolicy = Policy[*nkgAPI.ClientSettingsPolicy]
+
+func processClientSettingsPolicies(
+	policies map[types.NamespacedName]*nkgAPI.ClientSettingsPolicy,
+	validator validation.PolicyValidator,
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+) map[types.NamespacedName]*ClientSettingsPolicy {
+	targets := policyTargets{gw: gw, routes: routes}
+
+	return processPolicies(policies, policyKind[*nkgAPI.ClientSettingsPolicy, policyTarget]{
+		name:       nkgAPI.ClientSettingsPolicyKind,
+		sameTarget: "resource",
+		validate: func(policy *nkgAPI.ClientSettingsPolicy) error {
+			return validateClientSettingsPolicy(validator, policy)
+		},
+		getTarget:  getClientSettingsPolicyTarget,
+		findTarget: targets.find,
+	})
+}
+
+func getClientSettingsPolicyTarget(policy *nkgAPI.ClientSettingsPolicy) policyTarget {
+	return getPolicyTarget(policy, nil)
+}
+
+func validateClientSettingsPolicy(
+	validator validation.PolicyValidator,
+	policy *nkgAPI.ClientSettingsPolicy,
+) error {
+	specPath := field.NewPath("spec")
+
+	spec := policy.Spec
+
+	allErrs := validatePolicyTargetRef(policy, specPath.Child("targetRef"), gatewayGroupKind, httpRouteGroupKind)
+
+	if spec.Body != nil {
+		bodyPath := specPath.Child("body")
+
+		if spec.Body.MaxSize != nil {
+			if err := validator.ValidateSize(string(*spec.Body.MaxSize)); err != nil {
+				allErrs = append(allErrs, field.Invalid(bodyPath.Child("maxSize"), *spec.Body.MaxSize, err.Error()))
+			}
+		}
+
+		allErrs = append(allErrs, validateOptionalDuration(validator, spec.Body.Timeout, bodyPath.Child("timeout"))...)
+	}
+
+	if spec.Header != nil {
+		headerPath := specPath.Child("header")
+
+		if spec.TargetRef.Kind != kindGateway {
+			allErrs = append(allErrs, field.Forbidden(headerPath, "can only be set for a Gateway"))
+		}
+
+		allErrs = append(
+			allErrs,
+			validateOptionalDuration(validator, spec.Header.Timeout, headerPath.Child("timeout"))...,
+		)
+	}
+
+	if spec.KeepAlive != nil {
+		keepAlivePath := specPath.Child("keepAlive")
+
+		allErrs = append(
+			allErrs,
+			validateOptionalDuration(validator, spec.KeepAlive.Time, keepAlivePath.Child("time"))...,
+		)
+
+		if spec.KeepAlive.Timeout != nil {
+			timeoutPath := keepAlivePath.Child("timeout")
+			timeout := spec.KeepAlive.Timeout
+
+			allErrs = append(
+				allErrs,
+				validateOptionalDuration(validator, &timeout.Server, timeoutPath.Child("server"))...,
+			)
+			allErrs = append(
+				allErrs,
+				validateOptionalDuration(validator, timeout.Header, timeoutPath.Child("header"))...,
+			)
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateOptionalDuration(
+	validator validation.PolicyValidator,
+	duration *nkgAPI.Duration,
+	path *field.Path,
+) field.ErrorList {
+	if duration == nil {
+		return nil
+	}
+
+	if err := validator.ValidateDuration(string(*duration)); err != nil {
+		return field.ErrorList{field.Invalid(path, *duration, err.Error())}
+	}
+
+	return nil
+}
+
+// addClientSettingsToGatewayAndRoutes adds the ClientSettingsPolicy specs to the Gateway and the routes.
+// The Gateway and the routes are modified in place.
+func addClientSettingsToGatewayAndRoutes(
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+	policies map[types.NamespacedName]*ClientSettingsPolicy,
+) {
+	attached := newAttachedPolicies(policies, getClientSettingsPolicyTarget)
+	if len(attached) == 0 {
+		return
+	}
+
+	if gw != nil {
+		if p := attached.forGateway(gw); p != nil {
+			gw.ClientSettings = &p.Source.Spec
+		}
+	}
+
+	for routeNsName, r := range routes {
+		if p := attached.forRoute(routeNsName); p != nil {
+			r.ClientSettings = &p.Source.Spec
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+var errInvalidSize = errors.New("invalid size")
+
+func createClientSettingsPolicy(
+	name string,
+	kind v1alpha2.Kind,
+	targetName string,
+) *nkgAPI.ClientSettingsPolicy {
+	return &nkgAPI.ClientSettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: nkgAPI.ClientSettingsPolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kind,
+				Name:  v1alpha2.ObjectName(targetName),
+			},
+			Body: &nkgAPI.ClientBody{
+				MaxSize: helpers.GetPointer[nkgAPI.Size]("10m"),
+				Timeout: helpers.GetPointer[nkgAPI.Duration]("30s"),
+			},
+			KeepAlive: &nkgAPI.ClientKeepAlive{
+				Requests: helpers.GetPointer[int32](100),
+				Time:     helpers.GetPointer[nkgAPI.Duration]("1h"),
+				Timeout: &nkgAPI.ClientKeepAliveTimeout{
+					Server: "75s",
+					Header: helpers.GetPointer[nkgAPI.Duration]("60s"),
+				},
+			},
+		},
+	}
+}
+
+func TestValidateClientSettingsPolicy(t *testing.T) {
+	createPolicy := func(mutate func(spec *nkgAPI.ClientSettingsPolicySpec)) *nkgAPI.ClientSettingsPolicy {
+		p := createClientSettingsPolicy("policy", kindHTTPRoute, "hr")
+
+		if mutate != nil {
+			mutate(&p.Spec)
+		}
+
+		return p
+	}
+
+	tests := []struct {
+		policy          *nkgAPI.ClientSettingsPolicy
+		name            string
+		expectErrCount  int
+		invalidSize     bool
+		invalidDuration bool
+	}{
+		{
+			policy: createPolicy(nil),
+			name:   "valid",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.ClientSettingsPolicySpec) {
+				spec.TargetRef.Kind = kindGateway
+				spec.Header = &nkgAPI.ClientHeader{
+					Timeout: helpers.GetPointer[nkgAPI.Duration]("10s"),
+				}
+			}),
+			name: "valid gateway policy with header",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.ClientSettingsPolicySpec) {
+				spec.TargetRef.Namespace = helpers.GetPointer[v1alpha2.Namespace]("other")
+			}),
+			expectErrCount: 1,
+			name:           "target in another namespace",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.ClientSettingsPolicySpec) {
+				spec.Header = &nkgAPI.ClientHeader{}
+			}),
+			expectErrCount: 1,
+			name:           "header for HTTPRoute",
+		},
+		{
+			policy:         createPolicy(nil),
+			invalidSize:    true,
+			expectErrCount: 1,
+			name:           "invalid body max size",
+		},
+		{
+			policy:          createPolicy(nil),
+			invalidDuration: true,
+			expectErrCount:  4,
+			name:            "invalid durations",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{}
+			if test.invalidSize {
+				validator.ValidateSizeReturns(errInvalidSize)
+			}
+			if test.invalidDuration {
+				validator.ValidateDurationReturns(errInvalidDuration)
+			}
+
+			err := validateClientSettingsPolicy(validator, test.policy)
+
+			if test.expectErrCount == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.(interface{ Errors() []error }).Errors()).To(HaveLen(test.expectErrCount))
+			}
+		})
+	}
+}
+
+func TestAddClientSettingsToGatewayAndRoutes(t *testing.T) {
+	gatewayPolicy := createClientSettingsPolicy("gateway", kindGateway, "gateway")
+	routePolicy := createClientSettingsPolicy("route", kindHTTPRoute, "hr")
+	invalidPolicy := createClientSettingsPolicy("invalid", kindHTTPRoute, "hr2")
+
+	policies := map[types.NamespacedName]*ClientSettingsPolicy{
+		{Namespace: "test", Name: "gateway"}: {Source: gatewayPolicy, Valid: true},
+		{Namespace: "test", Name: "route"}:   {Source: routePolicy, Valid: true},
+		{Namespace: "test", Name: "invalid"}: {Source: invalidPolicy},
+	}
+
+	gw := &Gateway{
+		Source: &v1beta1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+		},
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}:  {},
+		{Namespace: "test", Name: "hr2"}: {},
+	}
+
+	addClientSettingsToGatewayAndRoutes(gw, routes, policies)
+
+	g := NewWithT(t)
+
+	g.Expect(gw.ClientSettings).To(Equal(&gatewayPolicy.Spec))
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr"}].ClientSettings).To(Equal(&routePolicy.Spec))
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr2"}].ClientSettings).To(BeNil())
+}
@@ -0,0 +1,79 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// CompressionPolicy represents a CompressionPolicy resource.
+type CompressionPolicy = Policy[*nkgAPI.CompressionPolicy]
+
+func processCompressionPolicies(
+	policies map[types.NamespacedName]*nkgAPI.CompressionPolicy,
+	validator validation.PolicyValidator,
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+) map[types.NamespacedName]*CompressionPolicy {
+	targets := policyTargets{gw: gw, routes: routes}
+
+	return processPolicies(policies, policyKind[*nkgAPI.CompressionPolicy, policyTarget]{
+		name:       nkgAPI.CompressionPolicyKind,
+		sameTarget: "resource",
+		validate: func(policy *nkgAPI.CompressionPolicy) error {
+			return validateCompressionPolicy(validator, policy)
+		},
+		getTarget:  getCompressionPolicyTarget,
+		findTarget: targets.find,
+	})
+}
+
+func getCompressionPolicyTarget(policy *nkgAPI.CompressionPolicy) policyTarget {
+	return getPolicyTarget(policy, nil)
+}
+
+func validateCompressionPolicy(
+	validator validation.PolicyValidator,
+	policy *nkgAPI.CompressionPolicy,
+) error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicyTargetRef(policy, specPath.Child("targetRef"), gatewayGroupKind, httpRouteGroupKind)
+
+	typesPath := specPath.Child("gzip", "types")
+
+	for i, mimeType := range policy.Spec.Gzip.Types {
+		if err := validator.ValidateMIMEType(string(mimeType)); err != nil {
+			allErrs = append(allErrs, field.Invalid(typesPath.Index(i), mimeType, err.Error()))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// addCompressionToGatewayAndRoutes adds the gzip settings of the CompressionPolicies to the Gateway and the routes.
+// The Gateway and the routes are modified in place.
+func addCompressionToGatewayAndRoutes(
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+	policies map[types.NamespacedName]*CompressionPolicy,
+) {
+	attached := newAttachedPolicies(policies, getCompressionPolicyTarget)
+	if len(attached) == 0 {
+		return
+	}
+
+	if gw != nil {
+		if p := attached.forGateway(gw); p != nil {
+			gw.Compression = &p.Source.Spec.Gzip
+		}
+	}
+
+	for routeNsName, r := range routes {
+		if p := attached.forRoute(routeNsName); p != nil {
+			r.Compression = &p.Source.Spec.Gzip
+		}
+	}
+}
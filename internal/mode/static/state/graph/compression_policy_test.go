@@ -0,0 +1,140 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+func createCompressionPolicy(
+	name string,
+	kind v1alpha2.Kind,
+	targetName string,
+) *nkgAPI.CompressionPolicy {
+	return &nkgAPI.CompressionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: nkgAPI.CompressionPolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kind,
+				Name:  v1alpha2.ObjectName(targetName),
+			},
+			Gzip: nkgAPI.Gzip{
+				Enable:    true,
+				Types:     []nkgAPI.MIMEType{"application/json", "text/css"},
+				MinLength: helpers.GetPointer[int32](1000),
+				Level:     helpers.GetPointer[int32](5),
+				Vary:      helpers.GetPointer(true),
+			},
+		},
+	}
+}
+
+func TestValidateCompressionPolicy(t *testing.T) {
+	createPolicy := func(mutate func(spec *nkgAPI.CompressionPolicySpec)) *nkgAPI.CompressionPolicy {
+		p := createCompressionPolicy("policy", kindHTTPRoute, "hr")
+
+		if mutate != nil {
+			mutate(&p.Spec)
+		}
+
+		return p
+	}
+
+	tests := []struct {
+		policy          *nkgAPI.CompressionPolicy
+		name            string
+		expectErrCount  int
+		invalidMIMEType bool
+	}{
+		{
+			policy: createPolicy(nil),
+			name:   "valid",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.CompressionPolicySpec) {
+				spec.Gzip = nkgAPI.Gzip{}
+			}),
+			name: "valid policy that disables compression",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.CompressionPolicySpec) {
+				spec.TargetRef.Namespace = helpers.GetPointer[v1alpha2.Namespace]("other")
+			}),
+			expectErrCount: 1,
+			name:           "target in another namespace",
+		},
+		{
+			policy:          createPolicy(nil),
+			invalidMIMEType: true,
+			expectErrCount:  2,
+			name:            "invalid types",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{}
+			if test.invalidMIMEType {
+				validator.ValidateMIMETypeReturns(errors.New("invalid MIME type"))
+			}
+
+			err := validateCompressionPolicy(validator, test.policy)
+
+			if test.expectErrCount == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.(interface{ Errors() []error }).Errors()).To(HaveLen(test.expectErrCount))
+			}
+		})
+	}
+}
+
+func TestAddCompressionToGatewayAndRoutes(t *testing.T) {
+	gatewayPolicy := createCompressionPolicy("gateway", kindGateway, "gateway")
+	routePolicy := createCompressionPolicy("route", kindHTTPRoute, "hr")
+	invalidPolicy := createCompressionPolicy("invalid", kindHTTPRoute, "hr2")
+
+	policies := map[types.NamespacedName]*CompressionPolicy{
+		{Namespace: "test", Name: "gateway"}: {Source: gatewayPolicy, Valid: true},
+		{Namespace: "test", Name: "route"}:   {Source: routePolicy, Valid: true},
+		{Namespace: "test", Name: "invalid"}: {Source: invalidPolicy},
+	}
+
+	gw := &Gateway{
+		Source: &v1beta1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+		},
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}:  {},
+		{Namespace: "test", Name: "hr2"}: {},
+	}
+
+	addCompressionToGatewayAndRoutes(gw, routes, policies)
+
+	g := NewWithT(t)
+
+	g.Expect(gw.Compression).To(Equal(&gatewayPolicy.Spec.Gzip))
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr"}].Compression).To(Equal(&routePolicy.Spec.Gzip))
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr2"}].Compression).To(BeNil())
+}
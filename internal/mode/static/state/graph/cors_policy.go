@@ -0,0 +1,115 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// CORSPolicy represents a CORSPolicy resource.
+type CORSPolicy = Policy[*nkgAPI.CORSPolicy]
+
+func processCORSPolicies(
+	policies map[types.NamespacedName]*nkgAPI.CORSPolicy,
+	validator validation.PolicyValidator,
+	routes map[types.NamespacedName]*Route,
+) map[types.NamespacedName]*CORSPolicy {
+	targets := policyTargets{routes: routes}
+
+	return processPolicies(policies, policyKind[*nkgAPI.CORSPolicy, ruleTarget]{
+		name:       nkgAPI.CORSPolicyKind,
+		sameTarget: "rules",
+		validate: func(policy *nkgAPI.CORSPolicy) error {
+			return validateCORSPolicy(validator, policy)
+		},
+		getTarget:  getCORSPolicyTarget,
+		findTarget: targets.findRule,
+	})
+}
+
+func getCORSPolicyTarget(policy *nkgAPI.CORSPolicy) ruleTarget {
+	return getRuleTarget(policy, policy.Spec.RuleIndex)
+}
+
+func validateCORSPolicy(
+	validator validation.PolicyValidator,
+	policy *nkgAPI.CORSPolicy,
+) error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicyTargetRef(policy, specPath.Child("targetRef"), httpRouteGroupKind)
+
+	if policy.Spec.RuleIndex != nil && *policy.Spec.RuleIndex < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("ruleIndex"), *policy.Spec.RuleIndex, "must be non-negative"))
+	}
+
+	allowAllOrigins := false
+
+	for i, origin := range policy.Spec.AllowOrigins {
+		if err := validator.ValidateCORSOrigin(origin); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("allowOrigins").Index(i), origin, err.Error()))
+		}
+
+		allowAllOrigins = allowAllOrigins || origin == "*"
+	}
+
+	// The Fetch standard forbids the * origin for the requests with credentials.
+	if allowAllOrigins && policy.Spec.AllowCredentials != nil && *policy.Spec.AllowCredentials {
+		allErrs = append(
+			allErrs,
+			field.Invalid(specPath.Child("allowCredentials"), true, "cannot be combined with the * origin"),
+		)
+	}
+
+	allErrs = append(
+		allErrs,
+		validateCORSHeaderNames(validator, policy.Spec.AllowHeaders, specPath.Child("allowHeaders"))...,
+	)
+
+	for i, name := range policy.Spec.ExposeHeaders {
+		if name == "*" {
+			// The clients ignore * in the Access-Control-Expose-Headers header of the responses to the requests
+			// with credentials, which makes the behavior of * depend on the requests.
+			allErrs = append(
+				allErrs,
+				field.Invalid(specPath.Child("exposeHeaders").Index(i), name, "* is not supported"),
+			)
+		}
+	}
+
+	allErrs = append(
+		allErrs,
+		validateCORSHeaderNames(validator, policy.Spec.ExposeHeaders, specPath.Child("exposeHeaders"))...,
+	)
+
+	return allErrs.ToAggregate()
+}
+
+func validateCORSHeaderNames(
+	validator validation.PolicyValidator,
+	names []string,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, name := range names {
+		if err := validator.ValidateCORSHeaderName(name); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Index(i), name, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// addCORSToRules adds the CORSPolicy specs to the rules of the routes. A policy that targets a rule
+// overrides a policy that targets all rules of the route. The routes are modified in place.
+func addCORSToRules(
+	routes map[types.NamespacedName]*Route,
+	policies map[types.NamespacedName]*CORSPolicy,
+) {
+	addPoliciesToRules(routes, policies, getCORSPolicyTarget, func(rule *Rule, p *CORSPolicy) {
+		rule.CORS = &p.Source.Spec
+	})
+}
@@ -0,0 +1,171 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+var (
+	errInvalidOrigin     = errors.New("invalid origin")
+	errInvalidHeaderName = errors.New("invalid header name")
+)
+
+func createCORSPolicy(name string, routeName string, ruleIdx *int32) *nkgAPI.CORSPolicy {
+	return &nkgAPI.CORSPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: nkgAPI.CORSPolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kindHTTPRoute,
+				Name:  v1alpha2.ObjectName(routeName),
+			},
+			RuleIndex:        ruleIdx,
+			AllowOrigins:     []string{"https://example.com", "https://*.example.com"},
+			AllowMethods:     []nkgAPI.CORSMethod{"GET", "PUT"},
+			AllowHeaders:     []string{"Content-Type"},
+			ExposeHeaders:    []string{"X-Request-ID"},
+			AllowCredentials: helpers.GetPointer(true),
+			MaxAge:           helpers.GetPointer[int32](600),
+		},
+	}
+}
+
+func TestValidateCORSPolicy(t *testing.T) {
+	createPolicy := func(mutate func(spec *nkgAPI.CORSPolicySpec)) *nkgAPI.CORSPolicy {
+		p := createCORSPolicy("policy", "hr", nil)
+
+		if mutate != nil {
+			mutate(&p.Spec)
+		}
+
+		return p
+	}
+
+	tests := []struct {
+		policy            *nkgAPI.CORSPolicy
+		name              string
+		expectErrCount    int
+		invalidOrigin     bool
+		invalidHeaderName bool
+	}{
+		{
+			policy: createPolicy(nil),
+			name:   "valid",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.CORSPolicySpec) {
+				spec.AllowOrigins = []string{"*"}
+				spec.AllowCredentials = nil
+				spec.AllowHeaders = []string{"*"}
+			}),
+			name: "valid with any origin and header",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.CORSPolicySpec) {
+				spec.TargetRef.Namespace = helpers.GetPointer[v1alpha2.Namespace]("other")
+			}),
+			expectErrCount: 1,
+			name:           "target in another namespace",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.CORSPolicySpec) {
+				spec.RuleIndex = helpers.GetPointer[int32](-1)
+			}),
+			expectErrCount: 1,
+			name:           "negative rule index",
+		},
+		{
+			policy:         createPolicy(nil),
+			invalidOrigin:  true,
+			expectErrCount: 2,
+			name:           "invalid origins",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.CORSPolicySpec) {
+				spec.AllowOrigins = []string{"https://example.com", "*"}
+			}),
+			expectErrCount: 1,
+			name:           "credentials with any origin",
+		},
+		{
+			policy:            createPolicy(nil),
+			invalidHeaderName: true,
+			expectErrCount:    2,
+			name:              "invalid header names",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.CORSPolicySpec) {
+				spec.ExposeHeaders = []string{"*"}
+			}),
+			expectErrCount: 1,
+			name:           "expose any header",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{}
+			if test.invalidOrigin {
+				validator.ValidateCORSOriginReturns(errInvalidOrigin)
+			}
+			if test.invalidHeaderName {
+				validator.ValidateCORSHeaderNameReturns(errInvalidHeaderName)
+			}
+
+			err := validateCORSPolicy(validator, test.policy)
+
+			if test.expectErrCount == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.(interface{ Errors() []error }).Errors()).To(HaveLen(test.expectErrCount))
+			}
+		})
+	}
+}
+
+func TestAddCORSToRules(t *testing.T) {
+	routePolicy := createCORSPolicy("route", "hr", nil)
+	rulePolicy := createCORSPolicy("rule", "hr", helpers.GetPointer[int32](1))
+	invalidPolicy := createCORSPolicy("invalid", "hr2", nil)
+
+	policies := map[types.NamespacedName]*CORSPolicy{
+		{Namespace: "test", Name: "route"}:   {Source: routePolicy, Valid: true},
+		{Namespace: "test", Name: "rule"}:    {Source: rulePolicy, Valid: true},
+		{Namespace: "test", Name: "invalid"}: {Source: invalidPolicy},
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}: {
+			Rules: []Rule{{}, {}},
+		},
+		{Namespace: "test", Name: "hr2"}: {
+			Rules: []Rule{{}},
+		},
+	}
+
+	addCORSToRules(routes, policies)
+
+	g := NewWithT(t)
+
+	rules := routes[types.NamespacedName{Namespace: "test", Name: "hr"}].Rules
+	g.Expect(rules[0].CORS).To(Equal(&routePolicy.Spec))
+	g.Expect(rules[1].CORS).To(Equal(&rulePolicy.Spec))
+
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr2"}].Rules[0].CORS).To(BeNil())
+}
@@ -0,0 +1,225 @@
+package graph
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// DirectResponseFilter represents a DirectResponseFilter resource that the rules of HTTPRoutes reference.
+type DirectResponseFilter struct {
+	// Source is the source resource of the filter.
+	Source *nkgAPI.DirectResponseFilter
+	// Body is the body of the response from the ConfigMap.
+	// It is nil if the filter is invalid or doesn't set a body.
+	Body []byte
+	// Valid indicates whether the filter is valid and its ConfigMap is resolved.
+	Valid bool
+}
+
+// getDirectResponseConfigMapRef returns the namespaced name of the ConfigMap with the body of the filter.
+// It returns false if the filter doesn't set a body.
+func getDirectResponseConfigMapRef(filter *nkgAPI.DirectResponseFilter) (types.NamespacedName, bool) {
+	if filter.Spec.Body == nil {
+		return types.NamespacedName{}, false
+	}
+
+	return types.NamespacedName{Namespace: filter.Namespace, Name: filter.Spec.Body.ConfigMapRef.Name}, true
+}
+
+// directResponseFilterResolver resolves the DirectResponseFilters, including their ConfigMaps.
+// All resolved filters are saved to be used later.
+type directResponseFilterResolver struct {
+	validator       validation.HTTPFieldsValidator
+	clusterFilters  map[types.NamespacedName]*nkgAPI.DirectResponseFilter
+	configMaps      map[types.NamespacedName]*v1.ConfigMap
+	resolvedFilters map[types.NamespacedName]*DirectResponseFilter
+	errs            map[types.NamespacedName]error
+}
+
+func newDirectResponseFilterResolver(
+	filters map[types.NamespacedName]*nkgAPI.DirectResponseFilter,
+	configMaps map[types.NamespacedName]*v1.ConfigMap,
+	validator validation.HTTPFieldsValidator,
+) *directResponseFilterResolver {
+	return &directResponseFilterResolver{
+		validator:       validator,
+		clusterFilters:  filters,
+		configMaps:      configMaps,
+		resolvedFilters: make(map[types.NamespacedName]*DirectResponseFilter),
+		errs:            make(map[types.NamespacedName]error),
+	}
+}
+
+// resolve returns the filter or an error if the filter doesn't exist or is invalid.
+func (r *directResponseFilterResolver) resolve(nsname types.NamespacedName) (*DirectResponseFilter, error) {
+	if err, resolved := r.errs[nsname]; resolved {
+		return r.resolvedFilters[nsname], err
+	}
+
+	source, exists := r.clusterFilters[nsname]
+	if !exists {
+		err := errors.New("DirectResponseFilter does not exist")
+		r.errs[nsname] = err
+		return nil, err
+	}
+
+	filter := &DirectResponseFilter{
+		Source: source,
+	}
+	r.resolvedFilters[nsname] = filter
+
+	err := r.validate(source.Spec)
+	if err == nil {
+		filter.Body, err = r.getBody(source)
+	}
+
+	r.errs[nsname] = err
+	filter.Valid = err == nil
+
+	if !filter.Valid {
+		filter.Body = nil
+	}
+
+	return filter, err
+}
+
+func (r *directResponseFilterResolver) validate(spec nkgAPI.DirectResponseFilterSpec) error {
+	if err := r.validator.ValidateDirectResponseStatusCode(spec.StatusCode); err != nil {
+		return fmt.Errorf("status code is invalid: %w", err)
+	}
+
+	if spec.ContentType != nil {
+		if err := r.validator.ValidateResponseHeaderValue(*spec.ContentType); err != nil {
+			return fmt.Errorf("content type is invalid: %w", err)
+		}
+	}
+
+	for _, h := range spec.Headers {
+		if strings.EqualFold(h.Name, "Content-Type") {
+			return errors.New("header Content-Type must be set with contentType")
+		}
+		if err := r.validator.ValidateResponseHeaderName(h.Name); err != nil {
+			return fmt.Errorf("header name %q is invalid: %w", h.Name, err)
+		}
+		if err := r.validator.ValidateResponseHeaderValue(h.Value); err != nil {
+			return fmt.Errorf("value of header %q is invalid: %w", h.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *directResponseFilterResolver) getBody(filter *nkgAPI.DirectResponseFilter) ([]byte, error) {
+	nsname, exists := getDirectResponseConfigMapRef(filter)
+	if !exists {
+		return nil, nil
+	}
+
+	configMap, exists := r.configMaps[nsname]
+	if !exists {
+		return nil, fmt.Errorf("configmap %s does not exist", nsname)
+	}
+
+	key := filter.Spec.Body.Key
+
+	body, exists := configMap.Data[key]
+	if !exists {
+		return nil, fmt.Errorf("%s %s does not have the %s key", kindConfigMap, nsname, key)
+	}
+
+	if err := r.validator.ValidateDirectResponseBody(body); err != nil {
+		return nil, fmt.Errorf("the body in %s %s is invalid: %w", kindConfigMap, nsname, err)
+	}
+
+	return []byte(body), nil
+}
+
+func (r *directResponseFilterResolver) getResolvedFilters() map[types.NamespacedName]*DirectResponseFilter {
+	if len(r.resolvedFilters) == 0 {
+		return nil
+	}
+
+	return r.resolvedFilters
+}
+
+// addDirectResponseFiltersToRoutes resolves the DirectResponseFilters that the rules of the routes reference in
+// ExtensionRef filters. If a filter doesn't exist or is invalid, or the rule also has a RequestRedirect filter,
+// the filters of the rule become invalid, so that the data plane responds with 500, and the route gets a condition.
+// The routes are modified in place.
+func addDirectResponseFiltersToRoutes(
+	routes map[types.NamespacedName]*Route,
+	resolver *directResponseFilterResolver,
+) {
+	for _, r := range routes {
+		if !r.Valid {
+			continue
+		}
+
+		for ruleIdx, rule := range r.Source.Spec.Rules {
+			if !r.Rules[ruleIdx].ValidMatches || !r.Rules[ruleIdx].ValidFilters {
+				continue
+			}
+
+			var hasRedirect bool
+			for _, f := range rule.Filters {
+				if f.Type == v1beta1.HTTPRouteFilterRequestRedirect {
+					hasRedirect = true
+				}
+			}
+
+			for filterIdx, f := range rule.Filters {
+				if !isExtensionRefFilterOfKind(f, nkgAPI.DirectResponseFilterKind) {
+					continue
+				}
+
+				nsname := types.NamespacedName{Namespace: r.Source.Namespace, Name: string(f.ExtensionRef.Name)}
+				path := field.NewPath("spec").Child("rules").Index(ruleIdx).
+					Child("filters").Index(filterIdx).Child("extensionRef")
+
+				var valErr *field.Error
+
+				if hasRedirect {
+					valErr = field.Forbidden(path, "DirectResponseFilter cannot be used with the RequestRedirect filter")
+				} else {
+					filter, err := resolver.resolve(nsname)
+					if err != nil {
+						if filter == nil {
+							valErr = field.NotFound(path, nsname.Name)
+						} else {
+							valErr = field.Invalid(path, nsname.Name, err.Error())
+						}
+					} else if r.Rules[ruleIdx].DirectResponse == nil {
+						// using the first filter
+						r.Rules[ruleIdx].DirectResponse = filter
+					}
+				}
+
+				if valErr != nil {
+					r.Rules[ruleIdx].ValidFilters = false
+					r.Rules[ruleIdx].DirectResponse = nil
+					r.Conditions = append(r.Conditions, staticConds.NewRouteInvalidFilter(valErr.Error()))
+
+					break
+				}
+			}
+		}
+	}
+}
+
+// isExtensionRefFilterOfKind returns true if the filter is an ExtensionRef filter that references the kind of NKG.
+func isExtensionRefFilterOfKind(filter v1beta1.HTTPRouteFilter, kind string) bool {
+	return filter.Type == v1beta1.HTTPRouteFilterExtensionRef &&
+		filter.ExtensionRef != nil &&
+		filter.ExtensionRef.Group == nkgAPI.GroupName &&
+		string(filter.ExtensionRef.Kind) == kind
+}
@@ -0,0 +1,312 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+func createDirectResponseFilter(name string, body *nkgAPI.DirectResponseBody) *nkgAPI.DirectResponseFilter {
+	return &nkgAPI.DirectResponseFilter{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: nkgAPI.DirectResponseFilterSpec{
+			StatusCode:  503,
+			Body:        body,
+			ContentType: helpers.GetPointer("text/html"),
+			Headers: []nkgAPI.ResponseHeader{
+				{Name: "Retry-After", Value: "120"},
+			},
+		},
+	}
+}
+
+func createRouteWithDirectResponseFilter(filterName string, otherFilters ...v1beta1.HTTPRouteFilter) *Route {
+	hr := createHTTPRoute("hr", "gateway", "example.com", "/")
+	for _, f := range otherFilters {
+		addFilterToPath(hr, "/", f)
+	}
+	addFilterToPath(hr, "/", v1beta1.HTTPRouteFilter{
+		Type: v1beta1.HTTPRouteFilterExtensionRef,
+		ExtensionRef: &v1beta1.LocalObjectReference{
+			Group: nkgAPI.GroupName,
+			Kind:  nkgAPI.DirectResponseFilterKind,
+			Name:  v1beta1.ObjectName(filterName),
+		},
+	})
+
+	return &Route{
+		Source: hr,
+		Valid:  true,
+		Rules: []Rule{
+			{
+				ValidMatches: true,
+				ValidFilters: true,
+			},
+		},
+	}
+}
+
+func TestAddDirectResponseFiltersToRoutes(t *testing.T) {
+	maintenanceConfigMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "maintenance"},
+		Data: map[string]string{
+			"index.html": "<p>Under maintenance</p>",
+		},
+	}
+
+	configMaps := map[types.NamespacedName]*v1.ConfigMap{
+		{Namespace: "test", Name: "maintenance"}: maintenanceConfigMap,
+	}
+
+	withBody := createDirectResponseFilter("with-body", &nkgAPI.DirectResponseBody{
+		ConfigMapRef: nkgAPI.LocalObjectReference{Name: "maintenance"},
+		Key:          "index.html",
+	})
+	withoutBody := createDirectResponseFilter("without-body", nil)
+	missingConfigMap := createDirectResponseFilter("missing-configmap", &nkgAPI.DirectResponseBody{
+		ConfigMapRef: nkgAPI.LocalObjectReference{Name: "missing"},
+		Key:          "index.html",
+	})
+	missingKey := createDirectResponseFilter("missing-key", &nkgAPI.DirectResponseBody{
+		ConfigMapRef: nkgAPI.LocalObjectReference{Name: "maintenance"},
+		Key:          "missing.html",
+	})
+	contentTypeHeader := createDirectResponseFilter("content-type-header", nil)
+	contentTypeHeader.Spec.Headers = []nkgAPI.ResponseHeader{{Name: "content-type", Value: "text/plain"}}
+
+	filters := map[types.NamespacedName]*nkgAPI.DirectResponseFilter{
+		{Namespace: "test", Name: "with-body"}:           withBody,
+		{Namespace: "test", Name: "without-body"}:        withoutBody,
+		{Namespace: "test", Name: "missing-configmap"}:   missingConfigMap,
+		{Namespace: "test", Name: "missing-key"}:         missingKey,
+		{Namespace: "test", Name: "content-type-header"}: contentTypeHeader,
+	}
+
+	tests := []struct {
+		validator       *validationfakes.FakeHTTPFieldsValidator
+		route           *Route
+		expectedFilter  *DirectResponseFilter
+		name            string
+		expectedConds   []conditions.Condition
+		expectedValid   bool
+		expectedFilters int
+	}{
+		{
+			route: createRouteWithDirectResponseFilter("with-body"),
+			expectedFilter: &DirectResponseFilter{
+				Source: withBody,
+				Body:   []byte("<p>Under maintenance</p>"),
+				Valid:  true,
+			},
+			expectedValid:   true,
+			expectedFilters: 1,
+			name:            "body from a ConfigMap",
+		},
+		{
+			route: createRouteWithDirectResponseFilter("without-body"),
+			expectedFilter: &DirectResponseFilter{
+				Source: withoutBody,
+				Valid:  true,
+			},
+			expectedValid:   true,
+			expectedFilters: 1,
+			name:            "no body",
+		},
+		{
+			route: createRouteWithDirectResponseFilter(
+				"with-body",
+				v1beta1.HTTPRouteFilter{
+					Type: v1beta1.HTTPRouteFilterExtensionRef,
+					ExtensionRef: &v1beta1.LocalObjectReference{
+						Group: nkgAPI.GroupName,
+						Kind:  nkgAPI.AuthenticationFilterKind,
+						Name:  "auth",
+					},
+				},
+			),
+			expectedFilter: &DirectResponseFilter{
+				Source: withBody,
+				Body:   []byte("<p>Under maintenance</p>"),
+				Valid:  true,
+			},
+			expectedValid:   true,
+			expectedFilters: 1,
+			name:            "AuthenticationFilter is ignored",
+		},
+		{
+			route: createRouteWithDirectResponseFilter("not-found"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Not found: "not-found"`,
+				),
+			},
+			name: "filter not found",
+		},
+		{
+			route: createRouteWithDirectResponseFilter("missing-configmap"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Invalid value: "missing-configmap": ` +
+						`configmap test/missing does not exist`,
+				),
+			},
+			expectedFilters: 1,
+			name:            "ConfigMap not found",
+		},
+		{
+			route: createRouteWithDirectResponseFilter("missing-key"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Invalid value: "missing-key": ` +
+						`ConfigMap test/maintenance does not have the missing.html key`,
+				),
+			},
+			expectedFilters: 1,
+			name:            "ConfigMap without the key",
+		},
+		{
+			route: createRouteWithDirectResponseFilter("content-type-header"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Invalid value: "content-type-header": ` +
+						`header Content-Type must be set with contentType`,
+				),
+			},
+			expectedFilters: 1,
+			name:            "Content-Type header",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := &validationfakes.FakeHTTPFieldsValidator{}
+				v.ValidateDirectResponseStatusCodeReturns(errors.New("invalid status code"))
+				return v
+			}(),
+			route: createRouteWithDirectResponseFilter("without-body"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Invalid value: "without-body": ` +
+						`status code is invalid: invalid status code`,
+				),
+			},
+			expectedFilters: 1,
+			name:            "invalid status code",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := &validationfakes.FakeHTTPFieldsValidator{}
+				v.ValidateResponseHeaderNameReturns(errors.New("invalid header"))
+				return v
+			}(),
+			route: createRouteWithDirectResponseFilter("without-body"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Invalid value: "without-body": ` +
+						`header name "Retry-After" is invalid: invalid header`,
+				),
+			},
+			expectedFilters: 1,
+			name:            "invalid header name",
+		},
+		{
+			validator: func() *validationfakes.FakeHTTPFieldsValidator {
+				v := &validationfakes.FakeHTTPFieldsValidator{}
+				v.ValidateDirectResponseBodyReturns(errors.New("invalid body"))
+				return v
+			}(),
+			route: createRouteWithDirectResponseFilter("with-body"),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[0].extensionRef: Invalid value: "with-body": ` +
+						`the body in ConfigMap test/maintenance is invalid: invalid body`,
+				),
+			},
+			expectedFilters: 1,
+			name:            "invalid body",
+		},
+		{
+			route: createRouteWithDirectResponseFilter(
+				"with-body",
+				v1beta1.HTTPRouteFilter{
+					Type:            v1beta1.HTTPRouteFilterRequestRedirect,
+					RequestRedirect: &v1beta1.HTTPRequestRedirectFilter{},
+				},
+			),
+			expectedConds: []conditions.Condition{
+				staticConds.NewRouteInvalidFilter(
+					`spec.rules[0].filters[1].extensionRef: Forbidden: ` +
+						`DirectResponseFilter cannot be used with the RequestRedirect filter`,
+				),
+			},
+			name: "RequestRedirect filter",
+		},
+		{
+			route: func() *Route {
+				r := createRouteWithDirectResponseFilter("with-body")
+				r.Rules[0].ValidFilters = false
+				return r
+			}(),
+			name: "rule with invalid filters is skipped",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := test.validator
+			if validator == nil {
+				validator = &validationfakes.FakeHTTPFieldsValidator{}
+			}
+
+			routes := map[types.NamespacedName]*Route{
+				{Namespace: "test", Name: "hr"}: test.route,
+			}
+
+			resolver := newDirectResponseFilterResolver(filters, configMaps, validator)
+			addDirectResponseFiltersToRoutes(routes, resolver)
+
+			g.Expect(test.route.Rules[0].DirectResponse).To(Equal(test.expectedFilter))
+			g.Expect(test.route.Rules[0].ValidFilters).To(Equal(test.expectedValid))
+			g.Expect(test.route.Conditions).To(Equal(test.expectedConds))
+			g.Expect(resolver.getResolvedFilters()).To(HaveLen(test.expectedFilters))
+		})
+	}
+}
+
+func TestIsReferencedByDirectResponseFilters(t *testing.T) {
+	graph := &Graph{
+		DirectResponseFilters: map[types.NamespacedName]*DirectResponseFilter{
+			{Namespace: "test", Name: "with-body"}: {
+				Source: createDirectResponseFilter("with-body", &nkgAPI.DirectResponseBody{
+					ConfigMapRef: nkgAPI.LocalObjectReference{Name: "maintenance"},
+					Key:          "index.html",
+				}),
+			},
+			{Namespace: "test", Name: "without-body"}: {
+				Source: createDirectResponseFilter("without-body", nil),
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	nsname := types.NamespacedName{Namespace: "test", Name: "maintenance"}
+	g.Expect(graph.IsReferenced(&v1.ConfigMap{}, nsname)).To(BeTrue())
+	g.Expect(graph.IsReferenced(&v1.Secret{}, nsname)).To(BeFalse())
+	g.Expect(graph.IsReferenced(&v1.ConfigMap{}, types.NamespacedName{Namespace: "other", Name: "maintenance"})).
+		To(BeFalse())
+}
@@ -0,0 +1,203 @@
+package graph
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// ErrorPagePolicy represents an ErrorPagePolicy resource.
+type ErrorPagePolicy = Policy[*nkgAPI.ErrorPagePolicy]
+
+// ErrorPages holds the error pages of the ErrorPagePolicy that targets a Gateway or an HTTPRoute.
+type ErrorPages struct {
+	// Bodies are the bodies of the responses of the pages from the ConfigMaps by the index of the page.
+	// The pages with redirects don't have bodies.
+	Bodies map[int][]byte
+	// Policy is the namespaced name of the ErrorPagePolicy.
+	Policy types.NamespacedName
+	// Pages are the error pages of the ErrorPagePolicy.
+	Pages []nkgAPI.ErrorPage
+}
+
+func processErrorPagePolicies(
+	policies map[types.NamespacedName]*nkgAPI.ErrorPagePolicy,
+	validator validation.PolicyValidator,
+	configMaps map[types.NamespacedName]*v1.ConfigMap,
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+) map[types.NamespacedName]*ErrorPagePolicy {
+	targets := policyTargets{gw: gw, routes: routes}
+
+	processed := processPolicies(policies, policyKind[*nkgAPI.ErrorPagePolicy, policyTarget]{
+		name:       nkgAPI.ErrorPagePolicyKind,
+		sameTarget: "resource",
+		validate: func(policy *nkgAPI.ErrorPagePolicy) error {
+			return validateErrorPagePolicy(validator, policy)
+		},
+		getTarget:  getErrorPagePolicyTarget,
+		findTarget: targets.find,
+	})
+
+	validateErrorPageConfigMaps(processed, configMaps)
+
+	return processed
+}
+
+func getErrorPagePolicyTarget(policy *nkgAPI.ErrorPagePolicy) policyTarget {
+	return getPolicyTarget(policy, nil)
+}
+
+// getErrorPageConfigMapRefs returns the namespaced names of the ConfigMaps that the error pages of the policy
+// reference.
+func getErrorPageConfigMapRefs(policy *nkgAPI.ErrorPagePolicy) []types.NamespacedName {
+	var refs []types.NamespacedName
+
+	for _, page := range policy.Spec.ErrorPages {
+		if page.Response != nil {
+			refs = append(refs, types.NamespacedName{
+				Namespace: policy.Namespace,
+				Name:      page.Response.ConfigMapRef.Name,
+			})
+		}
+	}
+
+	return refs
+}
+
+func validateErrorPagePolicy(
+	validator validation.PolicyValidator,
+	policy *nkgAPI.ErrorPagePolicy,
+) error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicyTargetRef(policy, specPath.Child("targetRef"), gatewayGroupKind, httpRouteGroupKind)
+
+	codes := make(map[nkgAPI.ErrorStatusCode]struct{})
+
+	for i, page := range policy.Spec.ErrorPages {
+		pagePath := specPath.Child("errorPages").Index(i)
+
+		for j, code := range page.Codes {
+			if _, exists := codes[code]; exists {
+				allErrs = append(allErrs, field.Duplicate(pagePath.Child("codes").Index(j), code))
+			}
+			codes[code] = struct{}{}
+		}
+
+		switch {
+		case page.Response != nil && page.Redirect != nil:
+			allErrs = append(allErrs, field.Invalid(pagePath, page, "only one of response and redirect can be set"))
+		case page.Response != nil:
+			if ct := page.Response.ContentType; ct != nil {
+				if err := validator.ValidateContentType(*ct); err != nil {
+					allErrs = append(allErrs, field.Invalid(pagePath.Child("response", "contentType"), *ct, err.Error()))
+				}
+			}
+		case page.Redirect != nil:
+			if err := validator.ValidateRedirectURL(page.Redirect.URL); err != nil {
+				urlPath := pagePath.Child("redirect", "url")
+				allErrs = append(allErrs, field.Invalid(urlPath, page.Redirect.URL, err.Error()))
+			}
+		default:
+			allErrs = append(allErrs, field.Required(pagePath, "one of response and redirect must be set"))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// validateErrorPageConfigMaps invalidates the valid policies that reference a ConfigMap that doesn't exist or
+// doesn't have the key of the body.
+func validateErrorPageConfigMaps(
+	policies map[types.NamespacedName]*ErrorPagePolicy,
+	configMaps map[types.NamespacedName]*v1.ConfigMap,
+) {
+	for _, p := range policies {
+		if !p.Valid {
+			continue
+		}
+
+		for i, page := range p.Source.Spec.ErrorPages {
+			if page.Response == nil {
+				continue
+			}
+
+			nsname := types.NamespacedName{Namespace: p.Source.Namespace, Name: page.Response.ConfigMapRef.Name}
+			path := field.NewPath("spec", "errorPages").Index(i).Child("response")
+
+			var err *field.Error
+
+			if cm, exists := configMaps[nsname]; !exists {
+				err = field.NotFound(path.Child("configMapRef"), nsname.String())
+			} else if _, exists := cm.Data[page.Response.Key]; !exists {
+				msg := fmt.Sprintf("ConfigMap %s does not have the key", nsname)
+				err = field.Invalid(path.Child("key"), page.Response.Key, msg)
+			}
+
+			if err != nil {
+				p.Valid = false
+				p.Conditions = append(p.Conditions, staticConds.NewPolicyInvalid(err.Error()))
+
+				break
+			}
+		}
+	}
+}
+
+// addErrorPagesToGatewayAndRoutes adds the error pages of the ErrorPagePolicies, including the bodies of the
+// responses from the ConfigMaps, to the Gateway and the routes.
+// The Gateway and the routes are modified in place.
+func addErrorPagesToGatewayAndRoutes(
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+	policies map[types.NamespacedName]*ErrorPagePolicy,
+	configMaps map[types.NamespacedName]*v1.ConfigMap,
+) {
+	attached := newAttachedPolicies(policies, getErrorPagePolicyTarget)
+	if len(attached) == 0 {
+		return
+	}
+
+	if gw != nil {
+		if p := attached.forGateway(gw); p != nil {
+			gw.ErrorPages = newErrorPages(p.Source, configMaps)
+		}
+	}
+
+	for routeNsName, r := range routes {
+		if p := attached.forRoute(routeNsName); p != nil {
+			r.ErrorPages = newErrorPages(p.Source, configMaps)
+		}
+	}
+}
+
+// newErrorPages creates the ErrorPages of a valid policy. validateErrorPageConfigMaps ensures that the ConfigMaps
+// and their keys exist.
+func newErrorPages(
+	policy *nkgAPI.ErrorPagePolicy,
+	configMaps map[types.NamespacedName]*v1.ConfigMap,
+) *ErrorPages {
+	pages := &ErrorPages{
+		Policy: types.NamespacedName{Namespace: policy.Namespace, Name: policy.Name},
+		Pages:  policy.Spec.ErrorPages,
+		Bodies: make(map[int][]byte),
+	}
+
+	for i, page := range policy.Spec.ErrorPages {
+		if page.Response == nil {
+			continue
+		}
+
+		nsname := types.NamespacedName{Namespace: policy.Namespace, Name: page.Response.ConfigMapRef.Name}
+		pages.Bodies[i] = []byte(configMaps[nsname].Data[page.Response.Key])
+	}
+
+	return pages
+}
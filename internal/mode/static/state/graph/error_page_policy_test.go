@@ -0,0 +1,271 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+func createErrorPagePolicy(
+	name string,
+	kind v1alpha2.Kind,
+	targetName string,
+) *nkgAPI.ErrorPagePolicy {
+	return &nkgAPI.ErrorPagePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: nkgAPI.ErrorPagePolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kind,
+				Name:  v1alpha2.ObjectName(targetName),
+			},
+			ErrorPages: []nkgAPI.ErrorPage{
+				{
+					Codes: []nkgAPI.ErrorStatusCode{404},
+					Response: &nkgAPI.ErrorPageResponse{
+						ConfigMapRef: nkgAPI.LocalObjectReference{Name: "pages"},
+						Key:          "not-found.html",
+						ContentType:  helpers.GetStringPointer("text/html; charset=utf-8"),
+					},
+				},
+				{
+					Codes: []nkgAPI.ErrorStatusCode{502, 503},
+					Redirect: &nkgAPI.ErrorPageRedirect{
+						URL: "https://status.example.com",
+					},
+				},
+			},
+		},
+	}
+}
+
+var errorPagesConfigMap = &v1.ConfigMap{
+	ObjectMeta: metav1.ObjectMeta{
+		Namespace: "test",
+		Name:      "pages",
+	},
+	Data: map[string]string{
+		"not-found.html": "<h1>Not Found</h1>",
+	},
+}
+
+func TestProcessErrorPagePolicies(t *testing.T) {
+	gatewayPolicy := createErrorPagePolicy("gateway", kindGateway, "gateway")
+
+	configMapNotFoundPolicy := createErrorPagePolicy("configmap-not-found", kindHTTPRoute, "hr")
+	configMapNotFoundPolicy.Spec.ErrorPages[0].Response.ConfigMapRef.Name = "not-found"
+
+	keyNotFoundPolicy := createErrorPagePolicy("key-not-found", kindHTTPRoute, "hr2")
+	keyNotFoundPolicy.Spec.ErrorPages[0].Response.Key = "other.html"
+
+	gw := &Gateway{
+		Source: &v1beta1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+		},
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}:  {},
+		{Namespace: "test", Name: "hr2"}: {},
+	}
+
+	policies := map[types.NamespacedName]*nkgAPI.ErrorPagePolicy{
+		{Namespace: "test", Name: "gateway"}:             gatewayPolicy,
+		{Namespace: "test", Name: "configmap-not-found"}: configMapNotFoundPolicy,
+		{Namespace: "test", Name: "key-not-found"}:       keyNotFoundPolicy,
+	}
+
+	configMaps := map[types.NamespacedName]*v1.ConfigMap{
+		{Namespace: "test", Name: "pages"}: errorPagesConfigMap,
+	}
+
+	expected := map[types.NamespacedName]*ErrorPagePolicy{
+		{Namespace: "test", Name: "gateway"}: {
+			Source: gatewayPolicy,
+			Valid:  true,
+		},
+		{Namespace: "test", Name: "configmap-not-found"}: {
+			Source: configMapNotFoundPolicy,
+			Conditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(`spec.errorPages[0].response.configMapRef: Not found: "test/not-found"`),
+			},
+		},
+		{Namespace: "test", Name: "key-not-found"}: {
+			Source: keyNotFoundPolicy,
+			Conditions: []conditions.Condition{
+				staticConds.NewPolicyInvalid(
+					`spec.errorPages[0].response.key: Invalid value: "other.html": ` +
+						`ConfigMap test/pages does not have the key`,
+				),
+			},
+		},
+	}
+
+	validator := &validationfakes.FakePolicyValidator{}
+
+	g := NewWithT(t)
+
+	result := processErrorPagePolicies(policies, validator, configMaps, gw, routes)
+	g.Expect(helpers.Diff(expected, result)).To(BeEmpty())
+}
+
+func TestValidateErrorPagePolicy(t *testing.T) {
+	createPolicy := func(mutate func(spec *nkgAPI.ErrorPagePolicySpec)) *nkgAPI.ErrorPagePolicy {
+		p := createErrorPagePolicy("policy", kindHTTPRoute, "hr")
+
+		if mutate != nil {
+			mutate(&p.Spec)
+		}
+
+		return p
+	}
+
+	tests := []struct {
+		policy             *nkgAPI.ErrorPagePolicy
+		name               string
+		expectErrCount     int
+		invalidContentType bool
+		invalidURL         bool
+	}{
+		{
+			policy: createPolicy(nil),
+			name:   "valid",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.ErrorPagePolicySpec) {
+				spec.TargetRef.Namespace = helpers.GetPointer[v1alpha2.Namespace]("other")
+			}),
+			expectErrCount: 1,
+			name:           "target in another namespace",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.ErrorPagePolicySpec) {
+				spec.ErrorPages[1].Codes = []nkgAPI.ErrorStatusCode{503, 404, 503}
+			}),
+			expectErrCount: 2,
+			name:           "duplicate codes",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.ErrorPagePolicySpec) {
+				spec.ErrorPages[0].Redirect = spec.ErrorPages[1].Redirect
+				spec.ErrorPages[1].Redirect = nil
+			}),
+			expectErrCount: 2,
+			name:           "both and none of response and redirect",
+		},
+		{
+			policy:             createPolicy(nil),
+			invalidContentType: true,
+			expectErrCount:     1,
+			name:               "invalid content type",
+		},
+		{
+			policy:         createPolicy(nil),
+			invalidURL:     true,
+			expectErrCount: 1,
+			name:           "invalid redirect URL",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{}
+			if test.invalidContentType {
+				validator.ValidateContentTypeReturns(errors.New("invalid content type"))
+			}
+			if test.invalidURL {
+				validator.ValidateRedirectURLReturns(errors.New("invalid URL"))
+			}
+
+			err := validateErrorPagePolicy(validator, test.policy)
+
+			if test.expectErrCount == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.(interface{ Errors() []error }).Errors()).To(HaveLen(test.expectErrCount))
+			}
+		})
+	}
+}
+
+func TestAddErrorPagesToGatewayAndRoutes(t *testing.T) {
+	gatewayPolicy := createErrorPagePolicy("gateway", kindGateway, "gateway")
+	routePolicy := createErrorPagePolicy("route", kindHTTPRoute, "hr")
+	invalidPolicy := createErrorPagePolicy("invalid", kindHTTPRoute, "hr2")
+
+	policies := map[types.NamespacedName]*ErrorPagePolicy{
+		{Namespace: "test", Name: "gateway"}: {Source: gatewayPolicy, Valid: true},
+		{Namespace: "test", Name: "route"}:   {Source: routePolicy, Valid: true},
+		{Namespace: "test", Name: "invalid"}: {Source: invalidPolicy},
+	}
+
+	configMaps := map[types.NamespacedName]*v1.ConfigMap{
+		{Namespace: "test", Name: "pages"}: errorPagesConfigMap,
+	}
+
+	gw := &Gateway{
+		Source: &v1beta1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+		},
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}:  {},
+		{Namespace: "test", Name: "hr2"}: {},
+	}
+
+	addErrorPagesToGatewayAndRoutes(gw, routes, policies, configMaps)
+
+	g := NewWithT(t)
+
+	g.Expect(gw.ErrorPages).To(Equal(&ErrorPages{
+		Policy: types.NamespacedName{Namespace: "test", Name: "gateway"},
+		Pages:  gatewayPolicy.Spec.ErrorPages,
+		Bodies: map[int][]byte{0: []byte("<h1>Not Found</h1>")},
+	}))
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr"}].ErrorPages).To(Equal(&ErrorPages{
+		Policy: types.NamespacedName{Namespace: "test", Name: "route"},
+		Pages:  routePolicy.Spec.ErrorPages,
+		Bodies: map[int][]byte{0: []byte("<h1>Not Found</h1>")},
+	}))
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr2"}].ErrorPages).To(BeNil())
+}
+
+func TestIsReferencedByErrorPagePolicies(t *testing.T) {
+	graph := &Graph{
+		ErrorPagePolicies: map[types.NamespacedName]*ErrorPagePolicy{
+			{Namespace: "test", Name: "policy"}: {
+				Source: createErrorPagePolicy("policy", kindGateway, "gateway"),
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(graph.IsReferenced(&v1.ConfigMap{}, types.NamespacedName{Namespace: "test", Name: "pages"})).To(BeTrue())
+	g.Expect(graph.IsReferenced(&v1.ConfigMap{}, types.NamespacedName{Namespace: "other", Name: "pages"})).To(BeFalse())
+}
@@ -8,6 +8,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
 	nkgsort "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/sort"
 	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
@@ -19,6 +20,24 @@ type Gateway struct {
 	Source *v1beta1.Gateway
 	// Listeners include the listeners of the Gateway.
 	Listeners map[string]*Listener
+	// ClientSettings is the spec of the ClientSettingsPolicy that targets the Gateway.
+	// If nil, no ClientSettingsPolicy targets it.
+	ClientSettings *nkgAPI.ClientSettingsPolicySpec
+	// Compression is the gzip configuration of the CompressionPolicy that targets the Gateway.
+	// If nil, no CompressionPolicy targets it.
+	Compression *nkgAPI.Gzip
+	// ErrorPages are the error pages of the ErrorPagePolicy that targets the Gateway.
+	// If nil, no ErrorPagePolicy targets it.
+	ErrorPages *ErrorPages
+	// Snippets are the snippets of the SnippetsPolicy that targets the Gateway.
+	// If nil, no SnippetsPolicy targets it.
+	Snippets *Snippets
+	// Tracing is the tracing configuration of the ObservabilityPolicy that targets the Gateway.
+	// If nil, no ObservabilityPolicy with tracing targets it, and tracing is disabled.
+	Tracing *nkgAPI.Tracing
+	// AccessLog is the access log configuration of the ObservabilityPolicy that targets the Gateway.
+	// If nil, no ObservabilityPolicy with access logs targets it, and the NGINX defaults are used.
+	AccessLog *nkgAPI.AccessLog
 	// Conditions holds the conditions for the Gateway.
 	Conditions []conditions.Condition
 	// Valid indicates whether the Gateway Spec is valid.
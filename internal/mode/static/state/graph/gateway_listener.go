@@ -24,6 +24,10 @@ type Listener struct {
 	Routes map[types.NamespacedName]*Route
 	// AllowedRouteLabelSelector is the label selector for this Listener's allowed routes, if defined.
 	AllowedRouteLabelSelector labels.Selector
+	// RateLimit is the RateLimitPolicy that applies to the Listener. If nil, no RateLimitPolicy applies.
+	RateLimit *RateLimitPolicy
+	// AccessControl is the AccessControlPolicy that applies to the Listener. If nil, no AccessControlPolicy applies.
+	AccessControl *AccessControlPolicy
 	// ResolvedSecret is the namespaced name of the Secret resolved for this listener.
 	// Only applicable for HTTPS listeners.
 	ResolvedSecret *types.NamespacedName
@@ -53,6 +57,34 @@ func buildListeners(
 	return listeners
 }
 
+// ValidateListeners validates the listeners of the Gateway the same way NKG validates them when it builds the Graph.
+// Unlike the Graph, it doesn't check conflicts between the listeners and doesn't resolve the TLS Secrets.
+// The Gateway must pass the Gateway API webhook validation first.
+func ValidateListeners(gw *v1beta1.Gateway) field.ErrorList {
+	var allErrs field.ErrorList
+
+	listenerFactory := newListenerConfiguratorFactory(gw, nil, nil)
+	listenersPath := field.NewPath("spec").Child("listeners")
+
+	for i, gl := range gw.Spec.Listeners {
+		conds, _ := listenerFactory.getConfiguratorForListener(gl).validate(gl)
+
+		// a validator returns multiple conditions with the same message
+		seen := make(map[string]struct{})
+
+		for _, cond := range conds {
+			if _, exists := seen[cond.Message]; exists {
+				continue
+			}
+			seen[cond.Message] = struct{}{}
+
+			allErrs = append(allErrs, field.Invalid(listenersPath.Index(i), gl.Name, cond.Message))
+		}
+	}
+
+	return allErrs
+}
+
 type listenerConfiguratorFactory struct {
 	http, https, unsupportedProtocol *listenerConfigurator
 }
@@ -143,7 +175,8 @@ type listenerConfigurator struct {
 	externalReferenceResolvers []listenerExternalReferenceResolver
 }
 
-func (c *listenerConfigurator) configure(listener v1beta1.Listener) *Listener {
+// validate runs the validators and builds the allowed route label selector of the listener.
+func (c *listenerConfigurator) validate(listener v1beta1.Listener) ([]conditions.Condition, labels.Selector) {
 	var conds []conditions.Condition
 
 	// validators might return different conditions, so we run them all.
@@ -161,6 +194,12 @@ func (c *listenerConfigurator) configure(listener v1beta1.Listener) *Listener {
 		}
 	}
 
+	return conds, allowedRouteSelector
+}
+
+func (c *listenerConfigurator) configure(listener v1beta1.Listener) *Listener {
+	conds, allowedRouteSelector := c.validate(listener)
+
 	supportedKinds := getListenerSupportedKinds(listener)
 
 	if len(conds) > 0 {
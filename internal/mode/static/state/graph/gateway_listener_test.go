@@ -401,3 +401,57 @@ func TestValidateListenerPort(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateListeners(t *testing.T) {
+	gw := &v1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gateway",
+		},
+		Spec: v1beta1.GatewaySpec{
+			Listeners: []v1beta1.Listener{
+				{
+					Name:     "valid",
+					Port:     80,
+					Protocol: v1beta1.HTTPProtocolType,
+					Hostname: helpers.GetPointer[v1beta1.Hostname]("foo.example.com"),
+				},
+				{
+					Name:     "invalid-hostname",
+					Port:     80,
+					Protocol: v1beta1.HTTPProtocolType,
+					Hostname: helpers.GetPointer[v1beta1.Hostname]("$example.com"),
+				},
+				{
+					Name:     "unsupported-protocol",
+					Port:     53,
+					Protocol: v1beta1.UDPProtocolType,
+				},
+				{
+					Name:     "conflicting-but-valid",
+					Port:     80,
+					Protocol: v1beta1.HTTPSProtocolType,
+					TLS: &v1beta1.GatewayTLSConfig{
+						Mode: helpers.GetPointer(v1beta1.TLSModeTerminate),
+						CertificateRefs: []v1beta1.SecretObjectReference{
+							{
+								Name: "missing-secret",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	allErrs := ValidateListeners(gw)
+	g.Expect(allErrs).To(HaveLen(2))
+
+	g.Expect(allErrs[0].Field).To(Equal("spec.listeners[1]"))
+	g.Expect(allErrs[0].Detail).To(ContainSubstring("hostname: Invalid value"))
+
+	g.Expect(allErrs[1].Field).To(Equal("spec.listeners[2]"))
+	g.Expect(allErrs[1].Detail).To(ContainSubstring(`protocol: Unsupported value: "UDP"`))
+}
@@ -7,7 +7,6 @@ import (
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
-	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
 )
 
 // GatewayClass represents the GatewayClass resource.
@@ -65,7 +64,7 @@ func buildGatewayClass(gc *v1beta1.GatewayClass) *GatewayClass {
 
 	valErr := validateGatewayClass(gc)
 	if valErr != nil {
-		conds = append(conds, staticConds.NewGatewayClassInvalidParameters(valErr.Error()))
+		conds = append(conds, conditions.NewGatewayClassInvalidParameters(valErr.Error()))
 	}
 
 	return &GatewayClass{
@@ -76,7 +75,8 @@ func buildGatewayClass(gc *v1beta1.GatewayClass) *GatewayClass {
 }
 
 func validateGatewayClass(gc *v1beta1.GatewayClass) error {
-	if gc.Spec.ParametersRef != nil {
+	// A ConfigMap holds the parameters of the provisioner, which are not used in the static mode.
+	if ref := gc.Spec.ParametersRef; ref != nil && (ref.Group != "" || ref.Kind != "ConfigMap") {
 		path := field.NewPath("spec").Child("parametersRef")
 		return field.Forbidden(path, "parametersRef is not supported")
 	}
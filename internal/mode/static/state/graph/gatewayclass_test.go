@@ -11,7 +11,6 @@ import (
 
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
-	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
 )
 
 func TestProcessGatewayClasses(t *testing.T) {
@@ -121,6 +120,16 @@ func TestProcessGatewayClasses(t *testing.T) {
 func TestBuildGatewayClass(t *testing.T) {
 	validGC := &v1beta1.GatewayClass{}
 
+	gcWithProvisionerParams := &v1beta1.GatewayClass{
+		Spec: v1beta1.GatewayClassSpec{
+			ParametersRef: &v1beta1.ParametersReference{
+				Kind:      "ConfigMap",
+				Name:      "params",
+				Namespace: helpers.GetPointer[v1beta1.Namespace]("nginx-gateway"),
+			},
+		},
+	}
+
 	invalidGC := &v1beta1.GatewayClass{
 		Spec: v1beta1.GatewayClassSpec{
 			ParametersRef: &v1beta1.ParametersReference{},
@@ -140,6 +149,14 @@ func TestBuildGatewayClass(t *testing.T) {
 			},
 			name: "valid gatewayclass",
 		},
+		{
+			gc: gcWithProvisionerParams,
+			expected: &GatewayClass{
+				Source: gcWithProvisionerParams,
+				Valid:  true,
+			},
+			name: "valid gatewayclass with provisioner parameters",
+		},
 		{
 			gc:       nil,
 			expected: nil,
@@ -151,7 +168,7 @@ func TestBuildGatewayClass(t *testing.T) {
 				Source: invalidGC,
 				Valid:  false,
 				Conditions: []conditions.Condition{
-					staticConds.NewGatewayClassInvalidParameters(
+					conditions.NewGatewayClassInvalidParameters(
 						"spec.parametersRef: Forbidden: parametersRef is not supported",
 					),
 				},
@@ -6,6 +6,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
 )
 
@@ -18,6 +19,38 @@ type ClusterState struct {
 	Namespaces      map[types.NamespacedName]*v1.Namespace
 	ReferenceGrants map[types.NamespacedName]*v1beta1.ReferenceGrant
 	Secrets         map[types.NamespacedName]*v1.Secret
+	// ConfigMaps holds the ConfigMap resources.
+	ConfigMaps map[types.NamespacedName]*v1.ConfigMap
+	// UpstreamSettingsPolicies holds the UpstreamSettingsPolicy resources.
+	UpstreamSettingsPolicies map[types.NamespacedName]*nkgAPI.UpstreamSettingsPolicy
+	// UpstreamHealthPolicies holds the UpstreamHealthPolicy resources.
+	UpstreamHealthPolicies map[types.NamespacedName]*nkgAPI.UpstreamHealthPolicy
+	// RetryPolicies holds the RetryPolicy resources.
+	RetryPolicies map[types.NamespacedName]*nkgAPI.RetryPolicy
+	// RateLimitPolicies holds the RateLimitPolicy resources.
+	RateLimitPolicies map[types.NamespacedName]*nkgAPI.RateLimitPolicy
+	// ClientSettingsPolicies holds the ClientSettingsPolicy resources.
+	ClientSettingsPolicies map[types.NamespacedName]*nkgAPI.ClientSettingsPolicy
+	// ObservabilityPolicies holds the ObservabilityPolicy resources.
+	ObservabilityPolicies map[types.NamespacedName]*nkgAPI.ObservabilityPolicy
+	// CORSPolicies holds the CORSPolicy resources.
+	CORSPolicies map[types.NamespacedName]*nkgAPI.CORSPolicy
+	// AccessControlPolicies holds the AccessControlPolicy resources.
+	AccessControlPolicies map[types.NamespacedName]*nkgAPI.AccessControlPolicy
+	// CachePolicies holds the CachePolicy resources.
+	CachePolicies map[types.NamespacedName]*nkgAPI.CachePolicy
+	// CompressionPolicies holds the CompressionPolicy resources.
+	CompressionPolicies map[types.NamespacedName]*nkgAPI.CompressionPolicy
+	// ErrorPagePolicies holds the ErrorPagePolicy resources.
+	ErrorPagePolicies map[types.NamespacedName]*nkgAPI.ErrorPagePolicy
+	// SnippetsPolicies holds the SnippetsPolicy resources.
+	SnippetsPolicies map[types.NamespacedName]*nkgAPI.SnippetsPolicy
+	// TimeoutPolicies holds the TimeoutPolicy resources.
+	TimeoutPolicies map[types.NamespacedName]*nkgAPI.TimeoutPolicy
+	// AuthenticationFilters holds the AuthenticationFilter resources.
+	AuthenticationFilters map[types.NamespacedName]*nkgAPI.AuthenticationFilter
+	// DirectResponseFilters holds the DirectResponseFilter resources.
+	DirectResponseFilters map[types.NamespacedName]*nkgAPI.DirectResponseFilter
 }
 
 // Graph is a Graph-like representation of Gateway API resources.
@@ -41,24 +74,117 @@ type Graph struct {
 	// in the cluster. We need such entries so that we can query the Graph to determine if a Secret is referenced
 	// by the Gateway, including the case when the Secret is newly created.
 	ReferencedSecrets map[types.NamespacedName]*Secret
+	// UpstreamSettingsPolicies holds UpstreamSettingsPolicy resources, including invalid ones.
+	UpstreamSettingsPolicies map[types.NamespacedName]*UpstreamSettingsPolicy
+	// UpstreamHealthPolicies holds UpstreamHealthPolicy resources, including invalid ones.
+	UpstreamHealthPolicies map[types.NamespacedName]*UpstreamHealthPolicy
+	// RetryPolicies holds RetryPolicy resources, including invalid ones.
+	RetryPolicies map[types.NamespacedName]*RetryPolicy
+	// RateLimitPolicies holds RateLimitPolicy resources, including invalid ones.
+	RateLimitPolicies map[types.NamespacedName]*RateLimitPolicy
+	// ClientSettingsPolicies holds ClientSettingsPolicy resources, including invalid ones.
+	ClientSettingsPolicies map[types.NamespacedName]*ClientSettingsPolicy
+	// ObservabilityPolicies holds ObservabilityPolicy resources, including invalid ones.
+	ObservabilityPolicies map[types.NamespacedName]*ObservabilityPolicy
+	// CORSPolicies holds CORSPolicy resources, including invalid ones.
+	CORSPolicies map[types.NamespacedName]*CORSPolicy
+	// AccessControlPolicies holds AccessControlPolicy resources, including invalid ones.
+	AccessControlPolicies map[types.NamespacedName]*AccessControlPolicy
+	// CachePolicies holds CachePolicy resources, including invalid ones.
+	CachePolicies map[types.NamespacedName]*CachePolicy
+	// CompressionPolicies holds CompressionPolicy resources, including invalid ones.
+	CompressionPolicies map[types.NamespacedName]*CompressionPolicy
+	// ErrorPagePolicies holds ErrorPagePolicy resources, including invalid ones.
+	ErrorPagePolicies map[types.NamespacedName]*ErrorPagePolicy
+	// SnippetsPolicies holds SnippetsPolicy resources, including invalid ones.
+	SnippetsPolicies map[types.NamespacedName]*SnippetsPolicy
+	// TimeoutPolicies holds TimeoutPolicy resources, including invalid ones.
+	TimeoutPolicies map[types.NamespacedName]*TimeoutPolicy
+	// AuthenticationFilters holds the AuthenticationFilter resources that the HTTPRoutes reference,
+	// including invalid ones.
+	AuthenticationFilters map[types.NamespacedName]*AuthenticationFilter
+	// DirectResponseFilters holds the DirectResponseFilter resources that the HTTPRoutes reference,
+	// including invalid ones.
+	DirectResponseFilters map[types.NamespacedName]*DirectResponseFilter
 }
 
 // IsReferenced returns true if the Graph references the resource.
 func (g *Graph) IsReferenced(resourceType client.Object, nsname types.NamespacedName) bool {
-	// FIMXE(pleshakov): For now, only works with Secrets.
+	// FIMXE(pleshakov): For now, only works with Secrets, ConfigMaps and Services targeted by policies.
 	// Support EndpointSlices and Namespaces so that we can remove relationship.Capturer and use the Graph
 	// as source to determine the relationships.
 	// See https://github.com/nginxinc/nginx-kubernetes-gateway/issues/824
 
 	switch resourceType.(type) {
 	case *v1.Secret:
-		_, exists := g.ReferencedSecrets[nsname]
-		return exists
+		if _, exists := g.ReferencedSecrets[nsname]; exists {
+			return true
+		}
+		return g.authenticationFiltersReference(kindSecret, nsname)
+	case *v1.ConfigMap:
+		return g.authenticationFiltersReference(kindConfigMap, nsname) ||
+			g.errorPagePoliciesReference(nsname) ||
+			g.directResponseFiltersReference(nsname)
+	case *v1.Service:
+		// A policy that targets a Service that doesn't exist yet must be re-processed when the Service is created.
+		svcTarget := policyTarget{kind: kindService, nsname: nsname}
+
+		for _, p := range g.UpstreamSettingsPolicies {
+			if getUpstreamSettingsPolicyTarget(p.Source) == svcTarget {
+				return true
+			}
+		}
+		for _, p := range g.UpstreamHealthPolicies {
+			if getUpstreamHealthPolicyTarget(p.Source) == svcTarget {
+				return true
+			}
+		}
+		return false
 	default:
 		return false
 	}
 }
 
+// authenticationFiltersReference returns true if one of the AuthenticationFilters references the Secret or
+// the ConfigMap, including the one that doesn't exist yet.
+func (g *Graph) authenticationFiltersReference(kind string, nsname types.NamespacedName) bool {
+	ref := authenticationDataRef{kind: kind, nsname: nsname}
+
+	for _, f := range g.AuthenticationFilters {
+		if getAuthenticationDataRef(f.Source) == ref {
+			return true
+		}
+	}
+
+	return false
+}
+
+// errorPagePoliciesReference returns true if one of the ErrorPagePolicies references the ConfigMap, including
+// the one that doesn't exist yet.
+func (g *Graph) errorPagePoliciesReference(nsname types.NamespacedName) bool {
+	for _, p := range g.ErrorPagePolicies {
+		for _, ref := range getErrorPageConfigMapRefs(p.Source) {
+			if ref == nsname {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// directResponseFiltersReference returns true if one of the DirectResponseFilters references the ConfigMap,
+// including the one that doesn't exist yet.
+func (g *Graph) directResponseFiltersReference(nsname types.NamespacedName) bool {
+	for _, f := range g.DirectResponseFilters {
+		if ref, exists := getDirectResponseConfigMapRef(f.Source); exists && ref == nsname {
+			return true
+		}
+	}
+
+	return false
+}
+
 // BuildGraph builds a Graph from a state.
 func BuildGraph(
 	state ClusterState,
@@ -82,8 +208,99 @@ func BuildGraph(
 
 	routes := buildRoutesForGateways(validators.HTTPFieldsValidator, state.HTTPRoutes, processedGws.GetAllNsNames())
 	bindRoutesToListeners(routes, gw, state.Namespaces)
+
+	authFilterResolver := newAuthenticationFilterResolver(
+		state.AuthenticationFilters,
+		state.Secrets,
+		state.ConfigMaps,
+		state.Services,
+		validators.HTTPFieldsValidator,
+	)
+	addAuthenticationFiltersToRoutes(routes, authFilterResolver)
+
+	directResponseFilterResolver := newDirectResponseFilterResolver(
+		state.DirectResponseFilters,
+		state.ConfigMaps,
+		validators.HTTPFieldsValidator,
+	)
+	addDirectResponseFiltersToRoutes(routes, directResponseFilterResolver)
+
 	addBackendRefsToRouteRules(routes, refGrantResolver, state.Services)
 
+	upstreamSettingsPolicies := processUpstreamSettingsPolicies(
+		state.UpstreamSettingsPolicies,
+		validators.PolicyValidator,
+		state.Services,
+		routes,
+	)
+	addUpstreamSettingsToBackendRefs(routes, upstreamSettingsPolicies)
+
+	upstreamHealthPolicies := processUpstreamHealthPolicies(
+		state.UpstreamHealthPolicies,
+		validators.PolicyValidator,
+		state.Services,
+	)
+	addUpstreamHealthToBackendRefs(routes, upstreamHealthPolicies)
+
+	retryPolicies := processRetryPolicies(state.RetryPolicies, validators.PolicyValidator, routes)
+	addRetryToRules(routes, retryPolicies)
+
+	timeoutPolicies := processTimeoutPolicies(state.TimeoutPolicies, validators.PolicyValidator, routes)
+	addTimeoutsToRules(routes, timeoutPolicies)
+
+	rateLimitPolicies := processRateLimitPolicies(state.RateLimitPolicies, validators.PolicyValidator, gw, routes)
+	addRateLimitToListenersAndRoutes(gw, routes, rateLimitPolicies)
+
+	clientSettingsPolicies := processClientSettingsPolicies(
+		state.ClientSettingsPolicies,
+		validators.PolicyValidator,
+		gw,
+		routes,
+	)
+	addClientSettingsToGatewayAndRoutes(gw, routes, clientSettingsPolicies)
+
+	observabilityPolicies := processObservabilityPolicies(
+		state.ObservabilityPolicies,
+		validators.PolicyValidator,
+		gw,
+		routes,
+	)
+	addObservabilityToGatewayAndRoutes(gw, routes, observabilityPolicies)
+
+	corsPolicies := processCORSPolicies(state.CORSPolicies, validators.PolicyValidator, routes)
+	addCORSToRules(routes, corsPolicies)
+
+	accessControlPolicies := processAccessControlPolicies(
+		state.AccessControlPolicies,
+		validators.PolicyValidator,
+		gw,
+		routes,
+	)
+	addAccessControlToListenersAndRoutes(gw, routes, accessControlPolicies)
+
+	cachePolicies := processCachePolicies(state.CachePolicies, validators.PolicyValidator, gw, routes)
+	addCacheToRules(routes, cachePolicies)
+
+	compressionPolicies := processCompressionPolicies(
+		state.CompressionPolicies,
+		validators.PolicyValidator,
+		gw,
+		routes,
+	)
+	addCompressionToGatewayAndRoutes(gw, routes, compressionPolicies)
+
+	errorPagePolicies := processErrorPagePolicies(
+		state.ErrorPagePolicies,
+		validators.PolicyValidator,
+		state.ConfigMaps,
+		gw,
+		routes,
+	)
+	addErrorPagesToGatewayAndRoutes(gw, routes, errorPagePolicies, state.ConfigMaps)
+
+	snippetsPolicies := processSnippetsPolicies(state.SnippetsPolicies, validators.SnippetsValidator, gw, routes)
+	addSnippetsToGatewayAndRoutes(gw, routes, snippetsPolicies)
+
 	g := &Graph{
 		GatewayClass:          gc,
 		Gateway:               gw,
@@ -91,6 +308,22 @@ func BuildGraph(
 		IgnoredGatewayClasses: processedGwClasses.Ignored,
 		IgnoredGateways:       processedGws.Ignored,
 		ReferencedSecrets:     secretResolver.getResolvedSecrets(),
+
+		UpstreamSettingsPolicies: upstreamSettingsPolicies,
+		UpstreamHealthPolicies:   upstreamHealthPolicies,
+		RetryPolicies:            retryPolicies,
+		RateLimitPolicies:        rateLimitPolicies,
+		ClientSettingsPolicies:   clientSettingsPolicies,
+		ObservabilityPolicies:    observabilityPolicies,
+		CORSPolicies:             corsPolicies,
+		AccessControlPolicies:    accessControlPolicies,
+		CachePolicies:            cachePolicies,
+		CompressionPolicies:      compressionPolicies,
+		ErrorPagePolicies:        errorPagePolicies,
+		SnippetsPolicies:         snippetsPolicies,
+		TimeoutPolicies:          timeoutPolicies,
+		AuthenticationFilters:    authFilterResolver.getResolvedFilters(),
+		DirectResponseFilters:    directResponseFilterResolver.getResolvedFilters(),
 	}
 
 	return g
@@ -12,6 +12,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
 	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
@@ -21,6 +22,20 @@ const wildcardHostname = "~^"
 
 // Rule represents a rule of an HTTPRoute.
 type Rule struct {
+	// Retry is the spec of the RetryPolicy that applies to the rule. If nil, no RetryPolicy applies.
+	Retry *nkgAPI.RetryPolicySpec
+	// Timeouts is the spec of the TimeoutPolicy that applies to the rule. If nil, no TimeoutPolicy applies.
+	Timeouts *nkgAPI.TimeoutPolicySpec
+	// CORS is the spec of the CORSPolicy that applies to the rule. If nil, no CORSPolicy applies.
+	CORS *nkgAPI.CORSPolicySpec
+	// Cache is the cache settings of the CachePolicy that applies to the rule. If nil, no CachePolicy applies.
+	Cache *nkgAPI.CacheSettings
+	// Authentication is the AuthenticationFilter that the rule references in an ExtensionRef filter.
+	// If nil, the rule doesn't reference any.
+	Authentication *AuthenticationFilter
+	// DirectResponse is the DirectResponseFilter that the rule references in an ExtensionRef filter.
+	// If nil, the rule doesn't reference any.
+	DirectResponse *DirectResponseFilter
 	// BackendRefs is a list of BackendRefs for the rule.
 	BackendRefs []BackendRef
 	// ValidMatches indicates whether the matches of the rule are valid.
@@ -60,6 +75,28 @@ type Route struct {
 	Source *v1beta1.HTTPRoute
 	// ParentRefs includes ParentRefs with NKG Gateways only.
 	ParentRefs []ParentRef
+	// RateLimit is the RateLimitPolicy that targets the HTTPRoute. If nil, no RateLimitPolicy targets it.
+	RateLimit *RateLimitPolicy
+	// AccessControl is the AccessControlPolicy that targets the HTTPRoute. If nil, no AccessControlPolicy targets it.
+	AccessControl *AccessControlPolicy
+	// ClientSettings is the spec of the ClientSettingsPolicy that targets the HTTPRoute.
+	// If nil, no ClientSettingsPolicy targets it.
+	ClientSettings *nkgAPI.ClientSettingsPolicySpec
+	// Compression is the gzip configuration of the CompressionPolicy that targets the HTTPRoute.
+	// If nil, no CompressionPolicy targets it.
+	Compression *nkgAPI.Gzip
+	// ErrorPages are the error pages of the ErrorPagePolicy that targets the HTTPRoute.
+	// If nil, no ErrorPagePolicy targets it.
+	ErrorPages *ErrorPages
+	// Snippets are the snippets of the SnippetsPolicy that targets the HTTPRoute.
+	// If nil, no SnippetsPolicy targets it.
+	Snippets *Snippets
+	// Tracing is the tracing configuration of the ObservabilityPolicy that targets the HTTPRoute.
+	// If nil, no ObservabilityPolicy with tracing targets it.
+	Tracing *nkgAPI.Tracing
+	// AccessLog is the access log configuration that takes effect for the HTTPRoute. It combines the configuration
+	// of the ObservabilityPolicies that target the HTTPRoute and the Gateway. If nil, neither configures access logs.
+	AccessLog *nkgAPI.AccessLog
 	// Conditions include Conditions for the HTTPRoute.
 	Conditions []conditions.Condition
 	// Rules include Rules for the HTTPRoute. Each Rule[i] corresponds to the ith HTTPRouteRule.
@@ -198,19 +235,7 @@ func buildRoute(
 	for i, rule := range ghr.Spec.Rules {
 		rulePath := field.NewPath("spec").Child("rules").Index(i)
 
-		var matchesErrs field.ErrorList
-		for j, match := range rule.Matches {
-			matchPath := rulePath.Child("matches").Index(j)
-			matchesErrs = append(matchesErrs, validateMatch(validator, match, matchPath)...)
-		}
-
-		var filtersErrs field.ErrorList
-		for j, filter := range rule.Filters {
-			filterPath := rulePath.Child("filters").Index(j)
-			filtersErrs = append(filtersErrs, validateFilter(validator, filter, filterPath)...)
-		}
-
-		// rule.BackendRefs are validated separately because of their special requirements
+		matchesErrs, filtersErrs := validateRule(validator, rule, rulePath)
 
 		var allErrs field.ErrorList
 		allErrs = append(allErrs, matchesErrs...)
@@ -517,17 +542,63 @@ func getSectionName(s *v1beta1.SectionName) string {
 	return string(*s)
 }
 
+// ValidateHTTPRoute validates the fields of the HTTPRoute that NKG validates when it builds the Graph:
+// the hostnames, the matches and the filters of the rules.
+// Unlike the Graph, it doesn't validate the parentRefs and the backendRefs, because their validity depends on
+// other resources.
+// The HTTPRoute must pass the Gateway API webhook validation first.
+func ValidateHTTPRoute(validator validation.HTTPFieldsValidator, hr *v1beta1.HTTPRoute) field.ErrorList {
+	var allErrs field.ErrorList
+
+	allErrs = append(allErrs, validateHostnameList(hr.Spec.Hostnames, field.NewPath("spec").Child("hostnames"))...)
+
+	for i, rule := range hr.Spec.Rules {
+		rulePath := field.NewPath("spec").Child("rules").Index(i)
+
+		matchesErrs, filtersErrs := validateRule(validator, rule, rulePath)
+		allErrs = append(allErrs, matchesErrs...)
+		allErrs = append(allErrs, filtersErrs...)
+	}
+
+	return allErrs
+}
+
+func validateRule(
+	validator validation.HTTPFieldsValidator,
+	rule v1beta1.HTTPRouteRule,
+	rulePath *field.Path,
+) (matchesErrs, filtersErrs field.ErrorList) {
+	for j, match := range rule.Matches {
+		matchPath := rulePath.Child("matches").Index(j)
+		matchesErrs = append(matchesErrs, validateMatch(validator, match, matchPath)...)
+	}
+
+	for j, filter := range rule.Filters {
+		filterPath := rulePath.Child("filters").Index(j)
+		filtersErrs = append(filtersErrs, validateFilter(validator, filter, filterPath)...)
+	}
+
+	filtersErrs = append(filtersErrs, validateAuthenticationFilterCombination(rule.Filters, rulePath)...)
+
+	// rule.BackendRefs are validated separately because of their special requirements
+
+	return matchesErrs, filtersErrs
+}
+
 func validateHostnames(hostnames []v1beta1.Hostname, path *field.Path) error {
+	return validateHostnameList(hostnames, path).ToAggregate()
+}
+
+func validateHostnameList(hostnames []v1beta1.Hostname, path *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
 	for i := range hostnames {
 		if err := validateHostname(string(hostnames[i])); err != nil {
 			allErrs = append(allErrs, field.Invalid(path.Index(i), hostnames[i], err.Error()))
-			continue
 		}
 	}
 
-	return allErrs.ToAggregate()
+	return allErrs
 }
 
 func validateMatch(
@@ -675,6 +746,8 @@ func validateFilter(
 		return validateFilterRedirect(validator, filter, filterPath)
 	case v1beta1.HTTPRouteFilterRequestHeaderModifier:
 		return validateFilterHeaderModifier(validator, filter, filterPath)
+	case v1beta1.HTTPRouteFilterExtensionRef:
+		return validateFilterExtensionRef(filter, filterPath)
 	default:
 		valErr := field.NotSupported(
 			filterPath.Child("type"),
@@ -682,6 +755,7 @@ func validateFilter(
 			[]string{
 				string(v1beta1.HTTPRouteFilterRequestRedirect),
 				string(v1beta1.HTTPRouteFilterRequestHeaderModifier),
+				string(v1beta1.HTTPRouteFilterExtensionRef),
 			},
 		)
 		allErrs = append(allErrs, valErr)
@@ -689,6 +763,44 @@ func validateFilter(
 	}
 }
 
+// validateAuthenticationFilterCombination validates that a rule with an AuthenticationFilter doesn't have
+// a DirectResponseFilter or a RequestRedirect filter. NGINX responds to such a rule before the authentication,
+// so the response would be served without the authentication.
+func validateAuthenticationFilterCombination(
+	filters []v1beta1.HTTPRouteFilter,
+	rulePath *field.Path,
+) field.ErrorList {
+	hasAuthentication := false
+
+	for _, filter := range filters {
+		if isExtensionRefFilterOfKind(filter, nkgAPI.AuthenticationFilterKind) {
+			hasAuthentication = true
+			break
+		}
+	}
+
+	if !hasAuthentication {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+
+	for i, filter := range filters {
+		if filter.Type != v1beta1.HTTPRouteFilterRequestRedirect &&
+			!isExtensionRefFilterOfKind(filter, nkgAPI.DirectResponseFilterKind) {
+			continue
+		}
+
+		valErr := field.Forbidden(
+			rulePath.Child("filters").Index(i),
+			"cannot be used with an AuthenticationFilter, because NGINX responds before the authentication",
+		)
+		allErrs = append(allErrs, valErr)
+	}
+
+	return allErrs
+}
+
 func validateFilterRedirect(
 	validator validation.HTTPFieldsValidator,
 	filter v1beta1.HTTPRouteFilter,
@@ -740,6 +852,32 @@ func validateFilterRedirect(
 	return allErrs
 }
 
+// validateFilterExtensionRef validates that the ExtensionRef filter references a supported kind.
+// The referenced resource is resolved after the validation of the route.
+func validateFilterExtensionRef(filter v1beta1.HTTPRouteFilter, filterPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if filter.ExtensionRef == nil {
+		panicForBrokenWebhookAssumption(errors.New("extensionRef cannot be nil"))
+	}
+
+	if !isExtensionRefFilterOfKind(filter, nkgAPI.AuthenticationFilterKind) &&
+		!isExtensionRefFilterOfKind(filter, nkgAPI.DirectResponseFilterKind) {
+		ref := filter.ExtensionRef
+		valErr := field.NotSupported(
+			filterPath.Child("extensionRef"),
+			fmt.Sprintf("%s/%s", ref.Group, ref.Kind),
+			[]string{
+				nkgAPI.GroupName + "/" + nkgAPI.AuthenticationFilterKind,
+				nkgAPI.GroupName + "/" + nkgAPI.DirectResponseFilterKind,
+			},
+		)
+		allErrs = append(allErrs, valErr)
+	}
+
+	return allErrs
+}
+
 func validateFilterHeaderModifier(
 	validator validation.HTTPFieldsValidator,
 	filter v1beta1.HTTPRouteFilter,
@@ -14,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
 	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
@@ -358,6 +359,17 @@ func TestBuildRoute(t *testing.T) {
 	hrInvalidValidRules := createHTTPRoute("hr", gatewayNsName.Name, "example.com", invalidPath, "/filter", "/")
 	addFilterToPath(hrInvalidValidRules, "/filter", invalidFilter)
 
+	hrAuthenticationWithRedirect := createHTTPRoute("hr", gatewayNsName.Name, "example.com", "/filter")
+	addFilterToPath(hrAuthenticationWithRedirect, "/filter", v1beta1.HTTPRouteFilter{
+		Type: v1beta1.HTTPRouteFilterExtensionRef,
+		ExtensionRef: &v1beta1.LocalObjectReference{
+			Group: nkgAPI.GroupName,
+			Kind:  nkgAPI.AuthenticationFilterKind,
+			Name:  "auth",
+		},
+	})
+	addFilterToPath(hrAuthenticationWithRedirect, "/filter", validFilter)
+
 	validatorInvalidFieldsInRule := &validationfakes.FakeHTTPFieldsValidator{
 		ValidatePathInMatchStub: func(path string) error {
 			if path == invalidPath {
@@ -482,6 +494,32 @@ func TestBuildRoute(t *testing.T) {
 			},
 			name: "all rules invalid, with invalid filters",
 		},
+		{
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			hr:        hrAuthenticationWithRedirect,
+			expected: &Route{
+				Source: hrAuthenticationWithRedirect,
+				Valid:  false,
+				ParentRefs: []ParentRef{
+					{
+						Idx:     0,
+						Gateway: gatewayNsName,
+					},
+				},
+				Conditions: []conditions.Condition{
+					staticConds.NewRouteUnsupportedValue(
+						`All rules are invalid: spec.rules[0].filters[1]: Forbidden: cannot be used with ` +
+							`an AuthenticationFilter, because NGINX responds before the authentication`),
+				},
+				Rules: []Rule{
+					{
+						ValidMatches: true,
+						ValidFilters: false,
+					},
+				},
+			},
+			name: "all rules invalid, with authentication and redirect",
+		},
 		{
 			validator: validatorInvalidFieldsInRule,
 			hr:        hrInvalidValidRules,
@@ -1333,6 +1371,150 @@ func TestGetHostname(t *testing.T) {
 	}
 }
 
+func TestValidateHTTPRoute(t *testing.T) {
+	const (
+		invalidPath             = "/invalid"
+		invalidRedirectHostname = "invalid.example.com"
+	)
+
+	validator := &validationfakes.FakeHTTPFieldsValidator{
+		ValidatePathInMatchStub: func(path string) error {
+			if path == invalidPath {
+				return errors.New("invalid path")
+			}
+			return nil
+		},
+		ValidateRedirectHostnameStub: func(h string) error {
+			if h == invalidRedirectHostname {
+				return errors.New("invalid hostname")
+			}
+			return nil
+		},
+	}
+
+	invalidFilter := v1beta1.HTTPRouteFilter{
+		Type: v1beta1.HTTPRouteFilterRequestRedirect,
+		RequestRedirect: &v1beta1.HTTPRequestRedirectFilter{
+			Hostname: helpers.GetPointer[v1beta1.PreciseHostname](invalidRedirectHostname),
+		},
+	}
+
+	hrInvalidFilters := createHTTPRoute("hr", "gateway", "example.com", "/filter")
+	addFilterToPath(hrInvalidFilters, "/filter", invalidFilter)
+
+	hrInvalidEverything := createHTTPRoute("hr", "gateway", "", invalidPath, "/filter")
+	addFilterToPath(hrInvalidEverything, "/filter", invalidFilter)
+
+	tests := []struct {
+		hr             *v1beta1.HTTPRoute
+		name           string
+		expectErrCount int
+	}{
+		{
+			hr:             createHTTPRoute("hr", "gateway", "example.com", "/"),
+			expectErrCount: 0,
+			name:           "valid",
+		},
+		{
+			hr:             createHTTPRoute("hr", "gateway", "", "/"),
+			expectErrCount: 1,
+			name:           "invalid hostname",
+		},
+		{
+			hr:             createHTTPRoute("hr", "gateway", "example.com", "/", invalidPath),
+			expectErrCount: 1,
+			name:           "invalid match",
+		},
+		{
+			hr:             hrInvalidFilters,
+			expectErrCount: 1,
+			name:           "invalid filter",
+		},
+		{
+			hr:             hrInvalidEverything,
+			expectErrCount: 3,
+			name:           "invalid hostname, match and filter",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			allErrs := ValidateHTTPRoute(validator, test.hr)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+		})
+	}
+}
+
+func TestValidateAuthenticationFilterCombination(t *testing.T) {
+	authenticationFilter := v1beta1.HTTPRouteFilter{
+		Type: v1beta1.HTTPRouteFilterExtensionRef,
+		ExtensionRef: &v1beta1.LocalObjectReference{
+			Group: nkgAPI.GroupName,
+			Kind:  nkgAPI.AuthenticationFilterKind,
+			Name:  "auth",
+		},
+	}
+	directResponseFilter := v1beta1.HTTPRouteFilter{
+		Type: v1beta1.HTTPRouteFilterExtensionRef,
+		ExtensionRef: &v1beta1.LocalObjectReference{
+			Group: nkgAPI.GroupName,
+			Kind:  nkgAPI.DirectResponseFilterKind,
+			Name:  "dr",
+		},
+	}
+	redirectFilter := v1beta1.HTTPRouteFilter{
+		Type:            v1beta1.HTTPRouteFilterRequestRedirect,
+		RequestRedirect: &v1beta1.HTTPRequestRedirectFilter{},
+	}
+	headerModifierFilter := v1beta1.HTTPRouteFilter{
+		Type:                  v1beta1.HTTPRouteFilterRequestHeaderModifier,
+		RequestHeaderModifier: &v1beta1.HTTPHeaderFilter{},
+	}
+
+	tests := []struct {
+		name           string
+		filters        []v1beta1.HTTPRouteFilter
+		expectErrCount int
+	}{
+		{
+			filters:        []v1beta1.HTTPRouteFilter{authenticationFilter, headerModifierFilter},
+			expectErrCount: 0,
+			name:           "authentication",
+		},
+		{
+			filters:        []v1beta1.HTTPRouteFilter{directResponseFilter},
+			expectErrCount: 0,
+			name:           "direct response without authentication",
+		},
+		{
+			filters:        []v1beta1.HTTPRouteFilter{redirectFilter},
+			expectErrCount: 0,
+			name:           "redirect without authentication",
+		},
+		{
+			filters:        []v1beta1.HTTPRouteFilter{directResponseFilter, authenticationFilter},
+			expectErrCount: 1,
+			name:           "authentication with direct response",
+		},
+		{
+			filters:        []v1beta1.HTTPRouteFilter{authenticationFilter, redirectFilter},
+			expectErrCount: 1,
+			name:           "authentication with redirect",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			allErrs := validateAuthenticationFilterCombination(test.filters, field.NewPath("rule"))
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+		})
+	}
+}
+
 func TestValidateHostnames(t *testing.T) {
 	const validHostname = "example.com"
 
@@ -1651,6 +1833,42 @@ func TestValidateFilter(t *testing.T) {
 			expectErrCount: 0,
 			name:           "valid request header modifiers filter",
 		},
+		{
+			filter: v1beta1.HTTPRouteFilter{
+				Type: v1beta1.HTTPRouteFilterExtensionRef,
+				ExtensionRef: &v1beta1.LocalObjectReference{
+					Group: nkgAPI.GroupName,
+					Kind:  nkgAPI.AuthenticationFilterKind,
+					Name:  "auth",
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid authentication extension ref filter",
+		},
+		{
+			filter: v1beta1.HTTPRouteFilter{
+				Type: v1beta1.HTTPRouteFilterExtensionRef,
+				ExtensionRef: &v1beta1.LocalObjectReference{
+					Group: nkgAPI.GroupName,
+					Kind:  nkgAPI.DirectResponseFilterKind,
+					Name:  "maintenance",
+				},
+			},
+			expectErrCount: 0,
+			name:           "valid direct response extension ref filter",
+		},
+		{
+			filter: v1beta1.HTTPRouteFilter{
+				Type: v1beta1.HTTPRouteFilterExtensionRef,
+				ExtensionRef: &v1beta1.LocalObjectReference{
+					Group: "example.com",
+					Kind:  "Unsupported",
+					Name:  "filter",
+				},
+			},
+			expectErrCount: 1,
+			name:           "unsupported extension ref filter",
+		},
 		{
 			filter: v1beta1.HTTPRouteFilter{
 				Type: v1beta1.HTTPRouteFilterURLRewrite,
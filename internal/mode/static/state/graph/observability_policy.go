@@ -0,0 +1,259 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// ObservabilityPolicy represents an ObservabilityPolicy resource.
+type ObservabilityPolicy = Policy[*nkgAPI.ObservabilityPolicy]
+
+func processObservabilityPolicies(
+	policies map[types.NamespacedName]*nkgAPI.ObservabilityPolicy,
+	validator validation.PolicyValidator,
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+) map[types.NamespacedName]*ObservabilityPolicy {
+	targets := policyTargets{gw: gw, routes: routes}
+
+	return processPolicies(policies, policyKind[*nkgAPI.ObservabilityPolicy, policyTarget]{
+		name:       nkgAPI.ObservabilityPolicyKind,
+		sameTarget: "resource",
+		validate: func(policy *nkgAPI.ObservabilityPolicy) error {
+			return validateObservabilityPolicy(validator, policy)
+		},
+		getTarget:  getObservabilityPolicyTarget,
+		findTarget: targets.find,
+	})
+}
+
+func getObservabilityPolicyTarget(policy *nkgAPI.ObservabilityPolicy) policyTarget {
+	return getPolicyTarget(policy, nil)
+}
+
+func validateObservabilityPolicy(
+	validator validation.PolicyValidator,
+	policy *nkgAPI.ObservabilityPolicy,
+) error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicyTargetRef(policy, specPath.Child("targetRef"), gatewayGroupKind, httpRouteGroupKind)
+
+	forGateway := policy.Spec.TargetRef.Kind == kindGateway
+
+	if policy.Spec.Tracing == nil && policy.Spec.AccessLog == nil {
+		allErrs = append(allErrs, field.Required(specPath, "tracing or accessLog is required"))
+	}
+
+	if policy.Spec.Tracing != nil {
+		allErrs = append(
+			allErrs,
+			validateTracing(validator, *policy.Spec.Tracing, forGateway, specPath.Child("tracing"))...,
+		)
+	}
+
+	if policy.Spec.AccessLog != nil {
+		allErrs = append(
+			allErrs,
+			validateAccessLog(validator, *policy.Spec.AccessLog, forGateway, specPath.Child("accessLog"))...,
+		)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateTracing(
+	validator validation.PolicyValidator,
+	tracing nkgAPI.Tracing,
+	forGateway bool,
+	tracingPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	exporterPath := tracingPath.Child("exporter")
+
+	switch {
+	case forGateway && tracing.Exporter == nil:
+		allErrs = append(allErrs, field.Required(exporterPath, "required for a Gateway"))
+	case !forGateway && tracing.Exporter != nil:
+		allErrs = append(allErrs, field.Forbidden(exporterPath, "can only be set for a Gateway"))
+	case tracing.Exporter != nil:
+		allErrs = append(allErrs, validateTracingExporter(validator, *tracing.Exporter, exporterPath)...)
+	}
+
+	if tracing.ServiceName != nil {
+		serviceNamePath := tracingPath.Child("serviceName")
+
+		if !forGateway {
+			allErrs = append(allErrs, field.Forbidden(serviceNamePath, "can only be set for a Gateway"))
+		} else if err := validator.ValidateTracingServiceName(*tracing.ServiceName); err != nil {
+			allErrs = append(allErrs, field.Invalid(serviceNamePath, *tracing.ServiceName, err.Error()))
+		}
+	}
+
+	if tracing.Ratio != nil && (*tracing.Ratio < 0 || *tracing.Ratio > 100) {
+		allErrs = append(allErrs, field.Invalid(tracingPath.Child("ratio"), *tracing.Ratio, "must be between 0 and 100"))
+	}
+
+	if tracing.Context != nil {
+		contextPath := tracingPath.Child("context")
+
+		if !forGateway {
+			allErrs = append(allErrs, field.Forbidden(contextPath, "can only be set for a Gateway"))
+		} else if valid, supportedValues := validator.ValidateTraceContext(string(*tracing.Context)); !valid {
+			allErrs = append(allErrs, field.NotSupported(contextPath, *tracing.Context, supportedValues))
+		}
+	}
+
+	return allErrs
+}
+
+func validateTracingExporter(
+	validator validation.PolicyValidator,
+	exporter nkgAPI.TracingExporter,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if err := validator.ValidateTracingEndpoint(exporter.Endpoint); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("endpoint"), exporter.Endpoint, err.Error()))
+	}
+
+	allErrs = append(allErrs, validateOptionalDuration(validator, exporter.Interval, path.Child("interval"))...)
+
+	if exporter.BatchSize != nil && *exporter.BatchSize < 1 {
+		allErrs = append(allErrs, field.Invalid(path.Child("batchSize"), *exporter.BatchSize, "must be positive"))
+	}
+
+	if exporter.BatchCount != nil && *exporter.BatchCount < 1 {
+		allErrs = append(allErrs, field.Invalid(path.Child("batchCount"), *exporter.BatchCount, "must be positive"))
+	}
+
+	return allErrs
+}
+
+func validateAccessLog(
+	validator validation.PolicyValidator,
+	accessLog nkgAPI.AccessLog,
+	forGateway bool,
+	accessLogPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if accessLog.Format != nil {
+		formatPath := accessLogPath.Child("format")
+
+		if !forGateway {
+			allErrs = append(allErrs, field.Forbidden(formatPath, "can only be set for a Gateway"))
+		} else if valid, supportedValues := validator.ValidateAccessLogFormat(string(*accessLog.Format)); !valid {
+			allErrs = append(allErrs, field.NotSupported(formatPath, *accessLog.Format, supportedValues))
+		}
+	}
+
+	if accessLog.Destination != nil {
+		destinationPath := accessLogPath.Child("destination")
+
+		if !forGateway {
+			allErrs = append(allErrs, field.Forbidden(destinationPath, "can only be set for a Gateway"))
+		} else {
+			allErrs = append(
+				allErrs,
+				validateAccessLogDestination(validator, *accessLog.Destination, destinationPath)...,
+			)
+		}
+	}
+
+	return allErrs
+}
+
+func validateAccessLogDestination(
+	validator validation.PolicyValidator,
+	destination nkgAPI.AccessLogDestination,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	typePath := path.Child("type")
+	syslogPath := path.Child("syslog")
+
+	valid, supportedValues := validator.ValidateAccessLogDestinationType(string(destination.Type))
+	if !valid {
+		allErrs = append(allErrs, field.NotSupported(typePath, destination.Type, supportedValues))
+	}
+
+	isSyslog := destination.Type == nkgAPI.AccessLogDestinationSyslog
+
+	switch {
+	case isSyslog && destination.Syslog == nil:
+		allErrs = append(allErrs, field.Required(syslogPath, "required for the Syslog type"))
+	case !isSyslog && destination.Syslog != nil:
+		allErrs = append(allErrs, field.Forbidden(syslogPath, "can only be set for the Syslog type"))
+	case destination.Syslog != nil:
+		syslog := destination.Syslog
+
+		if err := validator.ValidateSyslogServer(syslog.Server); err != nil {
+			allErrs = append(allErrs, field.Invalid(syslogPath.Child("server"), syslog.Server, err.Error()))
+		}
+
+		if syslog.Tag != nil {
+			if err := validator.ValidateSyslogTag(*syslog.Tag); err != nil {
+				allErrs = append(allErrs, field.Invalid(syslogPath.Child("tag"), *syslog.Tag, err.Error()))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// addObservabilityToGatewayAndRoutes adds the tracing and the access log settings of the ObservabilityPolicies to
+// the Gateway and the routes. A policy for an HTTPRoute only overrides the tracing settings of the policy for
+// the Gateway, so they take effect only if a policy for the Gateway enables tracing. The access log settings of
+// a route are the settings that take effect for it: a policy for an HTTPRoute enables or disables the access logs
+// with the format and the destination of the policy for the Gateway; otherwise, the settings of the policy for
+// the Gateway apply. The Gateway and the routes are modified in place.
+func addObservabilityToGatewayAndRoutes(
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+	policies map[types.NamespacedName]*ObservabilityPolicy,
+) {
+	attached := newAttachedPolicies(policies, getObservabilityPolicyTarget)
+	if len(attached) == 0 || gw == nil {
+		return
+	}
+
+	if gwPolicy := attached.forGateway(gw); gwPolicy != nil {
+		gw.Tracing = gwPolicy.Source.Spec.Tracing
+		gw.AccessLog = gwPolicy.Source.Spec.AccessLog
+	}
+
+	for routeNsName, r := range routes {
+		p := attached.forRoute(routeNsName)
+
+		if p != nil && gw.Tracing != nil {
+			r.Tracing = p.Source.Spec.Tracing
+		}
+
+		r.AccessLog = getRouteAccessLog(gw.AccessLog, p)
+	}
+}
+
+// getRouteAccessLog returns the access log settings that take effect for a route.
+func getRouteAccessLog(gwAccessLog *nkgAPI.AccessLog, routePolicy *ObservabilityPolicy) *nkgAPI.AccessLog {
+	if routePolicy == nil || routePolicy.Source.Spec.AccessLog == nil {
+		return gwAccessLog
+	}
+
+	accessLog := &nkgAPI.AccessLog{
+		Enabled: routePolicy.Source.Spec.AccessLog.Enabled,
+	}
+
+	if gwAccessLog != nil {
+		accessLog.Format = gwAccessLog.Format
+		accessLog.Destination = gwAccessLog.Destination
+	}
+
+	return accessLog
+}
@@ -0,0 +1,284 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+func createObservabilityPolicy(
+	name string,
+	kind v1alpha2.Kind,
+	targetName string,
+) *nkgAPI.ObservabilityPolicy {
+	tracing := &nkgAPI.Tracing{
+		Ratio: helpers.GetPointer[int32](10),
+	}
+
+	accessLog := &nkgAPI.AccessLog{
+		Enabled: helpers.GetPointer(false),
+	}
+
+	if kind == kindGateway {
+		tracing.Exporter = &nkgAPI.TracingExporter{
+			Endpoint:   "otel-collector:4317",
+			Interval:   helpers.GetPointer[nkgAPI.Duration]("5s"),
+			BatchSize:  helpers.GetPointer[int32](512),
+			BatchCount: helpers.GetPointer[int32](4),
+		}
+		tracing.ServiceName = helpers.GetPointer("my-gateway")
+		tracing.Context = helpers.GetPointer(nkgAPI.TraceContextPropagate)
+
+		accessLog = &nkgAPI.AccessLog{
+			Format: helpers.GetPointer(nkgAPI.AccessLogFormatJSON),
+			Destination: &nkgAPI.AccessLogDestination{
+				Type: nkgAPI.AccessLogDestinationSyslog,
+				Syslog: &nkgAPI.SyslogDestination{
+					Server: "syslog:514",
+					Tag:    helpers.GetPointer("gateway"),
+				},
+			},
+		}
+	}
+
+	return &nkgAPI.ObservabilityPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: nkgAPI.ObservabilityPolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kind,
+				Name:  v1alpha2.ObjectName(targetName),
+			},
+			Tracing:   tracing,
+			AccessLog: accessLog,
+		},
+	}
+}
+
+func TestValidateObservabilityPolicy(t *testing.T) {
+	createPolicy := func(
+		kind v1alpha2.Kind,
+		mutate func(spec *nkgAPI.ObservabilityPolicySpec),
+	) *nkgAPI.ObservabilityPolicy {
+		p := createObservabilityPolicy("policy", kind, "target")
+
+		if mutate != nil {
+			mutate(&p.Spec)
+		}
+
+		return p
+	}
+
+	tests := []struct {
+		policy             *nkgAPI.ObservabilityPolicy
+		name               string
+		expectErrCount     int
+		invalidEndpoint    bool
+		invalidServiceName bool
+		invalidDuration    bool
+		unsupportedContext bool
+		unsupportedFormat  bool
+		unsupportedType    bool
+		invalidSyslog      bool
+	}{
+		{
+			policy: createPolicy(kindGateway, nil),
+			name:   "valid gateway policy",
+		},
+		{
+			policy: createPolicy(kindHTTPRoute, nil),
+			name:   "valid route policy",
+		},
+		{
+			policy: createPolicy(kindGateway, func(spec *nkgAPI.ObservabilityPolicySpec) {
+				spec.Tracing.Exporter = nil
+			}),
+			expectErrCount: 1,
+			name:           "gateway policy without exporter",
+		},
+		{
+			policy: createPolicy(kindHTTPRoute, func(spec *nkgAPI.ObservabilityPolicySpec) {
+				spec.Tracing.Exporter = &nkgAPI.TracingExporter{Endpoint: "otel-collector:4317"}
+				spec.Tracing.ServiceName = helpers.GetPointer("my-route")
+				spec.Tracing.Context = helpers.GetPointer(nkgAPI.TraceContextInject)
+			}),
+			expectErrCount: 3,
+			name:           "route policy with gateway fields",
+		},
+		{
+			policy: createPolicy(kindGateway, func(spec *nkgAPI.ObservabilityPolicySpec) {
+				spec.Tracing.Ratio = helpers.GetPointer[int32](101)
+				spec.Tracing.Exporter.BatchSize = helpers.GetPointer[int32](0)
+				spec.Tracing.Exporter.BatchCount = helpers.GetPointer[int32](-1)
+			}),
+			expectErrCount: 3,
+			name:           "out of range numbers",
+		},
+		{
+			policy:             createPolicy(kindGateway, nil),
+			invalidEndpoint:    true,
+			invalidServiceName: true,
+			invalidDuration:    true,
+			unsupportedContext: true,
+			expectErrCount:     4,
+			name:               "invalid values",
+		},
+		{
+			policy: createPolicy(kindGateway, func(spec *nkgAPI.ObservabilityPolicySpec) {
+				spec.Tracing = nil
+			}),
+			name: "gateway policy with access log only",
+		},
+		{
+			policy: createPolicy(kindHTTPRoute, func(spec *nkgAPI.ObservabilityPolicySpec) {
+				spec.AccessLog = nil
+			}),
+			name: "route policy with tracing only",
+		},
+		{
+			policy: createPolicy(kindGateway, func(spec *nkgAPI.ObservabilityPolicySpec) {
+				spec.Tracing = nil
+				spec.AccessLog = nil
+			}),
+			expectErrCount: 1,
+			name:           "neither tracing nor access log",
+		},
+		{
+			policy: createPolicy(kindHTTPRoute, func(spec *nkgAPI.ObservabilityPolicySpec) {
+				spec.AccessLog.Format = helpers.GetPointer(nkgAPI.AccessLogFormatText)
+				spec.AccessLog.Destination = &nkgAPI.AccessLogDestination{Type: nkgAPI.AccessLogDestinationStdout}
+			}),
+			expectErrCount: 2,
+			name:           "route policy with gateway access log fields",
+		},
+		{
+			policy: createPolicy(kindGateway, func(spec *nkgAPI.ObservabilityPolicySpec) {
+				spec.AccessLog.Destination.Syslog = nil
+			}),
+			expectErrCount: 1,
+			name:           "syslog destination without syslog",
+		},
+		{
+			policy: createPolicy(kindGateway, func(spec *nkgAPI.ObservabilityPolicySpec) {
+				spec.AccessLog.Destination.Type = nkgAPI.AccessLogDestinationStdout
+			}),
+			expectErrCount: 1,
+			name:           "stdout destination with syslog",
+		},
+		{
+			policy:            createPolicy(kindGateway, nil),
+			unsupportedFormat: true,
+			unsupportedType:   true,
+			invalidSyslog:     true,
+			expectErrCount:    4,
+			name:              "invalid access log values",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{}
+			validator.ValidateTraceContextReturns(!test.unsupportedContext, nil)
+			validator.ValidateAccessLogFormatReturns(!test.unsupportedFormat, nil)
+			validator.ValidateAccessLogDestinationTypeReturns(!test.unsupportedType, nil)
+			if test.invalidSyslog {
+				validator.ValidateSyslogServerReturns(errors.New("invalid server"))
+				validator.ValidateSyslogTagReturns(errors.New("invalid tag"))
+			}
+			if test.invalidEndpoint {
+				validator.ValidateTracingEndpointReturns(errors.New("invalid endpoint"))
+			}
+			if test.invalidServiceName {
+				validator.ValidateTracingServiceNameReturns(errors.New("invalid service name"))
+			}
+			if test.invalidDuration {
+				validator.ValidateDurationReturns(errInvalidDuration)
+			}
+
+			err := validateObservabilityPolicy(validator, test.policy)
+
+			if test.expectErrCount == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.(interface{ Errors() []error }).Errors()).To(HaveLen(test.expectErrCount))
+			}
+		})
+	}
+}
+
+func TestAddObservabilityToGatewayAndRoutes(t *testing.T) {
+	gatewayPolicy := createObservabilityPolicy("gateway", kindGateway, "gateway")
+	routePolicy := createObservabilityPolicy("route", kindHTTPRoute, "hr")
+	invalidPolicy := createObservabilityPolicy("invalid", kindHTTPRoute, "hr2")
+
+	policies := map[types.NamespacedName]*ObservabilityPolicy{
+		{Namespace: "test", Name: "gateway"}: {Source: gatewayPolicy, Valid: true},
+		{Namespace: "test", Name: "route"}:   {Source: routePolicy, Valid: true},
+		{Namespace: "test", Name: "invalid"}: {Source: invalidPolicy},
+	}
+
+	gw := &Gateway{
+		Source: &v1beta1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+		},
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}:  {},
+		{Namespace: "test", Name: "hr2"}: {},
+	}
+
+	hr := types.NamespacedName{Namespace: "test", Name: "hr"}
+	hr2 := types.NamespacedName{Namespace: "test", Name: "hr2"}
+
+	addObservabilityToGatewayAndRoutes(gw, routes, policies)
+
+	g := NewWithT(t)
+
+	g.Expect(gw.Tracing).To(Equal(gatewayPolicy.Spec.Tracing))
+	g.Expect(gw.AccessLog).To(Equal(gatewayPolicy.Spec.AccessLog))
+	g.Expect(routes[hr].Tracing).To(Equal(routePolicy.Spec.Tracing))
+	g.Expect(routes[hr].AccessLog).To(Equal(&nkgAPI.AccessLog{
+		Enabled:     helpers.GetPointer(false),
+		Format:      gatewayPolicy.Spec.AccessLog.Format,
+		Destination: gatewayPolicy.Spec.AccessLog.Destination,
+	}))
+	g.Expect(routes[hr2].Tracing).To(BeNil())
+	g.Expect(routes[hr2].AccessLog).To(Equal(gatewayPolicy.Spec.AccessLog))
+
+	// Without a policy for the Gateway, only the access log settings of the policies for the routes take effect.
+	delete(policies, types.NamespacedName{Namespace: "test", Name: "gateway"})
+
+	gw.Tracing = nil
+	gw.AccessLog = nil
+	for _, r := range routes {
+		r.Tracing = nil
+		r.AccessLog = nil
+	}
+
+	addObservabilityToGatewayAndRoutes(gw, routes, policies)
+
+	g.Expect(gw.Tracing).To(BeNil())
+	g.Expect(gw.AccessLog).To(BeNil())
+	g.Expect(routes[hr].Tracing).To(BeNil())
+	g.Expect(routes[hr].AccessLog).To(Equal(routePolicy.Spec.AccessLog))
+	g.Expect(routes[hr2].AccessLog).To(BeNil())
+}
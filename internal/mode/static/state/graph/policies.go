@@ -0,0 +1,417 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
+	nkgsort "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/sort"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
+)
+
+const (
+	kindGateway   = "Gateway"
+	kindHTTPRoute = "HTTPRoute"
+	kindService   = "Service"
+)
+
+var (
+	gatewayGroupKind   = schema.GroupKind{Group: v1beta1.GroupName, Kind: kindGateway}
+	httpRouteGroupKind = schema.GroupKind{Group: v1beta1.GroupName, Kind: kindHTTPRoute}
+	serviceGroupKind   = schema.GroupKind{Kind: kindService}
+)
+
+// Policy represents an NKG policy resource, which attaches to the resource that its targetRef references.
+type Policy[T nkgAPI.Policy] struct {
+	// Source is the source resource.
+	Source T
+	// Conditions include Conditions for the policy.
+	Conditions []conditions.Condition
+	// Valid indicates whether the policy is valid and attached to its target.
+	// If it is invalid, NKG should not apply it to any resources.
+	Valid bool
+}
+
+// PolicyKey identifies a policy of any kind.
+type PolicyKey struct {
+	NsName types.NamespacedName
+	// Kind is the kind of the policy. For example, RetryPolicy.
+	Kind string
+}
+
+// GetAllPolicies returns the policies of all kinds, including invalid ones.
+func (g *Graph) GetAllPolicies() map[PolicyKey]*Policy[nkgAPI.Policy] {
+	policies := make(map[PolicyKey]*Policy[nkgAPI.Policy])
+
+	addPolicies(policies, g.UpstreamSettingsPolicies)
+	addPolicies(policies, g.UpstreamHealthPolicies)
+	addPolicies(policies, g.RetryPolicies)
+	addPolicies(policies, g.RateLimitPolicies)
+	addPolicies(policies, g.ClientSettingsPolicies)
+	addPolicies(policies, g.ObservabilityPolicies)
+	addPolicies(policies, g.CORSPolicies)
+	addPolicies(policies, g.AccessControlPolicies)
+	addPolicies(policies, g.CachePolicies)
+	addPolicies(policies, g.CompressionPolicies)
+	addPolicies(policies, g.ErrorPagePolicies)
+	addPolicies(policies, g.SnippetsPolicies)
+	addPolicies(policies, g.TimeoutPolicies)
+
+	return policies
+}
+
+// addPolicies adds the policies of the kind T to the policies.
+// It panics if T is not registered in nkgAPI.PolicyKinds.
+func addPolicies[T nkgAPI.Policy](
+	policies map[PolicyKey]*Policy[nkgAPI.Policy],
+	kindPolicies map[types.NamespacedName]*Policy[T],
+) {
+	if len(kindPolicies) == 0 {
+		return
+	}
+
+	var policy T
+	kind, ok := nkgAPI.FindPolicyKind(policy)
+	if !ok {
+		panic(fmt.Errorf("unknown policy type %T", policy))
+	}
+
+	for nsname, p := range kindPolicies {
+		policies[PolicyKey{NsName: nsname, Kind: kind.Name}] = &Policy[nkgAPI.Policy]{
+			Source:     p.Source,
+			Conditions: p.Conditions,
+			Valid:      p.Valid,
+		}
+	}
+}
+
+// policyTarget identifies the target resource of a policy: a Gateway, a listener of a Gateway, an HTTPRoute or
+// a Service.
+type policyTarget struct {
+	nsname types.NamespacedName
+	kind   string
+	// sectionName is the name of the listener. It is empty unless the policy targets a listener of a Gateway.
+	sectionName string
+}
+
+// policyKind defines how to process the policies of a particular kind.
+// K is the type that identifies the target of a policy. Policies with equal targets conflict with each other.
+type policyKind[T nkgAPI.Policy, K comparable] struct {
+	// validate validates the policy.
+	validate func(policy T) error
+	// getTarget returns the target of the policy.
+	getTarget func(policy T) K
+	// findTarget returns an empty string if the target exists.
+	// Otherwise, it returns the message that explains why the target is not found.
+	findTarget func(target K) string
+	// name is the kind of the policies. For example, RateLimitPolicy.
+	name string
+	// sameTarget describes the target in the message of the Conflicted condition. For example, resource.
+	sameTarget string
+}
+
+// processPolicies validates the policies, finds their targets and resolves the conflicts between the policies
+// that target the same resource.
+func processPolicies[T nkgAPI.Policy, K comparable](
+	policies map[types.NamespacedName]T,
+	kind policyKind[T, K],
+) map[types.NamespacedName]*Policy[T] {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	processed := make(map[types.NamespacedName]*Policy[T], len(policies))
+	policiesPerTarget := make(map[K][]*Policy[T])
+
+	for nsname, p := range policies {
+		policy := &Policy[T]{
+			Source: p,
+		}
+		processed[nsname] = policy
+
+		if err := kind.validate(p); err != nil {
+			policy.Conditions = append(policy.Conditions, staticConds.NewPolicyInvalid(err.Error()))
+			continue
+		}
+
+		target := kind.getTarget(p)
+
+		if msg := kind.findTarget(target); msg != "" {
+			policy.Conditions = append(policy.Conditions, staticConds.NewPolicyTargetNotFound(msg))
+			continue
+		}
+
+		policy.Valid = true
+		policiesPerTarget[target] = append(policiesPerTarget[target], policy)
+	}
+
+	for _, targetPolicies := range policiesPerTarget {
+		resolvePolicyConflicts(targetPolicies, kind.name, kind.sameTarget)
+	}
+
+	return processed
+}
+
+// resolvePolicyConflicts keeps the oldest of the policies that target the same resource and
+// invalidates the rest. If the creation timestamps are the same, the policy that comes first in alphabetical order
+// of the namespaced name wins.
+func resolvePolicyConflicts[T nkgAPI.Policy](policies []*Policy[T], kind string, sameTarget string) {
+	if len(policies) < 2 {
+		return
+	}
+
+	sort.Slice(policies, func(i, j int) bool {
+		return nkgsort.LessObjectMeta(policyObjectMeta(policies[i].Source), policyObjectMeta(policies[j].Source))
+	})
+
+	winner := client.ObjectKeyFromObject(policies[0].Source)
+
+	for _, p := range policies[1:] {
+		msg := fmt.Sprintf("Conflicts with %s %s, which targets the same %s", kind, winner, sameTarget)
+
+		p.Valid = false
+		p.Conditions = append(p.Conditions, staticConds.NewPolicyConflicted(msg))
+	}
+}
+
+// policyObjectMeta returns the part of the ObjectMeta of the policy that conflict resolution uses.
+func policyObjectMeta(policy nkgAPI.Policy) *metav1.ObjectMeta {
+	return &metav1.ObjectMeta{
+		Namespace:         policy.GetNamespace(),
+		Name:              policy.GetName(),
+		CreationTimestamp: policy.GetCreationTimestamp(),
+	}
+}
+
+// getPolicyTarget returns the target of the policy. sectionName is the name of the listener for a policy that
+// targets a listener of a Gateway.
+func getPolicyTarget(policy nkgAPI.Policy, sectionName *v1beta1.SectionName) policyTarget {
+	targetRef := policy.GetTargetRef()
+
+	var name string
+	if sectionName != nil {
+		name = string(*sectionName)
+	}
+
+	// targetRef.Namespace is either nil or equal to the namespace of the policy, which validation ensures.
+	return policyTarget{
+		kind:        string(targetRef.Kind),
+		nsname:      types.NamespacedName{Namespace: policy.GetNamespace(), Name: string(targetRef.Name)},
+		sectionName: name,
+	}
+}
+
+// validatePolicyTargetRef validates that the targetRef of the policy references one of the supported kinds
+// in the namespace of the policy.
+func validatePolicyTargetRef(
+	policy nkgAPI.Policy,
+	path *field.Path,
+	supportedKinds ...schema.GroupKind,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	targetRef := policy.GetTargetRef()
+	groupKind := schema.GroupKind{Group: string(targetRef.Group), Kind: string(targetRef.Kind)}
+
+	supported := false
+	supportedValues := make([]string, 0, len(supportedKinds))
+
+	for _, gk := range supportedKinds {
+		supported = supported || gk == groupKind
+		supportedValues = append(supportedValues, gk.Group+"/"+gk.Kind)
+	}
+
+	if !supported {
+		valErr := field.NotSupported(path, fmt.Sprintf("%s/%s", targetRef.Group, targetRef.Kind), supportedValues)
+		allErrs = append(allErrs, valErr)
+	}
+
+	if targetRef.Namespace != nil && string(*targetRef.Namespace) != policy.GetNamespace() {
+		valErr := field.Invalid(
+			path.Child("namespace"),
+			*targetRef.Namespace,
+			"must be the same as the namespace of the policy",
+		)
+		allErrs = append(allErrs, valErr)
+	}
+
+	return allErrs
+}
+
+// policyTargets resolves the targets of the policies against the resources of the Graph.
+type policyTargets struct {
+	gw       *Gateway
+	routes   map[types.NamespacedName]*Route
+	services map[types.NamespacedName]*v1.Service
+}
+
+// find returns an empty string if the target exists.
+// Otherwise, it returns the message that explains why the target is not found.
+func (t policyTargets) find(target policyTarget) string {
+	switch target.kind {
+	case kindGateway:
+		if t.gw == nil || client.ObjectKeyFromObject(t.gw.Source) != target.nsname {
+			return fmt.Sprintf("The target Gateway %s is not found or not handled by NKG", target.nsname)
+		}
+
+		if target.sectionName != "" {
+			if _, exists := t.gw.Listeners[target.sectionName]; !exists {
+				return fmt.Sprintf(
+					"The listener %s of the target Gateway %s is not found",
+					target.sectionName,
+					target.nsname,
+				)
+			}
+		}
+	case kindHTTPRoute:
+		if _, exists := t.routes[target.nsname]; !exists {
+			return fmt.Sprintf("The target HTTPRoute %s is not found or not handled by the Gateway", target.nsname)
+		}
+	case kindService:
+		if _, exists := t.services[target.nsname]; !exists {
+			return fmt.Sprintf("The target Service %s is not found", target.nsname)
+		}
+	default:
+		return fmt.Sprintf("The target %s %s is not supported", target.kind, target.nsname)
+	}
+
+	return ""
+}
+
+// allRules is the rule index of a ruleTarget that applies to all rules of an HTTPRoute.
+const allRules = -1
+
+// ruleTarget identifies the target of a policy that applies to the rules of an HTTPRoute: either a rule of
+// the HTTPRoute or all its rules.
+type ruleTarget struct {
+	route   types.NamespacedName
+	ruleIdx int
+}
+
+// getRuleTarget returns the target of a policy that targets an HTTPRoute and, optionally, one of its rules.
+func getRuleTarget(policy nkgAPI.Policy, ruleIndex *int32) ruleTarget {
+	ruleIdx := allRules
+	if ruleIndex != nil {
+		ruleIdx = int(*ruleIndex)
+	}
+
+	// targetRef.Namespace is either nil or equal to the namespace of the policy, which validation ensures.
+	return ruleTarget{
+		route: types.NamespacedName{
+			Namespace: policyObjectMeta(policy).Namespace,
+			Name:      string(policy.GetTargetRef().Name),
+		},
+		ruleIdx: ruleIdx,
+	}
+}
+
+// findRule returns an empty string if the target route and its rule exist.
+// Otherwise, it returns the message that explains why the target is not found.
+func (t policyTargets) findRule(target ruleTarget) string {
+	if msg := t.find(policyTarget{kind: kindHTTPRoute, nsname: target.route}); msg != "" {
+		return msg
+	}
+
+	if target.ruleIdx >= len(t.routes[target.route].Rules) {
+		return fmt.Sprintf("The rule %d of the target HTTPRoute %s is not found", target.ruleIdx, target.route)
+	}
+
+	return ""
+}
+
+// addPoliciesToRules calls add for every rule of the routes with the valid policy that applies to the rule.
+// A policy that targets a rule overrides a policy that targets all rules of the route.
+func addPoliciesToRules[T nkgAPI.Policy](
+	routes map[types.NamespacedName]*Route,
+	policies map[types.NamespacedName]*Policy[T],
+	getTarget func(policy T) ruleTarget,
+	add func(rule *Rule, policy *Policy[T]),
+) {
+	policiesPerTarget := make(map[ruleTarget]*Policy[T])
+
+	for _, p := range policies {
+		if p.Valid {
+			policiesPerTarget[getTarget(p.Source)] = p
+		}
+	}
+
+	if len(policiesPerTarget) == 0 {
+		return
+	}
+
+	for routeNsName, r := range routes {
+		routePolicy := policiesPerTarget[ruleTarget{route: routeNsName, ruleIdx: allRules}]
+
+		for ruleIdx := range r.Rules {
+			p, exists := policiesPerTarget[ruleTarget{route: routeNsName, ruleIdx: ruleIdx}]
+			if !exists {
+				p = routePolicy
+			}
+
+			if p != nil {
+				add(&r.Rules[ruleIdx], p)
+			}
+		}
+	}
+}
+
+// attachedPolicies holds the valid policies of a kind per target.
+// It computes the policies that take effect for a resource, where a policy that targets a more specific resource
+// overrides a policy that targets a less specific one.
+type attachedPolicies[T nkgAPI.Policy] map[policyTarget]*Policy[T]
+
+// newAttachedPolicies returns the attachedPolicies for the valid policies.
+func newAttachedPolicies[T nkgAPI.Policy](
+	policies map[types.NamespacedName]*Policy[T],
+	getTarget func(policy T) policyTarget,
+) attachedPolicies[T] {
+	attached := make(attachedPolicies[T])
+
+	for _, p := range policies {
+		if p.Valid {
+			attached[getTarget(p.Source)] = p
+		}
+	}
+
+	return attached
+}
+
+// forGateway returns the policy that targets the whole Gateway or nil.
+func (a attachedPolicies[T]) forGateway(gw *Gateway) *Policy[T] {
+	return a[policyTarget{kind: kindGateway, nsname: client.ObjectKeyFromObject(gw.Source)}]
+}
+
+// forListener returns the policy that takes effect for the listener of the Gateway or nil.
+// A policy that targets the listener overrides a policy that targets the whole Gateway.
+func (a attachedPolicies[T]) forListener(gw *Gateway, listenerName string) *Policy[T] {
+	target := policyTarget{
+		kind:        kindGateway,
+		nsname:      client.ObjectKeyFromObject(gw.Source),
+		sectionName: listenerName,
+	}
+
+	if p, exists := a[target]; exists {
+		return p
+	}
+
+	return a.forGateway(gw)
+}
+
+// forRoute returns the policy that targets the HTTPRoute or nil.
+func (a attachedPolicies[T]) forRoute(routeNsName types.NamespacedName) *Policy[T] {
+	return a[policyTarget{kind: kindHTTPRoute, nsname: routeNsName}]
+}
+
+// forService returns the policy that targets the Service or nil.
+func (a attachedPolicies[T]) forService(svcNsName types.NamespacedName) *Policy[T] {
+	return a[policyTarget{kind: kindService, nsname: svcNsName}]
+}
@@ -0,0 +1,444 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
+)
+
+func TestProcessPolicies(t *testing.T) {
+	older := metav1.Now()
+	newer := metav1.NewTime(older.Add(1))
+
+	listenerName := helpers.GetPointer[v1beta1.SectionName]("listener-80")
+
+	gatewayPolicy := createRateLimitPolicy("gateway", older, kindGateway, "gateway", nil)
+	listenerPolicy := createRateLimitPolicy("listener", older, kindGateway, "gateway", listenerName)
+	routePolicy := createRateLimitPolicy("route", older, kindHTTPRoute, "hr", nil)
+	conflictedPolicy := createRateLimitPolicy("conflicted", newer, kindHTTPRoute, "hr", nil)
+	routeNotFoundPolicy := createRateLimitPolicy("route-not-found", older, kindHTTPRoute, "not-found", nil)
+	invalidPolicy := createRateLimitPolicy("invalid", older, kindHTTPRoute, "hr", nil)
+
+	targets := policyTargets{
+		gw: &Gateway{
+			Source: &v1beta1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "gateway",
+				},
+			},
+			Listeners: map[string]*Listener{
+				"listener-80": {},
+			},
+		},
+		routes: map[types.NamespacedName]*Route{
+			{Namespace: "test", Name: "hr"}: {},
+		},
+	}
+
+	kind := policyKind[*nkgAPI.RateLimitPolicy, policyTarget]{
+		name:       nkgAPI.RateLimitPolicyKind,
+		sameTarget: "resource",
+		validate: func(policy *nkgAPI.RateLimitPolicy) error {
+			if policy == invalidPolicy {
+				return errors.New("invalid")
+			}
+			return nil
+		},
+		getTarget:  getRateLimitPolicyTarget,
+		findTarget: targets.find,
+	}
+
+	policies := map[types.NamespacedName]*nkgAPI.RateLimitPolicy{
+		{Namespace: "test", Name: "gateway"}:         gatewayPolicy,
+		{Namespace: "test", Name: "listener"}:        listenerPolicy,
+		{Namespace: "test", Name: "route"}:           routePolicy,
+		{Namespace: "test", Name: "conflicted"}:      conflictedPolicy,
+		{Namespace: "test", Name: "route-not-found"}: routeNotFoundPolicy,
+		{Namespace: "test", Name: "invalid"}:         invalidPolicy,
+	}
+
+	expected := map[types.NamespacedName]*RateLimitPolicy{
+		{Namespace: "test", Name: "gateway"}: {
+			Source: gatewayPolicy,
+			Valid:  true,
+		},
+		{Namespace: "test", Name: "listener"}: {
+			Source: listenerPolicy,
+			Valid:  true,
+		},
+		{Namespace: "test", Name: "route"}: {
+			Source: routePolicy,
+			Valid:  true,
+		},
+		{Namespace: "test", Name: "conflicted"}: {
+			Source: conflictedPolicy,
+			Conditions: []conditions.Condition{
+				staticConds.NewPolicyConflicted(
+					"Conflicts with RateLimitPolicy test/route, which targets the same resource",
+				),
+			},
+		},
+		{Namespace: "test", Name: "route-not-found"}: {
+			Source: routeNotFoundPolicy,
+			Conditions: []conditions.Condition{
+				staticConds.NewPolicyTargetNotFound(
+					"The target HTTPRoute test/not-found is not found or not handled by the Gateway",
+				),
+			},
+		},
+		{Namespace: "test", Name: "invalid"}: {
+			Source:     invalidPolicy,
+			Conditions: []conditions.Condition{staticConds.NewPolicyInvalid("invalid")},
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(helpers.Diff(expected, processPolicies(policies, kind))).To(BeEmpty())
+
+	g.Expect(processPolicies(nil, kind)).To(BeNil())
+}
+
+func TestResolvePolicyConflicts(t *testing.T) {
+	older := metav1.Now()
+	newer := metav1.NewTime(older.Add(1))
+
+	oldest := &RateLimitPolicy{
+		Source: createRateLimitPolicy("b", older, kindHTTPRoute, "hr", nil),
+		Valid:  true,
+	}
+	sameTime := &RateLimitPolicy{
+		Source: createRateLimitPolicy("c", older, kindHTTPRoute, "hr", nil),
+		Valid:  true,
+	}
+	newest := &RateLimitPolicy{
+		Source: createRateLimitPolicy("a", newer, kindHTTPRoute, "hr", nil),
+		Valid:  true,
+	}
+
+	resolvePolicyConflicts([]*RateLimitPolicy{newest, sameTime, oldest}, nkgAPI.RateLimitPolicyKind, "resource")
+
+	expectedCond := staticConds.NewPolicyConflicted(
+		"Conflicts with RateLimitPolicy test/b, which targets the same resource",
+	)
+
+	g := NewWithT(t)
+
+	g.Expect(oldest.Valid).To(BeTrue())
+	g.Expect(oldest.Conditions).To(BeEmpty())
+
+	g.Expect(sameTime.Valid).To(BeFalse())
+	g.Expect(sameTime.Conditions).To(Equal([]conditions.Condition{expectedCond}))
+
+	g.Expect(newest.Valid).To(BeFalse())
+	g.Expect(newest.Conditions).To(Equal([]conditions.Condition{expectedCond}))
+}
+
+func TestPolicyTargetsFind(t *testing.T) {
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	routeNsName := types.NamespacedName{Namespace: "test", Name: "hr"}
+	svcNsName := types.NamespacedName{Namespace: "test", Name: "svc"}
+	notFound := types.NamespacedName{Namespace: "test", Name: "not-found"}
+
+	targets := policyTargets{
+		gw: &Gateway{
+			Source: &v1beta1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: gwNsName.Namespace,
+					Name:      gwNsName.Name,
+				},
+			},
+			Listeners: map[string]*Listener{
+				"listener-80": {},
+			},
+		},
+		routes: map[types.NamespacedName]*Route{
+			routeNsName: {},
+		},
+		services: map[types.NamespacedName]*v1.Service{
+			svcNsName: {},
+		},
+	}
+
+	tests := []struct {
+		target      policyTarget
+		targets     policyTargets
+		name        string
+		expectedMsg string
+	}{
+		{
+			target:  policyTarget{kind: kindGateway, nsname: gwNsName},
+			targets: targets,
+			name:    "gateway",
+		},
+		{
+			target:  policyTarget{kind: kindGateway, nsname: gwNsName, sectionName: "listener-80"},
+			targets: targets,
+			name:    "listener",
+		},
+		{
+			target:  policyTarget{kind: kindHTTPRoute, nsname: routeNsName},
+			targets: targets,
+			name:    "route",
+		},
+		{
+			target:  policyTarget{kind: kindService, nsname: svcNsName},
+			targets: targets,
+			name:    "service",
+		},
+		{
+			target:      policyTarget{kind: kindGateway, nsname: notFound},
+			targets:     targets,
+			name:        "gateway not found",
+			expectedMsg: "The target Gateway test/not-found is not found or not handled by NKG",
+		},
+		{
+			target:      policyTarget{kind: kindGateway, nsname: gwNsName},
+			targets:     policyTargets{},
+			name:        "no gateway",
+			expectedMsg: "The target Gateway test/gateway is not found or not handled by NKG",
+		},
+		{
+			target:      policyTarget{kind: kindGateway, nsname: gwNsName, sectionName: "not-found"},
+			targets:     targets,
+			name:        "listener not found",
+			expectedMsg: "The listener not-found of the target Gateway test/gateway is not found",
+		},
+		{
+			target:      policyTarget{kind: kindHTTPRoute, nsname: notFound},
+			targets:     targets,
+			name:        "route not found",
+			expectedMsg: "The target HTTPRoute test/not-found is not found or not handled by the Gateway",
+		},
+		{
+			target:      policyTarget{kind: kindService, nsname: notFound},
+			targets:     targets,
+			name:        "service not found",
+			expectedMsg: "The target Service test/not-found is not found",
+		},
+		{
+			target:      policyTarget{kind: "Unsupported", nsname: notFound},
+			targets:     targets,
+			name:        "unsupported kind",
+			expectedMsg: "The target Unsupported test/not-found is not supported",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(test.targets.find(test.target)).To(Equal(test.expectedMsg))
+		})
+	}
+}
+
+func TestGetRuleTarget(t *testing.T) {
+	policy := &nkgAPI.RetryPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "policy",
+		},
+		Spec: nkgAPI.RetryPolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kindHTTPRoute,
+				Name:  "hr",
+			},
+		},
+	}
+
+	routeNsName := types.NamespacedName{Namespace: "test", Name: "hr"}
+
+	g := NewWithT(t)
+
+	g.Expect(getRuleTarget(policy, nil)).To(Equal(ruleTarget{route: routeNsName, ruleIdx: allRules}))
+	g.Expect(getRuleTarget(policy, helpers.GetPointer[int32](1))).To(Equal(ruleTarget{route: routeNsName, ruleIdx: 1}))
+}
+
+func TestPolicyTargetsFindRule(t *testing.T) {
+	routeNsName := types.NamespacedName{Namespace: "test", Name: "hr"}
+
+	targets := policyTargets{
+		routes: map[types.NamespacedName]*Route{
+			routeNsName: {
+				Rules: []Rule{{}, {}},
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedMsg string
+		target      ruleTarget
+	}{
+		{
+			target: ruleTarget{route: routeNsName, ruleIdx: allRules},
+			name:   "all rules",
+		},
+		{
+			target: ruleTarget{route: routeNsName, ruleIdx: 1},
+			name:   "rule",
+		},
+		{
+			target:      ruleTarget{route: routeNsName, ruleIdx: 2},
+			name:        "rule not found",
+			expectedMsg: "The rule 2 of the target HTTPRoute test/hr is not found",
+		},
+		{
+			target:      ruleTarget{route: types.NamespacedName{Namespace: "test", Name: "not-found"}},
+			name:        "route not found",
+			expectedMsg: "The target HTTPRoute test/not-found is not found or not handled by the Gateway",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			g.Expect(targets.findRule(test.target)).To(Equal(test.expectedMsg))
+		})
+	}
+}
+
+func TestValidatePolicyTargetRef(t *testing.T) {
+	tests := []struct {
+		targetRef   v1alpha2.PolicyTargetReference
+		name        string
+		expectedErr string
+	}{
+		{
+			targetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kindHTTPRoute,
+				Name:  "hr",
+			},
+			name: "valid route",
+		},
+		{
+			targetRef: v1alpha2.PolicyTargetReference{
+				Kind:      kindService,
+				Name:      "svc",
+				Namespace: helpers.GetPointer[v1alpha2.Namespace]("test"),
+			},
+			name: "valid service in the same namespace",
+		},
+		{
+			targetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kindGateway,
+				Name:  "gateway",
+			},
+			name: "unsupported kind",
+			expectedErr: `spec.targetRef: Unsupported value: "gateway.networking.k8s.io/Gateway": ` +
+				`supported values: "/Service", "gateway.networking.k8s.io/HTTPRoute"`,
+		},
+		{
+			targetRef: v1alpha2.PolicyTargetReference{
+				Kind:      kindService,
+				Name:      "svc",
+				Namespace: helpers.GetPointer[v1alpha2.Namespace]("other"),
+			},
+			name: "different namespace",
+			expectedErr: `spec.targetRef.namespace: Invalid value: "other": ` +
+				`must be the same as the namespace of the policy`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			policy := &nkgAPI.UpstreamSettingsPolicy{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "policy",
+				},
+				Spec: nkgAPI.UpstreamSettingsPolicySpec{
+					TargetRef: test.targetRef,
+				},
+			}
+
+			errs := validatePolicyTargetRef(
+				policy,
+				field.NewPath("spec").Child("targetRef"),
+				serviceGroupKind,
+				httpRouteGroupKind,
+			)
+
+			if test.expectedErr == "" {
+				g.Expect(errs).To(BeEmpty())
+			} else {
+				g.Expect(errs.ToAggregate()).To(MatchError(test.expectedErr))
+			}
+		})
+	}
+}
+
+func TestAttachedPolicies(t *testing.T) {
+	gatewayPolicy := &RateLimitPolicy{
+		Source: createRateLimitPolicy("gateway", metav1.Now(), kindGateway, "gateway", nil),
+		Valid:  true,
+	}
+	listenerPolicy := &RateLimitPolicy{
+		Source: createRateLimitPolicy(
+			"listener",
+			metav1.Now(),
+			kindGateway,
+			"gateway",
+			helpers.GetPointer[v1beta1.SectionName]("listener-443"),
+		),
+		Valid: true,
+	}
+	routePolicy := &RateLimitPolicy{
+		Source: createRateLimitPolicy("route", metav1.Now(), kindHTTPRoute, "hr", nil),
+		Valid:  true,
+	}
+	invalidPolicy := &RateLimitPolicy{
+		Source: createRateLimitPolicy("invalid", metav1.Now(), kindHTTPRoute, "hr2", nil),
+	}
+
+	policies := map[types.NamespacedName]*RateLimitPolicy{
+		{Namespace: "test", Name: "gateway"}:  gatewayPolicy,
+		{Namespace: "test", Name: "listener"}: listenerPolicy,
+		{Namespace: "test", Name: "route"}:    routePolicy,
+		{Namespace: "test", Name: "invalid"}:  invalidPolicy,
+	}
+
+	gw := &Gateway{
+		Source: &v1beta1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+		},
+	}
+
+	attached := newAttachedPolicies(policies, getRateLimitPolicyTarget)
+
+	g := NewWithT(t)
+
+	g.Expect(attached).To(HaveLen(3))
+
+	g.Expect(attached.forGateway(gw)).To(Equal(gatewayPolicy))
+	g.Expect(attached.forListener(gw, "listener-80")).To(Equal(gatewayPolicy))
+	g.Expect(attached.forListener(gw, "listener-443")).To(Equal(listenerPolicy))
+
+	g.Expect(attached.forRoute(types.NamespacedName{Namespace: "test", Name: "hr"})).To(Equal(routePolicy))
+	g.Expect(attached.forRoute(types.NamespacedName{Namespace: "test", Name: "hr2"})).To(BeNil())
+
+	g.Expect(attached.forService(types.NamespacedName{Namespace: "test", Name: "svc"})).To(BeNil())
+}
@@ -0,0 +1,124 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// RateLimitPolicy represents a RateLimitPolicy resource.
+type RateLimitPolicy = Policy[*nkgAPI.RateLimitPolicy]
+
+func processRateLimitPolicies(
+	policies map[types.NamespacedName]*nkgAPI.RateLimitPolicy,
+	validator validation.PolicyValidator,
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+) map[types.NamespacedName]*RateLimitPolicy {
+	targets := policyTargets{gw: gw, routes: routes}
+
+	return processPolicies(policies, policyKind[*nkgAPI.RateLimitPolicy, policyTarget]{
+		name:       nkgAPI.RateLimitPolicyKind,
+		sameTarget: "resource",
+		validate: func(policy *nkgAPI.RateLimitPolicy) error {
+			return validateRateLimitPolicy(validator, policy)
+		},
+		getTarget:  getRateLimitPolicyTarget,
+		findTarget: targets.find,
+	})
+}
+
+func getRateLimitPolicyTarget(policy *nkgAPI.RateLimitPolicy) policyTarget {
+	return getPolicyTarget(policy, policy.Spec.SectionName)
+}
+
+func validateRateLimitPolicy(
+	validator validation.PolicyValidator,
+	policy *nkgAPI.RateLimitPolicy,
+) error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicyTargetRef(policy, specPath.Child("targetRef"), gatewayGroupKind, httpRouteGroupKind)
+
+	if policy.Spec.SectionName != nil && policy.Spec.TargetRef.Kind != kindGateway {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("sectionName"), "can only be set for a Gateway"))
+	}
+
+	allErrs = append(allErrs, validateRateLimitKey(validator, policy.Spec.Key, specPath.Child("key"))...)
+
+	if err := validator.ValidateRateLimitRate(policy.Spec.Rate); err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("rate"), policy.Spec.Rate, err.Error()))
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateRateLimitKey(
+	validator validation.PolicyValidator,
+	key nkgAPI.RateLimitKey,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	namePath := path.Child("name")
+
+	switch key.Type {
+	case nkgAPI.RateLimitKeyTypeClientIP:
+		if key.Name != nil {
+			allErrs = append(allErrs, field.Forbidden(namePath, "cannot be set for the ClientIP key"))
+		}
+	case nkgAPI.RateLimitKeyTypeHeader:
+		if key.Name == nil {
+			allErrs = append(allErrs, field.Required(namePath, "required for the Header key"))
+		} else if err := validator.ValidateRateLimitKeyName(*key.Name); err != nil {
+			allErrs = append(allErrs, field.Invalid(namePath, *key.Name, err.Error()))
+		}
+	case nkgAPI.RateLimitKeyTypeJWTClaim:
+		// NGINX limits the rate before the auth_request of an AuthenticationFilter validates the token.
+		valErr := field.Invalid(
+			path.Child("type"),
+			key.Type,
+			"not supported, because NGINX limits the rate of the requests before it validates their JSON Web Tokens, "+
+				"so the claim would come from a token that is not validated",
+		)
+		allErrs = append(allErrs, valErr)
+	default:
+		valErr := field.NotSupported(
+			path.Child("type"),
+			key.Type,
+			[]string{
+				string(nkgAPI.RateLimitKeyTypeClientIP),
+				string(nkgAPI.RateLimitKeyTypeHeader),
+			},
+		)
+		allErrs = append(allErrs, valErr)
+	}
+
+	return allErrs
+}
+
+// addRateLimitToListenersAndRoutes adds the RateLimitPolicies to the listeners of the Gateway and the routes.
+// A policy that targets a listener overrides a policy that targets the whole Gateway.
+// The Gateway and the routes are modified in place.
+func addRateLimitToListenersAndRoutes(
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+	policies map[types.NamespacedName]*RateLimitPolicy,
+) {
+	attached := newAttachedPolicies(policies, getRateLimitPolicyTarget)
+	if len(attached) == 0 {
+		return
+	}
+
+	if gw != nil {
+		for name, l := range gw.Listeners {
+			l.RateLimit = attached.forListener(gw, name)
+		}
+	}
+
+	for routeNsName, r := range routes {
+		r.RateLimit = attached.forRoute(routeNsName)
+	}
+}
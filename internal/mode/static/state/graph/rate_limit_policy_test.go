@@ -0,0 +1,217 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+func createRateLimitPolicy(
+	name string,
+	created metav1.Time,
+	kind v1alpha2.Kind,
+	targetName string,
+	sectionName *v1beta1.SectionName,
+) *nkgAPI.RateLimitPolicy {
+	return &nkgAPI.RateLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "test",
+			Name:              name,
+			CreationTimestamp: created,
+		},
+		Spec: nkgAPI.RateLimitPolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kind,
+				Name:  v1alpha2.ObjectName(targetName),
+			},
+			SectionName: sectionName,
+			Key: nkgAPI.RateLimitKey{
+				Type: nkgAPI.RateLimitKeyTypeClientIP,
+			},
+			Rate:  "10r/s",
+			Burst: helpers.GetPointer[int32](5),
+		},
+	}
+}
+
+func TestValidateRateLimitPolicy(t *testing.T) {
+	createPolicy := func(mutate func(spec *nkgAPI.RateLimitPolicySpec)) *nkgAPI.RateLimitPolicy {
+		p := createRateLimitPolicy("policy", metav1.Now(), kindHTTPRoute, "hr", nil)
+
+		if mutate != nil {
+			mutate(&p.Spec)
+		}
+
+		return p
+	}
+
+	tests := []struct {
+		policy         *nkgAPI.RateLimitPolicy
+		name           string
+		expectErrCount int
+		invalidRate    bool
+		invalidKeyName bool
+	}{
+		{
+			policy: createPolicy(nil),
+			name:   "valid",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.RateLimitPolicySpec) {
+				spec.TargetRef.Kind = kindGateway
+				spec.SectionName = helpers.GetPointer[v1beta1.SectionName]("listener")
+				spec.Key = nkgAPI.RateLimitKey{
+					Type: nkgAPI.RateLimitKeyTypeHeader,
+					Name: helpers.GetStringPointer("X-User-ID"),
+				}
+			}),
+			name: "valid listener policy with header key",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.RateLimitPolicySpec) {
+				spec.TargetRef.Namespace = helpers.GetPointer[v1alpha2.Namespace]("other")
+			}),
+			expectErrCount: 1,
+			name:           "target in another namespace",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.RateLimitPolicySpec) {
+				spec.SectionName = helpers.GetPointer[v1beta1.SectionName]("listener")
+			}),
+			expectErrCount: 1,
+			name:           "section name for HTTPRoute",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.RateLimitPolicySpec) {
+				spec.Key.Name = helpers.GetStringPointer("name")
+			}),
+			expectErrCount: 1,
+			name:           "name for client IP key",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.RateLimitPolicySpec) {
+				spec.Key.Type = nkgAPI.RateLimitKeyTypeHeader
+			}),
+			expectErrCount: 1,
+			name:           "no name for header key",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.RateLimitPolicySpec) {
+				spec.Key.Type = nkgAPI.RateLimitKeyTypeHeader
+				spec.Key.Name = helpers.GetStringPointer("X-User")
+			}),
+			invalidKeyName: true,
+			expectErrCount: 1,
+			name:           "invalid key name",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.RateLimitPolicySpec) {
+				spec.Key.Type = "unsupported"
+			}),
+			expectErrCount: 1,
+			name:           "unsupported key type",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.RateLimitPolicySpec) {
+				spec.Key.Type = nkgAPI.RateLimitKeyTypeJWTClaim
+				spec.Key.Name = helpers.GetStringPointer("sub")
+			}),
+			expectErrCount: 1,
+			name:           "JWT claim key",
+		},
+		{
+			policy:         createPolicy(nil),
+			invalidRate:    true,
+			expectErrCount: 1,
+			name:           "invalid rate",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{}
+			if test.invalidRate {
+				validator.ValidateRateLimitRateReturns(errors.New("invalid rate"))
+			}
+			if test.invalidKeyName {
+				validator.ValidateRateLimitKeyNameReturns(errors.New("invalid key name"))
+			}
+
+			err := validateRateLimitPolicy(validator, test.policy)
+
+			if test.expectErrCount == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.(interface{ Errors() []error }).Errors()).To(HaveLen(test.expectErrCount))
+			}
+		})
+	}
+}
+
+func TestAddRateLimitToListenersAndRoutes(t *testing.T) {
+	listenerName := helpers.GetPointer[v1beta1.SectionName]("listener-443")
+
+	gatewayPolicy := &RateLimitPolicy{
+		Source: createRateLimitPolicy("gateway", metav1.Now(), kindGateway, "gateway", nil),
+		Valid:  true,
+	}
+	listenerPolicy := &RateLimitPolicy{
+		Source: createRateLimitPolicy("listener", metav1.Now(), kindGateway, "gateway", listenerName),
+		Valid:  true,
+	}
+	routePolicy := &RateLimitPolicy{
+		Source: createRateLimitPolicy("route", metav1.Now(), kindHTTPRoute, "hr", nil),
+		Valid:  true,
+	}
+	invalidPolicy := &RateLimitPolicy{
+		Source: createRateLimitPolicy("invalid", metav1.Now(), kindHTTPRoute, "hr2", nil),
+	}
+
+	policies := map[types.NamespacedName]*RateLimitPolicy{
+		{Namespace: "test", Name: "gateway"}:  gatewayPolicy,
+		{Namespace: "test", Name: "listener"}: listenerPolicy,
+		{Namespace: "test", Name: "route"}:    routePolicy,
+		{Namespace: "test", Name: "invalid"}:  invalidPolicy,
+	}
+
+	gw := &Gateway{
+		Source: &v1beta1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+		},
+		Listeners: map[string]*Listener{
+			"listener-80":  {},
+			"listener-443": {},
+		},
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}:  {},
+		{Namespace: "test", Name: "hr2"}: {},
+	}
+
+	addRateLimitToListenersAndRoutes(gw, routes, policies)
+
+	g := NewWithT(t)
+
+	g.Expect(gw.Listeners["listener-80"].RateLimit).To(Equal(gatewayPolicy))
+	g.Expect(gw.Listeners["listener-443"].RateLimit).To(Equal(listenerPolicy))
+
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr"}].RateLimit).To(Equal(routePolicy))
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr2"}].RateLimit).To(BeNil())
+}
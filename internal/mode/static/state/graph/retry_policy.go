@@ -0,0 +1,71 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// RetryPolicy represents a RetryPolicy resource.
+type RetryPolicy = Policy[*nkgAPI.RetryPolicy]
+
+func processRetryPolicies(
+	policies map[types.NamespacedName]*nkgAPI.RetryPolicy,
+	validator validation.PolicyValidator,
+	routes map[types.NamespacedName]*Route,
+) map[types.NamespacedName]*RetryPolicy {
+	targets := policyTargets{routes: routes}
+
+	return processPolicies(policies, policyKind[*nkgAPI.RetryPolicy, ruleTarget]{
+		name:       nkgAPI.RetryPolicyKind,
+		sameTarget: "rules",
+		validate: func(policy *nkgAPI.RetryPolicy) error {
+			return validateRetryPolicy(validator, policy)
+		},
+		getTarget:  getRetryPolicyTarget,
+		findTarget: targets.findRule,
+	})
+}
+
+func getRetryPolicyTarget(policy *nkgAPI.RetryPolicy) ruleTarget {
+	return getRuleTarget(policy, policy.Spec.RuleIndex)
+}
+
+func validateRetryPolicy(
+	validator validation.PolicyValidator,
+	policy *nkgAPI.RetryPolicy,
+) error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicyTargetRef(policy, specPath.Child("targetRef"), httpRouteGroupKind)
+
+	if policy.Spec.RuleIndex != nil && *policy.Spec.RuleIndex < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("ruleIndex"), *policy.Spec.RuleIndex, "must be non-negative"))
+	}
+
+	allErrs = append(
+		allErrs,
+		validateNextUpstreamConditions(validator, policy.Spec.Conditions, specPath.Child("conditions"))...,
+	)
+
+	if policy.Spec.Timeout != nil {
+		if err := validator.ValidateDuration(string(*policy.Spec.Timeout)); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("timeout"), *policy.Spec.Timeout, err.Error()))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// addRetryToRules adds the RetryPolicy specs to the rules of the routes. A policy that targets a rule
+// overrides a policy that targets all rules of the route. The routes are modified in place.
+func addRetryToRules(
+	routes map[types.NamespacedName]*Route,
+	policies map[types.NamespacedName]*RetryPolicy,
+) {
+	addPoliciesToRules(routes, policies, getRetryPolicyTarget, func(rule *Rule, p *RetryPolicy) {
+		rule.Retry = &p.Source.Spec
+	})
+}
@@ -0,0 +1,151 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+func createRetryPolicy(name string, routeName string, ruleIdx *int32) *nkgAPI.RetryPolicy {
+	return &nkgAPI.RetryPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: nkgAPI.RetryPolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kindHTTPRoute,
+				Name:  v1alpha2.ObjectName(routeName),
+			},
+			RuleIndex: ruleIdx,
+			Conditions: []nkgAPI.NextUpstreamCondition{
+				nkgAPI.NextUpstreamConditionError,
+				nkgAPI.NextUpstreamConditionHTTP503,
+			},
+			Attempts: helpers.GetPointer[int32](3),
+			Timeout:  helpers.GetPointer[nkgAPI.Duration]("10s"),
+		},
+	}
+}
+
+func TestValidateRetryPolicy(t *testing.T) {
+	createPolicy := func(mutate func(spec *nkgAPI.RetryPolicySpec)) *nkgAPI.RetryPolicy {
+		p := createRetryPolicy("policy", "hr", nil)
+
+		if mutate != nil {
+			mutate(&p.Spec)
+		}
+
+		return p
+	}
+
+	tests := []struct {
+		policy           *nkgAPI.RetryPolicy
+		name             string
+		invalidCondition nkgAPI.NextUpstreamCondition
+		expectErrCount   int
+		invalidDuration  bool
+	}{
+		{
+			policy: createPolicy(nil),
+			name:   "valid",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.RetryPolicySpec) {
+				spec.TargetRef.Namespace = helpers.GetPointer[v1alpha2.Namespace]("other")
+			}),
+			expectErrCount: 1,
+			name:           "target in another namespace",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.RetryPolicySpec) {
+				spec.RuleIndex = helpers.GetPointer[int32](-1)
+			}),
+			expectErrCount: 1,
+			name:           "negative rule index",
+		},
+		{
+			policy:           createPolicy(nil),
+			invalidCondition: nkgAPI.NextUpstreamConditionHTTP503,
+			expectErrCount:   1,
+			name:             "invalid condition",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.RetryPolicySpec) {
+				spec.Conditions = nil
+			}),
+			expectErrCount: 1,
+			name:           "no conditions",
+		},
+		{
+			policy:          createPolicy(nil),
+			invalidDuration: true,
+			expectErrCount:  1,
+			name:            "invalid timeout",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{
+				ValidateNextUpstreamConditionStub: func(cond string) (bool, []string) {
+					return cond != string(test.invalidCondition), nil
+				},
+			}
+			if test.invalidDuration {
+				validator.ValidateDurationReturns(errInvalidDuration)
+			}
+
+			err := validateRetryPolicy(validator, test.policy)
+
+			if test.expectErrCount == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.(interface{ Errors() []error }).Errors()).To(HaveLen(test.expectErrCount))
+			}
+		})
+	}
+}
+
+func TestAddRetryToRules(t *testing.T) {
+	routePolicy := createRetryPolicy("route", "hr", nil)
+	rulePolicy := createRetryPolicy("rule", "hr", helpers.GetPointer[int32](1))
+	invalidPolicy := createRetryPolicy("invalid", "hr2", nil)
+
+	policies := map[types.NamespacedName]*RetryPolicy{
+		{Namespace: "test", Name: "route"}:   {Source: routePolicy, Valid: true},
+		{Namespace: "test", Name: "rule"}:    {Source: rulePolicy, Valid: true},
+		{Namespace: "test", Name: "invalid"}: {Source: invalidPolicy},
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}: {
+			Rules: []Rule{{}, {}},
+		},
+		{Namespace: "test", Name: "hr2"}: {
+			Rules: []Rule{{}},
+		},
+	}
+
+	addRetryToRules(routes, policies)
+
+	g := NewWithT(t)
+
+	rules := routes[types.NamespacedName{Namespace: "test", Name: "hr"}].Rules
+	g.Expect(rules[0].Retry).To(Equal(&routePolicy.Spec))
+	g.Expect(rules[1].Retry).To(Equal(&rulePolicy.Spec))
+
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr2"}].Rules[0].Retry).To(BeNil())
+}
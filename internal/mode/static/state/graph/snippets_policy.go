@@ -0,0 +1,183 @@
+package graph
+
+import (
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
+	nkgsort "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/sort"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// SnippetsPolicy represents a SnippetsPolicy resource.
+type SnippetsPolicy = Policy[*nkgAPI.SnippetsPolicy]
+
+// Snippets holds the snippets of the SnippetsPolicy that targets a Gateway or an HTTPRoute.
+type Snippets struct {
+	// Policy is the namespaced name of the SnippetsPolicy.
+	Policy types.NamespacedName
+	// Snippets are the snippets of the SnippetsPolicy.
+	Snippets []nkgAPI.Snippet
+}
+
+// processSnippetsPolicies processes the SnippetsPolicies. If the validator is nil, the snippets are disabled,
+// and all policies are not accepted.
+func processSnippetsPolicies(
+	policies map[types.NamespacedName]*nkgAPI.SnippetsPolicy,
+	validator validation.SnippetsValidator,
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+) map[types.NamespacedName]*SnippetsPolicy {
+	if validator == nil {
+		return processDisabledSnippetsPolicies(policies)
+	}
+
+	targets := policyTargets{gw: gw, routes: routes}
+
+	return processPolicies(policies, policyKind[*nkgAPI.SnippetsPolicy, policyTarget]{
+		name:       nkgAPI.SnippetsPolicyKind,
+		sameTarget: "resource",
+		validate: func(policy *nkgAPI.SnippetsPolicy) error {
+			return validateSnippetsPolicy(validator, policy)
+		},
+		getTarget:  getSnippetsPolicyTarget,
+		findTarget: targets.find,
+	})
+}
+
+func processDisabledSnippetsPolicies(
+	policies map[types.NamespacedName]*nkgAPI.SnippetsPolicy,
+) map[types.NamespacedName]*SnippetsPolicy {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	processed := make(map[types.NamespacedName]*SnippetsPolicy, len(policies))
+
+	for nsname, p := range policies {
+		processed[nsname] = &SnippetsPolicy{
+			Source:     p,
+			Conditions: []conditions.Condition{staticConds.NewPolicySnippetsDisabled()},
+		}
+	}
+
+	return processed
+}
+
+func getSnippetsPolicyTarget(policy *nkgAPI.SnippetsPolicy) policyTarget {
+	return getPolicyTarget(policy, nil)
+}
+
+// supportedSnippetContexts are the contexts of the snippets that the policies for the kinds of the targets support.
+var supportedSnippetContexts = map[string][]nkgAPI.SnippetContext{
+	kindGateway:   {nkgAPI.SnippetContextHTTP, nkgAPI.SnippetContextServer},
+	kindHTTPRoute: {nkgAPI.SnippetContextLocation},
+}
+
+func validateSnippetsPolicy(
+	validator validation.SnippetsValidator,
+	policy *nkgAPI.SnippetsPolicy,
+) error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicyTargetRef(policy, specPath.Child("targetRef"), gatewayGroupKind, httpRouteGroupKind)
+	if len(allErrs) > 0 {
+		return allErrs.ToAggregate()
+	}
+
+	supportedContexts := supportedSnippetContexts[string(policy.Spec.TargetRef.Kind)]
+
+	for i, snippet := range policy.Spec.Snippets {
+		snippetPath := specPath.Child("snippets").Index(i)
+
+		if !isSupportedSnippetContext(snippet.Context, supportedContexts) {
+			contextPath := snippetPath.Child("context")
+			allErrs = append(allErrs, field.NotSupported(contextPath, snippet.Context, snippetContextValues(supportedContexts)))
+
+			continue
+		}
+
+		if err := validator.ValidateSnippet(string(snippet.Context), snippet.Value); err != nil {
+			allErrs = append(allErrs, field.Invalid(snippetPath.Child("value"), snippet.Value, err.Error()))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func isSupportedSnippetContext(context nkgAPI.SnippetContext, supported []nkgAPI.SnippetContext) bool {
+	for _, c := range supported {
+		if c == context {
+			return true
+		}
+	}
+
+	return false
+}
+
+func snippetContextValues(contexts []nkgAPI.SnippetContext) []string {
+	values := make([]string, 0, len(contexts))
+	for _, c := range contexts {
+		values = append(values, string(c))
+	}
+
+	return values
+}
+
+// addSnippetsToGatewayAndRoutes adds the snippets of the SnippetsPolicies to the Gateway and the routes.
+// The Gateway and the routes are modified in place.
+func addSnippetsToGatewayAndRoutes(
+	gw *Gateway,
+	routes map[types.NamespacedName]*Route,
+	policies map[types.NamespacedName]*SnippetsPolicy,
+) {
+	attached := newAttachedPolicies(policies, getSnippetsPolicyTarget)
+	if len(attached) == 0 {
+		return
+	}
+
+	if gw != nil {
+		if p := attached.forGateway(gw); p != nil {
+			gw.Snippets = newSnippets(p)
+		}
+	}
+
+	for routeNsName, r := range routes {
+		if p := attached.forRoute(routeNsName); p != nil {
+			r.Snippets = newSnippets(p)
+		}
+	}
+}
+
+func newSnippets(policy *SnippetsPolicy) *Snippets {
+	return &Snippets{
+		Policy:   types.NamespacedName{Namespace: policy.Source.Namespace, Name: policy.Source.Name},
+		Snippets: policy.Source.Spec.Snippets,
+	}
+}
+
+// GetValidSnippetsPolicies returns the namespaced names of the valid SnippetsPolicies, from the oldest to
+// the newest, the same order as the order of conflict resolution.
+func (g *Graph) GetValidSnippetsPolicies() []types.NamespacedName {
+	var valid []*SnippetsPolicy
+	for _, p := range g.SnippetsPolicies {
+		if p.Valid {
+			valid = append(valid, p)
+		}
+	}
+
+	sort.Slice(valid, func(i, j int) bool {
+		return nkgsort.LessObjectMeta(policyObjectMeta(valid[i].Source), policyObjectMeta(valid[j].Source))
+	})
+
+	nsnames := make([]types.NamespacedName, 0, len(valid))
+	for _, p := range valid {
+		nsnames = append(nsnames, types.NamespacedName{Namespace: p.Source.Namespace, Name: p.Source.Name})
+	}
+
+	return nsnames
+}
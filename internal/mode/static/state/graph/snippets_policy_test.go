@@ -0,0 +1,243 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	staticConds "github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/conditions"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+func createSnippetsPolicy(
+	name string,
+	created metav1.Time,
+	kind v1alpha2.Kind,
+	targetName string,
+) *nkgAPI.SnippetsPolicy {
+	snippets := []nkgAPI.Snippet{
+		{
+			Context: nkgAPI.SnippetContextLocation,
+			Value:   "proxy_buffer_size 16k;",
+		},
+	}
+
+	if kind == kindGateway {
+		snippets = []nkgAPI.Snippet{
+			{
+				Context: nkgAPI.SnippetContextHTTP,
+				Value:   "map_hash_bucket_size 128;",
+			},
+			{
+				Context: nkgAPI.SnippetContextServer,
+				Value:   "large_client_header_buffers 4 16k;",
+			},
+		}
+	}
+
+	return &nkgAPI.SnippetsPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         "test",
+			Name:              name,
+			CreationTimestamp: created,
+		},
+		Spec: nkgAPI.SnippetsPolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kind,
+				Name:  v1alpha2.ObjectName(targetName),
+			},
+			Snippets: snippets,
+		},
+	}
+}
+
+func TestProcessSnippetsPoliciesDisabled(t *testing.T) {
+	policy := createSnippetsPolicy("route", metav1.Now(), kindHTTPRoute, "hr")
+
+	policies := map[types.NamespacedName]*nkgAPI.SnippetsPolicy{
+		{Namespace: "test", Name: "route"}: policy,
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}: {},
+	}
+
+	expected := map[types.NamespacedName]*SnippetsPolicy{
+		{Namespace: "test", Name: "route"}: {
+			Source:     policy,
+			Conditions: []conditions.Condition{staticConds.NewPolicySnippetsDisabled()},
+		},
+	}
+
+	g := NewWithT(t)
+
+	result := processSnippetsPolicies(policies, nil, nil, routes)
+	g.Expect(helpers.Diff(expected, result)).To(BeEmpty())
+
+	g.Expect(processSnippetsPolicies(nil, nil, nil, routes)).To(BeNil())
+}
+
+func TestValidateSnippetsPolicy(t *testing.T) {
+	tests := []struct {
+		policy         *nkgAPI.SnippetsPolicy
+		name           string
+		expectErrCount int
+		invalidSnippet bool
+	}{
+		{
+			policy: createSnippetsPolicy("policy", metav1.Now(), kindGateway, "gateway"),
+			name:   "valid policy for a Gateway",
+		},
+		{
+			policy: createSnippetsPolicy("policy", metav1.Now(), kindHTTPRoute, "hr"),
+			name:   "valid policy for an HTTPRoute",
+		},
+		{
+			policy: func() *nkgAPI.SnippetsPolicy {
+				p := createSnippetsPolicy("policy", metav1.Now(), kindHTTPRoute, "hr")
+				p.Spec.TargetRef.Namespace = helpers.GetPointer[v1alpha2.Namespace]("other")
+				return p
+			}(),
+			expectErrCount: 1,
+			name:           "target in another namespace",
+		},
+		{
+			policy: func() *nkgAPI.SnippetsPolicy {
+				p := createSnippetsPolicy("policy", metav1.Now(), kindGateway, "gateway")
+				p.Spec.Snippets = append(p.Spec.Snippets, nkgAPI.Snippet{
+					Context: nkgAPI.SnippetContextLocation,
+					Value:   "proxy_buffer_size 16k;",
+				})
+				return p
+			}(),
+			expectErrCount: 1,
+			name:           "location snippet for a Gateway",
+		},
+		{
+			policy: func() *nkgAPI.SnippetsPolicy {
+				p := createSnippetsPolicy("policy", metav1.Now(), kindHTTPRoute, "hr")
+				p.Spec.Snippets[0].Context = nkgAPI.SnippetContextServer
+				return p
+			}(),
+			expectErrCount: 1,
+			name:           "server snippet for an HTTPRoute",
+		},
+		{
+			policy:         createSnippetsPolicy("policy", metav1.Now(), kindGateway, "gateway"),
+			invalidSnippet: true,
+			expectErrCount: 2,
+			name:           "invalid snippets",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakeSnippetsValidator{}
+			if test.invalidSnippet {
+				validator.ValidateSnippetReturns(errors.New("invalid snippet"))
+			}
+
+			err := validateSnippetsPolicy(validator, test.policy)
+
+			if test.expectErrCount == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.(interface{ Errors() []error }).Errors()).To(HaveLen(test.expectErrCount))
+			}
+		})
+	}
+}
+
+func TestValidateSnippetsPolicyValidatesOnlySupportedContexts(t *testing.T) {
+	policy := createSnippetsPolicy("policy", metav1.Now(), kindHTTPRoute, "hr")
+	policy.Spec.Snippets[0].Context = nkgAPI.SnippetContextHTTP
+
+	validator := &validationfakes.FakeSnippetsValidator{}
+
+	g := NewWithT(t)
+
+	err := validateSnippetsPolicy(validator, policy)
+	g.Expect(err).To(MatchError(`spec.snippets[0].context: Unsupported value: "HTTP": supported values: "Location"`))
+	g.Expect(validator.ValidateSnippetCallCount()).To(BeZero())
+}
+
+func TestAddSnippetsToGatewayAndRoutes(t *testing.T) {
+	gatewayPolicy := createSnippetsPolicy("gateway", metav1.Now(), kindGateway, "gateway")
+	routePolicy := createSnippetsPolicy("route", metav1.Now(), kindHTTPRoute, "hr")
+	invalidPolicy := createSnippetsPolicy("invalid", metav1.Now(), kindHTTPRoute, "hr2")
+
+	policies := map[types.NamespacedName]*SnippetsPolicy{
+		{Namespace: "test", Name: "gateway"}: {Source: gatewayPolicy, Valid: true},
+		{Namespace: "test", Name: "route"}:   {Source: routePolicy, Valid: true},
+		{Namespace: "test", Name: "invalid"}: {Source: invalidPolicy},
+	}
+
+	gw := &Gateway{
+		Source: &v1beta1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      "gateway",
+			},
+		},
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}:  {},
+		{Namespace: "test", Name: "hr2"}: {},
+	}
+
+	addSnippetsToGatewayAndRoutes(gw, routes, policies)
+
+	g := NewWithT(t)
+
+	g.Expect(gw.Snippets).To(Equal(&Snippets{
+		Policy:   types.NamespacedName{Namespace: "test", Name: "gateway"},
+		Snippets: gatewayPolicy.Spec.Snippets,
+	}))
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr"}].Snippets).To(Equal(&Snippets{
+		Policy:   types.NamespacedName{Namespace: "test", Name: "route"},
+		Snippets: routePolicy.Spec.Snippets,
+	}))
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr2"}].Snippets).To(BeNil())
+}
+
+func TestGetValidSnippetsPolicies(t *testing.T) {
+	older := metav1.NewTime(time.Now().Add(-time.Minute))
+	newer := metav1.Now()
+
+	gatewayNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	routeNsName := types.NamespacedName{Namespace: "test", Name: "route"}
+
+	graph := &Graph{
+		SnippetsPolicies: map[types.NamespacedName]*SnippetsPolicy{
+			gatewayNsName: {
+				Source: createSnippetsPolicy("gateway", newer, kindGateway, "gateway"),
+				Valid:  true,
+			},
+			routeNsName: {
+				Source: createSnippetsPolicy("route", older, kindHTTPRoute, "hr"),
+				Valid:  true,
+			},
+			{Namespace: "test", Name: "invalid"}: {
+				Source: createSnippetsPolicy("invalid", older, kindHTTPRoute, "hr2"),
+			},
+		},
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(graph.GetValidSnippetsPolicies()).To(Equal([]types.NamespacedName{routeNsName, gatewayNsName}))
+}
@@ -0,0 +1,66 @@
+package graph
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// TimeoutPolicy represents a TimeoutPolicy resource.
+type TimeoutPolicy = Policy[*nkgAPI.TimeoutPolicy]
+
+func processTimeoutPolicies(
+	policies map[types.NamespacedName]*nkgAPI.TimeoutPolicy,
+	validator validation.PolicyValidator,
+	routes map[types.NamespacedName]*Route,
+) map[types.NamespacedName]*TimeoutPolicy {
+	targets := policyTargets{routes: routes}
+
+	return processPolicies(policies, policyKind[*nkgAPI.TimeoutPolicy, ruleTarget]{
+		name:       nkgAPI.TimeoutPolicyKind,
+		sameTarget: "rules",
+		validate: func(policy *nkgAPI.TimeoutPolicy) error {
+			return validateTimeoutPolicy(validator, policy)
+		},
+		getTarget:  getTimeoutPolicyTarget,
+		findTarget: targets.findRule,
+	})
+}
+
+func getTimeoutPolicyTarget(policy *nkgAPI.TimeoutPolicy) ruleTarget {
+	return getRuleTarget(policy, policy.Spec.RuleIndex)
+}
+
+func validateTimeoutPolicy(
+	validator validation.PolicyValidator,
+	policy *nkgAPI.TimeoutPolicy,
+) error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicyTargetRef(policy, specPath.Child("targetRef"), httpRouteGroupKind)
+
+	spec := policy.Spec
+
+	if spec.RuleIndex != nil && *spec.RuleIndex < 0 {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("ruleIndex"), *spec.RuleIndex, "must be non-negative"))
+	}
+
+	if err := validator.ValidateDuration(string(spec.BackendRequest)); err != nil {
+		allErrs = append(allErrs, field.Invalid(specPath.Child("backendRequest"), spec.BackendRequest, err.Error()))
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// addTimeoutsToRules adds the TimeoutPolicy specs to the rules of the routes. A policy that targets a rule
+// overrides a policy that targets all rules of the route. The routes are modified in place.
+func addTimeoutsToRules(
+	routes map[types.NamespacedName]*Route,
+	policies map[types.NamespacedName]*TimeoutPolicy,
+) {
+	addPoliciesToRules(routes, policies, getTimeoutPolicyTarget, func(rule *Rule, p *TimeoutPolicy) {
+		rule.Timeouts = &p.Source.Spec
+	})
+}
@@ -0,0 +1,121 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+func createTimeoutPolicy(name string, routeName string, ruleIdx *int32) *nkgAPI.TimeoutPolicy {
+	return &nkgAPI.TimeoutPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: nkgAPI.TimeoutPolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: v1beta1.GroupName,
+				Kind:  kindHTTPRoute,
+				Name:  v1alpha2.ObjectName(routeName),
+			},
+			RuleIndex:      ruleIdx,
+			BackendRequest: "10s",
+		},
+	}
+}
+
+func TestValidateTimeoutPolicy(t *testing.T) {
+	createPolicy := func(mutate func(spec *nkgAPI.TimeoutPolicySpec)) *nkgAPI.TimeoutPolicy {
+		p := createTimeoutPolicy("policy", "hr", nil)
+
+		if mutate != nil {
+			mutate(&p.Spec)
+		}
+
+		return p
+	}
+
+	tests := []struct {
+		policy          *nkgAPI.TimeoutPolicy
+		name            string
+		expectErrCount  int
+		invalidDuration bool
+	}{
+		{
+			policy: createPolicy(nil),
+			name:   "valid",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.TimeoutPolicySpec) {
+				spec.RuleIndex = helpers.GetPointer[int32](-1)
+			}),
+			expectErrCount: 1,
+			name:           "negative rule index",
+		},
+		{
+			policy:          createPolicy(nil),
+			invalidDuration: true,
+			expectErrCount:  1,
+			name:            "invalid backend request",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{}
+			if test.invalidDuration {
+				validator.ValidateDurationReturns(errInvalidDuration)
+			}
+
+			err := validateTimeoutPolicy(validator, test.policy)
+
+			if test.expectErrCount == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.(interface{ Errors() []error }).Errors()).To(HaveLen(test.expectErrCount))
+			}
+		})
+	}
+}
+
+func TestAddTimeoutsToRules(t *testing.T) {
+	routePolicy := createTimeoutPolicy("route", "hr", nil)
+	rulePolicy := createTimeoutPolicy("rule", "hr", helpers.GetPointer[int32](1))
+	invalidPolicy := createTimeoutPolicy("invalid", "hr2", nil)
+
+	policies := map[types.NamespacedName]*TimeoutPolicy{
+		{Namespace: "test", Name: "route"}:   {Source: routePolicy, Valid: true},
+		{Namespace: "test", Name: "rule"}:    {Source: rulePolicy, Valid: true},
+		{Namespace: "test", Name: "invalid"}: {Source: invalidPolicy},
+	}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}: {
+			Rules: []Rule{{}, {}},
+		},
+		{Namespace: "test", Name: "hr2"}: {
+			Rules: []Rule{{}},
+		},
+	}
+
+	addTimeoutsToRules(routes, policies)
+
+	g := NewWithT(t)
+
+	rules := routes[types.NamespacedName{Namespace: "test", Name: "hr"}].Rules
+	g.Expect(rules[0].Timeouts).To(Equal(&routePolicy.Spec))
+	g.Expect(rules[1].Timeouts).To(Equal(&rulePolicy.Spec))
+
+	g.Expect(routes[types.NamespacedName{Namespace: "test", Name: "hr2"}].Rules[0].Timeouts).To(BeNil())
+}
@@ -0,0 +1,128 @@
+package graph
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// UpstreamHealthPolicy represents an UpstreamHealthPolicy resource.
+type UpstreamHealthPolicy = Policy[*nkgAPI.UpstreamHealthPolicy]
+
+func processUpstreamHealthPolicies(
+	policies map[types.NamespacedName]*nkgAPI.UpstreamHealthPolicy,
+	validator validation.PolicyValidator,
+	services map[types.NamespacedName]*v1.Service,
+) map[types.NamespacedName]*UpstreamHealthPolicy {
+	targets := policyTargets{services: services}
+
+	return processPolicies(policies, policyKind[*nkgAPI.UpstreamHealthPolicy, policyTarget]{
+		name:       nkgAPI.UpstreamHealthPolicyKind,
+		sameTarget: "Service",
+		validate: func(policy *nkgAPI.UpstreamHealthPolicy) error {
+			return validateUpstreamHealthPolicy(validator, policy)
+		},
+		getTarget:  getUpstreamHealthPolicyTarget,
+		findTarget: targets.find,
+	})
+}
+
+func getUpstreamHealthPolicyTarget(policy *nkgAPI.UpstreamHealthPolicy) policyTarget {
+	return getPolicyTarget(policy, nil)
+}
+
+func validateUpstreamHealthPolicy(
+	validator validation.PolicyValidator,
+	policy *nkgAPI.UpstreamHealthPolicy,
+) error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicyTargetRef(policy, specPath.Child("targetRef"), serviceGroupKind)
+
+	if policy.Spec.FailTimeout != nil {
+		if err := validator.ValidateDuration(string(*policy.Spec.FailTimeout)); err != nil {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("failTimeout"), *policy.Spec.FailTimeout, err.Error()))
+		}
+	}
+
+	if policy.Spec.NextUpstream != nil {
+		nextUpstreamPath := specPath.Child("nextUpstream")
+		allErrs = append(allErrs, validateNextUpstream(validator, *policy.Spec.NextUpstream, nextUpstreamPath)...)
+	}
+
+	return allErrs.ToAggregate()
+}
+
+func validateNextUpstream(
+	validator validation.PolicyValidator,
+	nextUpstream nkgAPI.NextUpstream,
+	path *field.Path,
+) field.ErrorList {
+	allErrs := validateNextUpstreamConditions(validator, nextUpstream.Conditions, path.Child("conditions"))
+
+	if nextUpstream.Timeout != nil {
+		if err := validator.ValidateDuration(string(*nextUpstream.Timeout)); err != nil {
+			allErrs = append(allErrs, field.Invalid(path.Child("timeout"), *nextUpstream.Timeout, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+func validateNextUpstreamConditions(
+	validator validation.PolicyValidator,
+	conds []nkgAPI.NextUpstreamCondition,
+	path *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if len(conds) == 0 {
+		allErrs = append(allErrs, field.Required(path, "must include at least one condition"))
+	}
+
+	for i, cond := range conds {
+		if valid, supportedValues := validator.ValidateNextUpstreamCondition(string(cond)); !valid {
+			allErrs = append(allErrs, field.NotSupported(path.Index(i), cond, supportedValues))
+		}
+
+		if cond == nkgAPI.NextUpstreamConditionOff && len(conds) > 1 {
+			valErr := field.Invalid(path.Index(i), cond, "cannot be combined with other conditions")
+			allErrs = append(allErrs, valErr)
+		}
+	}
+
+	return allErrs
+}
+
+// addUpstreamHealthToBackendRefs adds the UpstreamHealthPolicy specs to the valid BackendRefs of the routes
+// that reference the target Services of the policies. The routes are modified in place.
+func addUpstreamHealthToBackendRefs(
+	routes map[types.NamespacedName]*Route,
+	policies map[types.NamespacedName]*UpstreamHealthPolicy,
+) {
+	attached := newAttachedPolicies(policies, getUpstreamHealthPolicyTarget)
+	if len(attached) == 0 {
+		return
+	}
+
+	for _, r := range routes {
+		for ruleIdx := range r.Rules {
+			refs := r.Rules[ruleIdx].BackendRefs
+
+			for refIdx := range refs {
+				if !refs[refIdx].Valid {
+					continue
+				}
+
+				svcNsName := types.NamespacedName{Namespace: refs[refIdx].Svc.Namespace, Name: refs[refIdx].Svc.Name}
+
+				if p := attached.forService(svcNsName); p != nil {
+					refs[refIdx].UpstreamHealth = &p.Source.Spec
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+var errInvalidDuration = errors.New("invalid duration")
+
+func createUpstreamHealthPolicy(name string, svcName string) *nkgAPI.UpstreamHealthPolicy {
+	return &nkgAPI.UpstreamHealthPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: nkgAPI.UpstreamHealthPolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Kind: kindService,
+				Name: v1alpha2.ObjectName(svcName),
+			},
+			MaxFails:    helpers.GetPointer[int32](3),
+			FailTimeout: helpers.GetPointer[nkgAPI.Duration]("30s"),
+			NextUpstream: &nkgAPI.NextUpstream{
+				Conditions: []nkgAPI.NextUpstreamCondition{
+					nkgAPI.NextUpstreamConditionError,
+					nkgAPI.NextUpstreamConditionHTTP502,
+				},
+				Tries:   helpers.GetPointer[int32](2),
+				Timeout: helpers.GetPointer[nkgAPI.Duration]("10s"),
+			},
+		},
+	}
+}
+
+func TestValidateUpstreamHealthPolicy(t *testing.T) {
+	createPolicy := func(mutate func(spec *nkgAPI.UpstreamHealthPolicySpec)) *nkgAPI.UpstreamHealthPolicy {
+		p := createUpstreamHealthPolicy("policy", "svc")
+
+		if mutate != nil {
+			mutate(&p.Spec)
+		}
+
+		return p
+	}
+
+	tests := []struct {
+		policy            *nkgAPI.UpstreamHealthPolicy
+		name              string
+		invalidCondition  nkgAPI.NextUpstreamCondition
+		invalidDuration   nkgAPI.Duration
+		expectErrCount    int
+		expectCondChecks  int
+		expectDurationCnt int
+	}{
+		{
+			policy:            createPolicy(nil),
+			expectCondChecks:  2,
+			expectDurationCnt: 2,
+			name:              "valid",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.UpstreamHealthPolicySpec) {
+				spec.FailTimeout = nil
+				spec.NextUpstream = nil
+			}),
+			name: "valid without optional fields",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.UpstreamHealthPolicySpec) {
+				spec.TargetRef.Namespace = helpers.GetPointer[v1alpha2.Namespace]("other")
+			}),
+			expectErrCount:    1,
+			expectCondChecks:  2,
+			expectDurationCnt: 2,
+			name:              "target in another namespace",
+		},
+		{
+			policy:            createPolicy(nil),
+			invalidDuration:   "10s",
+			expectErrCount:    1,
+			expectCondChecks:  2,
+			expectDurationCnt: 2,
+			name:              "invalid next upstream timeout",
+		},
+		{
+			policy:            createPolicy(nil),
+			invalidCondition:  nkgAPI.NextUpstreamConditionHTTP502,
+			expectErrCount:    1,
+			expectCondChecks:  2,
+			expectDurationCnt: 2,
+			name:              "invalid condition",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.UpstreamHealthPolicySpec) {
+				spec.NextUpstream.Conditions = []nkgAPI.NextUpstreamCondition{
+					nkgAPI.NextUpstreamConditionError,
+					nkgAPI.NextUpstreamConditionOff,
+				}
+			}),
+			expectErrCount:    1,
+			expectCondChecks:  2,
+			expectDurationCnt: 2,
+			name:              "off combined with other conditions",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.UpstreamHealthPolicySpec) {
+				spec.NextUpstream.Conditions = nil
+			}),
+			expectErrCount:    1,
+			expectDurationCnt: 2,
+			name:              "no conditions",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{
+				ValidateNextUpstreamConditionStub: func(cond string) (bool, []string) {
+					return cond != string(test.invalidCondition), nil
+				},
+				ValidateDurationStub: func(duration string) error {
+					if duration == string(test.invalidDuration) {
+						return errInvalidDuration
+					}
+					return nil
+				},
+			}
+
+			err := validateUpstreamHealthPolicy(validator, test.policy)
+
+			if test.expectErrCount == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.(interface{ Errors() []error }).Errors()).To(HaveLen(test.expectErrCount))
+			}
+
+			g.Expect(validator.ValidateNextUpstreamConditionCallCount()).To(Equal(test.expectCondChecks))
+			g.Expect(validator.ValidateDurationCallCount()).To(Equal(test.expectDurationCnt))
+		})
+	}
+}
+
+func TestAddUpstreamHealthToBackendRefs(t *testing.T) {
+	validPolicy := createUpstreamHealthPolicy("valid", "svc1")
+	invalidPolicy := createUpstreamHealthPolicy("invalid", "svc2")
+
+	policies := map[types.NamespacedName]*UpstreamHealthPolicy{
+		{Namespace: "test", Name: "valid"}:   {Source: validPolicy, Valid: true},
+		{Namespace: "test", Name: "invalid"}: {Source: invalidPolicy},
+	}
+
+	svc1 := &v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "svc1"}}
+	svc2 := &v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "svc2"}}
+
+	routes := map[types.NamespacedName]*Route{
+		{Namespace: "test", Name: "hr"}: {
+			Rules: []Rule{
+				{
+					BackendRefs: []BackendRef{
+						{Svc: svc1, Port: 80, Valid: true},
+						{Svc: svc2, Port: 80, Valid: true},
+						{Valid: false},
+					},
+				},
+			},
+		},
+	}
+
+	addUpstreamHealthToBackendRefs(routes, policies)
+
+	g := NewWithT(t)
+
+	refs := routes[types.NamespacedName{Namespace: "test", Name: "hr"}].Rules[0].BackendRefs
+	g.Expect(refs[0].UpstreamHealth).To(Equal(&validPolicy.Spec))
+	g.Expect(refs[1].UpstreamHealth).To(BeNil())
+	g.Expect(refs[2].UpstreamHealth).To(BeNil())
+}
@@ -0,0 +1,190 @@
+package graph
+
+import (
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// UpstreamSettingsPolicy represents an UpstreamSettingsPolicy resource.
+type UpstreamSettingsPolicy = Policy[*nkgAPI.UpstreamSettingsPolicy]
+
+// UpstreamSettings holds the settings of the upstream of a backendRef, which come from the UpstreamSettingsPolicies
+// that target the Service of the backendRef or the HTTPRoute of the backendRef.
+// The settings of a policy that targets the HTTPRoute override the settings of a policy that targets the Service.
+type UpstreamSettings struct {
+	// LoadBalancingMethod is the load-balancing method. If nil, the default method is used.
+	LoadBalancingMethod *nkgAPI.LoadBalancingMethod
+	// RouteNsName is the namespaced name of the HTTPRoute if any of the settings come from a policy that targets
+	// the HTTPRoute. Such settings are specific to the HTTPRoute, so the backendRef can't share the upstream
+	// with the backendRefs of other HTTPRoutes.
+	RouteNsName *types.NamespacedName
+	// KeepAlive configures the keep-alive connections to the endpoints. If nil, keep-alive connections are disabled.
+	KeepAlive *nkgAPI.UpstreamKeepAlive
+	// MaxConnections limits the number of connections to each endpoint. If nil, the number is not limited.
+	MaxConnections *int32
+	// HashMethodKey is the key of the Hash and HashConsistent load-balancing methods.
+	HashMethodKey string
+}
+
+func processUpstreamSettingsPolicies(
+	policies map[types.NamespacedName]*nkgAPI.UpstreamSettingsPolicy,
+	validator validation.PolicyValidator,
+	services map[types.NamespacedName]*v1.Service,
+	routes map[types.NamespacedName]*Route,
+) map[types.NamespacedName]*UpstreamSettingsPolicy {
+	targets := policyTargets{routes: routes, services: services}
+
+	return processPolicies(policies, policyKind[*nkgAPI.UpstreamSettingsPolicy, policyTarget]{
+		name:       nkgAPI.UpstreamSettingsPolicyKind,
+		sameTarget: "resource",
+		validate: func(policy *nkgAPI.UpstreamSettingsPolicy) error {
+			return validateUpstreamSettingsPolicy(validator, policy)
+		},
+		getTarget:  getUpstreamSettingsPolicyTarget,
+		findTarget: targets.find,
+	})
+}
+
+func getUpstreamSettingsPolicyTarget(policy *nkgAPI.UpstreamSettingsPolicy) policyTarget {
+	return getPolicyTarget(policy, nil)
+}
+
+func validateUpstreamSettingsPolicy(
+	validator validation.PolicyValidator,
+	policy *nkgAPI.UpstreamSettingsPolicy,
+) error {
+	specPath := field.NewPath("spec")
+
+	allErrs := validatePolicyTargetRef(policy, specPath.Child("targetRef"), serviceGroupKind, httpRouteGroupKind)
+
+	if policy.Spec.LoadBalancingMethod != nil {
+		method := *policy.Spec.LoadBalancingMethod
+		methodPath := specPath.Child("loadBalancingMethod")
+
+		if valid, supportedValues := validator.ValidateLoadBalancingMethod(string(method)); !valid {
+			allErrs = append(allErrs, field.NotSupported(methodPath, method, supportedValues))
+		}
+	}
+
+	hashMethod := policy.Spec.LoadBalancingMethod != nil &&
+		(*policy.Spec.LoadBalancingMethod == nkgAPI.LoadBalancingMethodHash ||
+			*policy.Spec.LoadBalancingMethod == nkgAPI.LoadBalancingMethodHashConsistent)
+
+	keyPath := specPath.Child("hashMethodKey")
+
+	switch {
+	case hashMethod && policy.Spec.HashMethodKey == nil:
+		allErrs = append(allErrs, field.Required(keyPath, "required for Hash and HashConsistent methods"))
+	case !hashMethod && policy.Spec.HashMethodKey != nil:
+		valErr := field.Forbidden(keyPath, "can only be set for Hash and HashConsistent methods")
+		allErrs = append(allErrs, valErr)
+	case hashMethod:
+		if err := validator.ValidateLoadBalancingHashKey(*policy.Spec.HashMethodKey); err != nil {
+			allErrs = append(allErrs, field.Invalid(keyPath, *policy.Spec.HashMethodKey, err.Error()))
+		}
+	}
+
+	if keepAlive := policy.Spec.KeepAlive; keepAlive != nil {
+		keepAlivePath := specPath.Child("keepAlive")
+
+		if keepAlive.Time != nil {
+			if err := validator.ValidateDuration(string(*keepAlive.Time)); err != nil {
+				allErrs = append(allErrs, field.Invalid(keepAlivePath.Child("time"), *keepAlive.Time, err.Error()))
+			}
+		}
+
+		if keepAlive.Timeout != nil {
+			if err := validator.ValidateDuration(string(*keepAlive.Timeout)); err != nil {
+				allErrs = append(allErrs, field.Invalid(keepAlivePath.Child("timeout"), *keepAlive.Timeout, err.Error()))
+			}
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// addUpstreamSettingsToBackendRefs adds the UpstreamSettings to the valid BackendRefs of the routes.
+// The routes are modified in place.
+func addUpstreamSettingsToBackendRefs(
+	routes map[types.NamespacedName]*Route,
+	policies map[types.NamespacedName]*UpstreamSettingsPolicy,
+) {
+	attached := newAttachedPolicies(policies, getUpstreamSettingsPolicyTarget)
+	if len(attached) == 0 {
+		return
+	}
+
+	for routeNsName, r := range routes {
+		routePolicy := attached.forRoute(routeNsName)
+
+		for ruleIdx := range r.Rules {
+			refs := r.Rules[ruleIdx].BackendRefs
+
+			for refIdx := range refs {
+				if !refs[refIdx].Valid {
+					continue
+				}
+
+				svcNsName := types.NamespacedName{Namespace: refs[refIdx].Svc.Namespace, Name: refs[refIdx].Svc.Name}
+				svcPolicy := attached.forService(svcNsName)
+
+				refs[refIdx].UpstreamSettings = buildUpstreamSettings(svcPolicy, routePolicy, routeNsName)
+			}
+		}
+	}
+}
+
+func buildUpstreamSettings(
+	svcPolicy *UpstreamSettingsPolicy,
+	routePolicy *UpstreamSettingsPolicy,
+	routeNsName types.NamespacedName,
+) *UpstreamSettings {
+	var settings UpstreamSettings
+
+	if svcPolicy != nil {
+		applyUpstreamSettings(&settings, svcPolicy.Source.Spec)
+	}
+
+	if routePolicy != nil && applyUpstreamSettings(&settings, routePolicy.Source.Spec) {
+		settings.RouteNsName = &routeNsName
+	}
+
+	if settings == (UpstreamSettings{}) {
+		return nil
+	}
+
+	return &settings
+}
+
+// applyUpstreamSettings overrides the settings with the settings that are set in the spec.
+// It returns true if the spec sets any settings.
+func applyUpstreamSettings(settings *UpstreamSettings, spec nkgAPI.UpstreamSettingsPolicySpec) bool {
+	var applied bool
+
+	if spec.LoadBalancingMethod != nil {
+		settings.LoadBalancingMethod = spec.LoadBalancingMethod
+		settings.HashMethodKey = ""
+
+		if spec.HashMethodKey != nil {
+			settings.HashMethodKey = *spec.HashMethodKey
+		}
+
+		applied = true
+	}
+
+	if spec.KeepAlive != nil {
+		settings.KeepAlive = spec.KeepAlive
+		applied = true
+	}
+
+	if spec.MaxConnections != nil {
+		settings.MaxConnections = spec.MaxConnections
+		applied = true
+	}
+
+	return applied
+}
@@ -0,0 +1,290 @@
+package graph
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+func createUpstreamSettingsPolicy(
+	name string,
+	kind v1alpha2.Kind,
+	group v1alpha2.Group,
+	targetName string,
+	method nkgAPI.LoadBalancingMethod,
+) *nkgAPI.UpstreamSettingsPolicy {
+	return &nkgAPI.UpstreamSettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      name,
+		},
+		Spec: nkgAPI.UpstreamSettingsPolicySpec{
+			TargetRef: v1alpha2.PolicyTargetReference{
+				Group: group,
+				Kind:  kind,
+				Name:  v1alpha2.ObjectName(targetName),
+			},
+			LoadBalancingMethod: helpers.GetPointer(method),
+		},
+	}
+}
+
+func TestValidateUpstreamSettingsPolicy(t *testing.T) {
+	createPolicy := func(mutate func(spec *nkgAPI.UpstreamSettingsPolicySpec)) *nkgAPI.UpstreamSettingsPolicy {
+		p := createUpstreamSettingsPolicy(
+			"policy", kindService, "", "svc", nkgAPI.LoadBalancingMethodHash,
+		)
+		p.Spec.HashMethodKey = helpers.GetPointer("$request_uri")
+
+		if mutate != nil {
+			mutate(&p.Spec)
+		}
+
+		return p
+	}
+
+	tests := []struct {
+		policy              *nkgAPI.UpstreamSettingsPolicy
+		hashKeyErr          error
+		durationErr         error
+		name                string
+		expectErrCount      int
+		expectHashKeyChecks int
+	}{
+		{
+			policy:              createPolicy(nil),
+			expectHashKeyChecks: 1,
+			name:                "valid",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.UpstreamSettingsPolicySpec) {
+				spec.TargetRef.Kind = kindHTTPRoute
+				spec.TargetRef.Group = v1beta1.GroupName
+			}),
+			expectHashKeyChecks: 1,
+			name:                "valid HTTPRoute target",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.UpstreamSettingsPolicySpec) {
+				spec.LoadBalancingMethod = nil
+				spec.HashMethodKey = nil
+			}),
+			name: "valid without method",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.UpstreamSettingsPolicySpec) {
+				spec.TargetRef.Kind = "Gateway"
+				spec.TargetRef.Group = v1beta1.GroupName
+			}),
+			expectErrCount:      1,
+			expectHashKeyChecks: 1,
+			name:                "unsupported target kind",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.UpstreamSettingsPolicySpec) {
+				spec.TargetRef.Namespace = helpers.GetPointer[v1alpha2.Namespace]("other")
+			}),
+			expectErrCount:      1,
+			expectHashKeyChecks: 1,
+			name:                "target in another namespace",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.UpstreamSettingsPolicySpec) {
+				spec.HashMethodKey = nil
+			}),
+			expectErrCount: 1,
+			name:           "hash key is missing",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.UpstreamSettingsPolicySpec) {
+				spec.LoadBalancingMethod = helpers.GetPointer(nkgAPI.LoadBalancingMethodRoundRobin)
+			}),
+			expectErrCount: 1,
+			name:           "hash key is set for non-hash method",
+		},
+		{
+			policy:              createPolicy(nil),
+			hashKeyErr:          errors.New("invalid key"),
+			expectErrCount:      1,
+			expectHashKeyChecks: 1,
+			name:                "invalid hash key",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.UpstreamSettingsPolicySpec) {
+				spec.KeepAlive = &nkgAPI.UpstreamKeepAlive{
+					Connections: 16,
+					Time:        helpers.GetPointer[nkgAPI.Duration]("1h"),
+					Timeout:     helpers.GetPointer[nkgAPI.Duration]("30s"),
+				}
+			}),
+			expectHashKeyChecks: 1,
+			name:                "valid keep-alive",
+		},
+		{
+			policy: createPolicy(func(spec *nkgAPI.UpstreamSettingsPolicySpec) {
+				spec.KeepAlive = &nkgAPI.UpstreamKeepAlive{
+					Connections: 16,
+					Time:        helpers.GetPointer[nkgAPI.Duration]("invalid"),
+					Timeout:     helpers.GetPointer[nkgAPI.Duration]("invalid"),
+				}
+			}),
+			durationErr:         errors.New("invalid duration"),
+			expectErrCount:      2,
+			expectHashKeyChecks: 1,
+			name:                "invalid keep-alive durations",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			validator := &validationfakes.FakePolicyValidator{}
+			validator.ValidateLoadBalancingMethodReturns(true, nil)
+			validator.ValidateLoadBalancingHashKeyReturns(test.hashKeyErr)
+			validator.ValidateDurationReturns(test.durationErr)
+
+			err := validateUpstreamSettingsPolicy(validator, test.policy)
+
+			if test.expectErrCount == 0 {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.(interface{ Errors() []error }).Errors()).To(HaveLen(test.expectErrCount))
+			}
+
+			g.Expect(validator.ValidateLoadBalancingHashKeyCallCount()).To(Equal(test.expectHashKeyChecks))
+		})
+	}
+}
+
+func TestAddUpstreamSettingsToBackendRefs(t *testing.T) {
+	svcPolicy := createUpstreamSettingsPolicy(
+		"svc-policy", kindService, "", "svc1", nkgAPI.LoadBalancingMethodHash,
+	)
+	svcPolicy.Spec.HashMethodKey = helpers.GetPointer("$remote_addr")
+	svcPolicy.Spec.KeepAlive = &nkgAPI.UpstreamKeepAlive{Connections: 16}
+	svcPolicy.Spec.MaxConnections = helpers.GetPointer[int32](100)
+
+	routePolicy := createUpstreamSettingsPolicy(
+		"route-policy", kindHTTPRoute, v1beta1.GroupName, "hr1", nkgAPI.LoadBalancingMethodLeastConn,
+	)
+
+	invalidPolicy := createUpstreamSettingsPolicy(
+		"invalid-policy", kindService, "", "svc2", nkgAPI.LoadBalancingMethodIPHash,
+	)
+
+	policies := map[types.NamespacedName]*UpstreamSettingsPolicy{
+		{Namespace: "test", Name: "svc-policy"}:     {Source: svcPolicy, Valid: true},
+		{Namespace: "test", Name: "route-policy"}:   {Source: routePolicy, Valid: true},
+		{Namespace: "test", Name: "invalid-policy"}: {Source: invalidPolicy},
+	}
+
+	svc1 := &v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "svc1"}}
+	svc2 := &v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "svc2"}}
+
+	createRoute := func() *Route {
+		return &Route{
+			Rules: []Rule{
+				{
+					BackendRefs: []BackendRef{
+						{Svc: svc1, Port: 80, Valid: true},
+						{Svc: svc2, Port: 80, Valid: true},
+						{Valid: false},
+					},
+				},
+			},
+		}
+	}
+
+	hr1NsName := types.NamespacedName{Namespace: "test", Name: "hr1"}
+	hr2NsName := types.NamespacedName{Namespace: "test", Name: "hr2"}
+
+	routes := map[types.NamespacedName]*Route{
+		hr1NsName: createRoute(),
+		hr2NsName: createRoute(),
+	}
+
+	addUpstreamSettingsToBackendRefs(routes, policies)
+
+	g := NewWithT(t)
+
+	hr1Refs := routes[hr1NsName].Rules[0].BackendRefs
+	g.Expect(hr1Refs[0].UpstreamSettings).To(Equal(&UpstreamSettings{
+		LoadBalancingMethod: helpers.GetPointer(nkgAPI.LoadBalancingMethodLeastConn),
+		RouteNsName:         &hr1NsName,
+		KeepAlive:           &nkgAPI.UpstreamKeepAlive{Connections: 16},
+		MaxConnections:      helpers.GetPointer[int32](100),
+	}))
+	g.Expect(hr1Refs[1].UpstreamSettings).To(Equal(&UpstreamSettings{
+		LoadBalancingMethod: helpers.GetPointer(nkgAPI.LoadBalancingMethodLeastConn),
+		RouteNsName:         &hr1NsName,
+	}))
+	g.Expect(hr1Refs[2].UpstreamSettings).To(BeNil())
+
+	hr2Refs := routes[hr2NsName].Rules[0].BackendRefs
+	g.Expect(hr2Refs[0].UpstreamSettings).To(Equal(&UpstreamSettings{
+		LoadBalancingMethod: helpers.GetPointer(nkgAPI.LoadBalancingMethodHash),
+		HashMethodKey:       "$remote_addr",
+		KeepAlive:           &nkgAPI.UpstreamKeepAlive{Connections: 16},
+		MaxConnections:      helpers.GetPointer[int32](100),
+	}))
+	g.Expect(hr2Refs[1].UpstreamSettings).To(BeNil())
+	g.Expect(hr2Refs[2].UpstreamSettings).To(BeNil())
+}
+
+func TestBackendRefUpstreamName(t *testing.T) {
+	svc := &v1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "svc"}}
+
+	tests := []struct {
+		ref      BackendRef
+		name     string
+		expected string
+	}{
+		{
+			ref:      BackendRef{Svc: svc, Port: 80},
+			expected: "test_svc_80",
+			name:     "no settings",
+		},
+		{
+			ref: BackendRef{
+				Svc:  svc,
+				Port: 80,
+				UpstreamSettings: &UpstreamSettings{
+					LoadBalancingMethod: helpers.GetPointer(nkgAPI.LoadBalancingMethodLeastConn),
+				},
+			},
+			expected: "test_svc_80",
+			name:     "service settings",
+		},
+		{
+			ref: BackendRef{
+				Svc:  svc,
+				Port: 80,
+				UpstreamSettings: &UpstreamSettings{
+					LoadBalancingMethod: helpers.GetPointer(nkgAPI.LoadBalancingMethodLeastConn),
+					RouteNsName:         &types.NamespacedName{Namespace: "route-ns", Name: "hr"},
+				},
+			},
+			expected: "test_svc_80_route-ns_hr",
+			name:     "route settings",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(test.ref.UpstreamName()).To(Equal(test.expected))
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/controller/index"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/graph"
 )
@@ -18,10 +19,11 @@ import (
 // Capturer captures relationships between Kubernetes objects and can be queried for whether a relationship exists
 // for a given object.
 //
-// The relationships between HTTPRoutes -> Services are many to 1,
+// The relationships between HTTPRoutes -> Services and AuthenticationFilters -> Services are many to 1,
 // so these relationships are tracked using a counter.
-// A Service relationship exists if at least one HTTPRoute references it.
-// An EndpointSlice relationship exists if its Service owner is referenced by at least one HTTPRoute.
+// A Service relationship exists if at least one HTTPRoute or AuthenticationFilter references it.
+// An EndpointSlice relationship exists if its Service owner is referenced by at least one HTTPRoute or
+// AuthenticationFilter.
 //
 // A Namespace relationship exists if it has labels that match a Gateway listener's label selector.
 type Capturer interface {
@@ -33,7 +35,11 @@ type Capturer interface {
 type (
 	// routeToServicesMap maps HTTPRoute names to the set of Services it references.
 	routeToServicesMap map[types.NamespacedName]map[types.NamespacedName]struct{}
-	// serviceRefCountMap maps Service names to the number of HTTPRoutes that reference it.
+	// authFilterToServiceMap maps AuthenticationFilter names to the Service of the external authorization service
+	// that it references.
+	authFilterToServiceMap map[types.NamespacedName]types.NamespacedName
+	// serviceRefCountMap maps Service names to the number of HTTPRoutes and AuthenticationFilters that
+	// reference it.
 	serviceRefCountMap map[types.NamespacedName]int
 	// gatewayLabelSelectorsMap maps Gateways to the label selectors that their listeners use for allowed routes
 	gatewayLabelSelectorsMap map[types.NamespacedName][]labels.Selector
@@ -55,6 +61,7 @@ func (n namespaceCfg) match() bool {
 // CapturerImpl implements the Capturer interface.
 type CapturerImpl struct {
 	routesToServices      routeToServicesMap
+	authFiltersToServices authFilterToServiceMap
 	serviceRefCount       serviceRefCountMap
 	gatewayLabelSelectors gatewayLabelSelectorsMap
 	namespaces            namespaces
@@ -65,6 +72,7 @@ type CapturerImpl struct {
 func NewCapturerImpl() *CapturerImpl {
 	return &CapturerImpl{
 		routesToServices:      make(routeToServicesMap),
+		authFiltersToServices: make(authFilterToServiceMap),
 		serviceRefCount:       make(serviceRefCountMap),
 		gatewayLabelSelectors: make(gatewayLabelSelectorsMap),
 		namespaces:            make(namespaces),
@@ -77,6 +85,8 @@ func (c *CapturerImpl) Capture(obj client.Object) {
 	switch o := obj.(type) {
 	case *v1beta1.HTTPRoute:
 		c.upsertForRoute(o)
+	case *nkgAPI.AuthenticationFilter:
+		c.upsertForAuthFilter(o)
 	case *discoveryV1.EndpointSlice:
 		svcName := index.GetServiceNameFromEndpointSlice(o)
 		if svcName != "" {
@@ -134,6 +144,8 @@ func (c *CapturerImpl) Remove(resourceType client.Object, nsname types.Namespace
 	switch resourceType.(type) {
 	case *v1beta1.HTTPRoute:
 		c.deleteForRoute(nsname)
+	case *nkgAPI.AuthenticationFilter:
+		c.deleteForAuthFilter(nsname)
 	case *discoveryV1.EndpointSlice:
 		delete(c.endpointSliceOwners, nsname)
 	case *v1beta1.Gateway:
@@ -193,6 +205,27 @@ func (c *CapturerImpl) deleteForRoute(routeName types.NamespacedName) {
 	delete(c.routesToServices, routeName)
 }
 
+func (c *CapturerImpl) upsertForAuthFilter(filter *nkgAPI.AuthenticationFilter) {
+	filterName := client.ObjectKeyFromObject(filter)
+	c.deleteForAuthFilter(filterName)
+
+	if filter.Spec.External == nil {
+		return
+	}
+
+	svc := types.NamespacedName{Namespace: filter.Namespace, Name: filter.Spec.External.BackendRef.Name}
+
+	c.serviceRefCount[svc]++
+	c.authFiltersToServices[filterName] = svc
+}
+
+func (c *CapturerImpl) deleteForAuthFilter(filterName types.NamespacedName) {
+	if svc, exists := c.authFiltersToServices[filterName]; exists {
+		c.decrementRefCount(svc)
+		delete(c.authFiltersToServices, filterName)
+	}
+}
+
 func (c *CapturerImpl) decrementRefCount(svcName types.NamespacedName) {
 	if count, exist := c.serviceRefCount[svcName]; exist {
 		if count == 1 {
@@ -10,6 +10,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	nkgAPI "github.com/nginxinc/nginx-kubernetes-gateway/apis/v1alpha1"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/controller/index"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
 	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/relationship"
@@ -315,6 +316,64 @@ var _ = Describe("Capturer", func() {
 			})
 		})
 	})
+	Describe("Capture service relationships for authentication filters", Ordered, func() {
+		createFilter := func(svcName string) *nkgAPI.AuthenticationFilter {
+			return &nkgAPI.AuthenticationFilter{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "filter"},
+				Spec: nkgAPI.AuthenticationFilterSpec{
+					Type: nkgAPI.AuthenticationTypeExternal,
+					External: &nkgAPI.ExternalAuthentication{
+						BackendRef: nkgAPI.ServiceReference{Name: svcName, Port: 80},
+					},
+				},
+			}
+		}
+
+		filterName := types.NamespacedName{Namespace: "test", Name: "filter"}
+
+		BeforeAll(func() {
+			capturer = relationship.NewCapturerImpl()
+		})
+
+		When("a filter with an external authorization service is captured", func() {
+			It("reports a service relationship", func() {
+				capturer.Capture(createFilter("svc1"))
+				capturer.Capture(hr1)
+
+				Expect(capturer.Exists(&v1.Service{}, svc1)).To(BeTrue())
+				Expect(capturer.GetRefCountForService(svc1)).To(Equal(2))
+			})
+		})
+		When("the filter changes its external authorization service", func() {
+			It("moves the service relationship", func() {
+				capturer.Capture(createFilter("svc2"))
+
+				Expect(capturer.GetRefCountForService(svc1)).To(Equal(1))
+				Expect(capturer.Exists(&v1.Service{}, svc2)).To(BeTrue())
+				Expect(capturer.GetRefCountForService(svc2)).To(Equal(1))
+			})
+		})
+		When("the filter changes its type", func() {
+			It("removes the service relationship", func() {
+				filter := createFilter("svc2")
+				filter.Spec.Type = nkgAPI.AuthenticationTypeBasic
+				filter.Spec.External = nil
+
+				capturer.Capture(filter)
+
+				Expect(capturer.Exists(&v1.Service{}, svc2)).To(BeFalse())
+			})
+		})
+		When("the filter is removed", func() {
+			It("removes the service relationship", func() {
+				capturer.Capture(createFilter("svc2"))
+				capturer.Remove(&nkgAPI.AuthenticationFilter{}, filterName)
+
+				Expect(capturer.Exists(&v1.Service{}, svc2)).To(BeFalse())
+				Expect(capturer.GetRefCountForService(svc1)).To(Equal(1))
+			})
+		})
+	})
 	Describe("Capture namespace and gateway relationships", func() {
 		var gw *v1beta1.Gateway
 		var nsNoLabels, ns *v1.Namespace
@@ -63,6 +63,40 @@ func (e *ServiceResolverImpl) Resolve(ctx context.Context, svc *v1.Service, port
 	return resolveEndpoints(svc, port, endpointSliceList, initEndpointSetWithCalculatedSize)
 }
 
+// StaticServiceResolver implements ServiceResolver.
+// Unlike ServiceResolverImpl, it resolves Services using a fixed list of EndpointSlices rather than the ones from
+// the cluster. This allows generating the NGINX configuration without a Kubernetes API server.
+type StaticServiceResolver struct {
+	endpointSlices []discoveryV1.EndpointSlice
+}
+
+// NewStaticServiceResolver creates a new instance of a StaticServiceResolver.
+func NewStaticServiceResolver(endpointSlices []discoveryV1.EndpointSlice) *StaticServiceResolver {
+	return &StaticServiceResolver{endpointSlices: endpointSlices}
+}
+
+// Resolve resolves a Service and Port to a list of Endpoints.
+// Returns an error if the Service or Port cannot be resolved.
+func (e *StaticServiceResolver) Resolve(_ context.Context, svc *v1.Service, port int32) ([]Endpoint, error) {
+	if svc == nil {
+		return nil, errors.New("cannot resolve a nil Service")
+	}
+
+	var endpointSliceList discoveryV1.EndpointSliceList
+
+	for _, eps := range e.endpointSlices {
+		if eps.Namespace == svc.Namespace && index.GetServiceNameFromEndpointSlice(&eps) == svc.Name {
+			endpointSliceList.Items = append(endpointSliceList.Items, eps)
+		}
+	}
+
+	if len(endpointSliceList.Items) == 0 {
+		return nil, fmt.Errorf("no endpoints found for Service %s", client.ObjectKeyFromObject(svc))
+	}
+
+	return resolveEndpoints(svc, port, endpointSliceList, initEndpointSetWithCalculatedSize)
+}
+
 type initEndpointSetFunc func([]discoveryV1.EndpointSlice) map[Endpoint]struct{}
 
 func initEndpointSetWithCalculatedSize(endpointSlices []discoveryV1.EndpointSlice) map[Endpoint]struct{} {
@@ -247,4 +247,51 @@ var _ = Describe("ServiceResolver", func() {
 			Expect(endpoints).To(BeNil())
 		})
 	})
+	Describe("StaticServiceResolver", func() {
+		// sliceOtherNs belongs to a Service with the same name in a different namespace, so it must be ignored.
+		sliceOtherNs := createSlice(
+			"slice-other-ns",
+			addresses2,
+			8080,
+			httpPortName,
+			discoveryV1.AddressTypeIPv4,
+		)
+		sliceOtherNs.Namespace = "other"
+
+		staticResolver := resolver.NewStaticServiceResolver([]discoveryV1.EndpointSlice{
+			*slice1,
+			*sliceOtherNs,
+			*sliceNoMatchingPortName,
+		})
+
+		It("resolves a service for a given port", func() {
+			expectedEndpoints := []resolver.Endpoint{
+				{
+					Address: "9.0.0.1",
+					Port:    8080,
+				},
+				{
+					Address: "9.0.0.2",
+					Port:    8080,
+				},
+			}
+
+			endpoints, err := staticResolver.Resolve(context.TODO(), svc, 80)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(endpoints).To(ConsistOf(expectedEndpoints))
+		})
+		It("returns an error if there are no endpoint slices for the service", func() {
+			otherSvc := svc.DeepCopy()
+			otherSvc.Name = "other-svc"
+
+			endpoints, err := staticResolver.Resolve(context.TODO(), otherSvc, 80)
+			Expect(err).To(HaveOccurred())
+			Expect(endpoints).To(BeNil())
+		})
+		It("returns an error if the service is nil", func() {
+			endpoints, err := staticResolver.Resolve(context.TODO(), nil, 80)
+			Expect(err).To(HaveOccurred())
+			Expect(endpoints).To(BeNil())
+		})
+	})
 })
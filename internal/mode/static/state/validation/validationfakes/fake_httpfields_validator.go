@@ -8,6 +8,61 @@ import (
 )
 
 type FakeHTTPFieldsValidator struct {
+	ValidateAuthenticationRealmStub        func(string) error
+	validateAuthenticationRealmMutex       sync.RWMutex
+	validateAuthenticationRealmArgsForCall []struct {
+		arg1 string
+	}
+	validateAuthenticationRealmReturns struct {
+		result1 error
+	}
+	validateAuthenticationRealmReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateDirectResponseBodyStub        func(string) error
+	validateDirectResponseBodyMutex       sync.RWMutex
+	validateDirectResponseBodyArgsForCall []struct {
+		arg1 string
+	}
+	validateDirectResponseBodyReturns struct {
+		result1 error
+	}
+	validateDirectResponseBodyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateDirectResponseStatusCodeStub        func(int32) error
+	validateDirectResponseStatusCodeMutex       sync.RWMutex
+	validateDirectResponseStatusCodeArgsForCall []struct {
+		arg1 int32
+	}
+	validateDirectResponseStatusCodeReturns struct {
+		result1 error
+	}
+	validateDirectResponseStatusCodeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateExternalAuthHeaderNameStub        func(string) error
+	validateExternalAuthHeaderNameMutex       sync.RWMutex
+	validateExternalAuthHeaderNameArgsForCall []struct {
+		arg1 string
+	}
+	validateExternalAuthHeaderNameReturns struct {
+		result1 error
+	}
+	validateExternalAuthHeaderNameReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateExternalAuthPathStub        func(string) error
+	validateExternalAuthPathMutex       sync.RWMutex
+	validateExternalAuthPathArgsForCall []struct {
+		arg1 string
+	}
+	validateExternalAuthPathReturns struct {
+		result1 error
+	}
+	validateExternalAuthPathReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ValidateHeaderNameInMatchStub        func(string) error
 	validateHeaderNameInMatchMutex       sync.RWMutex
 	validateHeaderNameInMatchArgsForCall []struct {
@@ -146,10 +201,337 @@ type FakeHTTPFieldsValidator struct {
 	validateRequestHeaderValueReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ValidateResponseHeaderNameStub        func(string) error
+	validateResponseHeaderNameMutex       sync.RWMutex
+	validateResponseHeaderNameArgsForCall []struct {
+		arg1 string
+	}
+	validateResponseHeaderNameReturns struct {
+		result1 error
+	}
+	validateResponseHeaderNameReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateResponseHeaderValueStub        func(string) error
+	validateResponseHeaderValueMutex       sync.RWMutex
+	validateResponseHeaderValueArgsForCall []struct {
+		arg1 string
+	}
+	validateResponseHeaderValueReturns struct {
+		result1 error
+	}
+	validateResponseHeaderValueReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeHTTPFieldsValidator) ValidateAuthenticationRealm(arg1 string) error {
+	fake.validateAuthenticationRealmMutex.Lock()
+	ret, specificReturn := fake.validateAuthenticationRealmReturnsOnCall[len(fake.validateAuthenticationRealmArgsForCall)]
+	fake.validateAuthenticationRealmArgsForCall = append(fake.validateAuthenticationRealmArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateAuthenticationRealmStub
+	fakeReturns := fake.validateAuthenticationRealmReturns
+	fake.recordInvocation("ValidateAuthenticationRealm", []interface{}{arg1})
+	fake.validateAuthenticationRealmMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateAuthenticationRealmCallCount() int {
+	fake.validateAuthenticationRealmMutex.RLock()
+	defer fake.validateAuthenticationRealmMutex.RUnlock()
+	return len(fake.validateAuthenticationRealmArgsForCall)
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateAuthenticationRealmCalls(stub func(string) error) {
+	fake.validateAuthenticationRealmMutex.Lock()
+	defer fake.validateAuthenticationRealmMutex.Unlock()
+	fake.ValidateAuthenticationRealmStub = stub
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateAuthenticationRealmArgsForCall(i int) string {
+	fake.validateAuthenticationRealmMutex.RLock()
+	defer fake.validateAuthenticationRealmMutex.RUnlock()
+	argsForCall := fake.validateAuthenticationRealmArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateAuthenticationRealmReturns(result1 error) {
+	fake.validateAuthenticationRealmMutex.Lock()
+	defer fake.validateAuthenticationRealmMutex.Unlock()
+	fake.ValidateAuthenticationRealmStub = nil
+	fake.validateAuthenticationRealmReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateAuthenticationRealmReturnsOnCall(i int, result1 error) {
+	fake.validateAuthenticationRealmMutex.Lock()
+	defer fake.validateAuthenticationRealmMutex.Unlock()
+	fake.ValidateAuthenticationRealmStub = nil
+	if fake.validateAuthenticationRealmReturnsOnCall == nil {
+		fake.validateAuthenticationRealmReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateAuthenticationRealmReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateDirectResponseBody(arg1 string) error {
+	fake.validateDirectResponseBodyMutex.Lock()
+	ret, specificReturn := fake.validateDirectResponseBodyReturnsOnCall[len(fake.validateDirectResponseBodyArgsForCall)]
+	fake.validateDirectResponseBodyArgsForCall = append(fake.validateDirectResponseBodyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateDirectResponseBodyStub
+	fakeReturns := fake.validateDirectResponseBodyReturns
+	fake.recordInvocation("ValidateDirectResponseBody", []interface{}{arg1})
+	fake.validateDirectResponseBodyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateDirectResponseBodyCallCount() int {
+	fake.validateDirectResponseBodyMutex.RLock()
+	defer fake.validateDirectResponseBodyMutex.RUnlock()
+	return len(fake.validateDirectResponseBodyArgsForCall)
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateDirectResponseBodyCalls(stub func(string) error) {
+	fake.validateDirectResponseBodyMutex.Lock()
+	defer fake.validateDirectResponseBodyMutex.Unlock()
+	fake.ValidateDirectResponseBodyStub = stub
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateDirectResponseBodyArgsForCall(i int) string {
+	fake.validateDirectResponseBodyMutex.RLock()
+	defer fake.validateDirectResponseBodyMutex.RUnlock()
+	argsForCall := fake.validateDirectResponseBodyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateDirectResponseBodyReturns(result1 error) {
+	fake.validateDirectResponseBodyMutex.Lock()
+	defer fake.validateDirectResponseBodyMutex.Unlock()
+	fake.ValidateDirectResponseBodyStub = nil
+	fake.validateDirectResponseBodyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateDirectResponseBodyReturnsOnCall(i int, result1 error) {
+	fake.validateDirectResponseBodyMutex.Lock()
+	defer fake.validateDirectResponseBodyMutex.Unlock()
+	fake.ValidateDirectResponseBodyStub = nil
+	if fake.validateDirectResponseBodyReturnsOnCall == nil {
+		fake.validateDirectResponseBodyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateDirectResponseBodyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateDirectResponseStatusCode(arg1 int32) error {
+	fake.validateDirectResponseStatusCodeMutex.Lock()
+	ret, specificReturn := fake.validateDirectResponseStatusCodeReturnsOnCall[len(fake.validateDirectResponseStatusCodeArgsForCall)]
+	fake.validateDirectResponseStatusCodeArgsForCall = append(fake.validateDirectResponseStatusCodeArgsForCall, struct {
+		arg1 int32
+	}{arg1})
+	stub := fake.ValidateDirectResponseStatusCodeStub
+	fakeReturns := fake.validateDirectResponseStatusCodeReturns
+	fake.recordInvocation("ValidateDirectResponseStatusCode", []interface{}{arg1})
+	fake.validateDirectResponseStatusCodeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateDirectResponseStatusCodeCallCount() int {
+	fake.validateDirectResponseStatusCodeMutex.RLock()
+	defer fake.validateDirectResponseStatusCodeMutex.RUnlock()
+	return len(fake.validateDirectResponseStatusCodeArgsForCall)
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateDirectResponseStatusCodeCalls(stub func(int32) error) {
+	fake.validateDirectResponseStatusCodeMutex.Lock()
+	defer fake.validateDirectResponseStatusCodeMutex.Unlock()
+	fake.ValidateDirectResponseStatusCodeStub = stub
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateDirectResponseStatusCodeArgsForCall(i int) int32 {
+	fake.validateDirectResponseStatusCodeMutex.RLock()
+	defer fake.validateDirectResponseStatusCodeMutex.RUnlock()
+	argsForCall := fake.validateDirectResponseStatusCodeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateDirectResponseStatusCodeReturns(result1 error) {
+	fake.validateDirectResponseStatusCodeMutex.Lock()
+	defer fake.validateDirectResponseStatusCodeMutex.Unlock()
+	fake.ValidateDirectResponseStatusCodeStub = nil
+	fake.validateDirectResponseStatusCodeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateDirectResponseStatusCodeReturnsOnCall(i int, result1 error) {
+	fake.validateDirectResponseStatusCodeMutex.Lock()
+	defer fake.validateDirectResponseStatusCodeMutex.Unlock()
+	fake.ValidateDirectResponseStatusCodeStub = nil
+	if fake.validateDirectResponseStatusCodeReturnsOnCall == nil {
+		fake.validateDirectResponseStatusCodeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateDirectResponseStatusCodeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateExternalAuthHeaderName(arg1 string) error {
+	fake.validateExternalAuthHeaderNameMutex.Lock()
+	ret, specificReturn := fake.validateExternalAuthHeaderNameReturnsOnCall[len(fake.validateExternalAuthHeaderNameArgsForCall)]
+	fake.validateExternalAuthHeaderNameArgsForCall = append(fake.validateExternalAuthHeaderNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateExternalAuthHeaderNameStub
+	fakeReturns := fake.validateExternalAuthHeaderNameReturns
+	fake.recordInvocation("ValidateExternalAuthHeaderName", []interface{}{arg1})
+	fake.validateExternalAuthHeaderNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateExternalAuthHeaderNameCallCount() int {
+	fake.validateExternalAuthHeaderNameMutex.RLock()
+	defer fake.validateExternalAuthHeaderNameMutex.RUnlock()
+	return len(fake.validateExternalAuthHeaderNameArgsForCall)
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateExternalAuthHeaderNameCalls(stub func(string) error) {
+	fake.validateExternalAuthHeaderNameMutex.Lock()
+	defer fake.validateExternalAuthHeaderNameMutex.Unlock()
+	fake.ValidateExternalAuthHeaderNameStub = stub
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateExternalAuthHeaderNameArgsForCall(i int) string {
+	fake.validateExternalAuthHeaderNameMutex.RLock()
+	defer fake.validateExternalAuthHeaderNameMutex.RUnlock()
+	argsForCall := fake.validateExternalAuthHeaderNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateExternalAuthHeaderNameReturns(result1 error) {
+	fake.validateExternalAuthHeaderNameMutex.Lock()
+	defer fake.validateExternalAuthHeaderNameMutex.Unlock()
+	fake.ValidateExternalAuthHeaderNameStub = nil
+	fake.validateExternalAuthHeaderNameReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateExternalAuthHeaderNameReturnsOnCall(i int, result1 error) {
+	fake.validateExternalAuthHeaderNameMutex.Lock()
+	defer fake.validateExternalAuthHeaderNameMutex.Unlock()
+	fake.ValidateExternalAuthHeaderNameStub = nil
+	if fake.validateExternalAuthHeaderNameReturnsOnCall == nil {
+		fake.validateExternalAuthHeaderNameReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateExternalAuthHeaderNameReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateExternalAuthPath(arg1 string) error {
+	fake.validateExternalAuthPathMutex.Lock()
+	ret, specificReturn := fake.validateExternalAuthPathReturnsOnCall[len(fake.validateExternalAuthPathArgsForCall)]
+	fake.validateExternalAuthPathArgsForCall = append(fake.validateExternalAuthPathArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateExternalAuthPathStub
+	fakeReturns := fake.validateExternalAuthPathReturns
+	fake.recordInvocation("ValidateExternalAuthPath", []interface{}{arg1})
+	fake.validateExternalAuthPathMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateExternalAuthPathCallCount() int {
+	fake.validateExternalAuthPathMutex.RLock()
+	defer fake.validateExternalAuthPathMutex.RUnlock()
+	return len(fake.validateExternalAuthPathArgsForCall)
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateExternalAuthPathCalls(stub func(string) error) {
+	fake.validateExternalAuthPathMutex.Lock()
+	defer fake.validateExternalAuthPathMutex.Unlock()
+	fake.ValidateExternalAuthPathStub = stub
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateExternalAuthPathArgsForCall(i int) string {
+	fake.validateExternalAuthPathMutex.RLock()
+	defer fake.validateExternalAuthPathMutex.RUnlock()
+	argsForCall := fake.validateExternalAuthPathArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateExternalAuthPathReturns(result1 error) {
+	fake.validateExternalAuthPathMutex.Lock()
+	defer fake.validateExternalAuthPathMutex.Unlock()
+	fake.ValidateExternalAuthPathStub = nil
+	fake.validateExternalAuthPathReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateExternalAuthPathReturnsOnCall(i int, result1 error) {
+	fake.validateExternalAuthPathMutex.Lock()
+	defer fake.validateExternalAuthPathMutex.Unlock()
+	fake.ValidateExternalAuthPathStub = nil
+	if fake.validateExternalAuthPathReturnsOnCall == nil {
+		fake.validateExternalAuthPathReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateExternalAuthPathReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHTTPFieldsValidator) ValidateHeaderNameInMatch(arg1 string) error {
 	fake.validateHeaderNameInMatchMutex.Lock()
 	ret, specificReturn := fake.validateHeaderNameInMatchReturnsOnCall[len(fake.validateHeaderNameInMatchArgsForCall)]
@@ -891,9 +1273,141 @@ func (fake *FakeHTTPFieldsValidator) ValidateRequestHeaderValueReturnsOnCall(i i
 	}{result1}
 }
 
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderName(arg1 string) error {
+	fake.validateResponseHeaderNameMutex.Lock()
+	ret, specificReturn := fake.validateResponseHeaderNameReturnsOnCall[len(fake.validateResponseHeaderNameArgsForCall)]
+	fake.validateResponseHeaderNameArgsForCall = append(fake.validateResponseHeaderNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateResponseHeaderNameStub
+	fakeReturns := fake.validateResponseHeaderNameReturns
+	fake.recordInvocation("ValidateResponseHeaderName", []interface{}{arg1})
+	fake.validateResponseHeaderNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderNameCallCount() int {
+	fake.validateResponseHeaderNameMutex.RLock()
+	defer fake.validateResponseHeaderNameMutex.RUnlock()
+	return len(fake.validateResponseHeaderNameArgsForCall)
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderNameCalls(stub func(string) error) {
+	fake.validateResponseHeaderNameMutex.Lock()
+	defer fake.validateResponseHeaderNameMutex.Unlock()
+	fake.ValidateResponseHeaderNameStub = stub
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderNameArgsForCall(i int) string {
+	fake.validateResponseHeaderNameMutex.RLock()
+	defer fake.validateResponseHeaderNameMutex.RUnlock()
+	argsForCall := fake.validateResponseHeaderNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderNameReturns(result1 error) {
+	fake.validateResponseHeaderNameMutex.Lock()
+	defer fake.validateResponseHeaderNameMutex.Unlock()
+	fake.ValidateResponseHeaderNameStub = nil
+	fake.validateResponseHeaderNameReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderNameReturnsOnCall(i int, result1 error) {
+	fake.validateResponseHeaderNameMutex.Lock()
+	defer fake.validateResponseHeaderNameMutex.Unlock()
+	fake.ValidateResponseHeaderNameStub = nil
+	if fake.validateResponseHeaderNameReturnsOnCall == nil {
+		fake.validateResponseHeaderNameReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateResponseHeaderNameReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderValue(arg1 string) error {
+	fake.validateResponseHeaderValueMutex.Lock()
+	ret, specificReturn := fake.validateResponseHeaderValueReturnsOnCall[len(fake.validateResponseHeaderValueArgsForCall)]
+	fake.validateResponseHeaderValueArgsForCall = append(fake.validateResponseHeaderValueArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateResponseHeaderValueStub
+	fakeReturns := fake.validateResponseHeaderValueReturns
+	fake.recordInvocation("ValidateResponseHeaderValue", []interface{}{arg1})
+	fake.validateResponseHeaderValueMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderValueCallCount() int {
+	fake.validateResponseHeaderValueMutex.RLock()
+	defer fake.validateResponseHeaderValueMutex.RUnlock()
+	return len(fake.validateResponseHeaderValueArgsForCall)
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderValueCalls(stub func(string) error) {
+	fake.validateResponseHeaderValueMutex.Lock()
+	defer fake.validateResponseHeaderValueMutex.Unlock()
+	fake.ValidateResponseHeaderValueStub = stub
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderValueArgsForCall(i int) string {
+	fake.validateResponseHeaderValueMutex.RLock()
+	defer fake.validateResponseHeaderValueMutex.RUnlock()
+	argsForCall := fake.validateResponseHeaderValueArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderValueReturns(result1 error) {
+	fake.validateResponseHeaderValueMutex.Lock()
+	defer fake.validateResponseHeaderValueMutex.Unlock()
+	fake.ValidateResponseHeaderValueStub = nil
+	fake.validateResponseHeaderValueReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeHTTPFieldsValidator) ValidateResponseHeaderValueReturnsOnCall(i int, result1 error) {
+	fake.validateResponseHeaderValueMutex.Lock()
+	defer fake.validateResponseHeaderValueMutex.Unlock()
+	fake.ValidateResponseHeaderValueStub = nil
+	if fake.validateResponseHeaderValueReturnsOnCall == nil {
+		fake.validateResponseHeaderValueReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateResponseHeaderValueReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeHTTPFieldsValidator) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.validateAuthenticationRealmMutex.RLock()
+	defer fake.validateAuthenticationRealmMutex.RUnlock()
+	fake.validateDirectResponseBodyMutex.RLock()
+	defer fake.validateDirectResponseBodyMutex.RUnlock()
+	fake.validateDirectResponseStatusCodeMutex.RLock()
+	defer fake.validateDirectResponseStatusCodeMutex.RUnlock()
+	fake.validateExternalAuthHeaderNameMutex.RLock()
+	defer fake.validateExternalAuthHeaderNameMutex.RUnlock()
+	fake.validateExternalAuthPathMutex.RLock()
+	defer fake.validateExternalAuthPathMutex.RUnlock()
 	fake.validateHeaderNameInMatchMutex.RLock()
 	defer fake.validateHeaderNameInMatchMutex.RUnlock()
 	fake.validateHeaderValueInMatchMutex.RLock()
@@ -918,6 +1432,10 @@ func (fake *FakeHTTPFieldsValidator) Invocations() map[string][][]interface{} {
 	defer fake.validateRequestHeaderNameMutex.RUnlock()
 	fake.validateRequestHeaderValueMutex.RLock()
 	defer fake.validateRequestHeaderValueMutex.RUnlock()
+	fake.validateResponseHeaderNameMutex.RLock()
+	defer fake.validateResponseHeaderNameMutex.RUnlock()
+	fake.validateResponseHeaderValueMutex.RLock()
+	defer fake.validateResponseHeaderValueMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
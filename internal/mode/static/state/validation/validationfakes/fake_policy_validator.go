@@ -0,0 +1,1764 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package validationfakes
+
+import (
+	"sync"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+type FakePolicyValidator struct {
+	ValidateAccessLogDestinationTypeStub        func(string) (bool, []string)
+	validateAccessLogDestinationTypeMutex       sync.RWMutex
+	validateAccessLogDestinationTypeArgsForCall []struct {
+		arg1 string
+	}
+	validateAccessLogDestinationTypeReturns struct {
+		result1 bool
+		result2 []string
+	}
+	validateAccessLogDestinationTypeReturnsOnCall map[int]struct {
+		result1 bool
+		result2 []string
+	}
+	ValidateAccessLogFormatStub        func(string) (bool, []string)
+	validateAccessLogFormatMutex       sync.RWMutex
+	validateAccessLogFormatArgsForCall []struct {
+		arg1 string
+	}
+	validateAccessLogFormatReturns struct {
+		result1 bool
+		result2 []string
+	}
+	validateAccessLogFormatReturnsOnCall map[int]struct {
+		result1 bool
+		result2 []string
+	}
+	ValidateCORSHeaderNameStub        func(string) error
+	validateCORSHeaderNameMutex       sync.RWMutex
+	validateCORSHeaderNameArgsForCall []struct {
+		arg1 string
+	}
+	validateCORSHeaderNameReturns struct {
+		result1 error
+	}
+	validateCORSHeaderNameReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateCORSOriginStub        func(string) error
+	validateCORSOriginMutex       sync.RWMutex
+	validateCORSOriginArgsForCall []struct {
+		arg1 string
+	}
+	validateCORSOriginReturns struct {
+		result1 error
+	}
+	validateCORSOriginReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateCacheBypassNameStub        func(string) error
+	validateCacheBypassNameMutex       sync.RWMutex
+	validateCacheBypassNameArgsForCall []struct {
+		arg1 string
+	}
+	validateCacheBypassNameReturns struct {
+		result1 error
+	}
+	validateCacheBypassNameReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateCacheKeyStub        func(string) error
+	validateCacheKeyMutex       sync.RWMutex
+	validateCacheKeyArgsForCall []struct {
+		arg1 string
+	}
+	validateCacheKeyReturns struct {
+		result1 error
+	}
+	validateCacheKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateCacheZoneNameStub        func(string) error
+	validateCacheZoneNameMutex       sync.RWMutex
+	validateCacheZoneNameArgsForCall []struct {
+		arg1 string
+	}
+	validateCacheZoneNameReturns struct {
+		result1 error
+	}
+	validateCacheZoneNameReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateContentTypeStub        func(string) error
+	validateContentTypeMutex       sync.RWMutex
+	validateContentTypeArgsForCall []struct {
+		arg1 string
+	}
+	validateContentTypeReturns struct {
+		result1 error
+	}
+	validateContentTypeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateDurationStub        func(string) error
+	validateDurationMutex       sync.RWMutex
+	validateDurationArgsForCall []struct {
+		arg1 string
+	}
+	validateDurationReturns struct {
+		result1 error
+	}
+	validateDurationReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateIPAddressOrCIDRStub        func(string) error
+	validateIPAddressOrCIDRMutex       sync.RWMutex
+	validateIPAddressOrCIDRArgsForCall []struct {
+		arg1 string
+	}
+	validateIPAddressOrCIDRReturns struct {
+		result1 error
+	}
+	validateIPAddressOrCIDRReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateLoadBalancingHashKeyStub        func(string) error
+	validateLoadBalancingHashKeyMutex       sync.RWMutex
+	validateLoadBalancingHashKeyArgsForCall []struct {
+		arg1 string
+	}
+	validateLoadBalancingHashKeyReturns struct {
+		result1 error
+	}
+	validateLoadBalancingHashKeyReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateLoadBalancingMethodStub        func(string) (bool, []string)
+	validateLoadBalancingMethodMutex       sync.RWMutex
+	validateLoadBalancingMethodArgsForCall []struct {
+		arg1 string
+	}
+	validateLoadBalancingMethodReturns struct {
+		result1 bool
+		result2 []string
+	}
+	validateLoadBalancingMethodReturnsOnCall map[int]struct {
+		result1 bool
+		result2 []string
+	}
+	ValidateMIMETypeStub        func(string) error
+	validateMIMETypeMutex       sync.RWMutex
+	validateMIMETypeArgsForCall []struct {
+		arg1 string
+	}
+	validateMIMETypeReturns struct {
+		result1 error
+	}
+	validateMIMETypeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateNextUpstreamConditionStub        func(string) (bool, []string)
+	validateNextUpstreamConditionMutex       sync.RWMutex
+	validateNextUpstreamConditionArgsForCall []struct {
+		arg1 string
+	}
+	validateNextUpstreamConditionReturns struct {
+		result1 bool
+		result2 []string
+	}
+	validateNextUpstreamConditionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 []string
+	}
+	ValidateRateLimitKeyNameStub        func(string) error
+	validateRateLimitKeyNameMutex       sync.RWMutex
+	validateRateLimitKeyNameArgsForCall []struct {
+		arg1 string
+	}
+	validateRateLimitKeyNameReturns struct {
+		result1 error
+	}
+	validateRateLimitKeyNameReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateRateLimitRateStub        func(string) error
+	validateRateLimitRateMutex       sync.RWMutex
+	validateRateLimitRateArgsForCall []struct {
+		arg1 string
+	}
+	validateRateLimitRateReturns struct {
+		result1 error
+	}
+	validateRateLimitRateReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateRedirectURLStub        func(string) error
+	validateRedirectURLMutex       sync.RWMutex
+	validateRedirectURLArgsForCall []struct {
+		arg1 string
+	}
+	validateRedirectURLReturns struct {
+		result1 error
+	}
+	validateRedirectURLReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateSizeStub        func(string) error
+	validateSizeMutex       sync.RWMutex
+	validateSizeArgsForCall []struct {
+		arg1 string
+	}
+	validateSizeReturns struct {
+		result1 error
+	}
+	validateSizeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateSyslogServerStub        func(string) error
+	validateSyslogServerMutex       sync.RWMutex
+	validateSyslogServerArgsForCall []struct {
+		arg1 string
+	}
+	validateSyslogServerReturns struct {
+		result1 error
+	}
+	validateSyslogServerReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateSyslogTagStub        func(string) error
+	validateSyslogTagMutex       sync.RWMutex
+	validateSyslogTagArgsForCall []struct {
+		arg1 string
+	}
+	validateSyslogTagReturns struct {
+		result1 error
+	}
+	validateSyslogTagReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateTraceContextStub        func(string) (bool, []string)
+	validateTraceContextMutex       sync.RWMutex
+	validateTraceContextArgsForCall []struct {
+		arg1 string
+	}
+	validateTraceContextReturns struct {
+		result1 bool
+		result2 []string
+	}
+	validateTraceContextReturnsOnCall map[int]struct {
+		result1 bool
+		result2 []string
+	}
+	ValidateTracingEndpointStub        func(string) error
+	validateTracingEndpointMutex       sync.RWMutex
+	validateTracingEndpointArgsForCall []struct {
+		arg1 string
+	}
+	validateTracingEndpointReturns struct {
+		result1 error
+	}
+	validateTracingEndpointReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ValidateTracingServiceNameStub        func(string) error
+	validateTracingServiceNameMutex       sync.RWMutex
+	validateTracingServiceNameArgsForCall []struct {
+		arg1 string
+	}
+	validateTracingServiceNameReturns struct {
+		result1 error
+	}
+	validateTracingServiceNameReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePolicyValidator) ValidateAccessLogDestinationType(arg1 string) (bool, []string) {
+	fake.validateAccessLogDestinationTypeMutex.Lock()
+	ret, specificReturn := fake.validateAccessLogDestinationTypeReturnsOnCall[len(fake.validateAccessLogDestinationTypeArgsForCall)]
+	fake.validateAccessLogDestinationTypeArgsForCall = append(fake.validateAccessLogDestinationTypeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateAccessLogDestinationTypeStub
+	fakeReturns := fake.validateAccessLogDestinationTypeReturns
+	fake.recordInvocation("ValidateAccessLogDestinationType", []interface{}{arg1})
+	fake.validateAccessLogDestinationTypeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePolicyValidator) ValidateAccessLogDestinationTypeCallCount() int {
+	fake.validateAccessLogDestinationTypeMutex.RLock()
+	defer fake.validateAccessLogDestinationTypeMutex.RUnlock()
+	return len(fake.validateAccessLogDestinationTypeArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateAccessLogDestinationTypeCalls(stub func(string) (bool, []string)) {
+	fake.validateAccessLogDestinationTypeMutex.Lock()
+	defer fake.validateAccessLogDestinationTypeMutex.Unlock()
+	fake.ValidateAccessLogDestinationTypeStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateAccessLogDestinationTypeArgsForCall(i int) string {
+	fake.validateAccessLogDestinationTypeMutex.RLock()
+	defer fake.validateAccessLogDestinationTypeMutex.RUnlock()
+	argsForCall := fake.validateAccessLogDestinationTypeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateAccessLogDestinationTypeReturns(result1 bool, result2 []string) {
+	fake.validateAccessLogDestinationTypeMutex.Lock()
+	defer fake.validateAccessLogDestinationTypeMutex.Unlock()
+	fake.ValidateAccessLogDestinationTypeStub = nil
+	fake.validateAccessLogDestinationTypeReturns = struct {
+		result1 bool
+		result2 []string
+	}{result1, result2}
+}
+
+func (fake *FakePolicyValidator) ValidateAccessLogDestinationTypeReturnsOnCall(i int, result1 bool, result2 []string) {
+	fake.validateAccessLogDestinationTypeMutex.Lock()
+	defer fake.validateAccessLogDestinationTypeMutex.Unlock()
+	fake.ValidateAccessLogDestinationTypeStub = nil
+	if fake.validateAccessLogDestinationTypeReturnsOnCall == nil {
+		fake.validateAccessLogDestinationTypeReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 []string
+		})
+	}
+	fake.validateAccessLogDestinationTypeReturnsOnCall[i] = struct {
+		result1 bool
+		result2 []string
+	}{result1, result2}
+}
+
+func (fake *FakePolicyValidator) ValidateAccessLogFormat(arg1 string) (bool, []string) {
+	fake.validateAccessLogFormatMutex.Lock()
+	ret, specificReturn := fake.validateAccessLogFormatReturnsOnCall[len(fake.validateAccessLogFormatArgsForCall)]
+	fake.validateAccessLogFormatArgsForCall = append(fake.validateAccessLogFormatArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateAccessLogFormatStub
+	fakeReturns := fake.validateAccessLogFormatReturns
+	fake.recordInvocation("ValidateAccessLogFormat", []interface{}{arg1})
+	fake.validateAccessLogFormatMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePolicyValidator) ValidateAccessLogFormatCallCount() int {
+	fake.validateAccessLogFormatMutex.RLock()
+	defer fake.validateAccessLogFormatMutex.RUnlock()
+	return len(fake.validateAccessLogFormatArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateAccessLogFormatCalls(stub func(string) (bool, []string)) {
+	fake.validateAccessLogFormatMutex.Lock()
+	defer fake.validateAccessLogFormatMutex.Unlock()
+	fake.ValidateAccessLogFormatStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateAccessLogFormatArgsForCall(i int) string {
+	fake.validateAccessLogFormatMutex.RLock()
+	defer fake.validateAccessLogFormatMutex.RUnlock()
+	argsForCall := fake.validateAccessLogFormatArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateAccessLogFormatReturns(result1 bool, result2 []string) {
+	fake.validateAccessLogFormatMutex.Lock()
+	defer fake.validateAccessLogFormatMutex.Unlock()
+	fake.ValidateAccessLogFormatStub = nil
+	fake.validateAccessLogFormatReturns = struct {
+		result1 bool
+		result2 []string
+	}{result1, result2}
+}
+
+func (fake *FakePolicyValidator) ValidateAccessLogFormatReturnsOnCall(i int, result1 bool, result2 []string) {
+	fake.validateAccessLogFormatMutex.Lock()
+	defer fake.validateAccessLogFormatMutex.Unlock()
+	fake.ValidateAccessLogFormatStub = nil
+	if fake.validateAccessLogFormatReturnsOnCall == nil {
+		fake.validateAccessLogFormatReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 []string
+		})
+	}
+	fake.validateAccessLogFormatReturnsOnCall[i] = struct {
+		result1 bool
+		result2 []string
+	}{result1, result2}
+}
+
+func (fake *FakePolicyValidator) ValidateCORSHeaderName(arg1 string) error {
+	fake.validateCORSHeaderNameMutex.Lock()
+	ret, specificReturn := fake.validateCORSHeaderNameReturnsOnCall[len(fake.validateCORSHeaderNameArgsForCall)]
+	fake.validateCORSHeaderNameArgsForCall = append(fake.validateCORSHeaderNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateCORSHeaderNameStub
+	fakeReturns := fake.validateCORSHeaderNameReturns
+	fake.recordInvocation("ValidateCORSHeaderName", []interface{}{arg1})
+	fake.validateCORSHeaderNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateCORSHeaderNameCallCount() int {
+	fake.validateCORSHeaderNameMutex.RLock()
+	defer fake.validateCORSHeaderNameMutex.RUnlock()
+	return len(fake.validateCORSHeaderNameArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateCORSHeaderNameCalls(stub func(string) error) {
+	fake.validateCORSHeaderNameMutex.Lock()
+	defer fake.validateCORSHeaderNameMutex.Unlock()
+	fake.ValidateCORSHeaderNameStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateCORSHeaderNameArgsForCall(i int) string {
+	fake.validateCORSHeaderNameMutex.RLock()
+	defer fake.validateCORSHeaderNameMutex.RUnlock()
+	argsForCall := fake.validateCORSHeaderNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateCORSHeaderNameReturns(result1 error) {
+	fake.validateCORSHeaderNameMutex.Lock()
+	defer fake.validateCORSHeaderNameMutex.Unlock()
+	fake.ValidateCORSHeaderNameStub = nil
+	fake.validateCORSHeaderNameReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateCORSHeaderNameReturnsOnCall(i int, result1 error) {
+	fake.validateCORSHeaderNameMutex.Lock()
+	defer fake.validateCORSHeaderNameMutex.Unlock()
+	fake.ValidateCORSHeaderNameStub = nil
+	if fake.validateCORSHeaderNameReturnsOnCall == nil {
+		fake.validateCORSHeaderNameReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateCORSHeaderNameReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateCORSOrigin(arg1 string) error {
+	fake.validateCORSOriginMutex.Lock()
+	ret, specificReturn := fake.validateCORSOriginReturnsOnCall[len(fake.validateCORSOriginArgsForCall)]
+	fake.validateCORSOriginArgsForCall = append(fake.validateCORSOriginArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateCORSOriginStub
+	fakeReturns := fake.validateCORSOriginReturns
+	fake.recordInvocation("ValidateCORSOrigin", []interface{}{arg1})
+	fake.validateCORSOriginMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateCORSOriginCallCount() int {
+	fake.validateCORSOriginMutex.RLock()
+	defer fake.validateCORSOriginMutex.RUnlock()
+	return len(fake.validateCORSOriginArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateCORSOriginCalls(stub func(string) error) {
+	fake.validateCORSOriginMutex.Lock()
+	defer fake.validateCORSOriginMutex.Unlock()
+	fake.ValidateCORSOriginStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateCORSOriginArgsForCall(i int) string {
+	fake.validateCORSOriginMutex.RLock()
+	defer fake.validateCORSOriginMutex.RUnlock()
+	argsForCall := fake.validateCORSOriginArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateCORSOriginReturns(result1 error) {
+	fake.validateCORSOriginMutex.Lock()
+	defer fake.validateCORSOriginMutex.Unlock()
+	fake.ValidateCORSOriginStub = nil
+	fake.validateCORSOriginReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateCORSOriginReturnsOnCall(i int, result1 error) {
+	fake.validateCORSOriginMutex.Lock()
+	defer fake.validateCORSOriginMutex.Unlock()
+	fake.ValidateCORSOriginStub = nil
+	if fake.validateCORSOriginReturnsOnCall == nil {
+		fake.validateCORSOriginReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateCORSOriginReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateCacheBypassName(arg1 string) error {
+	fake.validateCacheBypassNameMutex.Lock()
+	ret, specificReturn := fake.validateCacheBypassNameReturnsOnCall[len(fake.validateCacheBypassNameArgsForCall)]
+	fake.validateCacheBypassNameArgsForCall = append(fake.validateCacheBypassNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateCacheBypassNameStub
+	fakeReturns := fake.validateCacheBypassNameReturns
+	fake.recordInvocation("ValidateCacheBypassName", []interface{}{arg1})
+	fake.validateCacheBypassNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateCacheBypassNameCallCount() int {
+	fake.validateCacheBypassNameMutex.RLock()
+	defer fake.validateCacheBypassNameMutex.RUnlock()
+	return len(fake.validateCacheBypassNameArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateCacheBypassNameCalls(stub func(string) error) {
+	fake.validateCacheBypassNameMutex.Lock()
+	defer fake.validateCacheBypassNameMutex.Unlock()
+	fake.ValidateCacheBypassNameStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateCacheBypassNameArgsForCall(i int) string {
+	fake.validateCacheBypassNameMutex.RLock()
+	defer fake.validateCacheBypassNameMutex.RUnlock()
+	argsForCall := fake.validateCacheBypassNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateCacheBypassNameReturns(result1 error) {
+	fake.validateCacheBypassNameMutex.Lock()
+	defer fake.validateCacheBypassNameMutex.Unlock()
+	fake.ValidateCacheBypassNameStub = nil
+	fake.validateCacheBypassNameReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateCacheBypassNameReturnsOnCall(i int, result1 error) {
+	fake.validateCacheBypassNameMutex.Lock()
+	defer fake.validateCacheBypassNameMutex.Unlock()
+	fake.ValidateCacheBypassNameStub = nil
+	if fake.validateCacheBypassNameReturnsOnCall == nil {
+		fake.validateCacheBypassNameReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateCacheBypassNameReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateCacheKey(arg1 string) error {
+	fake.validateCacheKeyMutex.Lock()
+	ret, specificReturn := fake.validateCacheKeyReturnsOnCall[len(fake.validateCacheKeyArgsForCall)]
+	fake.validateCacheKeyArgsForCall = append(fake.validateCacheKeyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateCacheKeyStub
+	fakeReturns := fake.validateCacheKeyReturns
+	fake.recordInvocation("ValidateCacheKey", []interface{}{arg1})
+	fake.validateCacheKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateCacheKeyCallCount() int {
+	fake.validateCacheKeyMutex.RLock()
+	defer fake.validateCacheKeyMutex.RUnlock()
+	return len(fake.validateCacheKeyArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateCacheKeyCalls(stub func(string) error) {
+	fake.validateCacheKeyMutex.Lock()
+	defer fake.validateCacheKeyMutex.Unlock()
+	fake.ValidateCacheKeyStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateCacheKeyArgsForCall(i int) string {
+	fake.validateCacheKeyMutex.RLock()
+	defer fake.validateCacheKeyMutex.RUnlock()
+	argsForCall := fake.validateCacheKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateCacheKeyReturns(result1 error) {
+	fake.validateCacheKeyMutex.Lock()
+	defer fake.validateCacheKeyMutex.Unlock()
+	fake.ValidateCacheKeyStub = nil
+	fake.validateCacheKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateCacheKeyReturnsOnCall(i int, result1 error) {
+	fake.validateCacheKeyMutex.Lock()
+	defer fake.validateCacheKeyMutex.Unlock()
+	fake.ValidateCacheKeyStub = nil
+	if fake.validateCacheKeyReturnsOnCall == nil {
+		fake.validateCacheKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateCacheKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateCacheZoneName(arg1 string) error {
+	fake.validateCacheZoneNameMutex.Lock()
+	ret, specificReturn := fake.validateCacheZoneNameReturnsOnCall[len(fake.validateCacheZoneNameArgsForCall)]
+	fake.validateCacheZoneNameArgsForCall = append(fake.validateCacheZoneNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateCacheZoneNameStub
+	fakeReturns := fake.validateCacheZoneNameReturns
+	fake.recordInvocation("ValidateCacheZoneName", []interface{}{arg1})
+	fake.validateCacheZoneNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateCacheZoneNameCallCount() int {
+	fake.validateCacheZoneNameMutex.RLock()
+	defer fake.validateCacheZoneNameMutex.RUnlock()
+	return len(fake.validateCacheZoneNameArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateCacheZoneNameCalls(stub func(string) error) {
+	fake.validateCacheZoneNameMutex.Lock()
+	defer fake.validateCacheZoneNameMutex.Unlock()
+	fake.ValidateCacheZoneNameStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateCacheZoneNameArgsForCall(i int) string {
+	fake.validateCacheZoneNameMutex.RLock()
+	defer fake.validateCacheZoneNameMutex.RUnlock()
+	argsForCall := fake.validateCacheZoneNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateCacheZoneNameReturns(result1 error) {
+	fake.validateCacheZoneNameMutex.Lock()
+	defer fake.validateCacheZoneNameMutex.Unlock()
+	fake.ValidateCacheZoneNameStub = nil
+	fake.validateCacheZoneNameReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateCacheZoneNameReturnsOnCall(i int, result1 error) {
+	fake.validateCacheZoneNameMutex.Lock()
+	defer fake.validateCacheZoneNameMutex.Unlock()
+	fake.ValidateCacheZoneNameStub = nil
+	if fake.validateCacheZoneNameReturnsOnCall == nil {
+		fake.validateCacheZoneNameReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateCacheZoneNameReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateContentType(arg1 string) error {
+	fake.validateContentTypeMutex.Lock()
+	ret, specificReturn := fake.validateContentTypeReturnsOnCall[len(fake.validateContentTypeArgsForCall)]
+	fake.validateContentTypeArgsForCall = append(fake.validateContentTypeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateContentTypeStub
+	fakeReturns := fake.validateContentTypeReturns
+	fake.recordInvocation("ValidateContentType", []interface{}{arg1})
+	fake.validateContentTypeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateContentTypeCallCount() int {
+	fake.validateContentTypeMutex.RLock()
+	defer fake.validateContentTypeMutex.RUnlock()
+	return len(fake.validateContentTypeArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateContentTypeCalls(stub func(string) error) {
+	fake.validateContentTypeMutex.Lock()
+	defer fake.validateContentTypeMutex.Unlock()
+	fake.ValidateContentTypeStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateContentTypeArgsForCall(i int) string {
+	fake.validateContentTypeMutex.RLock()
+	defer fake.validateContentTypeMutex.RUnlock()
+	argsForCall := fake.validateContentTypeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateContentTypeReturns(result1 error) {
+	fake.validateContentTypeMutex.Lock()
+	defer fake.validateContentTypeMutex.Unlock()
+	fake.ValidateContentTypeStub = nil
+	fake.validateContentTypeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateContentTypeReturnsOnCall(i int, result1 error) {
+	fake.validateContentTypeMutex.Lock()
+	defer fake.validateContentTypeMutex.Unlock()
+	fake.ValidateContentTypeStub = nil
+	if fake.validateContentTypeReturnsOnCall == nil {
+		fake.validateContentTypeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateContentTypeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateDuration(arg1 string) error {
+	fake.validateDurationMutex.Lock()
+	ret, specificReturn := fake.validateDurationReturnsOnCall[len(fake.validateDurationArgsForCall)]
+	fake.validateDurationArgsForCall = append(fake.validateDurationArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateDurationStub
+	fakeReturns := fake.validateDurationReturns
+	fake.recordInvocation("ValidateDuration", []interface{}{arg1})
+	fake.validateDurationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateDurationCallCount() int {
+	fake.validateDurationMutex.RLock()
+	defer fake.validateDurationMutex.RUnlock()
+	return len(fake.validateDurationArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateDurationCalls(stub func(string) error) {
+	fake.validateDurationMutex.Lock()
+	defer fake.validateDurationMutex.Unlock()
+	fake.ValidateDurationStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateDurationArgsForCall(i int) string {
+	fake.validateDurationMutex.RLock()
+	defer fake.validateDurationMutex.RUnlock()
+	argsForCall := fake.validateDurationArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateDurationReturns(result1 error) {
+	fake.validateDurationMutex.Lock()
+	defer fake.validateDurationMutex.Unlock()
+	fake.ValidateDurationStub = nil
+	fake.validateDurationReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateDurationReturnsOnCall(i int, result1 error) {
+	fake.validateDurationMutex.Lock()
+	defer fake.validateDurationMutex.Unlock()
+	fake.ValidateDurationStub = nil
+	if fake.validateDurationReturnsOnCall == nil {
+		fake.validateDurationReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateDurationReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateIPAddressOrCIDR(arg1 string) error {
+	fake.validateIPAddressOrCIDRMutex.Lock()
+	ret, specificReturn := fake.validateIPAddressOrCIDRReturnsOnCall[len(fake.validateIPAddressOrCIDRArgsForCall)]
+	fake.validateIPAddressOrCIDRArgsForCall = append(fake.validateIPAddressOrCIDRArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateIPAddressOrCIDRStub
+	fakeReturns := fake.validateIPAddressOrCIDRReturns
+	fake.recordInvocation("ValidateIPAddressOrCIDR", []interface{}{arg1})
+	fake.validateIPAddressOrCIDRMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateIPAddressOrCIDRCallCount() int {
+	fake.validateIPAddressOrCIDRMutex.RLock()
+	defer fake.validateIPAddressOrCIDRMutex.RUnlock()
+	return len(fake.validateIPAddressOrCIDRArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateIPAddressOrCIDRCalls(stub func(string) error) {
+	fake.validateIPAddressOrCIDRMutex.Lock()
+	defer fake.validateIPAddressOrCIDRMutex.Unlock()
+	fake.ValidateIPAddressOrCIDRStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateIPAddressOrCIDRArgsForCall(i int) string {
+	fake.validateIPAddressOrCIDRMutex.RLock()
+	defer fake.validateIPAddressOrCIDRMutex.RUnlock()
+	argsForCall := fake.validateIPAddressOrCIDRArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateIPAddressOrCIDRReturns(result1 error) {
+	fake.validateIPAddressOrCIDRMutex.Lock()
+	defer fake.validateIPAddressOrCIDRMutex.Unlock()
+	fake.ValidateIPAddressOrCIDRStub = nil
+	fake.validateIPAddressOrCIDRReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateIPAddressOrCIDRReturnsOnCall(i int, result1 error) {
+	fake.validateIPAddressOrCIDRMutex.Lock()
+	defer fake.validateIPAddressOrCIDRMutex.Unlock()
+	fake.ValidateIPAddressOrCIDRStub = nil
+	if fake.validateIPAddressOrCIDRReturnsOnCall == nil {
+		fake.validateIPAddressOrCIDRReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateIPAddressOrCIDRReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateLoadBalancingHashKey(arg1 string) error {
+	fake.validateLoadBalancingHashKeyMutex.Lock()
+	ret, specificReturn := fake.validateLoadBalancingHashKeyReturnsOnCall[len(fake.validateLoadBalancingHashKeyArgsForCall)]
+	fake.validateLoadBalancingHashKeyArgsForCall = append(fake.validateLoadBalancingHashKeyArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateLoadBalancingHashKeyStub
+	fakeReturns := fake.validateLoadBalancingHashKeyReturns
+	fake.recordInvocation("ValidateLoadBalancingHashKey", []interface{}{arg1})
+	fake.validateLoadBalancingHashKeyMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateLoadBalancingHashKeyCallCount() int {
+	fake.validateLoadBalancingHashKeyMutex.RLock()
+	defer fake.validateLoadBalancingHashKeyMutex.RUnlock()
+	return len(fake.validateLoadBalancingHashKeyArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateLoadBalancingHashKeyCalls(stub func(string) error) {
+	fake.validateLoadBalancingHashKeyMutex.Lock()
+	defer fake.validateLoadBalancingHashKeyMutex.Unlock()
+	fake.ValidateLoadBalancingHashKeyStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateLoadBalancingHashKeyArgsForCall(i int) string {
+	fake.validateLoadBalancingHashKeyMutex.RLock()
+	defer fake.validateLoadBalancingHashKeyMutex.RUnlock()
+	argsForCall := fake.validateLoadBalancingHashKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateLoadBalancingHashKeyReturns(result1 error) {
+	fake.validateLoadBalancingHashKeyMutex.Lock()
+	defer fake.validateLoadBalancingHashKeyMutex.Unlock()
+	fake.ValidateLoadBalancingHashKeyStub = nil
+	fake.validateLoadBalancingHashKeyReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateLoadBalancingHashKeyReturnsOnCall(i int, result1 error) {
+	fake.validateLoadBalancingHashKeyMutex.Lock()
+	defer fake.validateLoadBalancingHashKeyMutex.Unlock()
+	fake.ValidateLoadBalancingHashKeyStub = nil
+	if fake.validateLoadBalancingHashKeyReturnsOnCall == nil {
+		fake.validateLoadBalancingHashKeyReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateLoadBalancingHashKeyReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateLoadBalancingMethod(arg1 string) (bool, []string) {
+	fake.validateLoadBalancingMethodMutex.Lock()
+	ret, specificReturn := fake.validateLoadBalancingMethodReturnsOnCall[len(fake.validateLoadBalancingMethodArgsForCall)]
+	fake.validateLoadBalancingMethodArgsForCall = append(fake.validateLoadBalancingMethodArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateLoadBalancingMethodStub
+	fakeReturns := fake.validateLoadBalancingMethodReturns
+	fake.recordInvocation("ValidateLoadBalancingMethod", []interface{}{arg1})
+	fake.validateLoadBalancingMethodMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePolicyValidator) ValidateLoadBalancingMethodCallCount() int {
+	fake.validateLoadBalancingMethodMutex.RLock()
+	defer fake.validateLoadBalancingMethodMutex.RUnlock()
+	return len(fake.validateLoadBalancingMethodArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateLoadBalancingMethodCalls(stub func(string) (bool, []string)) {
+	fake.validateLoadBalancingMethodMutex.Lock()
+	defer fake.validateLoadBalancingMethodMutex.Unlock()
+	fake.ValidateLoadBalancingMethodStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateLoadBalancingMethodArgsForCall(i int) string {
+	fake.validateLoadBalancingMethodMutex.RLock()
+	defer fake.validateLoadBalancingMethodMutex.RUnlock()
+	argsForCall := fake.validateLoadBalancingMethodArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateLoadBalancingMethodReturns(result1 bool, result2 []string) {
+	fake.validateLoadBalancingMethodMutex.Lock()
+	defer fake.validateLoadBalancingMethodMutex.Unlock()
+	fake.ValidateLoadBalancingMethodStub = nil
+	fake.validateLoadBalancingMethodReturns = struct {
+		result1 bool
+		result2 []string
+	}{result1, result2}
+}
+
+func (fake *FakePolicyValidator) ValidateLoadBalancingMethodReturnsOnCall(i int, result1 bool, result2 []string) {
+	fake.validateLoadBalancingMethodMutex.Lock()
+	defer fake.validateLoadBalancingMethodMutex.Unlock()
+	fake.ValidateLoadBalancingMethodStub = nil
+	if fake.validateLoadBalancingMethodReturnsOnCall == nil {
+		fake.validateLoadBalancingMethodReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 []string
+		})
+	}
+	fake.validateLoadBalancingMethodReturnsOnCall[i] = struct {
+		result1 bool
+		result2 []string
+	}{result1, result2}
+}
+
+func (fake *FakePolicyValidator) ValidateMIMEType(arg1 string) error {
+	fake.validateMIMETypeMutex.Lock()
+	ret, specificReturn := fake.validateMIMETypeReturnsOnCall[len(fake.validateMIMETypeArgsForCall)]
+	fake.validateMIMETypeArgsForCall = append(fake.validateMIMETypeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateMIMETypeStub
+	fakeReturns := fake.validateMIMETypeReturns
+	fake.recordInvocation("ValidateMIMEType", []interface{}{arg1})
+	fake.validateMIMETypeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateMIMETypeCallCount() int {
+	fake.validateMIMETypeMutex.RLock()
+	defer fake.validateMIMETypeMutex.RUnlock()
+	return len(fake.validateMIMETypeArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateMIMETypeCalls(stub func(string) error) {
+	fake.validateMIMETypeMutex.Lock()
+	defer fake.validateMIMETypeMutex.Unlock()
+	fake.ValidateMIMETypeStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateMIMETypeArgsForCall(i int) string {
+	fake.validateMIMETypeMutex.RLock()
+	defer fake.validateMIMETypeMutex.RUnlock()
+	argsForCall := fake.validateMIMETypeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateMIMETypeReturns(result1 error) {
+	fake.validateMIMETypeMutex.Lock()
+	defer fake.validateMIMETypeMutex.Unlock()
+	fake.ValidateMIMETypeStub = nil
+	fake.validateMIMETypeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateMIMETypeReturnsOnCall(i int, result1 error) {
+	fake.validateMIMETypeMutex.Lock()
+	defer fake.validateMIMETypeMutex.Unlock()
+	fake.ValidateMIMETypeStub = nil
+	if fake.validateMIMETypeReturnsOnCall == nil {
+		fake.validateMIMETypeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateMIMETypeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateNextUpstreamCondition(arg1 string) (bool, []string) {
+	fake.validateNextUpstreamConditionMutex.Lock()
+	ret, specificReturn := fake.validateNextUpstreamConditionReturnsOnCall[len(fake.validateNextUpstreamConditionArgsForCall)]
+	fake.validateNextUpstreamConditionArgsForCall = append(fake.validateNextUpstreamConditionArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateNextUpstreamConditionStub
+	fakeReturns := fake.validateNextUpstreamConditionReturns
+	fake.recordInvocation("ValidateNextUpstreamCondition", []interface{}{arg1})
+	fake.validateNextUpstreamConditionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePolicyValidator) ValidateNextUpstreamConditionCallCount() int {
+	fake.validateNextUpstreamConditionMutex.RLock()
+	defer fake.validateNextUpstreamConditionMutex.RUnlock()
+	return len(fake.validateNextUpstreamConditionArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateNextUpstreamConditionCalls(stub func(string) (bool, []string)) {
+	fake.validateNextUpstreamConditionMutex.Lock()
+	defer fake.validateNextUpstreamConditionMutex.Unlock()
+	fake.ValidateNextUpstreamConditionStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateNextUpstreamConditionArgsForCall(i int) string {
+	fake.validateNextUpstreamConditionMutex.RLock()
+	defer fake.validateNextUpstreamConditionMutex.RUnlock()
+	argsForCall := fake.validateNextUpstreamConditionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateNextUpstreamConditionReturns(result1 bool, result2 []string) {
+	fake.validateNextUpstreamConditionMutex.Lock()
+	defer fake.validateNextUpstreamConditionMutex.Unlock()
+	fake.ValidateNextUpstreamConditionStub = nil
+	fake.validateNextUpstreamConditionReturns = struct {
+		result1 bool
+		result2 []string
+	}{result1, result2}
+}
+
+func (fake *FakePolicyValidator) ValidateNextUpstreamConditionReturnsOnCall(i int, result1 bool, result2 []string) {
+	fake.validateNextUpstreamConditionMutex.Lock()
+	defer fake.validateNextUpstreamConditionMutex.Unlock()
+	fake.ValidateNextUpstreamConditionStub = nil
+	if fake.validateNextUpstreamConditionReturnsOnCall == nil {
+		fake.validateNextUpstreamConditionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 []string
+		})
+	}
+	fake.validateNextUpstreamConditionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 []string
+	}{result1, result2}
+}
+
+func (fake *FakePolicyValidator) ValidateRateLimitKeyName(arg1 string) error {
+	fake.validateRateLimitKeyNameMutex.Lock()
+	ret, specificReturn := fake.validateRateLimitKeyNameReturnsOnCall[len(fake.validateRateLimitKeyNameArgsForCall)]
+	fake.validateRateLimitKeyNameArgsForCall = append(fake.validateRateLimitKeyNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateRateLimitKeyNameStub
+	fakeReturns := fake.validateRateLimitKeyNameReturns
+	fake.recordInvocation("ValidateRateLimitKeyName", []interface{}{arg1})
+	fake.validateRateLimitKeyNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateRateLimitKeyNameCallCount() int {
+	fake.validateRateLimitKeyNameMutex.RLock()
+	defer fake.validateRateLimitKeyNameMutex.RUnlock()
+	return len(fake.validateRateLimitKeyNameArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateRateLimitKeyNameCalls(stub func(string) error) {
+	fake.validateRateLimitKeyNameMutex.Lock()
+	defer fake.validateRateLimitKeyNameMutex.Unlock()
+	fake.ValidateRateLimitKeyNameStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateRateLimitKeyNameArgsForCall(i int) string {
+	fake.validateRateLimitKeyNameMutex.RLock()
+	defer fake.validateRateLimitKeyNameMutex.RUnlock()
+	argsForCall := fake.validateRateLimitKeyNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateRateLimitKeyNameReturns(result1 error) {
+	fake.validateRateLimitKeyNameMutex.Lock()
+	defer fake.validateRateLimitKeyNameMutex.Unlock()
+	fake.ValidateRateLimitKeyNameStub = nil
+	fake.validateRateLimitKeyNameReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateRateLimitKeyNameReturnsOnCall(i int, result1 error) {
+	fake.validateRateLimitKeyNameMutex.Lock()
+	defer fake.validateRateLimitKeyNameMutex.Unlock()
+	fake.ValidateRateLimitKeyNameStub = nil
+	if fake.validateRateLimitKeyNameReturnsOnCall == nil {
+		fake.validateRateLimitKeyNameReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateRateLimitKeyNameReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateRateLimitRate(arg1 string) error {
+	fake.validateRateLimitRateMutex.Lock()
+	ret, specificReturn := fake.validateRateLimitRateReturnsOnCall[len(fake.validateRateLimitRateArgsForCall)]
+	fake.validateRateLimitRateArgsForCall = append(fake.validateRateLimitRateArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateRateLimitRateStub
+	fakeReturns := fake.validateRateLimitRateReturns
+	fake.recordInvocation("ValidateRateLimitRate", []interface{}{arg1})
+	fake.validateRateLimitRateMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateRateLimitRateCallCount() int {
+	fake.validateRateLimitRateMutex.RLock()
+	defer fake.validateRateLimitRateMutex.RUnlock()
+	return len(fake.validateRateLimitRateArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateRateLimitRateCalls(stub func(string) error) {
+	fake.validateRateLimitRateMutex.Lock()
+	defer fake.validateRateLimitRateMutex.Unlock()
+	fake.ValidateRateLimitRateStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateRateLimitRateArgsForCall(i int) string {
+	fake.validateRateLimitRateMutex.RLock()
+	defer fake.validateRateLimitRateMutex.RUnlock()
+	argsForCall := fake.validateRateLimitRateArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateRateLimitRateReturns(result1 error) {
+	fake.validateRateLimitRateMutex.Lock()
+	defer fake.validateRateLimitRateMutex.Unlock()
+	fake.ValidateRateLimitRateStub = nil
+	fake.validateRateLimitRateReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateRateLimitRateReturnsOnCall(i int, result1 error) {
+	fake.validateRateLimitRateMutex.Lock()
+	defer fake.validateRateLimitRateMutex.Unlock()
+	fake.ValidateRateLimitRateStub = nil
+	if fake.validateRateLimitRateReturnsOnCall == nil {
+		fake.validateRateLimitRateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateRateLimitRateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateRedirectURL(arg1 string) error {
+	fake.validateRedirectURLMutex.Lock()
+	ret, specificReturn := fake.validateRedirectURLReturnsOnCall[len(fake.validateRedirectURLArgsForCall)]
+	fake.validateRedirectURLArgsForCall = append(fake.validateRedirectURLArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateRedirectURLStub
+	fakeReturns := fake.validateRedirectURLReturns
+	fake.recordInvocation("ValidateRedirectURL", []interface{}{arg1})
+	fake.validateRedirectURLMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateRedirectURLCallCount() int {
+	fake.validateRedirectURLMutex.RLock()
+	defer fake.validateRedirectURLMutex.RUnlock()
+	return len(fake.validateRedirectURLArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateRedirectURLCalls(stub func(string) error) {
+	fake.validateRedirectURLMutex.Lock()
+	defer fake.validateRedirectURLMutex.Unlock()
+	fake.ValidateRedirectURLStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateRedirectURLArgsForCall(i int) string {
+	fake.validateRedirectURLMutex.RLock()
+	defer fake.validateRedirectURLMutex.RUnlock()
+	argsForCall := fake.validateRedirectURLArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateRedirectURLReturns(result1 error) {
+	fake.validateRedirectURLMutex.Lock()
+	defer fake.validateRedirectURLMutex.Unlock()
+	fake.ValidateRedirectURLStub = nil
+	fake.validateRedirectURLReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateRedirectURLReturnsOnCall(i int, result1 error) {
+	fake.validateRedirectURLMutex.Lock()
+	defer fake.validateRedirectURLMutex.Unlock()
+	fake.ValidateRedirectURLStub = nil
+	if fake.validateRedirectURLReturnsOnCall == nil {
+		fake.validateRedirectURLReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateRedirectURLReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateSize(arg1 string) error {
+	fake.validateSizeMutex.Lock()
+	ret, specificReturn := fake.validateSizeReturnsOnCall[len(fake.validateSizeArgsForCall)]
+	fake.validateSizeArgsForCall = append(fake.validateSizeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateSizeStub
+	fakeReturns := fake.validateSizeReturns
+	fake.recordInvocation("ValidateSize", []interface{}{arg1})
+	fake.validateSizeMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateSizeCallCount() int {
+	fake.validateSizeMutex.RLock()
+	defer fake.validateSizeMutex.RUnlock()
+	return len(fake.validateSizeArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateSizeCalls(stub func(string) error) {
+	fake.validateSizeMutex.Lock()
+	defer fake.validateSizeMutex.Unlock()
+	fake.ValidateSizeStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateSizeArgsForCall(i int) string {
+	fake.validateSizeMutex.RLock()
+	defer fake.validateSizeMutex.RUnlock()
+	argsForCall := fake.validateSizeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateSizeReturns(result1 error) {
+	fake.validateSizeMutex.Lock()
+	defer fake.validateSizeMutex.Unlock()
+	fake.ValidateSizeStub = nil
+	fake.validateSizeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateSizeReturnsOnCall(i int, result1 error) {
+	fake.validateSizeMutex.Lock()
+	defer fake.validateSizeMutex.Unlock()
+	fake.ValidateSizeStub = nil
+	if fake.validateSizeReturnsOnCall == nil {
+		fake.validateSizeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateSizeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateSyslogServer(arg1 string) error {
+	fake.validateSyslogServerMutex.Lock()
+	ret, specificReturn := fake.validateSyslogServerReturnsOnCall[len(fake.validateSyslogServerArgsForCall)]
+	fake.validateSyslogServerArgsForCall = append(fake.validateSyslogServerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateSyslogServerStub
+	fakeReturns := fake.validateSyslogServerReturns
+	fake.recordInvocation("ValidateSyslogServer", []interface{}{arg1})
+	fake.validateSyslogServerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateSyslogServerCallCount() int {
+	fake.validateSyslogServerMutex.RLock()
+	defer fake.validateSyslogServerMutex.RUnlock()
+	return len(fake.validateSyslogServerArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateSyslogServerCalls(stub func(string) error) {
+	fake.validateSyslogServerMutex.Lock()
+	defer fake.validateSyslogServerMutex.Unlock()
+	fake.ValidateSyslogServerStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateSyslogServerArgsForCall(i int) string {
+	fake.validateSyslogServerMutex.RLock()
+	defer fake.validateSyslogServerMutex.RUnlock()
+	argsForCall := fake.validateSyslogServerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateSyslogServerReturns(result1 error) {
+	fake.validateSyslogServerMutex.Lock()
+	defer fake.validateSyslogServerMutex.Unlock()
+	fake.ValidateSyslogServerStub = nil
+	fake.validateSyslogServerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateSyslogServerReturnsOnCall(i int, result1 error) {
+	fake.validateSyslogServerMutex.Lock()
+	defer fake.validateSyslogServerMutex.Unlock()
+	fake.ValidateSyslogServerStub = nil
+	if fake.validateSyslogServerReturnsOnCall == nil {
+		fake.validateSyslogServerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateSyslogServerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateSyslogTag(arg1 string) error {
+	fake.validateSyslogTagMutex.Lock()
+	ret, specificReturn := fake.validateSyslogTagReturnsOnCall[len(fake.validateSyslogTagArgsForCall)]
+	fake.validateSyslogTagArgsForCall = append(fake.validateSyslogTagArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateSyslogTagStub
+	fakeReturns := fake.validateSyslogTagReturns
+	fake.recordInvocation("ValidateSyslogTag", []interface{}{arg1})
+	fake.validateSyslogTagMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateSyslogTagCallCount() int {
+	fake.validateSyslogTagMutex.RLock()
+	defer fake.validateSyslogTagMutex.RUnlock()
+	return len(fake.validateSyslogTagArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateSyslogTagCalls(stub func(string) error) {
+	fake.validateSyslogTagMutex.Lock()
+	defer fake.validateSyslogTagMutex.Unlock()
+	fake.ValidateSyslogTagStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateSyslogTagArgsForCall(i int) string {
+	fake.validateSyslogTagMutex.RLock()
+	defer fake.validateSyslogTagMutex.RUnlock()
+	argsForCall := fake.validateSyslogTagArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateSyslogTagReturns(result1 error) {
+	fake.validateSyslogTagMutex.Lock()
+	defer fake.validateSyslogTagMutex.Unlock()
+	fake.ValidateSyslogTagStub = nil
+	fake.validateSyslogTagReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateSyslogTagReturnsOnCall(i int, result1 error) {
+	fake.validateSyslogTagMutex.Lock()
+	defer fake.validateSyslogTagMutex.Unlock()
+	fake.ValidateSyslogTagStub = nil
+	if fake.validateSyslogTagReturnsOnCall == nil {
+		fake.validateSyslogTagReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateSyslogTagReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateTraceContext(arg1 string) (bool, []string) {
+	fake.validateTraceContextMutex.Lock()
+	ret, specificReturn := fake.validateTraceContextReturnsOnCall[len(fake.validateTraceContextArgsForCall)]
+	fake.validateTraceContextArgsForCall = append(fake.validateTraceContextArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateTraceContextStub
+	fakeReturns := fake.validateTraceContextReturns
+	fake.recordInvocation("ValidateTraceContext", []interface{}{arg1})
+	fake.validateTraceContextMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePolicyValidator) ValidateTraceContextCallCount() int {
+	fake.validateTraceContextMutex.RLock()
+	defer fake.validateTraceContextMutex.RUnlock()
+	return len(fake.validateTraceContextArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateTraceContextCalls(stub func(string) (bool, []string)) {
+	fake.validateTraceContextMutex.Lock()
+	defer fake.validateTraceContextMutex.Unlock()
+	fake.ValidateTraceContextStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateTraceContextArgsForCall(i int) string {
+	fake.validateTraceContextMutex.RLock()
+	defer fake.validateTraceContextMutex.RUnlock()
+	argsForCall := fake.validateTraceContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateTraceContextReturns(result1 bool, result2 []string) {
+	fake.validateTraceContextMutex.Lock()
+	defer fake.validateTraceContextMutex.Unlock()
+	fake.ValidateTraceContextStub = nil
+	fake.validateTraceContextReturns = struct {
+		result1 bool
+		result2 []string
+	}{result1, result2}
+}
+
+func (fake *FakePolicyValidator) ValidateTraceContextReturnsOnCall(i int, result1 bool, result2 []string) {
+	fake.validateTraceContextMutex.Lock()
+	defer fake.validateTraceContextMutex.Unlock()
+	fake.ValidateTraceContextStub = nil
+	if fake.validateTraceContextReturnsOnCall == nil {
+		fake.validateTraceContextReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 []string
+		})
+	}
+	fake.validateTraceContextReturnsOnCall[i] = struct {
+		result1 bool
+		result2 []string
+	}{result1, result2}
+}
+
+func (fake *FakePolicyValidator) ValidateTracingEndpoint(arg1 string) error {
+	fake.validateTracingEndpointMutex.Lock()
+	ret, specificReturn := fake.validateTracingEndpointReturnsOnCall[len(fake.validateTracingEndpointArgsForCall)]
+	fake.validateTracingEndpointArgsForCall = append(fake.validateTracingEndpointArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateTracingEndpointStub
+	fakeReturns := fake.validateTracingEndpointReturns
+	fake.recordInvocation("ValidateTracingEndpoint", []interface{}{arg1})
+	fake.validateTracingEndpointMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateTracingEndpointCallCount() int {
+	fake.validateTracingEndpointMutex.RLock()
+	defer fake.validateTracingEndpointMutex.RUnlock()
+	return len(fake.validateTracingEndpointArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateTracingEndpointCalls(stub func(string) error) {
+	fake.validateTracingEndpointMutex.Lock()
+	defer fake.validateTracingEndpointMutex.Unlock()
+	fake.ValidateTracingEndpointStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateTracingEndpointArgsForCall(i int) string {
+	fake.validateTracingEndpointMutex.RLock()
+	defer fake.validateTracingEndpointMutex.RUnlock()
+	argsForCall := fake.validateTracingEndpointArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateTracingEndpointReturns(result1 error) {
+	fake.validateTracingEndpointMutex.Lock()
+	defer fake.validateTracingEndpointMutex.Unlock()
+	fake.ValidateTracingEndpointStub = nil
+	fake.validateTracingEndpointReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateTracingEndpointReturnsOnCall(i int, result1 error) {
+	fake.validateTracingEndpointMutex.Lock()
+	defer fake.validateTracingEndpointMutex.Unlock()
+	fake.ValidateTracingEndpointStub = nil
+	if fake.validateTracingEndpointReturnsOnCall == nil {
+		fake.validateTracingEndpointReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateTracingEndpointReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateTracingServiceName(arg1 string) error {
+	fake.validateTracingServiceNameMutex.Lock()
+	ret, specificReturn := fake.validateTracingServiceNameReturnsOnCall[len(fake.validateTracingServiceNameArgsForCall)]
+	fake.validateTracingServiceNameArgsForCall = append(fake.validateTracingServiceNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.ValidateTracingServiceNameStub
+	fakeReturns := fake.validateTracingServiceNameReturns
+	fake.recordInvocation("ValidateTracingServiceName", []interface{}{arg1})
+	fake.validateTracingServiceNameMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakePolicyValidator) ValidateTracingServiceNameCallCount() int {
+	fake.validateTracingServiceNameMutex.RLock()
+	defer fake.validateTracingServiceNameMutex.RUnlock()
+	return len(fake.validateTracingServiceNameArgsForCall)
+}
+
+func (fake *FakePolicyValidator) ValidateTracingServiceNameCalls(stub func(string) error) {
+	fake.validateTracingServiceNameMutex.Lock()
+	defer fake.validateTracingServiceNameMutex.Unlock()
+	fake.ValidateTracingServiceNameStub = stub
+}
+
+func (fake *FakePolicyValidator) ValidateTracingServiceNameArgsForCall(i int) string {
+	fake.validateTracingServiceNameMutex.RLock()
+	defer fake.validateTracingServiceNameMutex.RUnlock()
+	argsForCall := fake.validateTracingServiceNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePolicyValidator) ValidateTracingServiceNameReturns(result1 error) {
+	fake.validateTracingServiceNameMutex.Lock()
+	defer fake.validateTracingServiceNameMutex.Unlock()
+	fake.ValidateTracingServiceNameStub = nil
+	fake.validateTracingServiceNameReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) ValidateTracingServiceNameReturnsOnCall(i int, result1 error) {
+	fake.validateTracingServiceNameMutex.Lock()
+	defer fake.validateTracingServiceNameMutex.Unlock()
+	fake.ValidateTracingServiceNameStub = nil
+	if fake.validateTracingServiceNameReturnsOnCall == nil {
+		fake.validateTracingServiceNameReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateTracingServiceNameReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePolicyValidator) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.validateAccessLogDestinationTypeMutex.RLock()
+	defer fake.validateAccessLogDestinationTypeMutex.RUnlock()
+	fake.validateAccessLogFormatMutex.RLock()
+	defer fake.validateAccessLogFormatMutex.RUnlock()
+	fake.validateCORSHeaderNameMutex.RLock()
+	defer fake.validateCORSHeaderNameMutex.RUnlock()
+	fake.validateCORSOriginMutex.RLock()
+	defer fake.validateCORSOriginMutex.RUnlock()
+	fake.validateCacheBypassNameMutex.RLock()
+	defer fake.validateCacheBypassNameMutex.RUnlock()
+	fake.validateCacheKeyMutex.RLock()
+	defer fake.validateCacheKeyMutex.RUnlock()
+	fake.validateCacheZoneNameMutex.RLock()
+	defer fake.validateCacheZoneNameMutex.RUnlock()
+	fake.validateContentTypeMutex.RLock()
+	defer fake.validateContentTypeMutex.RUnlock()
+	fake.validateDurationMutex.RLock()
+	defer fake.validateDurationMutex.RUnlock()
+	fake.validateIPAddressOrCIDRMutex.RLock()
+	defer fake.validateIPAddressOrCIDRMutex.RUnlock()
+	fake.validateLoadBalancingHashKeyMutex.RLock()
+	defer fake.validateLoadBalancingHashKeyMutex.RUnlock()
+	fake.validateLoadBalancingMethodMutex.RLock()
+	defer fake.validateLoadBalancingMethodMutex.RUnlock()
+	fake.validateMIMETypeMutex.RLock()
+	defer fake.validateMIMETypeMutex.RUnlock()
+	fake.validateNextUpstreamConditionMutex.RLock()
+	defer fake.validateNextUpstreamConditionMutex.RUnlock()
+	fake.validateRateLimitKeyNameMutex.RLock()
+	defer fake.validateRateLimitKeyNameMutex.RUnlock()
+	fake.validateRateLimitRateMutex.RLock()
+	defer fake.validateRateLimitRateMutex.RUnlock()
+	fake.validateRedirectURLMutex.RLock()
+	defer fake.validateRedirectURLMutex.RUnlock()
+	fake.validateSizeMutex.RLock()
+	defer fake.validateSizeMutex.RUnlock()
+	fake.validateSyslogServerMutex.RLock()
+	defer fake.validateSyslogServerMutex.RUnlock()
+	fake.validateSyslogTagMutex.RLock()
+	defer fake.validateSyslogTagMutex.RUnlock()
+	fake.validateTraceContextMutex.RLock()
+	defer fake.validateTraceContextMutex.RUnlock()
+	fake.validateTracingEndpointMutex.RLock()
+	defer fake.validateTracingEndpointMutex.RUnlock()
+	fake.validateTracingServiceNameMutex.RLock()
+	defer fake.validateTracingServiceNameMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePolicyValidator) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ validation.PolicyValidator = new(FakePolicyValidator)
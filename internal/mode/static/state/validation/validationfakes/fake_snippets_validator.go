@@ -0,0 +1,113 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package validationfakes
+
+import (
+	"sync"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+type FakeSnippetsValidator struct {
+	ValidateSnippetStub        func(string, string) error
+	validateSnippetMutex       sync.RWMutex
+	validateSnippetArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	validateSnippetReturns struct {
+		result1 error
+	}
+	validateSnippetReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSnippetsValidator) ValidateSnippet(arg1 string, arg2 string) error {
+	fake.validateSnippetMutex.Lock()
+	ret, specificReturn := fake.validateSnippetReturnsOnCall[len(fake.validateSnippetArgsForCall)]
+	fake.validateSnippetArgsForCall = append(fake.validateSnippetArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.ValidateSnippetStub
+	fakeReturns := fake.validateSnippetReturns
+	fake.recordInvocation("ValidateSnippet", []interface{}{arg1, arg2})
+	fake.validateSnippetMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeSnippetsValidator) ValidateSnippetCallCount() int {
+	fake.validateSnippetMutex.RLock()
+	defer fake.validateSnippetMutex.RUnlock()
+	return len(fake.validateSnippetArgsForCall)
+}
+
+func (fake *FakeSnippetsValidator) ValidateSnippetCalls(stub func(string, string) error) {
+	fake.validateSnippetMutex.Lock()
+	defer fake.validateSnippetMutex.Unlock()
+	fake.ValidateSnippetStub = stub
+}
+
+func (fake *FakeSnippetsValidator) ValidateSnippetArgsForCall(i int) (string, string) {
+	fake.validateSnippetMutex.RLock()
+	defer fake.validateSnippetMutex.RUnlock()
+	argsForCall := fake.validateSnippetArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSnippetsValidator) ValidateSnippetReturns(result1 error) {
+	fake.validateSnippetMutex.Lock()
+	defer fake.validateSnippetMutex.Unlock()
+	fake.ValidateSnippetStub = nil
+	fake.validateSnippetReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSnippetsValidator) ValidateSnippetReturnsOnCall(i int, result1 error) {
+	fake.validateSnippetMutex.Lock()
+	defer fake.validateSnippetMutex.Unlock()
+	fake.ValidateSnippetStub = nil
+	if fake.validateSnippetReturnsOnCall == nil {
+		fake.validateSnippetReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateSnippetReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSnippetsValidator) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.validateSnippetMutex.RLock()
+	defer fake.validateSnippetMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeSnippetsValidator) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ validation.SnippetsValidator = new(FakeSnippetsValidator)
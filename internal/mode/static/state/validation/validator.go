@@ -6,6 +6,9 @@ package validation
 // the field is valid.
 type Validators struct {
 	HTTPFieldsValidator HTTPFieldsValidator
+	PolicyValidator     PolicyValidator
+	// SnippetsValidator is nil if the snippets are disabled.
+	SnippetsValidator SnippetsValidator
 }
 
 // HTTPFieldsValidator validates the HTTP-related fields of Gateway API resources from the perspective of
@@ -25,4 +28,50 @@ type HTTPFieldsValidator interface {
 	ValidateRedirectStatusCode(statusCode int) (valid bool, supportedValues []string)
 	ValidateRequestHeaderName(name string) error
 	ValidateRequestHeaderValue(value string) error
+	ValidateAuthenticationRealm(realm string) error
+	ValidateExternalAuthPath(path string) error
+	ValidateExternalAuthHeaderName(name string) error
+	ValidateDirectResponseStatusCode(statusCode int32) error
+	ValidateDirectResponseBody(body string) error
+	ValidateResponseHeaderName(name string) error
+	ValidateResponseHeaderValue(value string) error
+}
+
+// PolicyValidator validates the fields of NKG policies from the perspective of a data-plane.
+// Data-plane implementations must implement this interface.
+//
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . PolicyValidator
+type PolicyValidator interface {
+	ValidateLoadBalancingMethod(method string) (valid bool, supportedValues []string)
+	ValidateLoadBalancingHashKey(key string) error
+	ValidateDuration(duration string) error
+	ValidateNextUpstreamCondition(condition string) (valid bool, supportedValues []string)
+	ValidateRateLimitRate(rate string) error
+	ValidateRateLimitKeyName(name string) error
+	ValidateSize(size string) error
+	ValidateTracingEndpoint(endpoint string) error
+	ValidateTracingServiceName(name string) error
+	ValidateTraceContext(context string) (valid bool, supportedValues []string)
+	ValidateAccessLogFormat(format string) (valid bool, supportedValues []string)
+	ValidateAccessLogDestinationType(destinationType string) (valid bool, supportedValues []string)
+	ValidateSyslogServer(server string) error
+	ValidateSyslogTag(tag string) error
+	ValidateCORSOrigin(origin string) error
+	ValidateCORSHeaderName(name string) error
+	ValidateIPAddressOrCIDR(address string) error
+	ValidateCacheZoneName(name string) error
+	ValidateCacheKey(key string) error
+	ValidateCacheBypassName(name string) error
+	ValidateMIMEType(mimeType string) error
+	ValidateContentType(contentType string) error
+	ValidateRedirectURL(url string) error
+}
+
+// SnippetsValidator validates the NGINX configuration snippets of SnippetsPolicies.
+// Data-plane implementations must implement this interface.
+//
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . SnippetsValidator
+type SnippetsValidator interface {
+	// ValidateSnippet validates the snippet for the context: HTTP, Server or Location.
+	ValidateSnippet(snippetContext string, snippet string) error
 }
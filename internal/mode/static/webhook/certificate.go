@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"sync"
+	"time"
+
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// getSecretTimeout is a timeout for getting the Secret during a TLS handshake.
+const getSecretTimeout = 5 * time.Second
+
+// SecretCertificateProvider provides the TLS certificate of the webhook server from a Secret of the type
+// kubernetes.io/tls.
+// The provider gets the Secret during every TLS handshake, so that a rotated certificate is used without a restart.
+// To avoid requests to the Kubernetes API server, use a Reader backed by a cache.
+// The certificate is only parsed when the Secret changes.
+type SecretCertificateProvider struct {
+	reader client.Reader
+	cert   *tls.Certificate
+
+	secretNsName    types.NamespacedName
+	resourceVersion string
+
+	lock sync.Mutex
+}
+
+// NewSecretCertificateProvider creates a new SecretCertificateProvider.
+func NewSecretCertificateProvider(reader client.Reader, secretNsName types.NamespacedName) *SecretCertificateProvider {
+	return &SecretCertificateProvider{
+		reader:       reader,
+		secretNsName: secretNsName,
+	}
+}
+
+// GetCertificate returns the certificate from the Secret.
+// It has the signature of the GetCertificate field of tls.Config.
+func (p *SecretCertificateProvider) GetCertificate(_ *tls.ClientHelloInfo) (*tls.Certificate, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), getSecretTimeout)
+	defer cancel()
+
+	var secret apiv1.Secret
+	if err := p.reader.Get(ctx, p.secretNsName, &secret); err != nil {
+		return nil, fmt.Errorf("failed to get Secret %s: %w", p.secretNsName, err)
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.cert != nil && secret.ResourceVersion == p.resourceVersion {
+		return p.cert, nil
+	}
+
+	if secret.Type != apiv1.SecretTypeTLS {
+		return nil, fmt.Errorf("secret %s must be of the type %s", p.secretNsName, apiv1.SecretTypeTLS)
+	}
+
+	cert, err := tls.X509KeyPair(secret.Data[apiv1.TLSCertKey], secret.Data[apiv1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the certificate from Secret %s: %w", p.secretNsName, err)
+	}
+
+	p.cert = &cert
+	p.resourceVersion = secret.ResourceVersion
+
+	return p.cert, nil
+}
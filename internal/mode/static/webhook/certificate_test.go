@@ -0,0 +1,124 @@
+package webhook
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func generateCertAndKey(commonName string) (cert, key []byte) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		panic(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	if err != nil {
+		panic(err)
+	}
+
+	keyDER, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		panic(err)
+	}
+
+	cert = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	key = pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+
+	return cert, key
+}
+
+func TestSecretCertificateProvider(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(apiv1.AddToScheme(scheme)).To(Succeed())
+
+	secretNsName := types.NamespacedName{Namespace: "nginx-gateway", Name: "webhook-cert"}
+
+	cert, key := generateCertAndKey("webhook-v1")
+	secret := &apiv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: secretNsName.Namespace,
+			Name:      secretNsName.Name,
+		},
+		Type: apiv1.SecretTypeTLS,
+		Data: map[string][]byte{
+			apiv1.TLSCertKey:       cert,
+			apiv1.TLSPrivateKeyKey: key,
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	provider := NewSecretCertificateProvider(fakeClient, secretNsName)
+
+	// missing Secret
+
+	result, err := provider.GetCertificate(nil)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(result).To(BeNil())
+
+	// valid Secret
+
+	g.Expect(fakeClient.Create(context.Background(), secret)).To(Succeed())
+
+	first, err := provider.GetCertificate(nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(first.Leaf.Subject.CommonName).To(Equal("webhook-v1"))
+
+	// unchanged Secret: the parsed certificate is reused
+
+	result, err = provider.GetCertificate(nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result).To(BeIdenticalTo(first))
+
+	// rotated certificate
+
+	cert, key = generateCertAndKey("webhook-v2")
+	secret.Data[apiv1.TLSCertKey] = cert
+	secret.Data[apiv1.TLSPrivateKeyKey] = key
+	g.Expect(fakeClient.Update(context.Background(), secret)).To(Succeed())
+
+	result, err = provider.GetCertificate(nil)
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Leaf.Subject.CommonName).To(Equal("webhook-v2"))
+
+	// invalid certificate
+
+	secret.Data[apiv1.TLSCertKey] = []byte("invalid")
+	g.Expect(fakeClient.Update(context.Background(), secret)).To(Succeed())
+
+	result, err = provider.GetCertificate(nil)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(result).To(BeNil())
+
+	// wrong Secret type
+
+	secret.Type = apiv1.SecretTypeOpaque
+	g.Expect(fakeClient.Update(context.Background(), secret)).To(Succeed())
+
+	result, err = provider.GetCertificate(nil)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(result).To(BeNil())
+}
@@ -0,0 +1,14 @@
+/*
+Package webhook implements an optional validating admission webhook for the static mode.
+
+The webhook validates Gateway and HTTPRoute resources with the same NGINX-specific validation that NKG runs when it
+builds the Graph, so that users learn about unsupported values at admission time rather than from the resource
+statuses.
+
+The package includes:
+- Validators for Gateway and HTTPRoute resources, which either reject the resources or allow them with warnings.
+- SecretCertificateProvider, which loads the TLS certificate of the webhook server from a Secret and picks up
+the rotated certificate without a restart.
+- NewServer, which creates the webhook server with the validators registered.
+*/
+package webhook
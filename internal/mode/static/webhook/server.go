@@ -0,0 +1,47 @@
+package webhook
+
+import (
+	"crypto/tls"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+const (
+	// GatewayPath is the path of the endpoint that validates Gateways.
+	GatewayPath = "/validate-gateway"
+	// HTTPRoutePath is the path of the endpoint that validates HTTPRoutes.
+	HTTPRoutePath = "/validate-httproute"
+)
+
+// ServerConfig holds configuration parameters for the webhook server.
+type ServerConfig struct {
+	// CertSecretNsName is the namespaced name of the Secret with the TLS certificate of the server.
+	CertSecretNsName types.NamespacedName
+	// Validator configures the validators.
+	Validator ValidatorConfig
+	// Port is the port the server listens on.
+	Port int
+}
+
+// NewServer creates a new webhook server with the Gateway and HTTPRoute validators registered.
+// The server gets its TLS certificate from a Secret using the Reader of the Validator config.
+//
+// The server implements the manager.Runnable interface from sigs.k8s.io/controller-runtime.
+func NewServer(cfg ServerConfig) webhook.Server {
+	certProvider := NewSecretCertificateProvider(cfg.Validator.Reader, cfg.CertSecretNsName)
+
+	server := webhook.NewServer(webhook.Options{
+		Port: cfg.Port,
+		TLSOpts: []func(*tls.Config){
+			func(c *tls.Config) {
+				c.GetCertificate = certProvider.GetCertificate
+			},
+		},
+	})
+
+	server.Register(GatewayPath, &webhook.Admission{Handler: NewGatewayValidator(cfg.Validator)})
+	server.Register(HTTPRoutePath, &webhook.Admission{Handler: NewHTTPRouteValidator(cfg.Validator)})
+
+	return server
+}
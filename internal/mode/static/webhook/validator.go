@@ -0,0 +1,173 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+	gwapivalidation "sigs.k8s.io/gateway-api/apis/v1beta1/validation"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/graph"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation"
+)
+
+// Mode defines how the validators respond to a resource that NKG doesn't support.
+type Mode string
+
+const (
+	// ModeReject makes the validators reject the resource.
+	ModeReject Mode = "reject"
+	// ModeWarn makes the validators allow the resource with warnings.
+	ModeWarn Mode = "warn"
+)
+
+// ValidatorConfig holds configuration parameters for the validators.
+type ValidatorConfig struct {
+	// Reader is used to get the Gateways referenced by HTTPRoutes.
+	Reader client.Reader
+	// Decoder decodes the resources from the admission requests.
+	Decoder *admission.Decoder
+	// HTTPFieldsValidator validates the HTTP-related fields of HTTPRoutes.
+	HTTPFieldsValidator validation.HTTPFieldsValidator
+	// GatewayClassName is the name of the GatewayClass resource.
+	// Only the resources that belong to the GatewayClass are validated.
+	GatewayClassName string
+	// Mode defines how the validators respond to a resource that NKG doesn't support.
+	Mode Mode
+}
+
+// GatewayValidator validates Gateway resources.
+//
+// GatewayValidator implements the admission.Handler interface from sigs.k8s.io/controller-runtime.
+type GatewayValidator struct {
+	cfg ValidatorConfig
+}
+
+// NewGatewayValidator creates a new GatewayValidator.
+func NewGatewayValidator(cfg ValidatorConfig) *GatewayValidator {
+	return &GatewayValidator{cfg: cfg}
+}
+
+// Handle validates the Gateway from the admission request.
+func (v *GatewayValidator) Handle(_ context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("")
+	}
+
+	var gw v1beta1.Gateway
+	if err := v.cfg.Decoder.Decode(req, &gw); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if string(gw.Spec.GatewayClassName) != v.cfg.GatewayClassName {
+		return admission.Allowed("")
+	}
+
+	// The Graph validation assumes that the resource is valid according to the Gateway API webhook validation.
+	if errs := gwapivalidation.ValidateGateway(&gw); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return respond(v.cfg.Mode, graph.ValidateListeners(&gw))
+}
+
+// HTTPRouteValidator validates HTTPRoute resources.
+//
+// HTTPRouteValidator implements the admission.Handler interface from sigs.k8s.io/controller-runtime.
+type HTTPRouteValidator struct {
+	cfg ValidatorConfig
+}
+
+// NewHTTPRouteValidator creates a new HTTPRouteValidator.
+func NewHTTPRouteValidator(cfg ValidatorConfig) *HTTPRouteValidator {
+	return &HTTPRouteValidator{cfg: cfg}
+}
+
+// Handle validates the HTTPRoute from the admission request.
+// The HTTPRoute is only validated if it references at least one Gateway that belongs to the GatewayClass.
+func (v *HTTPRouteValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("")
+	}
+
+	var hr v1beta1.HTTPRoute
+	if err := v.cfg.Decoder.Decode(req, &hr); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	referencesGateway, err := v.referencesGatewayOfClass(ctx, &hr)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	if !referencesGateway {
+		return admission.Allowed("")
+	}
+
+	// The Graph validation assumes that the resource is valid according to the Gateway API webhook validation.
+	if errs := gwapivalidation.ValidateHTTPRoute(&hr); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+
+	return respond(v.cfg.Mode, graph.ValidateHTTPRoute(v.cfg.HTTPFieldsValidator, &hr))
+}
+
+func (v *HTTPRouteValidator) referencesGatewayOfClass(ctx context.Context, hr *v1beta1.HTTPRoute) (bool, error) {
+	for _, ref := range hr.Spec.ParentRefs {
+		if ref.Group != nil && *ref.Group != v1beta1.GroupName {
+			continue
+		}
+		if ref.Kind != nil && *ref.Kind != "Gateway" {
+			continue
+		}
+
+		nsname := types.NamespacedName{Namespace: hr.Namespace, Name: string(ref.Name)}
+		if ref.Namespace != nil {
+			nsname.Namespace = string(*ref.Namespace)
+		}
+
+		var gw v1beta1.Gateway
+		if err := v.cfg.Reader.Get(ctx, nsname, &gw); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return false, fmt.Errorf("failed to get Gateway %s: %w", nsname, err)
+		}
+
+		if string(gw.Spec.GatewayClassName) == v.cfg.GatewayClassName {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func respond(mode Mode, errs field.ErrorList) admission.Response {
+	if len(errs) == 0 {
+		return admission.Allowed("")
+	}
+
+	if mode == ModeWarn {
+		warnings := make([]string, 0, len(errs))
+		for _, err := range errs {
+			warnings = append(warnings, "NKG doesn't support the value: "+err.Error())
+		}
+
+		return admission.Allowed("").WithWarnings(warnings...)
+	}
+
+	msgs := make([]string, 0, len(errs))
+	for _, err := range errs {
+		msgs = append(msgs, err.Error())
+	}
+
+	return admission.Denied("NKG doesn't support the values: " + strings.Join(msgs, "; "))
+}
@@ -0,0 +1,259 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/framework/helpers"
+	"github.com/nginxinc/nginx-kubernetes-gateway/internal/mode/static/state/validation/validationfakes"
+)
+
+const gcName = "nginx"
+
+func createScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		panic(err)
+	}
+	return scheme
+}
+
+func createRequest(op admissionv1.Operation, obj runtime.Object) admission.Request {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		panic(err)
+	}
+
+	return admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Operation: op,
+			Object:    runtime.RawExtension{Raw: raw},
+		},
+	}
+}
+
+func createGateway(className string, hostname v1beta1.Hostname) *v1beta1.Gateway {
+	return &v1beta1.Gateway{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1beta1.GroupVersion.String(),
+			Kind:       "Gateway",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gateway",
+		},
+		Spec: v1beta1.GatewaySpec{
+			GatewayClassName: v1beta1.ObjectName(className),
+			Listeners: []v1beta1.Listener{
+				{
+					Name:     "http",
+					Port:     80,
+					Protocol: v1beta1.HTTPProtocolType,
+					Hostname: &hostname,
+				},
+			},
+		},
+	}
+}
+
+func createHTTPRoute(gwName string, path string) *v1beta1.HTTPRoute {
+	return &v1beta1.HTTPRoute{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: v1beta1.GroupVersion.String(),
+			Kind:       "HTTPRoute",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "hr",
+		},
+		Spec: v1beta1.HTTPRouteSpec{
+			CommonRouteSpec: v1beta1.CommonRouteSpec{
+				ParentRefs: []v1beta1.ParentReference{
+					{
+						Name: v1beta1.ObjectName(gwName),
+					},
+				},
+			},
+			Hostnames: []v1beta1.Hostname{"foo.example.com"},
+			Rules: []v1beta1.HTTPRouteRule{
+				{
+					Matches: []v1beta1.HTTPRouteMatch{
+						{
+							Path: &v1beta1.HTTPPathMatch{
+								Type:  helpers.GetPointer(v1beta1.PathMatchPathPrefix),
+								Value: helpers.GetPointer(path),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestGatewayValidator(t *testing.T) {
+	tests := []struct {
+		req            admission.Request
+		name           string
+		mode           Mode
+		expectAllowed  bool
+		expectWarnings bool
+		expectCode     int32
+	}{
+		{
+			req:           createRequest(admissionv1.Create, createGateway(gcName, "foo.example.com")),
+			mode:          ModeReject,
+			expectAllowed: true,
+			expectCode:    http.StatusOK,
+			name:          "valid",
+		},
+		{
+			req:           createRequest(admissionv1.Create, createGateway(gcName, "$example.com")),
+			mode:          ModeReject,
+			expectAllowed: false,
+			expectCode:    http.StatusForbidden,
+			name:          "invalid hostname, reject",
+		},
+		{
+			req:            createRequest(admissionv1.Update, createGateway(gcName, "$example.com")),
+			mode:           ModeWarn,
+			expectAllowed:  true,
+			expectCode:     http.StatusOK,
+			expectWarnings: true,
+			name:           "invalid hostname, warn",
+		},
+		{
+			req:           createRequest(admissionv1.Create, createGateway("other", "$example.com")),
+			mode:          ModeReject,
+			expectAllowed: true,
+			expectCode:    http.StatusOK,
+			name:          "another GatewayClass",
+		},
+		{
+			req:           createRequest(admissionv1.Delete, createGateway(gcName, "$example.com")),
+			mode:          ModeReject,
+			expectAllowed: true,
+			expectCode:    http.StatusOK,
+			name:          "delete",
+		},
+		{
+			req: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Operation: admissionv1.Create,
+					Object:    runtime.RawExtension{Raw: []byte("invalid")},
+				},
+			},
+			mode:          ModeReject,
+			expectAllowed: false,
+			expectCode:    http.StatusBadRequest,
+			name:          "undecodable",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			v := NewGatewayValidator(ValidatorConfig{
+				Decoder:          admission.NewDecoder(createScheme()),
+				GatewayClassName: gcName,
+				Mode:             test.mode,
+			})
+
+			resp := v.Handle(context.Background(), test.req)
+			g.Expect(resp.Allowed).To(Equal(test.expectAllowed))
+			g.Expect(resp.Warnings != nil).To(Equal(test.expectWarnings))
+			g.Expect(resp.Result.Code).To(Equal(test.expectCode))
+		})
+	}
+}
+
+func TestHTTPRouteValidator(t *testing.T) {
+	const invalidPath = "/invalid"
+
+	scheme := createScheme()
+
+	gw := createGateway(gcName, "foo.example.com")
+	otherGw := createGateway("other", "foo.example.com")
+	otherGw.Name = "other-gateway"
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(gw, otherGw).Build()
+
+	validator := &validationfakes.FakeHTTPFieldsValidator{
+		ValidatePathInMatchStub: func(path string) error {
+			if path == invalidPath {
+				return errors.New("invalid path")
+			}
+			return nil
+		},
+	}
+
+	tests := []struct {
+		req            admission.Request
+		name           string
+		mode           Mode
+		expectAllowed  bool
+		expectWarnings bool
+	}{
+		{
+			req:           createRequest(admissionv1.Create, createHTTPRoute("gateway", "/")),
+			mode:          ModeReject,
+			expectAllowed: true,
+			name:          "valid",
+		},
+		{
+			req:           createRequest(admissionv1.Create, createHTTPRoute("gateway", invalidPath)),
+			mode:          ModeReject,
+			expectAllowed: false,
+			name:          "invalid path, reject",
+		},
+		{
+			req:            createRequest(admissionv1.Update, createHTTPRoute("gateway", invalidPath)),
+			mode:           ModeWarn,
+			expectAllowed:  true,
+			expectWarnings: true,
+			name:           "invalid path, warn",
+		},
+		{
+			req:           createRequest(admissionv1.Create, createHTTPRoute("other-gateway", invalidPath)),
+			mode:          ModeReject,
+			expectAllowed: true,
+			name:          "Gateway of another GatewayClass",
+		},
+		{
+			req:           createRequest(admissionv1.Create, createHTTPRoute("missing", invalidPath)),
+			mode:          ModeReject,
+			expectAllowed: true,
+			name:          "missing Gateway",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			v := NewHTTPRouteValidator(ValidatorConfig{
+				Reader:              fakeClient,
+				Decoder:             admission.NewDecoder(scheme),
+				HTTPFieldsValidator: validator,
+				GatewayClassName:    gcName,
+				Mode:                test.mode,
+			})
+
+			resp := v.Handle(context.Background(), test.req)
+			g.Expect(resp.Allowed).To(Equal(test.expectAllowed))
+			g.Expect(resp.Warnings != nil).To(Equal(test.expectWarnings))
+		})
+	}
+}